@@ -18,9 +18,41 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// LabelContentDigest is set on a Model to the short form of its content digest,
+// so Models holding identical content can be found with a label selector
+const LabelContentDigest = "models.main-currents.news/content-digest"
+
+// AnnotationRebuildStatus asks the controller to rebuild a Model's status from
+// its PVC and Jobs, for example after a restore that dropped the status. The
+// controller removes the annotation once the status is rebuilt.
+const AnnotationRebuildStatus = "models.main-currents.news/rebuild-status"
+
+// AnnotationLeaseDuration is set on a Job that consumes models, as a duration
+// such as "2h". The Job holds a lease on each model it injects until the Job
+// finishes or the duration has passed since it was created, whichever is
+// first, instead of pinning the models for as long as it exists.
+const AnnotationLeaseDuration = "models.main-currents.news/lease-duration"
+
+// AnnotationExpectedContentDigest is set on a Model imported from a bundle to
+// the content digest of the exported Model. A download that produces any
+// other content fails, so a promoted model is identical to the one validated.
+const AnnotationExpectedContentDigest = "models.main-currents.news/expected-content-digest"
+
+// AnnotationFreeze set to "true" on a Ready Model holds every change the
+// controller would otherwise make to the files on its own, such as rebuilding
+// the engine after spec.conversion changes or downloading again after a
+// re-verification, for change-freeze windows. Removing it applies what was held.
+const AnnotationFreeze = "models.main-currents.news/freeze"
+
+// AnnotationAdopt set to "true" on a PVC or Job that has the name the
+// operator would give it lets the Model of that name adopt it. Without it the
+// Model reports a ResourceConflict condition and leaves the object alone.
+const AnnotationAdopt = "models.main-currents.news/adopt"
+
 // ModelPhase represents the current phase of a Model
 type ModelPhase string
 
@@ -31,6 +63,24 @@ const (
 	ModelPhaseFailed      ModelPhase = "Failed"
 )
 
+// FailureReason is a machine-readable reason a Model is Failed
+type FailureReason string
+
+const (
+	// FailureReasonDownloadFailed is any failure without a more specific reason
+	FailureReasonDownloadFailed FailureReason = "DownloadFailed"
+	// FailureReasonNoSource means spec.source sets none of its fields
+	FailureReasonNoSource FailureReason = "NoSource"
+	// FailureReasonMultipleSources means spec.source sets more than one field
+	FailureReasonMultipleSources FailureReason = "MultipleSources"
+	// FailureReasonInvalidStorageSize means spec.storage.size is not a
+	// positive quantity
+	FailureReasonInvalidStorageSize FailureReason = "InvalidStorageSize"
+	// FailureReasonInvalidDownloadVolumes means spec.download.extraVolumes
+	// or extraVolumeMounts clash with the download pod's own
+	FailureReasonInvalidDownloadVolumes FailureReason = "InvalidDownloadVolumes"
+)
+
 // HuggingFaceSource defines configuration for downloading from HuggingFace Hub
 type HuggingFaceSource struct {
 	// RepoID is the HuggingFace repository ID (e.g., "meta-llama/Llama-3.1-8B-Instruct")
@@ -45,11 +95,30 @@ type HuggingFaceSource struct {
 
 	// Include patterns for files to download (e.g., ["*.safetensors", "*.json"])
 	// +optional
+	// +kubebuilder:validation:MaxItems=1024
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	// +kubebuilder:validation:items:Pattern=`^[^\r\n]+$`
 	Include []string `json:"include,omitempty"`
 
 	// Exclude patterns for files to skip (e.g., ["*.bin", "*.h5"])
 	// +optional
+	// +kubebuilder:validation:MaxItems=1024
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	// +kubebuilder:validation:items:Pattern=`^[^\r\n]+$`
 	Exclude []string `json:"exclude,omitempty"`
+
+	// Endpoint is a Hugging Face Hub mirror to download from (e.g. "https://hf-mirror.internal")
+	// +optional
+	// +kubebuilder:validation:Pattern=`^https?://`
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// FetchCard fetches the model card during the download, summarizing it
+	// in status.card and storing the full card in a ConfigMap. The endpoint
+	// must be one the operator allows with --model-card-endpoint.
+	// +optional
+	FetchCard bool `json:"fetchCard,omitempty"`
 }
 
 // URLSource defines configuration for direct HTTP/HTTPS downloads
@@ -60,7 +129,20 @@ type URLSource struct {
 	URL string `json:"url"`
 }
 
+// S3TransferTool selects the client that downloads S3 sources
+// +kubebuilder:validation:Enum=aws-cli;s5cmd
+type S3TransferTool string
+
+const (
+	// S3TransferToolAWSCLI downloads with aws s3 cp
+	S3TransferToolAWSCLI S3TransferTool = "aws-cli"
+	// S3TransferToolS5cmd downloads with s5cmd, which transfers many objects
+	// and parts in parallel and is much faster for large prefixes
+	S3TransferToolS5cmd S3TransferTool = "s5cmd"
+)
+
 // S3Source defines configuration for S3-compatible storage
+// +kubebuilder:validation:XValidation:rule="!has(self.presign) || !has(self.transferTool) || self.transferTool == 'aws-cli'",message="presigned downloads do not use a transfer tool"
 type S3Source struct {
 	// Bucket name
 	// +kubebuilder:validation:Required
@@ -77,6 +159,40 @@ type S3Source struct {
 	// Region for AWS S3
 	// +optional
 	Region string `json:"region,omitempty"`
+
+	// Presign makes the operator list the objects under the key and presign a
+	// short-lived GET URL for each with the credentialsSecret, so the download
+	// pod only receives the URLs and never the credentials. Any store that
+	// accepts SigV4 works, including GCS through its XML API with HMAC keys.
+	// A custom endpoint must be one the operator allows with
+	// --presign-endpoint.
+	// +optional
+	Presign *S3Presign `json:"presign,omitempty"`
+
+	// TransferTool is the client that downloads the objects. s5cmd is much
+	// faster than the aws-cli for prefixes of many or large objects.
+	// +optional
+	// +kubebuilder:default=aws-cli
+	TransferTool S3TransferTool `json:"transferTool,omitempty"`
+
+	// Concurrency is how many requests the transfer tool runs in parallel:
+	// s5cmd workers or aws-cli max_concurrent_requests. Defaults to the
+	// tool's own default.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	Concurrency *int32 `json:"concurrency,omitempty"`
+}
+
+// S3Presign configures downloads through presigned URLs
+type S3Presign struct {
+	// ExpirySeconds is how long the presigned URLs stay valid. It must cover
+	// the time from the Job's creation to the start of the last file download.
+	// +optional
+	// +kubebuilder:default=3600
+	// +kubebuilder:validation:Minimum=60
+	// +kubebuilder:validation:Maximum=604800
+	ExpirySeconds int32 `json:"expirySeconds,omitempty"`
 }
 
 // GitSource defines configuration for Git repositories (with LFS support)
@@ -103,13 +219,50 @@ type GitSource struct {
 	// Include patterns for sparse checkout (e.g., ["*.safetensors", "config.json"])
 	// Uses git sparse-checkout with cone mode disabled for glob support
 	// +optional
+	// +kubebuilder:validation:MaxItems=1024
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	// +kubebuilder:validation:items:Pattern=`^[^\r\n]+$`
 	Include []string `json:"include,omitempty"`
 
 	// Exclude patterns to remove after checkout (e.g., ["*.bin", "*.h5"])
 	// +optional
+	// +kubebuilder:validation:MaxItems=1024
+	// +kubebuilder:validation:items:MinLength=1
+	// +kubebuilder:validation:items:MaxLength=512
+	// +kubebuilder:validation:items:Pattern=`^[^\r\n]+$`
 	Exclude []string `json:"exclude,omitempty"`
 }
 
+// DVCSource defines configuration for artifacts tracked by DVC, downloaded
+// from the repository's DVC remote with dvc get
+type DVCSource struct {
+	// URL is the Git repository URL of the DVC project
+	// +kubebuilder:validation:Required
+	URL string `json:"url"`
+
+	// Path is the path of the tracked file or directory in the repository
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('/') && !self.split('/').exists(p, p == '..')",message="path must be relative to the repository root"
+	Path string `json:"path"`
+
+	// Rev is the git revision (branch, tag, or commit) to read the DVC files
+	// from. Defaults to the repository's default branch.
+	// +optional
+	Rev string `json:"rev,omitempty"`
+
+	// Remote is the name of the DVC remote to download from, if not the
+	// project's default remote
+	// +optional
+	Remote string `json:"remote,omitempty"`
+
+	// RemoteConfigSecret names a Secret whose entries are passed as DVC
+	// remote config options (e.g. access_key_id, secret_access_key,
+	// endpointurl), so credentials are not committed to the project
+	// +optional
+	RemoteConfigSecret string `json:"remoteConfigSecret,omitempty"`
+}
+
 // ModelSource defines where to download the model from.
 // Exactly one field must be set.
 type ModelSource struct {
@@ -128,6 +281,41 @@ type ModelSource struct {
 	// Git source for Git repositories (with optional LFS support)
 	// +optional
 	Git *GitSource `json:"git,omitempty"`
+
+	// DVC source for artifacts versioned with DVC
+	// +optional
+	DVC *DVCSource `json:"dvc,omitempty"`
+
+	// Fallbacks are alternative sources tried in order when the download from
+	// the previous source fails terminally (e.g. an internal S3 mirror of a
+	// Hugging Face repo). They share the Model's credentialsSecret.
+	// +optional
+	// +kubebuilder:validation:MaxItems=5
+	Fallbacks []FallbackSource `json:"fallbacks,omitempty"`
+}
+
+// FallbackSource is an alternative source for the same model content.
+// Exactly one field must be set.
+type FallbackSource struct {
+	// HuggingFace source configuration
+	// +optional
+	HuggingFace *HuggingFaceSource `json:"huggingFace,omitempty"`
+
+	// URL source for direct HTTP/HTTPS downloads
+	// +optional
+	URL *URLSource `json:"url,omitempty"`
+
+	// S3 source for S3-compatible storage
+	// +optional
+	S3 *S3Source `json:"s3,omitempty"`
+
+	// Git source for Git repositories (with optional LFS support)
+	// +optional
+	Git *GitSource `json:"git,omitempty"`
+
+	// DVC source for artifacts versioned with DVC
+	// +optional
+	DVC *DVCSource `json:"dvc,omitempty"`
 }
 
 // ModelfileSpec defines Ollama-style Modelfile configuration
@@ -190,13 +378,83 @@ type ModelParameters struct {
 	Seed *int `json:"seed,omitempty"`
 }
 
+// DeprecationPolicy decides what happens to new pods requesting a
+// deprecated Model
+// +kubebuilder:validation:Enum=Warn;Deny
+type DeprecationPolicy string
+
+const (
+	// DeprecationPolicyWarn admits the pod with a warning
+	DeprecationPolicyWarn DeprecationPolicy = "Warn"
+	// DeprecationPolicyDeny denies the pod, naming the replacement
+	DeprecationPolicyDeny DeprecationPolicy = "Deny"
+)
+
+// StorageMode selects where the model files are kept
+// +kubebuilder:validation:Enum=pvc;configmap;image
+type StorageMode string
+
+const (
+	// StorageModePVC keeps the model on a PersistentVolumeClaim
+	StorageModePVC StorageMode = "pvc"
+	// StorageModeConfigMap keeps a tiny model (up to 1Mi of top-level files)
+	// in a ConfigMap, mounted as a ConfigMap volume
+	StorageModeConfigMap StorageMode = "configmap"
+	// StorageModeImage pushes the model to spec.publish.image straight from
+	// the download, mounted as an image volume
+	StorageModeImage StorageMode = "image"
+)
+
+// Compression is the algorithm a model is compressed with at rest
+// +kubebuilder:validation:Enum=none;gzip;zstd
+type Compression string
+
+const (
+	// CompressionNone keeps the files as downloaded
+	CompressionNone Compression = "none"
+	// CompressionGzip compresses each file with gzip
+	CompressionGzip Compression = "gzip"
+	// CompressionZstd compresses each file with zstd
+	CompressionZstd Compression = "zstd"
+)
+
+// ReclaimPolicy is what happens to a Model's PVCs when the Model is deleted
+// +kubebuilder:validation:Enum=Retain;Delete
+type ReclaimPolicy string
+
+const (
+	// ReclaimPolicyDelete deletes the PVCs with the Model
+	ReclaimPolicyDelete ReclaimPolicy = "Delete"
+	// ReclaimPolicyRetain keeps the PVCs, for a Model of the same name to
+	// adopt
+	ReclaimPolicyRetain ReclaimPolicy = "Retain"
+)
+
 // StorageSpec defines PVC configuration for model storage
+// +kubebuilder:validation:XValidation:rule="has(self.storageClass) || has(self.local) || has(self.existingClaim) || (has(self.mode) && self.mode != 'pvc')",message="storageClass is required unless local storage or an existing claim is used"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode == 'pvc' || !has(self.local)",message="local storage requires the pvc mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'configmap' || quantity(self.size).compareTo(quantity('1Mi')) <= 0",message="configmap storage holds at most 1Mi"
+// +kubebuilder:validation:XValidation:rule="!has(self.mode) || self.mode != 'configmap' || !has(self.ownership)",message="ownership is not supported in the configmap mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.compression) || self.compression == 'none' || !has(self.mode) || self.mode == 'pvc'",message="compression requires the pvc mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.reclaimPolicy) || self.reclaimPolicy == 'Delete' || ((!has(self.mode) || self.mode == 'pvc') && !has(self.local))",message="reclaimPolicy Retain requires the pvc mode without local storage"
+// +kubebuilder:validation:XValidation:rule="!has(self.existingClaim) || ((!has(self.mode) || self.mode == 'pvc') && !has(self.local))",message="existingClaim requires the pvc mode without local storage"
+// +kubebuilder:validation:XValidation:rule="!has(self.existingClaim) || !has(self.existingClaim.verifyOnly) || !self.existingClaim.verifyOnly || !has(self.compression) || self.compression == 'none'",message="existingClaim.verifyOnly does not support compression"
 type StorageSpec struct {
-	// StorageClass name (e.g., "longhorn", "gp3")
-	// +kubebuilder:validation:Required
-	StorageClass string `json:"storageClass"`
+	// Mode selects where the model is kept: a PVC (default), a ConfigMap for
+	// tiny artifacts such as tokenizers, or an OCI image pushed to
+	// spec.publish.image. The configmap and image modes download into a
+	// scratch volume of Size and create no PVC.
+	// +optional
+	// +kubebuilder:default=pvc
+	Mode StorageMode `json:"mode,omitempty"`
 
-	// Size of the PVC (e.g., "20Gi")
+	// StorageClass name (e.g., "longhorn", "gp3"). Optional with local storage,
+	// where it only labels the statically provisioned volume.
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Size of the PVC (e.g., "20Gi"), or of the scratch volume in the
+	// configmap and image modes
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`^[0-9]+[KMGTPE]i?$`
 	Size string `json:"size"`
@@ -205,9 +463,476 @@ type StorageSpec struct {
 	// +optional
 	// +kubebuilder:default={"ReadWriteOnce"}
 	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+
+	// Local provisions a hostPath-backed PersistentVolume on a single node,
+	// for clusters without a dynamic provisioner. Pods using the model are
+	// scheduled onto that node automatically.
+	// +optional
+	Local *LocalStorageSpec `json:"local,omitempty"`
+
+	// Ownership sets the owner and permissions of the downloaded files, for
+	// runtimes that run as a fixed user (e.g. Ollama as 1000) and would
+	// otherwise hit EACCES on the read-only mount
+	// +optional
+	Ownership *StorageOwnership `json:"ownership,omitempty"`
+
+	// Compression compresses each downloaded file at rest, for archival models
+	// that are rarely read. Pods get the files decompressed into an emptyDir
+	// by an init container, unless they read them compressed with the
+	// models.main-currents.news/decompress: "false" annotation.
+	// +optional
+	// +kubebuilder:default=none
+	Compression Compression `json:"compression,omitempty"`
+
+	// ReclaimPolicy decides whether deleting the Model deletes its PVCs,
+	// replicas included, or keeps them with their files for a new Model of
+	// the same name to adopt. Defaults to Delete.
+	// +optional
+	// +kubebuilder:default=Delete
+	ReclaimPolicy ReclaimPolicy `json:"reclaimPolicy,omitempty"`
+
+	// ExistingClaim uses a pre-provisioned PVC in the Model's namespace
+	// instead of creating one, e.g. with files restored from a backup or
+	// synced by another tool. The operator never resizes or deletes it,
+	// whatever the ReclaimPolicy, and StorageClass and AccessModes do not
+	// apply.
+	// +optional
+	ExistingClaim *ExistingClaimSpec `json:"existingClaim,omitempty"`
+}
+
+// ExistingClaimSpec names a pre-provisioned PVC for the model files
+type ExistingClaimSpec struct {
+	// Name of the PVC
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// VerifyOnly skips the download. The files already on the PVC are
+	// checked against their completion marker, or get one written if they
+	// have none, and the Model becomes Ready without fetching the source.
+	// +optional
+	VerifyOnly bool `json:"verifyOnly,omitempty"`
+}
+
+// StorageOwnership sets the owner and permissions of the downloaded files
+// +kubebuilder:validation:XValidation:rule="has(self.uid) || has(self.gid) || has(self.mode)",message="ownership must set a uid, gid or mode"
+type StorageOwnership struct {
+	// UID is the user that owns the downloaded files
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	UID *int64 `json:"uid,omitempty"`
+
+	// GID is the group of the downloaded files. It is also the fsGroup of
+	// the download pod.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	GID *int64 `json:"gid,omitempty"`
+
+	// Mode is the octal permission mode of the downloaded files (e.g. "0640").
+	// Directories also get the execute bit for each read bit.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^0?[0-7]{3}$`
+	Mode string `json:"mode,omitempty"`
+}
+
+// LocalStorageSpec configures a hostPath-backed local PersistentVolume
+type LocalStorageSpec struct {
+	// NodeName is the node the model files are stored on
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	NodeName string `json:"nodeName"`
+
+	// Path names a directory for the files in the Model's own directory on
+	// the node, which is <base>/<namespace>/<name> under the operator's
+	// local storage base directory. {namespace} and {name} are replaced with
+	// the Model namespace and name. Path is a single path component; without
+	// it the files are kept in the Model's directory itself.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9._{}-]+$`
+	// +kubebuilder:validation:XValidation:rule="self != '.' && self != '..'",message="path must name a directory in the Model's directory"
+	Path string `json:"path,omitempty"`
+
+	// Reverify checks the files against their manifest whenever the node
+	// reboots or the local PersistentVolume is recreated, and downloads the
+	// model again if any file is missing or changed
+	// +optional
+	Reverify bool `json:"reverify,omitempty"`
+}
+
+// DownloadSpec configures the download Job
+// +kubebuilder:validation:XValidation:rule="!has(self.dnsPolicy) || self.dnsPolicy != 'None' || has(self.dnsConfig)",message="dnsConfig is required when dnsPolicy is None"
+type DownloadSpec struct {
+	// Tolerations for the download pod (e.g. to tolerate GPU node taints)
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity for the download pod (e.g. anti-affinity from serving pods)
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// TopologySpreadConstraints spread download pods across zones or nodes,
+	// e.g. to keep concurrent downloads off the same storage node. Select
+	// download pods with app.kubernetes.io/name=model-downloader.
+	// +optional
+	// +listType=atomic
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+
+	// Architecture pins the download pod to nodes of the given CPU architecture.
+	// By default the pod may run on any architecture the downloader image supports.
+	// +optional
+	// +kubebuilder:validation:Enum=amd64;arm64
+	Architecture string `json:"architecture,omitempty"`
+
+	// PriorityClassName of the download pod, e.g. a low priority so bulk
+	// downloads are preempted before inference workloads, or a high one for
+	// urgent rollouts. Defaults to the cluster's default priority.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// HostAliases are added to the download pod's /etc/hosts, for mirrors
+	// that do not resolve through cluster DNS
+	// +optional
+	HostAliases []corev1.HostAlias `json:"hostAliases,omitempty"`
+
+	// DNSPolicy for the download pod. Defaults to ClusterFirst.
+	// +optional
+	// +kubebuilder:validation:Enum=ClusterFirstWithHostNet;ClusterFirst;Default;None
+	DNSPolicy corev1.DNSPolicy `json:"dnsPolicy,omitempty"`
+
+	// DNSConfig adds nameservers, search domains and options to the download
+	// pod's resolver configuration
+	// +optional
+	DNSConfig *corev1.PodDNSConfig `json:"dnsConfig,omitempty"`
+
+	// Parallelism splits a single-file URL download into this many
+	// concurrent range requests, for high-latency links where one stream is
+	// slow. Servers that do not support ranges get a single stream.
+	// Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	Parallelism *int32 `json:"parallelism,omitempty"`
+
+	// Image replaces the downloader image of the source, e.g. with a mirror
+	// in an air-gapped registry. It needs the same tools as the default
+	// image (python3 for Hugging Face and DVC, aws or s5cmd for S3, curl
+	// for URLs, git for Git). Defaults to the operator's image for the source.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Resources of the downloader container, e.g. higher limits for models
+	// of hundreds of gigabytes or lower requests on small clusters. They
+	// replace the defaults for the source as a whole.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// BackoffLimit is how often the download pod is retried before the
+	// download fails, e.g. more for flaky sources. Defaults to 3.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// TTLSecondsAfterFinished is how long the finished download Job is kept.
+	// Defaults to 3600.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// Timeout fails the download once its Job has run this long, retries
+	// included (e.g. "12h" for models of hundreds of gigabytes). By
+	// default a download may run indefinitely.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1m')",message="timeout must be at least 1m"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// ImagePullSecrets pull the downloader images from a private registry,
+	// e.g. mirrors set with image. They are added to those the operator
+	// configures for all download pods.
+	// +optional
+	// +listType=atomic
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// ServiceAccountName runs the download pod as this ServiceAccount, e.g.
+	// one annotated for IRSA or GKE Workload Identity so S3 and GCS sources
+	// need no static credentialsSecret. Not supported in the configmap
+	// storage mode, whose download pod runs as the operator's store account.
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// SecurityContext of every container in the download pod, e.g.
+	// runAsNonRoot and readOnlyRootFilesystem for namespaces enforcing the
+	// restricted Pod Security Standard. The built-in scripts then write only
+	// to the model volume and a scratch /tmp.
+	// +optional
+	SecurityContext *corev1.SecurityContext `json:"securityContext,omitempty"`
+
+	// PodSecurityContext of the download pod. Running as non-root without a
+	// runAsUser uses 65532, and the fsGroup defaults to the group the
+	// downloader runs as so it can write to the model volume.
+	// +optional
+	PodSecurityContext *corev1.PodSecurityContext `json:"podSecurityContext,omitempty"`
+
+	// Env is added to the downloader container, e.g. HTTPS_PROXY or
+	// settings of a site-specific plugin. A variable the operator already
+	// sets is replaced.
+	// +optional
+	// +listType=atomic
+	Env []corev1.EnvVar `json:"env,omitempty"`
+
+	// EnvFrom adds the keys of ConfigMaps and Secrets to the downloader
+	// container's environment
+	// +optional
+	// +listType=atomic
+	EnvFrom []corev1.EnvFromSource `json:"envFrom,omitempty"`
+
+	// ExtraVolumes are added to the download pod, e.g. a CA bundle or a
+	// pre-populated cache. Their names must not clash with the operator's
+	// volumes. They are validated when the download Job is created, which
+	// keeps the large Volume schema out of the CRD.
+	// +optional
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:pruning:PreserveUnknownFields
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
+
+	// ExtraVolumeMounts mount extraVolumes into the downloader container.
+	// Their paths must not clash with the operator's mounts.
+	// +optional
+	// +listType=atomic
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+}
+
+// PrewarmSpec configures pre-pulling of serving runtime images onto the nodes
+// that will consume the model
+type PrewarmSpec struct {
+	// Images are the serving runtime images to pre-pull (e.g. "ollama/ollama:0.5.7").
+	// Each image must contain /bin/sh.
+	// +kubebuilder:validation:MinItems=1
+	Images []string `json:"images"`
+
+	// NodeSelector selects the nodes to pre-pull on
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations allow pre-pulling on tainted (e.g. GPU) nodes
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// FileServerSpec serves the model files read-only over HTTP inside the cluster
+type FileServerSpec struct {
+	// Port the Service serves the files on. Defaults to 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// Resources of the file server container
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ConversionTarget is the engine format a Model is converted to
+// +kubebuilder:validation:Enum=tensorrt-llm;onnx
+type ConversionTarget string
+
+const (
+	// ConversionTargetTensorRTLLM builds a TensorRT-LLM engine
+	ConversionTargetTensorRTLLM ConversionTarget = "tensorrt-llm"
+	// ConversionTargetONNX exports the model to ONNX
+	ConversionTargetONNX ConversionTarget = "onnx"
+)
+
+// ConversionSpec configures post-download conversion to a hardware-specific engine
+type ConversionSpec struct {
+	// Target is the engine format to produce
+	// +kubebuilder:validation:Required
+	Target ConversionTarget `json:"target"`
+
+	// Image overrides the builder image for the target
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Args are extra arguments passed to the builder
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Resources for the conversion Job. TensorRT-LLM builds default to one GPU.
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// NodeSelector for the conversion Job (e.g. to build on the GPU type the
+	// engine will be served on). Independent of spec.nodeSelector, so the
+	// download can run on cheap nodes while the conversion lands on a GPU node.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations for the conversion Job. When the Job requests GPUs and no
+	// tolerations are set, the nvidia.com/gpu taint is tolerated.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// Affinity for the conversion Job
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+}
+
+// VerificationSpec configures checks of the downloaded files before the Model becomes Ready
+type VerificationSpec struct {
+	// Signature verifies a detached signature over a downloaded file
+	// +optional
+	Signature *SignatureVerification `json:"signature,omitempty"`
+}
+
+// SignatureProvider is the tool that verifies a signature
+// +kubebuilder:validation:Enum=cosign;gpg
+type SignatureProvider string
+
+const (
+	// SignatureProviderCosign verifies a cosign blob signature against a public key
+	SignatureProviderCosign SignatureProvider = "cosign"
+	// SignatureProviderGPG verifies an OpenPGP detached signature against a keyring
+	SignatureProviderGPG SignatureProvider = "gpg"
+)
+
+// SignatureVerification verifies a detached signature over one downloaded
+// file. To cover every file, sign a SHA256SUMS manifest and set checksums.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.s3Key)",message="signature must set exactly one of url or s3Key"
+type SignatureVerification struct {
+	// Provider verifies the signature
+	// +kubebuilder:validation:Required
+	Provider SignatureProvider `json:"provider"`
+
+	// PublicKey references the Secret key holding the public key: a PEM key
+	// for cosign, or a binary keyring (gpg --export) for gpg
+	// +kubebuilder:validation:Required
+	PublicKey corev1.SecretKeySelector `json:"publicKey"`
+
+	// File is the signed file, relative to the model directory
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="!self.startsWith('/') && !self.split('/').exists(p, p == '..')",message="file must be a relative path inside the model directory"
+	File string `json:"file"`
+
+	// URL to download the detached signature from
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// S3Key is the object key of the detached signature in the bucket of
+	// spec.source.s3, fetched with the Model's credentialsSecret
+	// +optional
+	S3Key string `json:"s3Key,omitempty"`
+
+	// Checksums treats the signed file as a sha256sum manifest and checks
+	// the downloaded files against it once the signature is verified
+	// +optional
+	Checksums bool `json:"checksums,omitempty"`
+
+	// Image overrides the verifier image of the provider
+	// +optional
+	Image string `json:"image,omitempty"`
+}
+
+// PublishSpec configures publishing the downloaded model as an OCI image that
+// consumers can mount with the image volume source instead of the PVC
+type PublishSpec struct {
+	// Image is the repository and tag to push to (e.g. "registry.internal/models/llama:3.1")
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Image string `json:"image"`
+
+	// PushSecret is a kubernetes.io/dockerconfigjson Secret with registry credentials
+	// +optional
+	PushSecret string `json:"pushSecret,omitempty"`
+
+	// Insecure allows pushing to a plain-HTTP or self-signed registry
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+
+	// LayerSize is the target size of each image layer. Files are grouped into
+	// layers of about this size so pulls parallelize and unchanged files reuse layers.
+	// +optional
+	// +kubebuilder:default="1Gi"
+	LayerSize *resource.Quantity `json:"layerSize,omitempty"`
+}
+
+// RegistrationSpec registers the model with an external catalog before it
+// becomes Ready, so the catalog only lists models the cluster can serve
+type RegistrationSpec struct {
+	// Webhook receives the model's digest and location
+	// +kubebuilder:validation:Required
+	Webhook RegistrationWebhook `json:"webhook"`
+}
+
+// RegistrationWebhook is an HTTP endpoint the model is POSTed to as JSON
+type RegistrationWebhook struct {
+	// URL of the endpoint. It must start with one of the operator's
+	// --registration-url-prefix values. Any 2xx response registers the
+	// model; anything else, including a redirect, is retried with backoff.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://`
+	URL string `json:"url"`
+
+	// TokenSecret references the Secret key holding a bearer token sent in
+	// the Authorization header
+	// +optional
+	TokenSecret *corev1.SecretKeySelector `json:"tokenSecret,omitempty"`
+}
+
+// ModelOverlay overrides parts of the spec in one environment. Fields that
+// are set replace the corresponding fields of the spec.
+type ModelOverlay struct {
+	// StorageClass replaces spec.storage.storageClass
+	// +optional
+	StorageClass string `json:"storageClass,omitempty"`
+
+	// Size replaces spec.storage.size
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+[KMGTPE]i?$`
+	Size string `json:"size,omitempty"`
+
+	// NodeSelector replaces spec.nodeSelector
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Endpoint replaces the mirror endpoint of the source: the S3 endpoint
+	// or the Hugging Face Hub endpoint
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// ModelRevision is a previous revision of the model kept on its volume
+type ModelRevision struct {
+	// Revision is the source revision the files were downloaded at
+	Revision string `json:"revision"`
+
+	// ContentDigest is the digest of the file manifest of the revision
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+
+	// Path is the directory of the revision, relative to the volume root
+	Path string `json:"path"`
+
+	// ReplacedAt is when a newer revision replaced it
+	ReplacedAt metav1.Time `json:"replacedAt"`
 }
 
 // ModelSpec defines the desired state of Model
+// +kubebuilder:validation:XValidation:rule="!has(self.storage.mode) || self.storage.mode != 'image' || has(self.publish)",message="image storage requires spec.publish"
+// +kubebuilder:validation:XValidation:rule="!has(self.storage.mode) || self.storage.mode == 'pvc' || (!has(self.replicas) && !has(self.conversion))",message="replicas and conversion require the pvc storage mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.source.s3) || !has(self.source.s3.presign) || has(self.credentialsSecret)",message="presigned S3 downloads require credentialsSecret"
+// +kubebuilder:validation:XValidation:rule="!has(self.storage.mode) || self.storage.mode == 'pvc' || !has(self.verification)",message="verification requires the pvc storage mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.download) || !has(self.download.serviceAccountName) || !has(self.storage.mode) || self.storage.mode != 'configmap'",message="download.serviceAccountName is not supported in the configmap storage mode"
+// +kubebuilder:validation:XValidation:rule="!has(self.verification) || !has(self.verification.signature) || !has(self.verification.signature.s3Key) || has(self.source.s3)",message="signature s3Key requires an S3 source"
+// +kubebuilder:validation:XValidation:rule="!has(self.storage.compression) || self.storage.compression == 'none' || (!has(self.conversion) && !has(self.verification))",message="conversion and verification need the model files uncompressed"
+// +kubebuilder:validation:XValidation:rule="!has(self.revisionHistoryLimit) || self.revisionHistoryLimit == 0 || (has(self.source.huggingFace) && (!has(self.storage.mode) || self.storage.mode == 'pvc'))",message="revisionHistoryLimit requires a HuggingFace source and the pvc storage mode"
+// +kubebuilder:validation:XValidation:rule="(has(self.deprecated) && self.deprecated) || (!has(self.replacement) && !has(self.deprecationPolicy))",message="replacement and deprecationPolicy require deprecated"
+// +kubebuilder:validation:XValidation:rule="!has(self.fileServer) || !has(self.access)",message="fileServer serves the files to every pod, so it cannot be combined with access"
 type ModelSpec struct {
 	// Source defines where to download the model from
 	// +kubebuilder:validation:Required
@@ -225,15 +950,355 @@ type ModelSpec struct {
 	// +optional
 	Version string `json:"version,omitempty"`
 
+	// Family groups related variants of a model (e.g. "llama-3.1" for the 8B,
+	// 70B and quantized variants). Models of the same family are aggregated by
+	// the ModelFamily with the matching name in the same namespace.
+	// The label models.main-currents.news/family may be used instead.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	Family string `json:"family,omitempty"`
+
+	// Deprecated marks the model for retirement. Pods already running keep
+	// it; new pods are warned or denied per deprecationPolicy, and injected
+	// pods get MODEL_<NAME>_DEPRECATED=true.
+	// +optional
+	Deprecated bool `json:"deprecated,omitempty"`
+
+	// Replacement names the Model in the same namespace that consumers of a
+	// deprecated model should move to
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	Replacement string `json:"replacement,omitempty"`
+
+	// DeprecationPolicy is Warn to admit new pods of a deprecated model with
+	// a warning, or Deny to deny them. Defaults to Warn.
+	// +optional
+	DeprecationPolicy DeprecationPolicy `json:"deprecationPolicy,omitempty"`
+
 	// CredentialsSecret references a Secret containing credentials
 	// For HuggingFace: key "HF_TOKEN"
 	// For S3: keys "AWS_ACCESS_KEY_ID" and "AWS_SECRET_ACCESS_KEY"
+	// For Git and DVC: keys "GIT_USERNAME" and "GIT_PASSWORD"
 	// +optional
 	CredentialsSecret string `json:"credentialsSecret,omitempty"`
 
+	// AllowCredentialInjection lets pods in the namespace request the
+	// CredentialsSecret's HF_TOKEN with the models.main-currents.news/inject-credentials
+	// annotation, for apps that call the Hugging Face API at runtime
+	// +optional
+	AllowCredentialInjection bool `json:"allowCredentialInjection,omitempty"`
+
+	// Access restricts which pods in the namespace may mount the model, by
+	// injection or by naming its PVC or a replica's PVC as a volume. Without
+	// it any pod in the namespace may mount the model.
+	// +optional
+	Access *ModelAccess `json:"access,omitempty"`
+
 	// NodeSelector for the download Job
 	// +optional
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Download configures scheduling of the download Job
+	// +optional
+	Download *DownloadSpec `json:"download,omitempty"`
+
+	// Prewarm pre-pulls serving runtime images on consuming nodes via a DaemonSet
+	// +optional
+	Prewarm *PrewarmSpec `json:"prewarm,omitempty"`
+
+	// FileServer runs a read-only static file server for the model volume
+	// behind a Service, for runtimes that fetch weights over HTTP. It starts
+	// once the model is Ready and needs the pvc storage mode without
+	// compression. Any pod can reach the Service, so it cannot be combined
+	// with Access.
+	// +optional
+	FileServer *FileServerSpec `json:"fileServer,omitempty"`
+
+	// Conversion builds a hardware-specific engine from the downloaded model
+	// before it becomes Ready
+	// +optional
+	Conversion *ConversionSpec `json:"conversion,omitempty"`
+
+	// Verification checks the downloaded files, e.g. against a detached
+	// signature, before the Model becomes Ready or is converted
+	// +optional
+	Verification *VerificationSpec `json:"verification,omitempty"`
+
+	// Publish pushes the downloaded model to a registry as an OCI image once Ready
+	// +optional
+	Publish *PublishSpec `json:"publish,omitempty"`
+
+	// Registration registers the model with an external catalog once it is
+	// downloaded, verified and converted. The Model only becomes Ready once
+	// the catalog accepts it, and registers again after every new download.
+	// +optional
+	Registration *RegistrationSpec `json:"registration,omitempty"`
+
+	// RevisionHistoryLimit keeps up to this many previous revisions of a
+	// HuggingFace source on the volume when spec.source.huggingFace.revision
+	// changes. Pods can pin a kept revision with the
+	// models.main-currents.news/revision annotation, and moving the revision
+	// back to a kept one restores it without downloading it again. Kept
+	// revisions are hard links, so they only take up space for the files
+	// that changed.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+
+	// Replicas keep warm standby copies of the model on other storage classes
+	// or zones (e.g. fast local NVMe next to cheap NFS). Each replica is
+	// downloaded from the source into its own PVC once the model is Ready,
+	// and the webhook mounts the replica best suited to the pod's zone.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=8
+	Replicas []StorageReplica `json:"replicas,omitempty"`
+
+	// MinimumRetention denies deleting the Model for this long (e.g. "72h")
+	// after it became Ready, protecting an expensive download from cleanup
+	// scripts. Models that are not Ready, or in a namespace being deleted,
+	// can always be deleted; to delete one sooner, remove this field first.
+	// +optional
+	MinimumRetention *metav1.Duration `json:"minimumRetention,omitempty"`
+
+	// Overlays override parts of the spec per environment (e.g. "dev", "prod").
+	// The overlay named by the operator's --environment flag is applied when
+	// the Model is admitted, so one manifest can be promoted across clusters.
+	// +optional
+	Overlays map[string]ModelOverlay `json:"overlays,omitempty"`
+}
+
+// ModelAccess restricts which pods may mount the model. A pod is allowed if
+// it runs as one of the service accounts or matches one of the selectors.
+// +kubebuilder:validation:XValidation:rule="has(self.allowedServiceAccounts) || has(self.allowedSelectors)",message="access must allow at least one service account or selector"
+type ModelAccess struct {
+	// AllowedServiceAccounts are the service accounts, in the Model's
+	// namespace, whose pods may mount the model
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9.]*[a-z0-9])?$`
+	AllowedServiceAccounts []string `json:"allowedServiceAccounts,omitempty"`
+
+	// AllowedSelectors match the labels of pods that may mount the model.
+	// Pod authors set these labels themselves, so selectors only guard
+	// against mistakes; use allowedServiceAccounts to keep pods out.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	AllowedSelectors []metav1.LabelSelector `json:"allowedSelectors,omitempty"`
+}
+
+// StorageReplica is a standby copy of the model on another storage class or zone
+type StorageReplica struct {
+	// Name identifies the replica (e.g. "nvme-zone-a")
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=30
+	Name string `json:"name"`
+
+	// StorageClass for the replica PVC
+	StorageClass string `json:"storageClass"`
+
+	// Size of the replica PVC. Defaults to spec.storage.size.
+	// +optional
+	Size string `json:"size,omitempty"`
+
+	// Zone pins the replica to a topology.kubernetes.io/zone, so pods
+	// scheduled to that zone are given this replica
+	// +optional
+	Zone string `json:"zone,omitempty"`
+}
+
+// ModelLease is a time-limited claim on the model by a consuming Job
+type ModelLease struct {
+	// Job is the name of the consuming Job
+	Job string `json:"job"`
+
+	// ExpiresAt is when the lease lapses, even if the Job is still running
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// ReplicaStatus is the observed state of a storage replica
+type ReplicaStatus struct {
+	// Name of the replica
+	Name string `json:"name"`
+
+	// PVCName is the name of the replica PVC
+	PVCName string `json:"pvcName,omitempty"`
+
+	// Phase of the replica download
+	// +kubebuilder:validation:Enum=Pending;Downloading;Ready;Failed
+	Phase ModelPhase `json:"phase,omitempty"`
+
+	// ContentDigest is the model content digest the replica was downloaded
+	// for; a replica whose digest differs from the model's is refreshed
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+
+	// Message is a human-readable status message
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// StageName names a step of provisioning a Model
+type StageName string
+
+// Stages in the order a Model goes through them
+const (
+	StageDownload StageName = "Download"
+	StageVerify   StageName = "Verify"
+	StageConvert  StageName = "Convert"
+	StagePublish  StageName = "Publish"
+)
+
+// StageState is the state of a provisioning stage
+type StageState string
+
+const (
+	StageStatePending   StageState = "Pending"
+	StageStateRunning   StageState = "Running"
+	StageStateSucceeded StageState = "Succeeded"
+	StageStateFailed    StageState = "Failed"
+)
+
+// ModelStage reports one step of provisioning the Model, for rendering the
+// pipeline
+type ModelStage struct {
+	// Name of the stage
+	Name StageName `json:"name"`
+
+	// State of the stage
+	// +kubebuilder:validation:Enum=Pending;Running;Succeeded;Failed
+	State StageState `json:"state"`
+
+	// StartTime is when the stage started running
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the stage succeeded or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Message is a human-readable status message
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PhaseDuration is how long the last provisioning of the Model spent in one
+// phase: Pending until the download started, then each stage that ran
+type PhaseDuration struct {
+	// Phase is Pending, or Downloading, Verifying, Converting or Publishing
+	// for the stages of the same name
+	// +kubebuilder:validation:Enum=Pending;Downloading;Verifying;Converting;Publishing
+	Phase string `json:"phase"`
+
+	// Duration spent in the phase, to the second
+	Duration metav1.Duration `json:"duration"`
+}
+
+// PublicationStatus records the OCI image the model was published as
+type PublicationStatus struct {
+	// Image is the pushed image pinned by digest (e.g. "registry.internal/models/llama@sha256:...")
+	Image string `json:"image"`
+
+	// Reference is the spec.publish.image that was pushed
+	// +optional
+	Reference string `json:"reference,omitempty"`
+
+	// ContentDigest is the content digest of the model that was published
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+}
+
+// ModelCardStatus summarizes the model card fetched from the Hub
+type ModelCardStatus struct {
+	// Revision is the source revision the card was fetched at
+	// +optional
+	Revision string `json:"revision,omitempty"`
+
+	// Summary is the first paragraph of the card
+	// +optional
+	Summary string `json:"summary,omitempty"`
+
+	// License is the license identifier (e.g. "apache-2.0")
+	// +optional
+	License string `json:"license,omitempty"`
+
+	// Tags are the tags declared in the card metadata
+	// +optional
+	Tags []string `json:"tags,omitempty"`
+
+	// PipelineTag is the task the model is for (e.g. "text-generation")
+	// +optional
+	PipelineTag string `json:"pipelineTag,omitempty"`
+
+	// ConfigMap holds the full card under the README.md key
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+}
+
+// ConversionStatus records the engine produced by the conversion Job
+type ConversionStatus struct {
+	// Target is the engine format that was produced
+	Target ConversionTarget `json:"target"`
+
+	// ArtifactPath is the engine directory relative to the model volume root
+	ArtifactPath string `json:"artifactPath"`
+}
+
+// RegistrationStatus records the registration of the model with
+// spec.registration.webhook
+type RegistrationStatus struct {
+	// URL is the webhook the model was last registered with
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ContentDigest is the content digest that was last registered
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+
+	// RegisteredTime is when the webhook last accepted the model
+	// +optional
+	RegisteredTime *metav1.Time `json:"registeredTime,omitempty"`
+
+	// FailedAttempts counts the calls that failed since the model last
+	// needed registering
+	// +optional
+	FailedAttempts int32 `json:"failedAttempts,omitempty"`
+
+	// LastAttemptTime is when the webhook was last called
+	// +optional
+	LastAttemptTime *metav1.Time `json:"lastAttemptTime,omitempty"`
+}
+
+// ProvisionedBy records what produced the content on disk, so models
+// downloaded by different operator releases can be told apart
+type ProvisionedBy struct {
+	// OperatorVersion is the version of the operator that ran the download
+	// +optional
+	OperatorVersion string `json:"operatorVersion,omitempty"`
+
+	// DownloaderImage is the image the download ran, by digest when the
+	// kubelet reported one (e.g. "docker.io/curlimages/curl@sha256:...")
+	// +optional
+	DownloaderImage string `json:"downloaderImage,omitempty"`
+
+	// ScriptHash is the sha256 digest of the download script the Job ran
+	// +optional
+	ScriptHash string `json:"scriptHash,omitempty"`
+}
+
+// LocalStorageStatus records the node boot and volume the local files were
+// last verified on
+type LocalStorageStatus struct {
+	// NodeBootID is the boot ID the node reported when the files were last verified
+	// +optional
+	NodeBootID string `json:"nodeBootID,omitempty"`
+
+	// VolumeUID is the UID of the local PersistentVolume the files were last verified on
+	// +optional
+	VolumeUID string `json:"volumeUID,omitempty"`
 }
 
 // ModelStatus defines the observed state of Model
@@ -248,11 +1313,139 @@ type ModelStatus struct {
 	// Message is a human-readable status message
 	Message string `json:"message,omitempty"`
 
+	// FailureReason is why the Model is Failed, for tools that act on the
+	// cause rather than parse the message. It is also the reason of the
+	// Ready condition, and is cleared once the Model leaves the Failed phase.
+	// +optional
+	FailureReason FailureReason `json:"failureReason,omitempty"`
+
+	// FailureOutput is the end of the output of the last failed download
+	// attempt, from the termination message of its container. It is cleared
+	// when the download succeeds or a new one starts.
+	// +optional
+	FailureOutput string `json:"failureOutput,omitempty"`
+
 	// Progress is the download progress (0-100)
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=100
 	Progress int `json:"progress,omitempty"`
 
+	// DownloadedBytes is the number of bytes written so far, as published by
+	// the configured progress reporter
+	// +optional
+	DownloadedBytes int64 `json:"downloadedBytes,omitempty"`
+
+	// EstimatedSizeBytes is the size of the download as estimated from the
+	// source before it started
+	// +optional
+	EstimatedSizeBytes int64 `json:"estimatedSizeBytes,omitempty"`
+
+	// SourceIndex is the source the download uses: 0 for the primary source,
+	// n for spec.source.fallbacks[n-1]
+	// +optional
+	SourceIndex int32 `json:"sourceIndex,omitempty"`
+
+	// StartTime is when the download Job of the current download started
+	// running
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the download Job of the current download
+	// succeeded or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// DownloadDuration is how long the download Job ran, from startTime to
+	// completionTime, once it finished
+	// +optional
+	DownloadDuration *metav1.Duration `json:"downloadDuration,omitempty"`
+
+	// DownloadedFrom names the source the content was downloaded from
+	// (e.g. "primary" or "fallbacks[0]")
+	// +optional
+	DownloadedFrom string `json:"downloadedFrom,omitempty"`
+
+	// SourceRevision is the revision of the source the content was downloaded
+	// at, for sources that have one
+	// +optional
+	SourceRevision string `json:"sourceRevision,omitempty"`
+
+	// Revisions are the previous revisions kept on the volume under
+	// spec.revisionHistoryLimit, most recent first
+	// +optional
+	Revisions []ModelRevision `json:"revisions,omitempty"`
+
+	// LastActivityTime is when the downloaded byte count last changed
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty"`
+
+	// StallRestarts counts download Jobs restarted for making no progress
+	// +optional
+	StallRestarts int32 `json:"stallRestarts,omitempty"`
+
+	// ContentDigest is the sha256 digest of the downloaded file manifest, the
+	// canonical identity of the content on disk (e.g. "sha256:4f2a...")
+	// +optional
+	ContentDigest string `json:"contentDigest,omitempty"`
+
+	// ProvisionedBy records the operator and downloader that produced the content
+	// +optional
+	ProvisionedBy *ProvisionedBy `json:"provisionedBy,omitempty"`
+
+	// Local records where the local files were last verified, when
+	// spec.storage.local.reverify is set
+	// +optional
+	Local *LocalStorageStatus `json:"local,omitempty"`
+
+	// Conversion records the converted engine for serving runtimes to consume
+	// +optional
+	Conversion *ConversionStatus `json:"conversion,omitempty"`
+
+	// Registration records the registration with spec.registration.webhook
+	// +optional
+	Registration *RegistrationStatus `json:"registration,omitempty"`
+
+	// Publication records the OCI image the model was published as
+	// +optional
+	Publication *PublicationStatus `json:"publication,omitempty"`
+
+	// Replicas reports the state of each spec.replicas entry
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Replicas []ReplicaStatus `json:"replicas,omitempty"`
+
+	// Stages lists the provisioning stages the spec asks for, in pipeline
+	// order, with when each ran and how it ended. A new download resets them.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Stages []ModelStage `json:"stages,omitempty"`
+
+	// PhaseTransitionTime is when the Model entered its current phase
+	// +optional
+	PhaseTransitionTime *metav1.Time `json:"phaseTransitionTime,omitempty"`
+
+	// PhaseDurations records how long each phase and stage of provisioning
+	// took the last time it completed, e.g. to spot slower downloads after
+	// an upgrade
+	// +listType=map
+	// +listMapKey=phase
+	// +optional
+	PhaseDurations []PhaseDuration `json:"phaseDurations,omitempty"`
+
+	// Leases are the unexpired leases of Jobs consuming the model. The Model
+	// is not deleted, and replicas removed from the spec are kept, until they
+	// lapse.
+	// +listType=map
+	// +listMapKey=job
+	// +optional
+	Leases []ModelLease `json:"leases,omitempty"`
+
+	// Card summarizes the model card, when spec.source.huggingFace.fetchCard is set
+	// +optional
+	Card *ModelCardStatus `json:"card,omitempty"`
+
 	// Conditions provide detailed status information
 	// +listType=map
 	// +listMapKey=type
@@ -265,9 +1458,17 @@ type ModelStatus struct {
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=mdl,categories={all,ai}
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.spec.version`
+// +kubebuilder:printcolumn:name="Family",type=string,JSONPath=`.spec.family`,priority=1
 // +kubebuilder:printcolumn:name="Size",type=string,JSONPath=`.spec.storage.size`
+// +kubebuilder:printcolumn:name="Progress",type=integer,JSONPath=`.status.progress`,priority=1
+// +kubebuilder:printcolumn:name="From",type=string,JSONPath=`.status.downloadedFrom`,priority=1
+// +kubebuilder:printcolumn:name="Revision",type=string,JSONPath=`.status.sourceRevision`,priority=1
+// +kubebuilder:printcolumn:name="PVC",type=string,JSONPath=`.status.pvcName`,priority=1
+// +kubebuilder:printcolumn:name="Digest",type=string,JSONPath=`.status.contentDigest`,priority=1
+// +kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.message`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Model is the Schema for the models API
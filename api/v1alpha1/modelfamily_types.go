@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelFamily is the label that assigns a Model to a ModelFamily.
+// It is equivalent to setting spec.family on the Model.
+const LabelFamily = "models.main-currents.news/family"
+
+// ModelFamilySpec defines the desired state of ModelFamily
+type ModelFamilySpec struct {
+	// Description is a human-readable description of the family
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Selector matches additional Models by label, on top of Models whose
+	// spec.family or family label equals the ModelFamily name
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// ModelFamilyMember is the summarized state of a single Model in the family
+type ModelFamilyMember struct {
+	// Name of the Model
+	Name string `json:"name"`
+
+	// Phase of the Model
+	Phase ModelPhase `json:"phase,omitempty"`
+
+	// Version of the Model
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// ModelFamilyStatus defines the observed state of ModelFamily
+type ModelFamilyStatus struct {
+	// Phase is the worst phase among all members (Failed > Pending > Downloading > Ready)
+	// +kubebuilder:validation:Enum=Pending;Downloading;Ready;Failed
+	Phase ModelPhase `json:"phase,omitempty"`
+
+	// Total is the number of Models in the family
+	Total int `json:"total"`
+
+	// Ready is the number of Ready Models
+	Ready int `json:"ready"`
+
+	// Downloading is the number of Downloading Models
+	Downloading int `json:"downloading"`
+
+	// Pending is the number of Pending Models
+	Pending int `json:"pending"`
+
+	// Failed is the number of Failed Models
+	Failed int `json:"failed"`
+
+	// Members lists every Model in the family, sorted by name
+	// +optional
+	Members []ModelFamilyMember `json:"members,omitempty"`
+
+	// Conditions provide detailed status information
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ModelFamily is the Schema for the modelfamilies API.
+// It aggregates the status of all Model variants belonging to a family.
+type ModelFamily struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ModelFamilySpec   `json:"spec,omitempty"`
+	Status ModelFamilyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ModelFamilyList contains a list of ModelFamily
+type ModelFamilyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ModelFamily `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ModelFamily{}, &ModelFamilyList{})
+}
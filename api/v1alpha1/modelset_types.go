@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// LabelModelSet is the label on every Model a ModelSet creates, naming the ModelSet
+const LabelModelSet = "models.main-currents.news/model-set"
+
+// ModelTemplate is the Model a ModelSet creates for each of its entries
+type ModelTemplate struct {
+	// Labels added to every Model
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations added to every Model
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// Spec of every Model. $(name) in a string field, or in a label or
+	// annotation value, is replaced with the entry's parameter of that name.
+	// Fields validated against a pattern, such as huggingFace.repoId and
+	// storage.size, cannot hold parameters.
+	Spec ModelSpec `json:"spec"`
+}
+
+// ModelSetItem is one Model of a ModelSet
+type ModelSetItem struct {
+	// Name of the Model. Defaults to the ModelSet name followed by the
+	// parameter values, in the order of their names.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name,omitempty"`
+
+	// Parameters substituted into the template
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// ModelSetSpec defines the desired state of ModelSet
+// +kubebuilder:validation:XValidation:rule="has(self.items) || has(self.matrix)",message="a ModelSet needs items or a matrix"
+type ModelSetSpec struct {
+	// Template is the Model created for each entry
+	Template ModelTemplate `json:"template"`
+
+	// Items lists Models to create, each with its own parameters
+	// +optional
+	Items []ModelSetItem `json:"items,omitempty"`
+
+	// Matrix creates a Model for every combination of the listed parameter
+	// values, after Items. For example, {"quant": ["Q4_K_M", "Q8_0"]}
+	// creates one Model per quantization.
+	// +optional
+	Matrix map[string][]string `json:"matrix,omitempty"`
+}
+
+// ModelSetMember is the summarized state of a single Model of the set
+type ModelSetMember struct {
+	// Name of the Model
+	Name string `json:"name"`
+
+	// Phase of the Model, Failed when it could not be created
+	Phase ModelPhase `json:"phase,omitempty"`
+
+	// Message explains a Failed phase: the Model's status message, or why
+	// it could not be created
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// ModelSetStatus defines the observed state of ModelSet
+type ModelSetStatus struct {
+	// Phase is the worst phase among all members (Failed > Pending > Downloading > Ready)
+	// +kubebuilder:validation:Enum=Pending;Downloading;Ready;Failed
+	Phase ModelPhase `json:"phase,omitempty"`
+
+	// Total is the number of Models in the set
+	Total int `json:"total"`
+
+	// Ready is the number of Ready Models
+	Ready int `json:"ready"`
+
+	// Downloading is the number of Downloading Models
+	Downloading int `json:"downloading"`
+
+	// Pending is the number of Pending Models
+	Pending int `json:"pending"`
+
+	// Failed is the number of Failed Models, including those that could not be created
+	Failed int `json:"failed"`
+
+	// Members lists every Model of the set, in the order of the entries
+	// +optional
+	Members []ModelSetMember `json:"members,omitempty"`
+
+	// Conditions provide detailed status information
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the last observed generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=mdls,categories={ai}
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Ready",type=integer,JSONPath=`.status.ready`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failed`
+// +kubebuilder:printcolumn:name="Total",type=integer,JSONPath=`.status.total`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ModelSet is the Schema for the modelsets API.
+// It creates Models from a template, one per item or matrix combination.
+type ModelSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ModelSetSpec   `json:"spec,omitempty"`
+	Status ModelSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ModelSetList contains a list of ModelSet
+type ModelSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ModelSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ModelSet{}, &ModelSetList{})
+}
@@ -26,6 +26,274 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConversionSpec) DeepCopyInto(out *ConversionSpec) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversionSpec.
+func (in *ConversionSpec) DeepCopy() *ConversionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConversionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConversionStatus) DeepCopyInto(out *ConversionStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConversionStatus.
+func (in *ConversionStatus) DeepCopy() *ConversionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ConversionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DVCSource) DeepCopyInto(out *DVCSource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DVCSource.
+func (in *DVCSource) DeepCopy() *DVCSource {
+	if in == nil {
+		return nil
+	}
+	out := new(DVCSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DownloadSpec) DeepCopyInto(out *DownloadSpec) {
+	*out = *in
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]v1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.HostAliases != nil {
+		in, out := &in.HostAliases, &out.HostAliases
+		*out = make([]v1.HostAlias, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSConfig != nil {
+		in, out := &in.DNSConfig, &out.DNSConfig
+		*out = new(v1.PodDNSConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Parallelism != nil {
+		in, out := &in.Parallelism, &out.Parallelism
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityContext != nil {
+		in, out := &in.SecurityContext, &out.SecurityContext
+		*out = new(v1.SecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PodSecurityContext != nil {
+		in, out := &in.PodSecurityContext, &out.PodSecurityContext
+		*out = new(v1.PodSecurityContext)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Env != nil {
+		in, out := &in.Env, &out.Env
+		*out = make([]v1.EnvVar, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EnvFrom != nil {
+		in, out := &in.EnvFrom, &out.EnvFrom
+		*out = make([]v1.EnvFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DownloadSpec.
+func (in *DownloadSpec) DeepCopy() *DownloadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DownloadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExistingClaimSpec) DeepCopyInto(out *ExistingClaimSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExistingClaimSpec.
+func (in *ExistingClaimSpec) DeepCopy() *ExistingClaimSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExistingClaimSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FallbackSource) DeepCopyInto(out *FallbackSource) {
+	*out = *in
+	if in.HuggingFace != nil {
+		in, out := &in.HuggingFace, &out.HuggingFace
+		*out = new(HuggingFaceSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URLSource)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Source)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DVC != nil {
+		in, out := &in.DVC, &out.DVC
+		*out = new(DVCSource)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FallbackSource.
+func (in *FallbackSource) DeepCopy() *FallbackSource {
+	if in == nil {
+		return nil
+	}
+	out := new(FallbackSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileServerSpec) DeepCopyInto(out *FileServerSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(v1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileServerSpec.
+func (in *FileServerSpec) DeepCopy() *FileServerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FileServerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GitSource) DeepCopyInto(out *GitSource) {
 	*out = *in
@@ -44,279 +312,1157 @@ func (in *GitSource) DeepCopyInto(out *GitSource) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSource.
+func (in *GitSource) DeepCopy() *GitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HuggingFaceSource) DeepCopyInto(out *HuggingFaceSource) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HuggingFaceSource.
+func (in *HuggingFaceSource) DeepCopy() *HuggingFaceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HuggingFaceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageSpec) DeepCopyInto(out *LocalStorageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageSpec.
+func (in *LocalStorageSpec) DeepCopy() *LocalStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalStorageStatus) DeepCopyInto(out *LocalStorageStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalStorageStatus.
+func (in *LocalStorageStatus) DeepCopy() *LocalStorageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalStorageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Model) DeepCopyInto(out *Model) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
+func (in *Model) DeepCopy() *Model {
+	if in == nil {
+		return nil
+	}
+	out := new(Model)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Model) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelAccess) DeepCopyInto(out *ModelAccess) {
+	*out = *in
+	if in.AllowedServiceAccounts != nil {
+		in, out := &in.AllowedServiceAccounts, &out.AllowedServiceAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSelectors != nil {
+		in, out := &in.AllowedSelectors, &out.AllowedSelectors
+		*out = make([]metav1.LabelSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelAccess.
+func (in *ModelAccess) DeepCopy() *ModelAccess {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelAccess)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelCardStatus) DeepCopyInto(out *ModelCardStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelCardStatus.
+func (in *ModelCardStatus) DeepCopy() *ModelCardStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelCardStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFamily) DeepCopyInto(out *ModelFamily) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFamily.
+func (in *ModelFamily) DeepCopy() *ModelFamily {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFamily)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelFamily) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFamilyList) DeepCopyInto(out *ModelFamilyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModelFamily, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFamilyList.
+func (in *ModelFamilyList) DeepCopy() *ModelFamilyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFamilyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelFamilyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFamilyMember) DeepCopyInto(out *ModelFamilyMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFamilyMember.
+func (in *ModelFamilyMember) DeepCopy() *ModelFamilyMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFamilyMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFamilySpec) DeepCopyInto(out *ModelFamilySpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFamilySpec.
+func (in *ModelFamilySpec) DeepCopy() *ModelFamilySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFamilySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelFamilyStatus) DeepCopyInto(out *ModelFamilyStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ModelFamilyMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelFamilyStatus.
+func (in *ModelFamilyStatus) DeepCopy() *ModelFamilyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelFamilyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelLease) DeepCopyInto(out *ModelLease) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelLease.
+func (in *ModelLease) DeepCopy() *ModelLease {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelLease)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelList) DeepCopyInto(out *ModelList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Model, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
+func (in *ModelList) DeepCopy() *ModelList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelOverlay) DeepCopyInto(out *ModelOverlay) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelOverlay.
+func (in *ModelOverlay) DeepCopy() *ModelOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelParameters) DeepCopyInto(out *ModelParameters) {
+	*out = *in
+	if in.Temperature != nil {
+		in, out := &in.Temperature, &out.Temperature
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopP != nil {
+		in, out := &in.TopP, &out.TopP
+		*out = new(string)
+		**out = **in
+	}
+	if in.TopK != nil {
+		in, out := &in.TopK, &out.TopK
+		*out = new(int)
+		**out = **in
+	}
+	if in.RepeatPenalty != nil {
+		in, out := &in.RepeatPenalty, &out.RepeatPenalty
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stop != nil {
+		in, out := &in.Stop, &out.Stop
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NumCtx != nil {
+		in, out := &in.NumCtx, &out.NumCtx
+		*out = new(int)
+		**out = **in
+	}
+	if in.NumGPU != nil {
+		in, out := &in.NumGPU, &out.NumGPU
+		*out = new(int)
+		**out = **in
+	}
+	if in.Seed != nil {
+		in, out := &in.Seed, &out.Seed
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelParameters.
+func (in *ModelParameters) DeepCopy() *ModelParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelRevision) DeepCopyInto(out *ModelRevision) {
+	*out = *in
+	in.ReplacedAt.DeepCopyInto(&out.ReplacedAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelRevision.
+func (in *ModelRevision) DeepCopy() *ModelRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSet) DeepCopyInto(out *ModelSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSet.
+func (in *ModelSet) DeepCopy() *ModelSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSetItem) DeepCopyInto(out *ModelSetItem) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSetItem.
+func (in *ModelSetItem) DeepCopy() *ModelSetItem {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSetItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSetList) DeepCopyInto(out *ModelSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModelSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSetList.
+func (in *ModelSetList) DeepCopy() *ModelSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ModelSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSetMember) DeepCopyInto(out *ModelSetMember) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSetMember.
+func (in *ModelSetMember) DeepCopy() *ModelSetMember {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSetMember)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSetSpec) DeepCopyInto(out *ModelSetSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ModelSetItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Matrix != nil {
+		in, out := &in.Matrix, &out.Matrix
+		*out = make(map[string][]string, len(*in))
+		for key, val := range *in {
+			var outVal []string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make([]string, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSetSpec.
+func (in *ModelSetSpec) DeepCopy() *ModelSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSetStatus) DeepCopyInto(out *ModelSetStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]ModelSetMember, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSetStatus.
+func (in *ModelSetStatus) DeepCopy() *ModelSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSource) DeepCopyInto(out *ModelSource) {
+	*out = *in
+	if in.HuggingFace != nil {
+		in, out := &in.HuggingFace, &out.HuggingFace
+		*out = new(HuggingFaceSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(URLSource)
+		**out = **in
+	}
+	if in.S3 != nil {
+		in, out := &in.S3, &out.S3
+		*out = new(S3Source)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DVC != nil {
+		in, out := &in.DVC, &out.DVC
+		*out = new(DVCSource)
+		**out = **in
+	}
+	if in.Fallbacks != nil {
+		in, out := &in.Fallbacks, &out.Fallbacks
+		*out = make([]FallbackSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSource.
+func (in *ModelSource) DeepCopy() *ModelSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Storage.DeepCopyInto(&out.Storage)
+	if in.Modelfile != nil {
+		in, out := &in.Modelfile, &out.Modelfile
+		*out = new(ModelfileSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(ModelAccess)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Download != nil {
+		in, out := &in.Download, &out.Download
+		*out = new(DownloadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Prewarm != nil {
+		in, out := &in.Prewarm, &out.Prewarm
+		*out = new(PrewarmSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FileServer != nil {
+		in, out := &in.FileServer, &out.FileServer
+		*out = new(FileServerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conversion != nil {
+		in, out := &in.Conversion, &out.Conversion
+		*out = new(ConversionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Publish != nil {
+		in, out := &in.Publish, &out.Publish
+		*out = new(PublishSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registration != nil {
+		in, out := &in.Registration, &out.Registration
+		*out = new(RegistrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RevisionHistoryLimit != nil {
+		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]StorageReplica, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinimumRetention != nil {
+		in, out := &in.MinimumRetention, &out.MinimumRetention
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Overlays != nil {
+		in, out := &in.Overlays, &out.Overlays
+		*out = make(map[string]ModelOverlay, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
+func (in *ModelSpec) DeepCopy() *ModelSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStage) DeepCopyInto(out *ModelStage) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStage.
+func (in *ModelStage) DeepCopy() *ModelStage {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DownloadDuration != nil {
+		in, out := &in.DownloadDuration, &out.DownloadDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]ModelRevision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastActivityTime != nil {
+		in, out := &in.LastActivityTime, &out.LastActivityTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ProvisionedBy != nil {
+		in, out := &in.ProvisionedBy, &out.ProvisionedBy
+		*out = new(ProvisionedBy)
+		**out = **in
+	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalStorageStatus)
+		**out = **in
+	}
+	if in.Conversion != nil {
+		in, out := &in.Conversion, &out.Conversion
+		*out = new(ConversionStatus)
+		**out = **in
+	}
+	if in.Registration != nil {
+		in, out := &in.Registration, &out.Registration
+		*out = new(RegistrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Publication != nil {
+		in, out := &in.Publication, &out.Publication
+		*out = new(PublicationStatus)
+		**out = **in
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = make([]ReplicaStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stages != nil {
+		in, out := &in.Stages, &out.Stages
+		*out = make([]ModelStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PhaseTransitionTime != nil {
+		in, out := &in.PhaseTransitionTime, &out.PhaseTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PhaseDurations != nil {
+		in, out := &in.PhaseDurations, &out.PhaseDurations
+		*out = make([]PhaseDuration, len(*in))
+		copy(*out, *in)
+	}
+	if in.Leases != nil {
+		in, out := &in.Leases, &out.Leases
+		*out = make([]ModelLease, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Card != nil {
+		in, out := &in.Card, &out.Card
+		*out = new(ModelCardStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
+func (in *ModelStatus) DeepCopy() *ModelStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ModelStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ModelTemplate) DeepCopyInto(out *ModelTemplate) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitSource.
-func (in *GitSource) DeepCopy() *GitSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelTemplate.
+func (in *ModelTemplate) DeepCopy() *ModelTemplate {
 	if in == nil {
 		return nil
 	}
-	out := new(GitSource)
+	out := new(ModelTemplate)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HuggingFaceSource) DeepCopyInto(out *HuggingFaceSource) {
+func (in *ModelfileSpec) DeepCopyInto(out *ModelfileSpec) {
 	*out = *in
-	if in.Include != nil {
-		in, out := &in.Include, &out.Include
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = new(ModelParameters)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HuggingFaceSource.
-func (in *HuggingFaceSource) DeepCopy() *HuggingFaceSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelfileSpec.
+func (in *ModelfileSpec) DeepCopy() *ModelfileSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HuggingFaceSource)
+	out := new(ModelfileSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Model) DeepCopyInto(out *Model) {
+func (in *PhaseDuration) DeepCopyInto(out *PhaseDuration) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.Duration = in.Duration
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Model.
-func (in *Model) DeepCopy() *Model {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PhaseDuration.
+func (in *PhaseDuration) DeepCopy() *PhaseDuration {
 	if in == nil {
 		return nil
 	}
-	out := new(Model)
+	out := new(PhaseDuration)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Model) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelList) DeepCopyInto(out *ModelList) {
+func (in *PrewarmSpec) DeepCopyInto(out *PrewarmSpec) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Model, len(*in))
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelList.
-func (in *ModelList) DeepCopy() *ModelList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrewarmSpec.
+func (in *PrewarmSpec) DeepCopy() *PrewarmSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelList)
+	out := new(PrewarmSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ModelList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisionedBy) DeepCopyInto(out *ProvisionedBy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisionedBy.
+func (in *ProvisionedBy) DeepCopy() *ProvisionedBy {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(ProvisionedBy)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelParameters) DeepCopyInto(out *ModelParameters) {
+func (in *PublicationStatus) DeepCopyInto(out *PublicationStatus) {
 	*out = *in
-	if in.Temperature != nil {
-		in, out := &in.Temperature, &out.Temperature
-		*out = new(string)
-		**out = **in
-	}
-	if in.TopP != nil {
-		in, out := &in.TopP, &out.TopP
-		*out = new(string)
-		**out = **in
-	}
-	if in.TopK != nil {
-		in, out := &in.TopK, &out.TopK
-		*out = new(int)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicationStatus.
+func (in *PublicationStatus) DeepCopy() *PublicationStatus {
+	if in == nil {
+		return nil
 	}
-	if in.RepeatPenalty != nil {
-		in, out := &in.RepeatPenalty, &out.RepeatPenalty
-		*out = new(string)
-		**out = **in
+	out := new(PublicationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublishSpec) DeepCopyInto(out *PublishSpec) {
+	*out = *in
+	if in.LayerSize != nil {
+		in, out := &in.LayerSize, &out.LayerSize
+		x := (*in).DeepCopy()
+		*out = &x
 	}
-	if in.Stop != nil {
-		in, out := &in.Stop, &out.Stop
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublishSpec.
+func (in *PublishSpec) DeepCopy() *PublishSpec {
+	if in == nil {
+		return nil
 	}
-	if in.NumCtx != nil {
-		in, out := &in.NumCtx, &out.NumCtx
-		*out = new(int)
-		**out = **in
+	out := new(PublishSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrationSpec) DeepCopyInto(out *RegistrationSpec) {
+	*out = *in
+	in.Webhook.DeepCopyInto(&out.Webhook)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationSpec.
+func (in *RegistrationSpec) DeepCopy() *RegistrationSpec {
+	if in == nil {
+		return nil
 	}
-	if in.NumGPU != nil {
-		in, out := &in.NumGPU, &out.NumGPU
-		*out = new(int)
-		**out = **in
+	out := new(RegistrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistrationStatus) DeepCopyInto(out *RegistrationStatus) {
+	*out = *in
+	if in.RegisteredTime != nil {
+		in, out := &in.RegisteredTime, &out.RegisteredTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Seed != nil {
-		in, out := &in.Seed, &out.Seed
-		*out = new(int)
-		**out = **in
+	if in.LastAttemptTime != nil {
+		in, out := &in.LastAttemptTime, &out.LastAttemptTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelParameters.
-func (in *ModelParameters) DeepCopy() *ModelParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationStatus.
+func (in *RegistrationStatus) DeepCopy() *RegistrationStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelParameters)
+	out := new(RegistrationStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelSource) DeepCopyInto(out *ModelSource) {
+func (in *RegistrationWebhook) DeepCopyInto(out *RegistrationWebhook) {
 	*out = *in
-	if in.HuggingFace != nil {
-		in, out := &in.HuggingFace, &out.HuggingFace
-		*out = new(HuggingFaceSource)
+	if in.TokenSecret != nil {
+		in, out := &in.TokenSecret, &out.TokenSecret
+		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.URL != nil {
-		in, out := &in.URL, &out.URL
-		*out = new(URLSource)
-		**out = **in
-	}
-	if in.S3 != nil {
-		in, out := &in.S3, &out.S3
-		*out = new(S3Source)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrationWebhook.
+func (in *RegistrationWebhook) DeepCopy() *RegistrationWebhook {
+	if in == nil {
+		return nil
 	}
-	if in.Git != nil {
-		in, out := &in.Git, &out.Git
-		*out = new(GitSource)
-		(*in).DeepCopyInto(*out)
+	out := new(RegistrationWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReplicaStatus) DeepCopyInto(out *ReplicaStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicaStatus.
+func (in *ReplicaStatus) DeepCopy() *ReplicaStatus {
+	if in == nil {
+		return nil
 	}
+	out := new(ReplicaStatus)
+	in.DeepCopyInto(out)
+	return out
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSource.
-func (in *ModelSource) DeepCopy() *ModelSource {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *S3Presign) DeepCopyInto(out *S3Presign) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Presign.
+func (in *S3Presign) DeepCopy() *S3Presign {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelSource)
+	out := new(S3Presign)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelSpec) DeepCopyInto(out *ModelSpec) {
+func (in *S3Source) DeepCopyInto(out *S3Source) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Storage.DeepCopyInto(&out.Storage)
-	if in.Modelfile != nil {
-		in, out := &in.Modelfile, &out.Modelfile
-		*out = new(ModelfileSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Presign != nil {
+		in, out := &in.Presign, &out.Presign
+		*out = new(S3Presign)
+		**out = **in
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Concurrency != nil {
+		in, out := &in.Concurrency, &out.Concurrency
+		*out = new(int32)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelSpec.
-func (in *ModelSpec) DeepCopy() *ModelSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Source.
+func (in *S3Source) DeepCopy() *S3Source {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelSpec)
+	out := new(S3Source)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
+func (in *SignatureVerification) DeepCopyInto(out *SignatureVerification) {
 	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.PublicKey.DeepCopyInto(&out.PublicKey)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelStatus.
-func (in *ModelStatus) DeepCopy() *ModelStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerification.
+func (in *SignatureVerification) DeepCopy() *SignatureVerification {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelStatus)
+	out := new(SignatureVerification)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ModelfileSpec) DeepCopyInto(out *ModelfileSpec) {
+func (in *StorageOwnership) DeepCopyInto(out *StorageOwnership) {
 	*out = *in
-	if in.Parameters != nil {
-		in, out := &in.Parameters, &out.Parameters
-		*out = new(ModelParameters)
-		(*in).DeepCopyInto(*out)
+	if in.UID != nil {
+		in, out := &in.UID, &out.UID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GID != nil {
+		in, out := &in.GID, &out.GID
+		*out = new(int64)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ModelfileSpec.
-func (in *ModelfileSpec) DeepCopy() *ModelfileSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageOwnership.
+func (in *StorageOwnership) DeepCopy() *StorageOwnership {
 	if in == nil {
 		return nil
 	}
-	out := new(ModelfileSpec)
+	out := new(StorageOwnership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *S3Source) DeepCopyInto(out *S3Source) {
+func (in *StorageReplica) DeepCopyInto(out *StorageReplica) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new S3Source.
-func (in *S3Source) DeepCopy() *S3Source {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageReplica.
+func (in *StorageReplica) DeepCopy() *StorageReplica {
 	if in == nil {
 		return nil
 	}
-	out := new(S3Source)
+	out := new(StorageReplica)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -329,6 +1475,21 @@ func (in *StorageSpec) DeepCopyInto(out *StorageSpec) {
 		*out = make([]v1.PersistentVolumeAccessMode, len(*in))
 		copy(*out, *in)
 	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalStorageSpec)
+		**out = **in
+	}
+	if in.Ownership != nil {
+		in, out := &in.Ownership, &out.Ownership
+		*out = new(StorageOwnership)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExistingClaim != nil {
+		in, out := &in.ExistingClaim, &out.ExistingClaim
+		*out = new(ExistingClaimSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageSpec.
@@ -355,3 +1516,23 @@ func (in *URLSource) DeepCopy() *URLSource {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationSpec) DeepCopyInto(out *VerificationSpec) {
+	*out = *in
+	if in.Signature != nil {
+		in, out := &in.Signature, &out.Signature
+		*out = new(SignatureVerification)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationSpec.
+func (in *VerificationSpec) DeepCopy() *VerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
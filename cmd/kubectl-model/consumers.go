@@ -0,0 +1,152 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// consumer is a pod mounting a model
+type consumer struct {
+	Model     string
+	Namespace string
+	Pod       string
+	// Workload is the controller running the pod, such as "Deployment/vllm"
+	Workload string
+	// Containers are the containers mounting the model
+	Containers []string
+	// Mode is "ro" or "rw", or "ro,rw" when containers differ
+	Mode string
+	// Volume describes what the pod mounts: the PVC, ConfigMap or image
+	Volume string
+}
+
+// podConsumer describes how the pod consumes the model, if it does
+func podConsumer(pod *corev1.Pod, model *modelsv1alpha1.Model) (*consumer, bool) {
+	volume, ok := resources.MountsModel(pod, model)
+	if !ok {
+		return nil, false
+	}
+
+	c := &consumer{Model: model.Name, Namespace: pod.Namespace, Pod: pod.Name}
+	switch {
+	case volume.PersistentVolumeClaim != nil:
+		c.Volume = "pvc/" + volume.PersistentVolumeClaim.ClaimName
+	case volume.ConfigMap != nil:
+		c.Volume = "configmap/" + volume.ConfigMap.Name
+	case volume.Image != nil:
+		c.Volume = "image/" + volume.Image.Reference
+	default:
+		c.Volume = volume.Name
+	}
+
+	var modes []string
+	for _, container := range slices.Concat(pod.Spec.InitContainers, pod.Spec.Containers) {
+		for _, mount := range container.VolumeMounts {
+			if mount.Name != volume.Name {
+				continue
+			}
+			c.Containers = append(c.Containers, container.Name)
+			mode := "rw"
+			if mount.ReadOnly || (volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ReadOnly) {
+				mode = "ro"
+			}
+			if !slices.Contains(modes, mode) {
+				modes = append(modes, mode)
+			}
+		}
+	}
+	sort.Strings(modes)
+	c.Mode = strings.Join(modes, ",")
+	return c, true
+}
+
+// workload returns the controller running the pod, following ReplicaSets to
+// their Deployment
+func workload(ctx context.Context, c client.Client, pod *corev1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return "-"
+	}
+	// Without access to the ReplicaSet, report it rather than fail
+	if owner.Kind == "ReplicaSet" {
+		rs := &appsv1.ReplicaSet{}
+		if err := c.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: pod.Namespace}, rs); err == nil {
+			if rsOwner := metav1.GetControllerOf(rs); rsOwner != nil {
+				return rsOwner.Kind + "/" + rsOwner.Name
+			}
+		}
+	}
+	return owner.Kind + "/" + owner.Name
+}
+
+// findConsumers returns the pods mounting each of the models, sorted by model
+// and pod. Pods that have finished no longer mount anything and are skipped.
+func findConsumers(ctx context.Context, c client.Client, namespace string, models []modelsv1alpha1.Model) ([]consumer, error) {
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var consumers []consumer
+	for i := range models {
+		for j := range pods.Items {
+			pod := &pods.Items[j]
+			if !resources.IsConsumerPod(pod) {
+				continue
+			}
+			if cons, ok := podConsumer(pod, &models[i]); ok {
+				cons.Workload = workload(ctx, c, pod)
+				consumers = append(consumers, *cons)
+			}
+		}
+	}
+
+	sort.Slice(consumers, func(i, j int) bool {
+		if consumers[i].Model != consumers[j].Model {
+			return consumers[i].Model < consumers[j].Model
+		}
+		return consumers[i].Pod < consumers[j].Pod
+	})
+	return consumers, nil
+}
+
+// printConsumers writes the consumers as a table
+func printConsumers(out io.Writer, consumers []consumer) error {
+	w := tabwriter.NewWriter(out, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "MODEL\tNAMESPACE\tPOD\tWORKLOAD\tCONTAINERS\tMODE\tVOLUME")
+	for _, c := range consumers {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			c.Model, c.Namespace, c.Pod, c.Workload, strings.Join(c.Containers, ","), c.Mode, c.Volume)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestFindConsumers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	llama := modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"}}
+	mistral := modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "mistral", Namespace: "default"}}
+
+	pod := func(name string, phase corev1.PodPhase, owner *metav1.OwnerReference, volume corev1.Volume, mounts ...corev1.VolumeMount) *corev1.Pod {
+		p := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: corev1.PodSpec{
+				Volumes:    []corev1.Volume{volume},
+				Containers: []corev1.Container{{Name: "server", VolumeMounts: mounts}},
+			},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+		if owner != nil {
+			p.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return p
+	}
+	pvcVolume := func(name, claim string) corev1.Volume {
+		return corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim, ReadOnly: true},
+		}}
+	}
+
+	rs := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name:            "vllm-5d8f",
+		Namespace:       "default",
+		OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "vllm", Controller: ptr.To(true)}},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		rs,
+		// Injected by the webhook, run by a Deployment
+		pod("vllm-5d8f-abcde", corev1.PodRunning,
+			&metav1.OwnerReference{Kind: "ReplicaSet", Name: "vllm-5d8f", Controller: ptr.To(true)},
+			pvcVolume("model-llama", "model-llama"),
+			corev1.VolumeMount{Name: "model-llama", MountPath: "/models/llama", ReadOnly: true}),
+		// Mounting a replica PVC by hand
+		pod("notebook", corev1.PodRunning, nil,
+			corev1.Volume{Name: "weights", VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "model-replica-llama-nvme"},
+			}},
+			corev1.VolumeMount{Name: "weights", MountPath: "/data"}),
+		// Finished pods no longer mount anything
+		pod("eval-done", corev1.PodSucceeded, nil, pvcVolume("model-llama", "model-llama"),
+			corev1.VolumeMount{Name: "model-llama", MountPath: "/models/llama"}),
+		// Another model
+		pod("mistral-server", corev1.PodRunning,
+			&metav1.OwnerReference{Kind: "StatefulSet", Name: "mistral", Controller: ptr.To(true)},
+			pvcVolume("model-mistral", "model-mistral"),
+			corev1.VolumeMount{Name: "model-mistral", MountPath: "/models/mistral", ReadOnly: true}),
+	).Build()
+
+	consumers, err := findConsumers(context.Background(), c, "default", []modelsv1alpha1.Model{mistral, llama})
+	if err != nil {
+		t.Fatalf("findConsumers() error = %v", err)
+	}
+
+	want := []consumer{
+		{Model: "llama", Namespace: "default", Pod: "notebook", Workload: "-",
+			Containers: []string{"server"}, Mode: "rw", Volume: "pvc/model-replica-llama-nvme"},
+		{Model: "llama", Namespace: "default", Pod: "vllm-5d8f-abcde", Workload: "Deployment/vllm",
+			Containers: []string{"server"}, Mode: "ro", Volume: "pvc/model-llama"},
+		{Model: "mistral", Namespace: "default", Pod: "mistral-server", Workload: "StatefulSet/mistral",
+			Containers: []string{"server"}, Mode: "ro", Volume: "pvc/model-mistral"},
+	}
+	if len(consumers) != len(want) {
+		t.Fatalf("findConsumers() = %+v, want %+v", consumers, want)
+	}
+	for i := range want {
+		got := consumers[i]
+		if got.Model != want[i].Model || got.Pod != want[i].Pod || got.Workload != want[i].Workload ||
+			strings.Join(got.Containers, ",") != strings.Join(want[i].Containers, ",") ||
+			got.Mode != want[i].Mode || got.Volume != want[i].Volume {
+			t.Errorf("findConsumers()[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+
+	var out bytes.Buffer
+	if err := printConsumers(&out, consumers); err != nil {
+		t.Fatalf("printConsumers() error = %v", err)
+	}
+	if lines := strings.Split(strings.TrimSpace(out.String()), "\n"); len(lines) != 4 || !strings.HasPrefix(lines[0], "MODEL") {
+		t.Errorf("printConsumers() = %q, want a header and 3 rows", out.String())
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// noFamily groups the Models that belong to no family
+const noFamily = "<none>"
+
+// phaseSeverity orders phases from best to worst, as the ModelFamily
+// controller does for its worst-of phase
+var phaseSeverity = map[modelsv1alpha1.ModelPhase]int{
+	modelsv1alpha1.ModelPhaseReady:       0,
+	modelsv1alpha1.ModelPhaseDownloading: 1,
+	modelsv1alpha1.ModelPhasePending:     2,
+	modelsv1alpha1.ModelPhaseFailed:      3,
+}
+
+// family is a family of Models with its worst-of phase
+type family struct {
+	Name   string
+	Phase  modelsv1alpha1.ModelPhase
+	Ready  int
+	Models []modelsv1alpha1.Model
+}
+
+// familyOf returns the family named by the Model's spec.family, falling back
+// to its family label
+func familyOf(model *modelsv1alpha1.Model) string {
+	if model.Spec.Family != "" {
+		return model.Spec.Family
+	}
+	return model.Labels[modelsv1alpha1.LabelFamily]
+}
+
+// groupByFamily groups the Models by family, sorted by name with the Models
+// of no family last. A Model joins the family it names and every ModelFamily
+// whose selector matches it, so it can be listed under more than one.
+func groupByFamily(families []modelsv1alpha1.ModelFamily, models []modelsv1alpha1.Model) ([]family, error) {
+	selectors := map[string]labels.Selector{}
+	for i := range families {
+		if families[i].Spec.Selector == nil {
+			continue
+		}
+		s, err := metav1.LabelSelectorAsSelector(families[i].Spec.Selector)
+		if err != nil {
+			return nil, fmt.Errorf("ModelFamily %s has an invalid selector: %w", families[i].Name, err)
+		}
+		if !s.Empty() {
+			selectors[families[i].Name] = s
+		}
+	}
+
+	groups := map[string]*family{}
+	add := func(name string, model modelsv1alpha1.Model) {
+		g, ok := groups[name]
+		if !ok {
+			g = &family{Name: name}
+			groups[name] = g
+		}
+		for _, m := range g.Models {
+			if m.Name == model.Name {
+				return
+			}
+		}
+		g.Models = append(g.Models, model)
+	}
+	for _, model := range models {
+		name := familyOf(&model)
+		if name != "" {
+			add(name, model)
+		}
+		for selected, selector := range selectors {
+			if selector.Matches(labels.Set(model.Labels)) {
+				add(selected, model)
+				name = selected
+			}
+		}
+		if name == "" {
+			add(noFamily, model)
+		}
+	}
+
+	var grouped []family
+	for _, g := range groups {
+		sort.Slice(g.Models, func(i, j int) bool { return g.Models[i].Name < g.Models[j].Name })
+		g.Phase = modelsv1alpha1.ModelPhaseReady
+		for _, model := range g.Models {
+			phase := model.Status.Phase
+			if phase == "" {
+				phase = modelsv1alpha1.ModelPhasePending
+			}
+			if phase == modelsv1alpha1.ModelPhaseReady {
+				g.Ready++
+			}
+			if phaseSeverity[phase] > phaseSeverity[g.Phase] {
+				g.Phase = phase
+			}
+		}
+		grouped = append(grouped, *g)
+	}
+	sort.Slice(grouped, func(i, j int) bool {
+		if (grouped[i].Name == noFamily) != (grouped[j].Name == noFamily) {
+			return grouped[j].Name == noFamily
+		}
+		return grouped[i].Name < grouped[j].Name
+	})
+	return grouped, nil
+}
+
+// listModels returns the Models in the namespace, and its ModelFamilies when
+// grouping by family
+func listModels(ctx context.Context, c client.Client, namespace string, byFamily bool) ([]modelsv1alpha1.ModelFamily, []modelsv1alpha1.Model, error) {
+	models := &modelsv1alpha1.ModelList{}
+	if err := c.List(ctx, models, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	sort.Slice(models.Items, func(i, j int) bool { return models.Items[i].Name < models.Items[j].Name })
+	if !byFamily {
+		return nil, models.Items, nil
+	}
+	families := &modelsv1alpha1.ModelFamilyList{}
+	if err := c.List(ctx, families, client.InNamespace(namespace)); err != nil {
+		return nil, nil, err
+	}
+	return families.Items, models.Items, nil
+}
+
+// printModels writes the Models as a table
+func printModels(out io.Writer, models []modelsv1alpha1.Model) error {
+	w := tabwriter.NewWriter(out, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "NAME\tFAMILY\tPHASE\tVERSION")
+	for i := range models {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			models[i].Name, orDash(familyOf(&models[i])), orDash(string(models[i].Status.Phase)), orDash(models[i].Spec.Version))
+	}
+	return w.Flush()
+}
+
+// printFamilies writes a table of the families, with the worst-of phase and
+// ready count of each on the row of its first Model
+func printFamilies(out io.Writer, families []family) error {
+	w := tabwriter.NewWriter(out, 0, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "FAMILY\tPHASE\tREADY\tMODEL\tMODEL PHASE\tVERSION")
+	for _, f := range families {
+		name, phase, ready := f.Name, string(f.Phase), fmt.Sprintf("%d/%d", f.Ready, len(f.Models))
+		for _, model := range f.Models {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				name, phase, ready, model.Name, orDash(string(model.Status.Phase)), orDash(model.Spec.Version))
+			name, phase, ready = "", "", ""
+		}
+	}
+	return w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestGroupByFamily(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	model := func(name, family string, labels map[string]string, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+			Spec:       modelsv1alpha1.ModelSpec{Family: family},
+			Status:     modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		model("llama-8b", "llama", nil, modelsv1alpha1.ModelPhaseReady),
+		model("llama-70b", "", map[string]string{modelsv1alpha1.LabelFamily: "llama"}, modelsv1alpha1.ModelPhaseDownloading),
+		model("llama-8b-awq", "", map[string]string{"quantized": "true"}, modelsv1alpha1.ModelPhaseFailed),
+		model("mistral", "", nil, modelsv1alpha1.ModelPhaseReady),
+		&modelsv1alpha1.ModelFamily{
+			ObjectMeta: metav1.ObjectMeta{Name: "quantized", Namespace: "default"},
+			Spec: modelsv1alpha1.ModelFamilySpec{Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"quantized": "true"},
+			}},
+		},
+		&modelsv1alpha1.ModelFamily{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "other"},
+			Spec: modelsv1alpha1.ModelFamilySpec{Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"quantized": "true"},
+			}},
+		},
+	).Build()
+
+	families, models, err := listModels(context.Background(), c, "default", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	grouped, err := groupByFamily(families, models)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type summary struct {
+		name   string
+		phase  modelsv1alpha1.ModelPhase
+		ready  int
+		models string
+	}
+	var got []summary
+	for _, f := range grouped {
+		var names []string
+		for _, m := range f.Models {
+			names = append(names, m.Name)
+		}
+		got = append(got, summary{f.Name, f.Phase, f.Ready, strings.Join(names, ",")})
+	}
+	want := []summary{
+		{"llama", modelsv1alpha1.ModelPhaseDownloading, 1, "llama-70b,llama-8b"},
+		{"quantized", modelsv1alpha1.ModelPhaseFailed, 0, "llama-8b-awq"},
+		{noFamily, modelsv1alpha1.ModelPhaseReady, 1, "mistral"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got families %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("family %d is %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	var out bytes.Buffer
+	if err := printFamilies(&out, grouped); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("printed %d lines, want a header and a row per member:\n%s", len(lines), out.String())
+	}
+	if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "llama Downloading 1/2 llama-70b Downloading -" {
+		t.Errorf("first family row is %q", lines[1])
+	}
+	if fields := strings.Fields(lines[2]); strings.Join(fields, " ") != "llama-8b Ready -" {
+		t.Errorf("second member row repeats the family: %q", lines[2])
+	}
+}
@@ -0,0 +1,396 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command kubectl-model is a kubectl plugin for working with Models.
+//
+// export writes the bundle of a Ready Model, and import creates the Model
+// from a bundle in another cluster, where its download must reproduce the
+// exported content digest. consumers lists the pods mounting a Model, for
+// checking who is affected before an upgrade or deletion. watch follows a
+// Model's status, events and download logs until it is Ready or Failed. list
+// lists the Models, or with --by-family rolls them up by ModelFamily:
+//
+//	kubectl model export llama-3-8b --bundle llama-3-8b.yaml --context staging
+//	kubectl model import --bundle llama-3-8b.yaml --context prod
+//	kubectl model consumers llama-3-8b
+//	kubectl model watch llama-3-8b
+//	kubectl model list --by-family
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/bundle"
+)
+
+const usage = `Work with Models.
+
+Usage:
+  kubectl model export NAME [--bundle FILE] [--artifact-url URL] [flags]
+  kubectl model import --bundle FILE [--name NAME] [--dry-run] [flags]
+  kubectl model consumers [NAME] [flags]
+  kubectl model watch NAME [--interval DURATION] [--tail LINES] [flags]
+  kubectl model list [--by-family] [flags]
+
+Run "kubectl model COMMAND -h" for the flags of a command.
+`
+
+// clusterFlags select the cluster and namespace, like kubectl's own flags
+type clusterFlags struct {
+	kubeconfig string
+	context    string
+	namespace  string
+}
+
+func (f *clusterFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.kubeconfig, "kubeconfig", "", "Path to the kubeconfig file. Defaults to $KUBECONFIG or ~/.kube/config.")
+	fs.StringVar(&f.context, "context", "", "The kubeconfig context to use.")
+	fs.StringVar(&f.namespace, "namespace", "", "The namespace of the Model. Defaults to the context's namespace.")
+	fs.StringVar(&f.namespace, "n", "", "Shorthand for --namespace.")
+}
+
+// config returns the REST config of the selected cluster and the namespace
+// to use
+func (f *clusterFlags) config() (*rest.Config, string, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = f.kubeconfig
+	loader := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules,
+		&clientcmd.ConfigOverrides{CurrentContext: f.context})
+
+	cfg, err := loader.ClientConfig()
+	if err != nil {
+		return nil, "", err
+	}
+	namespace := f.namespace
+	if namespace == "" {
+		if namespace, _, err = loader.Namespace(); err != nil {
+			return nil, "", err
+		}
+	}
+	return cfg, namespace, nil
+}
+
+// client returns a client for the selected cluster and the namespace to use
+func (f *clusterFlags) client() (client.Client, string, error) {
+	cfg, namespace, err := f.config()
+	if err != nil {
+		return nil, "", err
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		return nil, "", err
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		return nil, "", err
+	}
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	return c, namespace, err
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "export":
+		err = runExport(os.Args[2:])
+	case "import":
+		err = runImport(os.Args[2:])
+	case "consumers":
+		err = runConsumers(os.Args[2:])
+	case "watch":
+		err = runWatch(os.Args[2:])
+	case "list":
+		err = runList(os.Args[2:])
+	case "-h", "--help", "help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", os.Args[1], usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// parseInterspersed parses flags before and after the first positional
+// argument, which the flag package would otherwise stop at
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			return positional, nil
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+}
+
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	var cluster clusterFlags
+	cluster.register(fs)
+	out := fs.String("bundle", "-", "The file to write the bundle to, or - for stdout.")
+	artifactURL := fs.String("artifact-url", "", "Where a tarball of the model content was uploaded, recorded in the bundle.")
+	names, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(names) != 1 {
+		return errors.New("export takes exactly one Model name")
+	}
+
+	c, namespace, err := cluster.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	model := &modelsv1alpha1.Model{}
+	if err := c.Get(ctx, types.NamespacedName{Name: names[0], Namespace: namespace}, model); err != nil {
+		return err
+	}
+	b, err := bundle.Export(model, *artifactURL, time.Now())
+	if err != nil {
+		return err
+	}
+	data, err := b.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if *out == "-" {
+		_, err = os.Stdout.Write(data)
+		return err
+	}
+	if err := os.WriteFile(*out, data, 0o644); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported model %s/%s (%s) to %s\n", namespace, model.Name, b.Manifest.ContentDigest, *out)
+	return nil
+}
+
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	var cluster clusterFlags
+	cluster.register(fs)
+	in := fs.String("bundle", "-", "The bundle file to import, or - for stdin.")
+	name := fs.String("name", "", "The name of the imported Model. Defaults to the exported name.")
+	dryRun := fs.Bool("dry-run", false, "Print the Model instead of creating it.")
+	if _, err := parseInterspersed(fs, args); err != nil {
+		return err
+	}
+
+	var data []byte
+	var err error
+	if *in == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(*in)
+	}
+	if err != nil {
+		return err
+	}
+	b, err := bundle.Parse(data)
+	if err != nil {
+		return err
+	}
+	if *name != "" {
+		b.Name = *name
+	}
+
+	if *dryRun {
+		namespace := cluster.namespace
+		if namespace == "" {
+			namespace = "default"
+		}
+		out, err := yaml.Marshal(b.Model(namespace))
+		if err != nil {
+			return err
+		}
+		_, err = os.Stdout.Write(out)
+		return err
+	}
+
+	c, namespace, err := cluster.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	model := b.Model(namespace)
+	if err := c.Create(ctx, model); err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Imported model %s/%s, expecting content %s\n", namespace, model.Name, b.Manifest.ContentDigest)
+	return nil
+}
+
+func runConsumers(args []string) error {
+	fs := flag.NewFlagSet("consumers", flag.ExitOnError)
+	var cluster clusterFlags
+	cluster.register(fs)
+	names, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(names) > 1 {
+		return errors.New("consumers takes at most one Model name")
+	}
+
+	c, namespace, err := cluster.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Without a name, report on every Model in the namespace
+	var models []modelsv1alpha1.Model
+	if len(names) == 1 {
+		model := &modelsv1alpha1.Model{}
+		if err := c.Get(ctx, types.NamespacedName{Name: names[0], Namespace: namespace}, model); err != nil {
+			return err
+		}
+		models = append(models, *model)
+	} else {
+		list := &modelsv1alpha1.ModelList{}
+		if err := c.List(ctx, list, client.InNamespace(namespace)); err != nil {
+			return err
+		}
+		models = list.Items
+	}
+
+	consumers, err := findConsumers(ctx, c, namespace, models)
+	if err != nil {
+		return err
+	}
+	if len(consumers) == 0 {
+		fmt.Fprintf(os.Stderr, "No pods in %s mount the model\n", namespace)
+		return nil
+	}
+	return printConsumers(os.Stdout, consumers)
+}
+
+func runList(args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	var cluster clusterFlags
+	cluster.register(fs)
+	byFamily := fs.Bool("by-family", false, "Group the Models by family, with the worst-of phase and ready count of each family.")
+	names, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(names) != 0 {
+		return errors.New("list takes no arguments")
+	}
+
+	c, namespace, err := cluster.client()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	families, models, err := listModels(ctx, c, namespace, *byFamily)
+	if err != nil {
+		return err
+	}
+	if len(models) == 0 {
+		fmt.Fprintf(os.Stderr, "No Models in %s\n", namespace)
+		return nil
+	}
+	if !*byFamily {
+		return printModels(os.Stdout, models)
+	}
+	grouped, err := groupByFamily(families, models)
+	if err != nil {
+		return err
+	}
+	return printFamilies(os.Stdout, grouped)
+}
+
+func runWatch(args []string) error {
+	fs := flag.NewFlagSet("watch", flag.ExitOnError)
+	var cluster clusterFlags
+	cluster.register(fs)
+	interval := fs.Duration("interval", 2*time.Second, "How often to check the Model and its events.")
+	tail := fs.Int64("tail", 10, "Lines of each download container's log to show from before it is followed.")
+	noLogs := fs.Bool("no-logs", false, "Do not follow the logs of the download pods.")
+	names, err := parseInterspersed(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(names) != 1 {
+		return errors.New("watch takes exactly one Model name")
+	}
+
+	c, namespace, err := cluster.client()
+	if err != nil {
+		return err
+	}
+	var logs logsFunc
+	if !*noLogs {
+		cfg, _, err := cluster.config()
+		if err != nil {
+			return err
+		}
+		clientset, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return err
+		}
+		logs = func(ctx context.Context, pod, container string) (io.ReadCloser, error) {
+			return clientset.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+				Container: container,
+				Follow:    true,
+				TailLines: tail,
+			}).Stream(ctx)
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	return newWatcher(c, namespace, names[0], os.Stdout, logs).run(ctx, *interval, 5*time.Second)
+}
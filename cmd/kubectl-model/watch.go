@@ -0,0 +1,251 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// logsFunc opens the log stream of a container of a pod
+type logsFunc func(ctx context.Context, pod, container string) (io.ReadCloser, error)
+
+// watcher reports what happens to a Model: its status and progress, its
+// provisioning stages, its events and those of its download Job and pods, and the logs of the download
+// pods
+type watcher struct {
+	client    client.Client
+	namespace string
+	name      string
+	// logs follows the download pods' logs, nil to skip them
+	logs logsFunc
+
+	mu  sync.Mutex
+	out io.Writer
+	now func() time.Time
+
+	status    string
+	stages    map[modelsv1alpha1.StageName]modelsv1alpha1.StageState
+	events    map[string]bool
+	following map[string]bool
+	streams   sync.WaitGroup
+}
+
+func newWatcher(c client.Client, namespace, name string, out io.Writer, logs logsFunc) *watcher {
+	return &watcher{
+		client:    c,
+		namespace: namespace,
+		name:      name,
+		logs:      logs,
+		out:       out,
+		now:       time.Now,
+		stages:    map[modelsv1alpha1.StageName]modelsv1alpha1.StageState{},
+		events:    map[string]bool{},
+		following: map[string]bool{},
+	}
+}
+
+// printf writes a line, serialized with the log streams
+func (w *watcher) printf(format string, args ...any) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	fmt.Fprintf(w.out, format+"\n", args...)
+}
+
+// statusLine describes the phase, progress and message of the Model
+func statusLine(model *modelsv1alpha1.Model) string {
+	phase := string(model.Status.Phase)
+	if phase == "" {
+		phase = "Pending"
+	}
+	line := phase
+	if model.Status.Phase == modelsv1alpha1.ModelPhaseDownloading {
+		line += fmt.Sprintf(" %d%%", model.Status.Progress)
+		if model.Status.DownloadedBytes > 0 {
+			line += " " + resource.NewQuantity(model.Status.DownloadedBytes, resource.BinarySI).String()
+			if model.Status.EstimatedSizeBytes > 0 {
+				line += " of " + resource.NewQuantity(model.Status.EstimatedSizeBytes, resource.BinarySI).String()
+			}
+		}
+	}
+	if model.Status.Message != "" {
+		line += ": " + model.Status.Message
+	}
+	return line
+}
+
+// poll reports what changed since the last poll and whether the Model has
+// settled in Ready or Failed
+func (w *watcher) poll(ctx context.Context) (*modelsv1alpha1.Model, error) {
+	model := &modelsv1alpha1.Model{}
+	if err := w.client.Get(ctx, types.NamespacedName{Name: w.name, Namespace: w.namespace}, model); err != nil {
+		return nil, err
+	}
+	if line := statusLine(model); line != w.status {
+		w.status = line
+		w.printf("%s %s", w.now().Format(time.TimeOnly), line)
+	}
+	for _, stage := range model.Status.Stages {
+		if w.stages[stage.Name] == stage.State {
+			continue
+		}
+		w.stages[stage.Name] = stage.State
+		w.printf("%s stage %s %s", w.now().Format(time.TimeOnly), stage.Name, stage.State)
+	}
+
+	pods := &corev1.PodList{}
+	if err := w.client.List(ctx, pods, client.InNamespace(w.namespace),
+		client.MatchingLabels{batchv1.JobNameLabel: resources.JobName(w.name)}); err != nil {
+		return nil, err
+	}
+	if err := w.reportEvents(ctx, pods.Items); err != nil {
+		return nil, err
+	}
+	if w.logs != nil {
+		for i := range pods.Items {
+			w.follow(ctx, &pods.Items[i])
+		}
+	}
+	return model, nil
+}
+
+// reportEvents prints the events not printed yet of the Model, its download
+// Job and the Job's pods, oldest first
+func (w *watcher) reportEvents(ctx context.Context, pods []corev1.Pod) error {
+	involved := map[string]bool{
+		"Model/" + w.name:                  true,
+		"Job/" + resources.JobName(w.name): true,
+	}
+	for _, pod := range pods {
+		involved["Pod/"+pod.Name] = true
+	}
+
+	list := &corev1.EventList{}
+	if err := w.client.List(ctx, list, client.InNamespace(w.namespace)); err != nil {
+		return err
+	}
+	var events []corev1.Event
+	for _, e := range list.Items {
+		key := fmt.Sprintf("%s/%d", e.UID, e.Count)
+		if !involved[e.InvolvedObject.Kind+"/"+e.InvolvedObject.Name] || w.events[key] {
+			continue
+		}
+		w.events[key] = true
+		events = append(events, e)
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(&events[i]).Before(eventTime(&events[j]))
+	})
+	for _, e := range events {
+		w.printf("%s %s %s/%s %s: %s", eventTime(&e).Format(time.TimeOnly), e.Type,
+			e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Reason, e.Message)
+	}
+	return nil
+}
+
+// eventTime returns when the event last happened
+func eventTime(e *corev1.Event) time.Time {
+	switch {
+	case !e.LastTimestamp.IsZero():
+		return e.LastTimestamp.Time
+	case !e.EventTime.IsZero():
+		return e.EventTime.Time
+	}
+	return e.CreationTimestamp.Time
+}
+
+// follow streams the logs of the containers of a download pod that has
+// started, once per container
+func (w *watcher) follow(ctx context.Context, pod *corev1.Pod) {
+	for _, status := range pod.Status.ContainerStatuses {
+		key := pod.Name + "/" + status.Name
+		if w.following[key] || (status.State.Running == nil && status.State.Terminated == nil) {
+			continue
+		}
+		w.following[key] = true
+		w.streams.Add(1)
+		go func(container string) {
+			defer w.streams.Done()
+			stream, err := w.logs(ctx, pod.Name, container)
+			if err != nil {
+				w.printf("[%s] cannot follow the logs: %v", key, err)
+				return
+			}
+			defer func() { _ = stream.Close() }()
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				w.printf("[%s] %s", key, scanner.Text())
+			}
+		}(status.Name)
+	}
+}
+
+// run polls every interval until the Model is Ready or Failed, then waits up
+// to drain for the log streams to finish. A Failed Model is an error.
+func (w *watcher) run(ctx context.Context, interval, drain time.Duration) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		model, err := w.poll(ctx)
+		if err != nil {
+			return err
+		}
+		switch model.Status.Phase {
+		case modelsv1alpha1.ModelPhaseReady, modelsv1alpha1.ModelPhaseFailed:
+			w.drain(drain)
+			if model.Status.Phase == modelsv1alpha1.ModelPhaseFailed {
+				return fmt.Errorf("model %s/%s failed", w.namespace, w.name)
+			}
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// drain waits up to timeout for the log streams to reach their end
+func (w *watcher) drain(timeout time.Duration) {
+	done := make(chan struct{})
+	go func() {
+		w.streams.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(timeout):
+	}
+}
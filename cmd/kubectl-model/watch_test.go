@@ -0,0 +1,160 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestWatcher(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	at := metav1.NewTime(time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC))
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Status: modelsv1alpha1.ModelStatus{
+			Phase:              modelsv1alpha1.ModelPhaseDownloading,
+			Progress:           50,
+			DownloadedBytes:    1 << 30,
+			EstimatedSizeBytes: 2 << 30,
+			Stages: []modelsv1alpha1.ModelStage{
+				{Name: modelsv1alpha1.StageDownload, State: modelsv1alpha1.StageStateRunning},
+				{Name: modelsv1alpha1.StageVerify, State: modelsv1alpha1.StageStatePending},
+			},
+		},
+	}
+	event := func(name, kind, object, reason string) *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name)},
+			InvolvedObject: corev1.ObjectReference{Kind: kind, Name: object},
+			Type:           corev1.EventTypeNormal,
+			Reason:         reason,
+			Message:        reason + " happened",
+			Count:          1,
+			LastTimestamp:  at,
+		}
+	}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "model-download-llama-x7k2",
+			Namespace: "default",
+			Labels:    map[string]string{batchv1.JobNameLabel: "model-download-llama"},
+		},
+		Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: "download", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			{Name: "progress", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}}},
+		}},
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		model, pod,
+		event("started", "Model", "llama", "DownloadStarted"),
+		event("scheduled", "Pod", pod.Name, "Scheduled"),
+		event("other", "Model", "mistral", "DownloadStarted"),
+	).Build()
+
+	var out bytes.Buffer
+	var followed []string
+	logs := func(_ context.Context, pod, container string) (io.ReadCloser, error) {
+		followed = append(followed, pod+"/"+container)
+		return io.NopCloser(strings.NewReader("fetching model.gguf\n")), nil
+	}
+	w := newWatcher(c, "default", "llama", &out, logs)
+	w.now = func() time.Time { return at.Time }
+	ctx := context.Background()
+
+	if _, err := w.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	w.drain(time.Second)
+	for _, want := range []string{
+		"Downloading 50% 1Gi of 2Gi",
+		"stage Download Running",
+		"stage Verify Pending",
+		"Model/llama DownloadStarted: DownloadStarted happened",
+		"Pod/model-download-llama-x7k2 Scheduled",
+		"[model-download-llama-x7k2/download] fetching model.gguf",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("output is missing %q:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "mistral") {
+		t.Errorf("events of other Models should not be shown:\n%s", out.String())
+	}
+	if len(followed) != 1 {
+		t.Errorf("only the started container should be followed, got %v", followed)
+	}
+
+	// Nothing changed, nothing is printed again
+	out.Reset()
+	if _, err := w.poll(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if out.Len() != 0 {
+		t.Errorf("unexpected output:\n%s", out.String())
+	}
+
+	update := func(phase modelsv1alpha1.ModelPhase, message string) {
+		t.Helper()
+		current := &modelsv1alpha1.Model{}
+		if err := c.Get(ctx, client.ObjectKeyFromObject(model), current); err != nil {
+			t.Fatal(err)
+		}
+		current.Status.Phase, current.Status.Message = phase, message
+		if err := c.Update(ctx, current); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	update(modelsv1alpha1.ModelPhaseReady, "")
+	if err := w.run(ctx, time.Millisecond, time.Second); err != nil {
+		t.Errorf("a Ready Model should end the watch without error, got %v", err)
+	}
+	if !strings.Contains(out.String(), "Ready") {
+		t.Errorf("the transition to Ready is missing:\n%s", out.String())
+	}
+
+	update(modelsv1alpha1.ModelPhaseFailed, "download failed")
+	if err := w.run(ctx, time.Millisecond, time.Second); err == nil {
+		t.Error("a Failed Model should end the watch with an error")
+	}
+	if !strings.Contains(out.String(), "Failed: download failed") {
+		t.Errorf("the failure is missing:\n%s", out.String())
+	}
+}
@@ -17,34 +17,75 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"flag"
+	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/discovery"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/certs"
 	"github.com/rsJames-ttrpg/model-operator/internal/controller"
+	"github.com/rsJames-ttrpg/model-operator/internal/health"
+	"github.com/rsJames-ttrpg/model-operator/internal/inventory"
+	"github.com/rsJames-ttrpg/model-operator/internal/modelcard"
+	"github.com/rsJames-ttrpg/model-operator/internal/monitoring"
+	"github.com/rsJames-ttrpg/model-operator/internal/progress"
+	"github.com/rsJames-ttrpg/model-operator/internal/registration"
+	"github.com/rsJames-ttrpg/model-operator/internal/registry"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/internal/sharding"
 	modelwebhook "github.com/rsJames-ttrpg/model-operator/internal/webhook"
+	"github.com/rsJames-ttrpg/model-operator/pkg/estimate"
+	"github.com/rsJames-ttrpg/model-operator/pkg/presign"
 	// +kubebuilder:scaffold:imports
 )
 
+// cardFetchTimeout bounds each model card request to the Hugging Face Hub
+const cardFetchTimeout = 30 * time.Second
+
+// discoveryTimeout bounds the API discovery request of the CRD readiness check
+const discoveryTimeout = 5 * time.Second
+
+// estimateTimeout bounds each request made to estimate a download size or
+// list the objects to presign
+const estimateTimeout = 30 * time.Second
+
+// registrationTimeout bounds each call to a Model's registration webhook
+const registrationTimeout = 30 * time.Second
+
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+
+	// version is set at build time with -ldflags "-X main.version=..."
+	version = "dev"
 )
 
 func init() {
@@ -59,11 +100,32 @@ func main() {
 	var metricsAddr string
 	var metricsCertPath, metricsCertName, metricsCertKey string
 	var webhookCertPath, webhookCertName, webhookCertKey string
+	var webhookCertProvider, webhookCertSecret, webhookService string
+	var mutatingWebhookConfig, validatingWebhookConfig string
 	var enableLeaderElection bool
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var tlsOpts []func(*tls.Config)
+	var progressCfg progress.Config
+	controllerConfig := controller.Config{OperatorVersion: version}
+	var environment string
+	var stallTimeout time.Duration
+	var maxStallRestarts int
+	var auditConfigMap string
+	var podLabels, podAnnotations string
+	var estimateSizes bool
+	var estimateEndpoints []string
+	var presignEndpoints []string
+	var cardEndpoints []string
+	var registrationPrefixes []string
+	var shard sharding.Shard
+	var enableMonitoring bool
+	var monitoringConfig monitoring.Config
+	var monitoringLabels string
+	var registryPort int
+	var kubeAPIQPS, webhookKubeAPIQPS float64
+	var kubeAPIBurst, webhookKubeAPIBurst int
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -75,12 +137,165 @@ func main() {
 	flag.StringVar(&webhookCertPath, "webhook-cert-path", "", "The directory that contains the webhook certificate.")
 	flag.StringVar(&webhookCertName, "webhook-cert-name", "tls.crt", "The name of the webhook certificate file.")
 	flag.StringVar(&webhookCertKey, "webhook-cert-key", "tls.key", "The name of the webhook key file.")
+	flag.StringVar(&webhookCertProvider, "webhook-cert-provider", certs.ProviderCertManager,
+		"How the webhook certificate is provisioned: cert-manager, which mounts it into --webhook-cert-path, "+
+			"or self-signed, where the operator issues and rotates it and patches the webhook configurations' CA bundle.")
+	flag.StringVar(&webhookCertSecret, "webhook-cert-secret", "webhook-server-cert",
+		"The Secret in the operator namespace the self-signed webhook certificate is kept in.")
+	flag.StringVar(&webhookService, "webhook-service-name", "model-operator-webhook-service",
+		"The webhook Service the self-signed certificate is issued for.")
+	flag.StringVar(&mutatingWebhookConfig, "mutating-webhook-configuration", "model-operator-mutating-webhook-configuration",
+		"The MutatingWebhookConfiguration whose CA bundle is patched with the self-signed CA.")
+	flag.StringVar(&validatingWebhookConfig, "validating-webhook-configuration",
+		"model-operator-validating-webhook-configuration",
+		"The ValidatingWebhookConfiguration whose CA bundle is patched with the self-signed CA.")
 	flag.StringVar(&metricsCertPath, "metrics-cert-path", "",
 		"The directory that contains the metrics server certificate.")
 	flag.StringVar(&metricsCertName, "metrics-cert-name", "tls.crt", "The name of the metrics server certificate file.")
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&progressCfg.Type, "progress-reporter", progress.TypeNone,
+		"How download Jobs report progress: none, configmap, status or pushgateway.")
+	flag.StringVar(&progressCfg.Image, "progress-image", progress.DefaultImage,
+		"The image used for the progress reporter sidecar.")
+	flag.DurationVar(&progressCfg.Interval, "progress-interval", progress.DefaultInterval,
+		"How often the progress reporter sidecar publishes progress.")
+	flag.StringVar(&progressCfg.PushgatewayURL, "pushgateway-url", "",
+		"The Prometheus pushgateway URL used by the pushgateway progress reporter.")
+	flag.StringVar(&progressCfg.ServiceAccountName, "progress-service-account", "",
+		"The ServiceAccount for download pods when the progress reporter needs Kubernetes API access.")
+	images := &controllerConfig.Resources.Images
+	imageFlag(&controllerConfig.Resources.HuggingFace.Image, "hf-downloader-image", "HF_DOWNLOADER",
+		"Overrides the Hugging Face downloader image, e.g. one with huggingface_hub pre-installed.")
+	imageFlag(&images.Compress, "compress-image", "COMPRESS",
+		"Overrides the image that compresses models at rest and decompresses them for pods, e.g. one with zstd pre-installed.")
+	imageFlag(&images.S5cmd, "s5cmd-image", "S5CMD",
+		"Overrides the image that downloads S3 sources with transferTool: s5cmd.")
+	imageFlag(&images.S3, "s3-image", "S3", "Overrides the aws-cli image that downloads S3 sources.")
+	imageFlag(&images.Curl, "curl-image", "CURL",
+		"Overrides the curl image that downloads URL sources and presigned S3 objects.")
+	imageFlag(&images.Git, "git-image", "GIT", "Overrides the image that clones Git sources.")
+	imageFlag(&images.DVC, "dvc-image", "DVC",
+		"Overrides the image that downloads DVC sources, e.g. one with DVC pre-installed.")
+	imageFlag(&images.Busybox, "busybox-image", "BUSYBOX",
+		"Overrides the busybox image that cleans up local storage and rebuilds status.")
+	imageFlag(&images.Publish, "publish-image", "PUBLISH", "Overrides the crane image that publishes models as OCI images.")
+	imageFlag(&images.Pause, "pause-image", "PAUSE", "Overrides the pause image that keeps pre-pull pods alive.")
+	imageFlag(&images.FileServer, "file-server-image", "FILE_SERVER",
+		"Overrides the image that serves model files over HTTP, a non-root nginx serving /usr/share/nginx/html on 8080.")
+	flag.Func("download-image-pull-secrets",
+		"Image pull secrets added to download pods, as name,..., for downloader images in a private registry. "+
+			"Each must exist in the Model's namespace.",
+		func(value string) error {
+			controllerConfig.Resources.ImagePullSecrets = append(controllerConfig.Resources.ImagePullSecrets,
+				resources.ParseImagePullSecrets(value)...)
+			return nil
+		})
+	flag.StringVar(&controllerConfig.Resources.DownloaderServiceAccount.Name, "downloader-service-account",
+		resources.DefaultDownloaderServiceAccount,
+		"The ServiceAccount created in each namespace for download pods that name none. Empty uses the namespace's default.")
+	flag.Func("downloader-service-account-annotation",
+		"An annotation of the downloader ServiceAccount as key=value, e.g. for a cloud identity. "+
+			"The value is a Go template of .Namespace. Repeat for more annotations.",
+		func(value string) error {
+			key, tmpl, err := resources.ParseServiceAccountAnnotation(value)
+			if err != nil {
+				return err
+			}
+			if controllerConfig.Resources.DownloaderServiceAccount.Annotations == nil {
+				controllerConfig.Resources.DownloaderServiceAccount.Annotations = map[string]string{}
+			}
+			controllerConfig.Resources.DownloaderServiceAccount.Annotations[key] = tmpl
+			return nil
+		})
+	flag.StringVar(&controllerConfig.Resources.HuggingFace.PipSource, "hf-pip-source", resources.PipSourcePyPI,
+		"Where the Hugging Face downloader installs its Python packages from: pypi, index, wheels or none.")
+	flag.StringVar(&controllerConfig.Resources.HuggingFace.PipIndexURL, "hf-pip-index-url", "",
+		"The private package index used when --hf-pip-source=index.")
+	flag.StringVar(&controllerConfig.Resources.HuggingFace.WheelsConfigMap, "hf-wheels-configmap", "",
+		"A ConfigMap in the Model namespace holding wheels, used when --hf-pip-source=wheels.")
+	flag.StringVar(&controllerConfig.Resources.HuggingFace.WheelsClaimName, "hf-wheels-pvc", "",
+		"A PVC in the Model namespace holding wheels, used when --hf-pip-source=wheels.")
+	flag.BoolVar(&controllerConfig.Resources.HuggingFace.DisableXet, "hf-disable-xet", false,
+		"Download Xet-backed Hugging Face repos over plain HTTP instead of by deduplicated chunks.")
+	flag.StringVar(&controllerConfig.Resources.HuggingFace.XetCacheDir, "hf-xet-cache-dir", resources.DefaultXetCacheDir,
+		"Where the Hugging Face downloader mounts the scratch volume holding the Xet chunk cache, kept off the model volume.")
+	flag.StringVar(&controllerConfig.Resources.LocalStorageBaseDir, "local-storage-base-dir", resources.DefaultLocalStorageBaseDir,
+		"The directory on nodes that local storage is kept under. Each Model's files are in <dir>/<namespace>/<name>, "+
+			"in the directory named by spec.storage.local.path if set.")
+	flag.DurationVar(&stallTimeout, "download-stall-timeout", 30*time.Minute,
+		"Restart a download Job that moves no bytes for this long; 0 disables. Needs the configmap or status progress reporter.")
+	flag.IntVar(&maxStallRestarts, "download-stall-restarts", 3,
+		"How many times a stalled download is restarted before the Model fails.")
+	flag.BoolVar(&estimateSizes, "estimate-download-size", true,
+		"Estimate each download's size from its source before it starts, in status.estimatedSizeBytes.")
+	flag.Func("estimate-endpoint",
+		"An endpoint download sizes may be estimated from, with the Model's credentials, e.g. https://minio.internal. "+
+			"Repeat for more endpoints. Defaults to "+modelcard.DefaultEndpoint+" alone; AWS S3 is always allowed.",
+		func(value string) error {
+			estimateEndpoints = append(estimateEndpoints, value)
+			return nil
+		})
+	flag.Func("presign-endpoint",
+		"An S3-compatible endpoint the operator may list objects at to presign downloads, e.g. https://storage.googleapis.com. "+
+			"Repeat for more endpoints. AWS S3 is always allowed.",
+		func(value string) error {
+			presignEndpoints = append(presignEndpoints, value)
+			return nil
+		})
+	flag.Func("model-card-endpoint",
+		"A Hugging Face Hub endpoint model cards may be fetched from, with the Model's Hub token. "+
+			"Repeat for more endpoints. Defaults to "+modelcard.DefaultEndpoint+" alone.",
+		func(value string) error {
+			cardEndpoints = append(cardEndpoints, value)
+			return nil
+		})
+	flag.Func("registration-url-prefix",
+		"A URL prefix spec.registration.webhook.url may start with, e.g. https://catalog.internal/api/. "+
+			"Repeat for more prefixes. Without any, Models cannot be registered.",
+		func(value string) error {
+			registrationPrefixes = append(registrationPrefixes, value)
+			return nil
+		})
+	flag.StringVar(&auditConfigMap, "audit-configmap", "",
+		"A ConfigMap in each Model namespace that logs who changed each Model; empty records events only.")
+	flag.StringVar(&podLabels, "injected-pod-labels", "",
+		"Labels added to pods the webhook injects models into, as key=value,... Namespaces add more with the "+
+			modelwebhook.AnnotationPodLabels+" annotation.")
+	flag.StringVar(&podAnnotations, "injected-pod-annotations", "",
+		"Annotations added to pods the webhook injects models into, as key=value,... Namespaces add more with the "+
+			modelwebhook.AnnotationPodAnnotations+" annotation.")
+	flag.IntVar(&shard.Count, "shard-count", 1,
+		"Split Model reconciliation across this many shards by namespace hash. Each shard elects its own leader.")
+	flag.IntVar(&shard.Index, "shard-index", 0,
+		"The shard this replica reconciles, from 0 to --shard-count - 1, e.g. the StatefulSet pod index.")
+	flag.StringVar(&environment, "environment", "",
+		"The environment (e.g. dev, staging, prod) whose spec.overlays entry is applied to admitted Models.")
+	flag.BoolVar(&enableMonitoring, "monitoring", false,
+		"Create and maintain a ServiceMonitor for the metrics and a PrometheusRule with the default alerts. "+
+			"Requires the Prometheus Operator CRDs.")
+	flag.StringVar(&monitoringLabels, "monitoring-labels", "",
+		"Labels added to the ServiceMonitor and PrometheusRule as key=value,..., e.g. to match a Prometheus selector.")
+	flag.DurationVar(&monitoringConfig.StuckAfter, "alert-download-stuck-after", monitoring.DefaultStuckAfter,
+		"Alert when a Model has been downloading for longer than this.")
+	flag.Float64Var(&monitoringConfig.FailureRatio, "alert-failure-ratio", monitoring.DefaultFailureRatio,
+		"Alert when more than this share of Models are Failed, from 0 to 1.")
+	flag.Float64Var(&monitoringConfig.StorageFullRatio, "alert-storage-full-ratio", monitoring.DefaultStorageFullRatio,
+		"Alert when a model PVC is fuller than this share of its capacity, from 0 to 1.")
+	flag.IntVar(&registryPort, "model-registry-port", 0,
+		"Serve each namespace with Models an index of its Ready Models at http://model-registry:<port>/ "+
+			"through a headless Service; 0 disables it. Needs the POD_IP environment variable.")
+	flag.Float64Var(&kubeAPIQPS, "kube-api-qps", 50,
+		"Requests per second the controllers may send to the Kubernetes API before client-side throttling. "+
+			"Raise it with --kube-api-burst for installs with hundreds of Models.")
+	flag.IntVar(&kubeAPIBurst, "kube-api-burst", 100,
+		"Requests the controllers may send to the Kubernetes API in a burst above --kube-api-qps.")
+	flag.Float64Var(&webhookKubeAPIQPS, "webhook-kube-api-qps", 20,
+		"Requests per second the webhooks may send to the Kubernetes API, limited apart from the controllers "+
+			"so a busy reconcile loop does not slow down pod admission.")
+	flag.IntVar(&webhookKubeAPIBurst, "webhook-kube-api-burst", 40,
+		"Requests the webhooks may send to the Kubernetes API in a burst above --webhook-kube-api-qps.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -89,6 +304,19 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	if webhookCertProvider != certs.ProviderCertManager && webhookCertProvider != certs.ProviderSelfSigned {
+		setupLog.Error(nil, "invalid --webhook-cert-provider", "provider", webhookCertProvider)
+		os.Exit(1)
+	}
+
+	if err := shard.Validate(); err != nil {
+		setupLog.Error(err, "invalid shard flags")
+		os.Exit(1)
+	}
+	if shard.Enabled() {
+		setupLog.Info("Reconciling a shard of the namespaces", "shard-index", shard.Index, "shard-count", shard.Count)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -118,6 +346,15 @@ func main() {
 		webhookServerOptions.CertName = webhookCertName
 		webhookServerOptions.KeyName = webhookCertKey
 	}
+	webhookCertDir := webhookCertPath
+	if webhookCertDir == "" {
+		webhookCertDir = filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs")
+	}
+	if webhookCertProvider == certs.ProviderSelfSigned {
+		webhookServerOptions.CertDir = webhookCertDir
+		webhookServerOptions.CertName = webhookCertName
+		webhookServerOptions.KeyName = webhookCertKey
+	}
 
 	webhookServer := webhook.NewServer(webhookServerOptions)
 
@@ -156,13 +393,17 @@ func main() {
 		metricsServerOptions.KeyName = metricsCertKey
 	}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	restConfig := ctrl.GetConfigOrDie()
+	restConfig.QPS = float32(kubeAPIQPS)
+	restConfig.Burst = kubeAPIBurst
+
+	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
 		Scheme:                 scheme,
 		Metrics:                metricsServerOptions,
 		WebhookServer:          webhookServer,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
-		LeaderElectionID:       "ddfcb75e.main-currents.news",
+		LeaderElectionID:       shard.LeaderElectionID("ddfcb75e.main-currents.news"),
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -174,29 +415,214 @@ func main() {
 		// if you are doing or is intended to do any operation such as perform cleanups
 		// after the manager stops then its usage might be unsafe.
 		// LeaderElectionReleaseOnCancel: true,
+		// The controller only watches and lists the pods it creates, so only
+		// those are cached. The registry and the inventory, which look at
+		// every pod, read them from the API server instead.
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				&corev1.Pod{}: {Label: labels.SelectorFromSet(labels.Set{"app.kubernetes.io/managed-by": "model-operator"})},
+			},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
 	}
 
+	if err := controllerConfig.Resources.HuggingFace.Validate(); err != nil {
+		setupLog.Error(err, "invalid Hugging Face downloader options")
+		os.Exit(1)
+	}
+	if err := resources.ValidateLocalStorageBaseDir(controllerConfig.Resources.LocalStorageBaseDir); err != nil {
+		setupLog.Error(err, "invalid --local-storage-base-dir")
+		os.Exit(1)
+	}
+
+	podMetadata, err := modelwebhook.ParsePodMetadata(podLabels, podAnnotations)
+	if err != nil {
+		setupLog.Error(err, "invalid injected pod metadata")
+		os.Exit(1)
+	}
+
+	progressReporter, err := progress.New(progressCfg)
+	if err != nil {
+		setupLog.Error(err, "unable to create progress reporter")
+		os.Exit(1)
+	}
+
+	cardFetcher, err := modelcard.NewFetcher(&http.Client{Timeout: cardFetchTimeout}, cardEndpoints)
+	if err != nil {
+		setupLog.Error(err, "invalid --model-card-endpoint")
+		os.Exit(1)
+	}
+
+	registrar, err := registration.NewRegistrar(&http.Client{Timeout: registrationTimeout}, registrationPrefixes)
+	if err != nil {
+		setupLog.Error(err, "invalid --registration-url-prefix")
+		os.Exit(1)
+	}
+
+	var estimator estimate.Estimator
+	if estimateSizes {
+		estimator, err = estimate.NewEstimator(&http.Client{Timeout: estimateTimeout}, estimateEndpoints)
+		if err != nil {
+			setupLog.Error(err, "invalid --estimate-endpoint")
+			os.Exit(1)
+		}
+	}
+
+	presigner, err := presign.NewPresigner(&http.Client{Timeout: estimateTimeout}, presignEndpoints)
+	if err != nil {
+		setupLog.Error(err, "invalid --presign-endpoint")
+		os.Exit(1)
+	}
+
 	if err := (&controller.ModelReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           client.WithFieldOwner(mgr.GetClient(), controller.FieldManager),
+		Scheme:           mgr.GetScheme(),
+		ProgressReporter: progressReporter,
+		Config:           controllerConfig,
+		CardFetcher:      cardFetcher,
+		Estimator:        estimator,
+		Presigner:        presigner,
+		Registrar:        registrar,
+		Recorder:         mgr.GetEventRecorderFor("model-controller"),
+		StallTimeout:     stallTimeout,
+		MaxStallRestarts: int32(maxStallRestarts),
+		Shard:            shard,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Model")
 		os.Exit(1)
 	}
+	if err := (&controller.ModelFamilyReconciler{
+		Client: client.WithFieldOwner(mgr.GetClient(), controller.FieldManager),
+		Scheme: mgr.GetScheme(),
+		Shard:  shard,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ModelFamily")
+		os.Exit(1)
+	}
+	if err := (&controller.ModelSetReconciler{
+		Client: client.WithFieldOwner(mgr.GetClient(), controller.FieldManager),
+		Scheme: mgr.GetScheme(),
+		Shard:  shard,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ModelSet")
+		os.Exit(1)
+	}
+
+	if registryPort > 0 {
+		podIP := os.Getenv("POD_IP")
+		if podIP == "" {
+			setupLog.Error(nil, "--model-registry-port needs the POD_IP environment variable")
+			os.Exit(1)
+		}
+		if err := registry.New(mgr.GetClient(), mgr.GetAPIReader(), fmt.Sprintf(":%d", registryPort)).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to serve the model registry")
+			os.Exit(1)
+		}
+		if err := (&controller.RegistryReconciler{
+			Client:  client.WithFieldOwner(mgr.GetClient(), controller.FieldManager),
+			Scheme:  mgr.GetScheme(),
+			Address: podIP,
+			Port:    int32(registryPort),
+			Shard:   shard,
+		}).SetupWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "Registry")
+			os.Exit(1)
+		}
+	}
+
+	// The webhooks read from the manager's cache but send the rest of their
+	// requests under their own rate limit
+	webhookConfig := rest.CopyConfig(restConfig)
+	webhookConfig.QPS = float32(webhookKubeAPIQPS)
+	webhookConfig.Burst = webhookKubeAPIBurst
+	webhookClient, err := client.New(webhookConfig, client.Options{
+		Scheme: mgr.GetScheme(),
+		Mapper: mgr.GetRESTMapper(),
+		Cache:  &client.CacheOptions{Reader: mgr.GetCache()},
+	})
+	if err != nil {
+		setupLog.Error(err, "unable to create client for the webhooks")
+		os.Exit(1)
+	}
 
 	// Register the model injector webhook
-	mgr.GetWebhookServer().Register("/mutate-v1-pod", &webhook.Admission{
+	mgr.GetWebhookServer().Register(modelwebhook.PathModelInjector, &webhook.Admission{
 		Handler: &modelwebhook.ModelInjector{
-			Client:  mgr.GetClient(),
+			Client:      webhookClient,
+			Decoder:     admission.NewDecoder(mgr.GetScheme()),
+			PodMetadata: podMetadata,
+			Recorder:    mgr.GetEventRecorderFor("model-injector"),
+			Images:      controllerConfig.Resources.Images,
+		},
+	})
+
+	// Register the Model overlay webhook
+	mgr.GetWebhookServer().Register(modelwebhook.PathModelOverlay, &webhook.Admission{
+		Handler: &modelwebhook.ModelOverlayDefaulter{
+			Environment: environment,
+			Decoder:     admission.NewDecoder(mgr.GetScheme()),
+		},
+	})
+
+	// Register the Model audit webhook
+	mgr.GetWebhookServer().Register(modelwebhook.PathModelAudit, &webhook.Admission{
+		Handler: &modelwebhook.ModelAuditor{
+			Client:    webhookClient,
+			Decoder:   admission.NewDecoder(mgr.GetScheme()),
+			Recorder:  mgr.GetEventRecorderFor("model-audit"),
+			ConfigMap: auditConfigMap,
+		},
+	})
+
+	// Register the Model retention webhook
+	mgr.GetWebhookServer().Register(modelwebhook.PathModelRetention, &webhook.Admission{
+		Handler: &modelwebhook.ModelRetention{
+			Client:  webhookClient,
 			Decoder: admission.NewDecoder(mgr.GetScheme()),
 		},
 	})
 	// +kubebuilder:scaffold:builder
 
+	// Serve the cluster-wide model inventory next to the metrics it also exports
+	modelInventory := inventory.New(mgr.GetClient(), mgr.GetAPIReader())
+	if err := mgr.AddMetricsServerExtraHandler(inventory.Path, modelInventory); err != nil {
+		setupLog.Error(err, "unable to serve the model inventory")
+		os.Exit(1)
+	}
+	if err := metrics.Registry.Register(modelInventory); err != nil {
+		setupLog.Error(err, "unable to register the model inventory metrics")
+		os.Exit(1)
+	}
+
+	if enableMonitoring {
+		namespace, err := certs.Namespace()
+		if err != nil {
+			setupLog.Error(err, "unable to determine the operator namespace")
+			os.Exit(1)
+		}
+		monitoringConfig.Namespace = namespace
+		monitoringConfig.ServiceSelector = map[string]string{
+			"control-plane":          "controller-manager",
+			"app.kubernetes.io/name": "model-operator",
+		}
+		monitoringConfig.Secure = secureMetrics
+		if monitoringConfig.Labels, err = labels.ConvertSelectorToLabelsMap(monitoringLabels); err != nil {
+			setupLog.Error(err, "invalid --monitoring-labels")
+			os.Exit(1)
+		}
+		if err := monitoringConfig.Validate(); err != nil {
+			setupLog.Error(err, "invalid alert flags")
+			os.Exit(1)
+		}
+		if err := mgr.Add(&monitoring.Manager{Client: mgr.GetClient(), Config: monitoringConfig}); err != nil {
+			setupLog.Error(err, "unable to set up monitoring")
+			os.Exit(1)
+		}
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -206,9 +632,77 @@ func main() {
 		os.Exit(1)
 	}
 
-	setupLog.Info("starting manager")
+	// Only report ready while the webhooks can be served, as the pod injector
+	// fails open otherwise
+	discoveryConfig := rest.CopyConfig(mgr.GetConfig())
+	discoveryConfig.Timeout = discoveryTimeout
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(discoveryConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to create discovery client")
+		os.Exit(1)
+	}
+	readiness := health.NewReadiness()
+	readyChecks := map[string]healthz.Checker{
+		"webhook":             mgr.GetWebhookServer().StartedChecker(),
+		"webhook-certificate": health.CertificateValid(filepath.Join(webhookCertDir, webhookCertName), time.Now),
+		"informer-cache":      health.CacheSynced(mgr.GetCache()),
+		"crds": health.CRDsAvailable(discoveryClient, modelsv1alpha1.GroupVersion.String(),
+			"models", "modelfamilies"),
+	}
+	for name, check := range readyChecks {
+		if err := mgr.AddReadyzCheck(name, readiness.Track(name, check)); err != nil {
+			setupLog.Error(err, "unable to set up ready check", "check", name)
+			os.Exit(1)
+		}
+	}
+	if err := metrics.Registry.Register(readiness); err != nil {
+		setupLog.Error(err, "unable to register the readiness metrics")
+		os.Exit(1)
+	}
+
+	// Issue the webhook certificate before the webhook server looks for it
+	if webhookCertProvider == certs.ProviderSelfSigned {
+		namespace, err := certs.Namespace()
+		if err != nil {
+			setupLog.Error(err, "unable to determine the operator namespace")
+			os.Exit(1)
+		}
+		directClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+		if err != nil {
+			setupLog.Error(err, "unable to create client for the webhook certificate")
+			os.Exit(1)
+		}
+		rotator := &certs.Rotator{
+			Client:                         directClient,
+			Secret:                         types.NamespacedName{Name: webhookCertSecret, Namespace: namespace},
+			Service:                        webhookService,
+			CertDir:                        webhookCertDir,
+			CertName:                       webhookCertName,
+			KeyName:                        webhookCertKey,
+			MutatingWebhookConfiguration:   mutatingWebhookConfig,
+			ValidatingWebhookConfiguration: validatingWebhookConfig,
+		}
+		if err := rotator.Ensure(context.Background()); err != nil {
+			setupLog.Error(err, "unable to issue the webhook certificate")
+			os.Exit(1)
+		}
+		if err := mgr.Add(rotator); err != nil {
+			setupLog.Error(err, "unable to set up webhook certificate rotation")
+			os.Exit(1)
+		}
+	}
+
+	setupLog.Info("starting manager", "version", version)
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
 }
+
+// imageFlag registers an image override flag. It defaults to the
+// RELATED_IMAGE_<env> environment variable, the convention OLM uses to
+// rewrite images for disconnected clusters.
+func imageFlag(p *string, name, env, usage string) {
+	env = "RELATED_IMAGE_" + env
+	flag.StringVar(p, name, os.Getenv(env), usage+" Defaults to $"+env+".")
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command manifests writes the install manifests and sample Models from the
+// compiled API types. Samples are validated against the CRD schemas, so a
+// sample that no longer matches the types fails generation.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/rsJames-ttrpg/model-operator/internal/manifests"
+)
+
+func main() {
+	var crdDir, out string
+	var opts manifests.Options
+	flag.StringVar(&crdDir, "crd-dir", "config/crd/bases", "The directory containing the CRDs generated by controller-gen.")
+	flag.StringVar(&out, "out", "dist/manifests", "The directory to write the manifests to.")
+	flag.StringVar(&opts.Namespace, "namespace", "model-operator-system", "The namespace the operator is installed in.")
+	flag.StringVar(&opts.NamePrefix, "name-prefix", "model-operator-", "The prefix added to the names of installed resources.")
+	flag.BoolVar(&opts.CertManager, "cert-manager", true,
+		"If set, cert-manager injects the serving certificate's CA into the webhook configurations.")
+	flag.Parse()
+
+	crds, err := manifests.LoadCRDs(crdDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load CRDs: %v\n", err)
+		os.Exit(1)
+	}
+	if err := manifests.Write(out, crds, opts); err != nil {
+		fmt.Fprintf(os.Stderr, "failed to write manifests: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Wrote manifests to %s\n", out)
+}
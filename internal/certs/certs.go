@@ -0,0 +1,207 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package certs issues and rotates the webhook serving certificate for
+// installs without cert-manager.
+//
+// The operator keeps a self-signed CA and a serving certificate signed by
+// it in a Secret, writes the serving certificate to the webhook server's
+// certificate directory, and patches the CA into the caBundle of the webhook
+// configurations. When the CA is rotated the previous one stays in the
+// bundle until it expires, so replicas still serving the old certificate
+// keep working.
+package certs
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+)
+
+// Ways the webhook serving certificate is provisioned
+const (
+	// ProviderCertManager relies on cert-manager to mount the certificate
+	ProviderCertManager = "cert-manager"
+	// ProviderSelfSigned has the operator issue and rotate it itself
+	ProviderSelfSigned = "self-signed"
+)
+
+// Secret keys, besides the tls.crt and tls.key of a TLS Secret
+const (
+	// CABundleKey holds the current CA followed by previous ones not yet expired
+	CABundleKey = "ca.crt"
+	// CAKeyKey holds the private key of the current CA
+	CAKeyKey = "ca.key"
+)
+
+// keyPair is a certificate with its private key
+type keyPair struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+// newCA creates a self-signed CA valid from now for validity
+func newCA(now time.Time, validity time.Duration) (*keyPair, error) {
+	return issue(&x509.Certificate{
+		Subject:               pkix.Name{CommonName: "model-operator-webhook-ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(validity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}, nil)
+}
+
+// newServingCert creates a serving certificate for dnsNames signed by ca
+func newServingCert(ca *keyPair, dnsNames []string, now time.Time, validity time.Duration) (*keyPair, error) {
+	return issue(&x509.Certificate{
+		Subject:     pkix.Name{CommonName: dnsNames[0]},
+		DNSNames:    dnsNames,
+		NotBefore:   now.Add(-time.Hour),
+		NotAfter:    now.Add(validity),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+}
+
+// issue creates a certificate from template with a new key, signed by
+// parent, or self-signed when parent is nil
+func issue(template *x509.Certificate, parent *keyPair) (*keyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+	template.SerialNumber = serial
+
+	signer, signerCert := crypto.Signer(key), template
+	if parent != nil {
+		signer, signerCert = parent.key, parent.cert
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signerCert, key.Public(), signer)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, err
+	}
+	return &keyPair{cert: cert, key: key}, nil
+}
+
+// encodeCert returns the PEM encoding of cert
+func encodeCert(cert *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+}
+
+// encodeKey returns the PKCS #8 PEM encoding of key
+func encodeKey(key crypto.Signer) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// parseCerts returns every certificate in the PEM data
+func parseCerts(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("no PEM certificate")
+	}
+	return certs, nil
+}
+
+// parseKeyPair parses a PEM certificate, the first of a bundle, and its PKCS #8 key
+func parseKeyPair(certPEM, keyPEM []byte) (*keyPair, error) {
+	certs, err := parseCerts(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("no PEM private key")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key type %T", key)
+	}
+	pub, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(certs[0].PublicKey) {
+		return nil, errors.New("private key does not match the certificate")
+	}
+	return &keyPair{cert: certs[0], key: signer}, nil
+}
+
+// due reports whether cert is not valid at now or has less than a third of
+// its lifetime left
+func due(cert *x509.Certificate, now time.Time) bool {
+	if now.Before(cert.NotBefore) || !now.Before(cert.NotAfter) {
+		return true
+	}
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+	return cert.NotAfter.Sub(now) < lifetime/3
+}
+
+// signedBy reports whether cert was signed by ca and names exactly dnsNames
+func signedBy(cert, ca *x509.Certificate, dnsNames []string) bool {
+	return cert.CheckSignatureFrom(ca) == nil && slices.Equal(cert.DNSNames, dnsNames)
+}
+
+// bundle returns the PEM bundle of ca followed by the previous CAs that
+// have not expired at now
+func bundle(ca *x509.Certificate, previous []*x509.Certificate, now time.Time) []byte {
+	var buf bytes.Buffer
+	buf.Write(encodeCert(ca))
+	for _, cert := range previous {
+		if !cert.Equal(ca) && now.Before(cert.NotAfter) {
+			buf.Write(encodeCert(cert))
+		}
+	}
+	return buf.Bytes()
+}
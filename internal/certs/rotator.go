@@ -0,0 +1,335 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=mutatingwebhookconfigurations;validatingwebhookconfigurations,verbs=get;patch
+
+// Defaults for the Rotator
+const (
+	DefaultCAValidity   = 10 * 365 * 24 * time.Hour
+	DefaultCertValidity = 365 * 24 * time.Hour
+	DefaultInterval     = time.Hour
+)
+
+// namespaceFile holds the namespace of the pod's ServiceAccount
+const namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+// Namespace returns the namespace the operator runs in, from POD_NAMESPACE
+// or the pod's ServiceAccount
+func Namespace() (string, error) {
+	if ns := os.Getenv("POD_NAMESPACE"); ns != "" {
+		return ns, nil
+	}
+	data, err := os.ReadFile(namespaceFile)
+	if err != nil {
+		return "", fmt.Errorf("reading the operator namespace: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// Rotator keeps the webhook serving certificate issued by a self-signed CA,
+// rotating both before they expire. Every replica runs one: the Secret is
+// written with optimistic locking, so only one replica rotates it and the
+// others pick up its certificate.
+type Rotator struct {
+	Client client.Client
+	// Secret holds the CA and the serving certificate
+	Secret types.NamespacedName
+	// Service is the webhook Service the certificate is issued for, in the
+	// namespace of Secret
+	Service string
+	// CertDir, CertName and KeyName are where the webhook server reads the
+	// certificate from
+	CertDir  string
+	CertName string
+	KeyName  string
+	// MutatingWebhookConfiguration and ValidatingWebhookConfiguration get the
+	// CA in the caBundle of every webhook; empty skips one
+	MutatingWebhookConfiguration   string
+	ValidatingWebhookConfiguration string
+	// CAValidity and CertValidity default to DefaultCAValidity and
+	// DefaultCertValidity. Each is renewed with a third of it left.
+	CAValidity   time.Duration
+	CertValidity time.Duration
+	// Interval is how often the certificate is checked, DefaultInterval by default
+	Interval time.Duration
+
+	now func() time.Time
+}
+
+// dnsNames returns the names the webhook Service is called by
+func (r *Rotator) dnsNames() []string {
+	return []string{
+		fmt.Sprintf("%s.%s.svc", r.Service, r.Secret.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", r.Service, r.Secret.Namespace),
+	}
+}
+
+func (r *Rotator) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+	return time.Now()
+}
+
+func orDefault(d, def time.Duration) time.Duration {
+	if d > 0 {
+		return d
+	}
+	return def
+}
+
+// Start implements manager.Runnable, checking the certificate every Interval
+func (r *Rotator) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("webhook-certs")
+	ticker := time.NewTicker(orDefault(r.Interval, DefaultInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := r.Ensure(ctx); err != nil {
+				log.Error(err, "Failed to rotate the webhook certificate")
+			}
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable: every
+// replica serves the webhooks, so every replica needs the certificate
+func (r *Rotator) NeedLeaderElection() bool {
+	return false
+}
+
+// Ensure issues or rotates the certificate in the Secret as needed, patches
+// the CA bundle into the webhook configurations and then writes the serving
+// certificate for the webhook server. Call it once before the manager starts
+// so the webhook server finds a certificate.
+func (r *Rotator) Ensure(ctx context.Context) error {
+	secret, err := r.ensureSecret(ctx)
+	if err != nil {
+		return err
+	}
+	// Trust the new CA before serving a certificate it signed
+	if err := r.patchCABundle(ctx, secret.Data[CABundleKey]); err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(r.CertDir, r.CertName), secret.Data[corev1.TLSCertKey]); err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(r.CertDir, r.KeyName), secret.Data[corev1.TLSPrivateKeyKey])
+}
+
+// ensureSecret returns the Secret with a current certificate, creating or
+// rotating it if needed
+func (r *Rotator) ensureSecret(ctx context.Context) (*corev1.Secret, error) {
+	log := logf.FromContext(ctx).WithName("webhook-certs")
+
+	secret := &corev1.Secret{}
+	err := r.Client.Get(ctx, r.Secret, secret)
+	if apierrors.IsNotFound(err) {
+		data, err := r.issue(nil)
+		if err != nil {
+			return nil, err
+		}
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      r.Secret.Name,
+				Namespace: r.Secret.Namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "model-operator"},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		log.Info("Issuing webhook certificate", "secret", r.Secret)
+		err = r.Client.Create(ctx, secret)
+		if !apierrors.IsAlreadyExists(err) {
+			return secret, err
+		}
+		// Another replica issued it first
+		secret = &corev1.Secret{}
+		err = r.Client.Get(ctx, r.Secret, secret)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !r.needsRotation(secret.Data) {
+		return secret, nil
+	}
+	data, err := r.issue(secret.Data)
+	if err != nil {
+		return nil, err
+	}
+	patch := client.MergeFromWithOptions(secret.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	secret.Data = data
+	log.Info("Rotating webhook certificate", "secret", r.Secret)
+	err = r.Client.Patch(ctx, secret, patch)
+	if apierrors.IsConflict(err) {
+		// Another replica rotated it first
+		secret = &corev1.Secret{}
+		err = r.Client.Get(ctx, r.Secret, secret)
+	}
+	return secret, err
+}
+
+// needsRotation reports whether the certificates in data are missing,
+// invalid or due for renewal
+func (r *Rotator) needsRotation(data map[string][]byte) bool {
+	now := r.clock()
+	ca, err := parseKeyPair(data[CABundleKey], data[CAKeyKey])
+	if err != nil || due(ca.cert, now) {
+		return true
+	}
+	serving, err := parseKeyPair(data[corev1.TLSCertKey], data[corev1.TLSPrivateKeyKey])
+	return err != nil || due(serving.cert, now) || !signedBy(serving.cert, ca.cert, r.dnsNames())
+}
+
+// issue returns Secret data with a new serving certificate, signed by the
+// CA in previous unless that one is missing or due, in which case a new CA
+// is created and the previous one kept in the bundle
+func (r *Rotator) issue(previous map[string][]byte) (map[string][]byte, error) {
+	now := r.clock()
+	var bundled []*x509.Certificate
+	ca, err := parseKeyPair(previous[CABundleKey], previous[CAKeyKey])
+	if err == nil {
+		bundled, _ = parseCerts(previous[CABundleKey])
+	}
+	if err != nil || due(ca.cert, now) {
+		if ca, err = newCA(now, orDefault(r.CAValidity, DefaultCAValidity)); err != nil {
+			return nil, err
+		}
+	}
+	serving, err := newServingCert(ca, r.dnsNames(), now, orDefault(r.CertValidity, DefaultCertValidity))
+	if err != nil {
+		return nil, err
+	}
+
+	caKey, err := encodeKey(ca.key)
+	if err != nil {
+		return nil, err
+	}
+	servingKey, err := encodeKey(serving.key)
+	if err != nil {
+		return nil, err
+	}
+	return map[string][]byte{
+		CABundleKey:             bundle(ca.cert, bundled, now),
+		CAKeyKey:                caKey,
+		corev1.TLSCertKey:       encodeCert(serving.cert),
+		corev1.TLSPrivateKeyKey: servingKey,
+	}, nil
+}
+
+// patchCABundle sets caBundle on every webhook of the configurations
+func (r *Rotator) patchCABundle(ctx context.Context, caBundle []byte) error {
+	if r.MutatingWebhookConfiguration != "" {
+		config := &admissionregistrationv1.MutatingWebhookConfiguration{}
+		err := r.setCABundle(ctx, r.MutatingWebhookConfiguration, config, caBundle,
+			func() []*admissionregistrationv1.WebhookClientConfig {
+				clientConfigs := make([]*admissionregistrationv1.WebhookClientConfig, len(config.Webhooks))
+				for i := range config.Webhooks {
+					clientConfigs[i] = &config.Webhooks[i].ClientConfig
+				}
+				return clientConfigs
+			})
+		if err != nil {
+			return err
+		}
+	}
+	if r.ValidatingWebhookConfiguration != "" {
+		config := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+		err := r.setCABundle(ctx, r.ValidatingWebhookConfiguration, config, caBundle,
+			func() []*admissionregistrationv1.WebhookClientConfig {
+				clientConfigs := make([]*admissionregistrationv1.WebhookClientConfig, len(config.Webhooks))
+				for i := range config.Webhooks {
+					clientConfigs[i] = &config.Webhooks[i].ClientConfig
+				}
+				return clientConfigs
+			})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setCABundle reads the named webhook configuration into config and patches
+// caBundle into the client configs it returns, if any differ. A
+// configuration that does not exist yet is skipped.
+func (r *Rotator) setCABundle(ctx context.Context, name string, config client.Object, caBundle []byte,
+	clientConfigs func() []*admissionregistrationv1.WebhookClientConfig) error {
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, config); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	patch := client.MergeFrom(config.DeepCopyObject().(client.Object))
+	changed := false
+	for _, cc := range clientConfigs() {
+		if !bytes.Equal(cc.CABundle, caBundle) {
+			cc.CABundle = caBundle
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+	return r.Client.Patch(ctx, config, patch)
+}
+
+// writeFile replaces path with data unless it already holds it, renaming a
+// temporary file into place so the webhook server never reads half a file
+func writeFile(path string, data []byte) error {
+	if current, err := os.ReadFile(path); err == nil && bytes.Equal(current, data) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certs
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var secretKey = types.NamespacedName{Name: "model-operator-webhook-server-cert", Namespace: "model-operator-system"}
+
+func newRotator(t *testing.T, c client.Client, now time.Time) *Rotator {
+	t.Helper()
+	return &Rotator{
+		Client:                         c,
+		Secret:                         secretKey,
+		Service:                        "model-operator-webhook-service",
+		CertDir:                        t.TempDir(),
+		CertName:                       "tls.crt",
+		KeyName:                        "tls.key",
+		MutatingWebhookConfiguration:   "model-operator-mutating-webhook-configuration",
+		ValidatingWebhookConfiguration: "model-operator-validating-webhook-configuration",
+		now:                            func() time.Time { return now },
+	}
+}
+
+func newClient() client.Client {
+	return fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		&admissionregistrationv1.MutatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "model-operator-mutating-webhook-configuration"},
+			Webhooks: []admissionregistrationv1.MutatingWebhook{
+				{Name: "model-injector.models.main-currents.news"},
+				{Name: "model-overlay.models.main-currents.news"},
+			},
+		},
+		&admissionregistrationv1.ValidatingWebhookConfiguration{
+			ObjectMeta: metav1.ObjectMeta{Name: "model-operator-validating-webhook-configuration"},
+			Webhooks:   []admissionregistrationv1.ValidatingWebhook{{Name: "model-audit.models.main-currents.news"}},
+		},
+	).Build()
+}
+
+// verify checks that the certificate the rotator wrote is trusted by the
+// CA bundle of every webhook and names the webhook Service
+func verify(t *testing.T, c client.Client, r *Rotator, now time.Time) *x509.Certificate {
+	t.Helper()
+	ctx := context.Background()
+
+	pair, err := tls.LoadX509KeyPair(filepath.Join(r.CertDir, r.CertName), filepath.Join(r.CertDir, r.KeyName))
+	if err != nil {
+		t.Fatalf("LoadX509KeyPair() error = %v", err)
+	}
+	cert, _ := x509.ParseCertificate(pair.Certificate[0])
+
+	mutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	if err := c.Get(ctx, types.NamespacedName{Name: r.MutatingWebhookConfiguration}, mutating); err != nil {
+		t.Fatal(err)
+	}
+	validating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	if err := c.Get(ctx, types.NamespacedName{Name: r.ValidatingWebhookConfiguration}, validating); err != nil {
+		t.Fatal(err)
+	}
+	bundles := [][]byte{validating.Webhooks[0].ClientConfig.CABundle}
+	for _, w := range mutating.Webhooks {
+		bundles = append(bundles, w.ClientConfig.CABundle)
+	}
+	for _, caBundle := range bundles {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			t.Fatal("caBundle holds no certificate")
+		}
+		_, err := cert.Verify(x509.VerifyOptions{
+			DNSName:     "model-operator-webhook-service.model-operator-system.svc",
+			Roots:       pool,
+			CurrentTime: now,
+		})
+		if err != nil {
+			t.Errorf("certificate does not verify against the caBundle: %v", err)
+		}
+	}
+	return cert
+}
+
+func TestRotator_Issue(t *testing.T) {
+	now := time.Now()
+	c := newClient()
+	r := newRotator(t, c, now)
+
+	if err := r.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+	cert := verify(t, c, r, now)
+
+	secret := &corev1.Secret{}
+	if err := c.Get(context.Background(), secretKey, secret); err != nil {
+		t.Fatalf("Secret not created: %v", err)
+	}
+	if secret.Type != corev1.SecretTypeTLS {
+		t.Errorf("Secret type = %v, want %v", secret.Type, corev1.SecretTypeTLS)
+	}
+
+	// Another replica serves the same certificate
+	other := newRotator(t, c, now)
+	if err := other.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+	if otherCert := verify(t, c, other, now); !otherCert.Equal(cert) {
+		t.Error("second replica issued its own certificate, want the one in the Secret")
+	}
+}
+
+func TestRotator_Rotate(t *testing.T) {
+	now := time.Now()
+	c := newClient()
+	r := newRotator(t, c, now)
+	if err := r.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+	issued := verify(t, c, r, now)
+
+	// Renewed with a third of its lifetime left, signed by the same CA
+	later := now.Add(DefaultCertValidity * 3 / 4)
+	r.now = func() time.Time { return later }
+	if err := r.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+	renewed := verify(t, c, r, later)
+	if renewed.Equal(issued) {
+		t.Error("certificate not renewed")
+	}
+	if !bytes.Equal(renewed.RawIssuer, issued.RawIssuer) || renewed.CheckSignatureFrom(mustCA(t, c)) != nil {
+		t.Error("certificate renewed with a new CA, want the current one")
+	}
+
+	// A new CA is bundled with the previous one, which still signs the old certificate
+	caDue := now.Add(DefaultCAValidity * 3 / 4)
+	r.now = func() time.Time { return caDue }
+	r.CertDir = t.TempDir()
+	if err := r.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+	verify(t, c, r, caDue)
+	secret := &corev1.Secret{}
+	if err := c.Get(context.Background(), secretKey, secret); err != nil {
+		t.Fatal(err)
+	}
+	bundled, err := parseCerts(secret.Data[CABundleKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bundled) != 2 {
+		t.Errorf("caBundle holds %d CAs, want the new and the previous one", len(bundled))
+	}
+}
+
+func mustCA(t *testing.T, c client.Client) *x509.Certificate {
+	t.Helper()
+	secret := &corev1.Secret{}
+	if err := c.Get(context.Background(), secretKey, secret); err != nil {
+		t.Fatal(err)
+	}
+	ca, err := parseKeyPair(secret.Data[CABundleKey], secret.Data[CAKeyKey])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ca.cert
+}
+
+func TestRotator_ReissuesForAnotherService(t *testing.T) {
+	now := time.Now()
+	c := newClient()
+	r := newRotator(t, c, now)
+	r.Service = "webhook"
+	if err := r.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+
+	r.Service = "model-operator-webhook-service"
+	if err := r.Ensure(context.Background()); err != nil {
+		t.Fatalf("Ensure() error = %v", err)
+	}
+	verify(t, c, r, now)
+}
+
+func TestWriteFile_Unchanged(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tls.crt")
+	if err := writeFile(path, []byte("cert")); err != nil {
+		t.Fatal(err)
+	}
+	before, _ := os.Stat(path)
+	if err := writeFile(path, []byte("cert")); err != nil {
+		t.Fatal(err)
+	}
+	after, _ := os.Stat(path)
+	if !os.SameFile(before, after) {
+		t.Error("writeFile() replaced a file that already held the data")
+	}
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// FieldManager identifies the operator's writes in managedFields and audit logs
+const FieldManager = "model-operator"
+
+// fieldOwner is the field manager owned resources are applied as, so fields
+// set by users and other controllers are left alone
+const fieldOwner = client.FieldOwner(FieldManager)
+
+// apply creates or updates obj with server-side apply. Only the fields set on
+// obj are owned by the operator; fields it no longer sets are removed.
+func (r *ModelReconciler) apply(ctx context.Context, obj client.Object) error {
+	return apply(ctx, r.Client, r.Scheme, obj)
+}
+
+// apply server-side applies obj through c as the operator's field manager
+func apply(ctx context.Context, c client.Client, scheme *runtime.Scheme, obj client.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+	return c.Patch(ctx, obj, client.Apply, fieldOwner, client.ForceOwnership)
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// Default requeue intervals. Job, download pod and progress ConfigMap
+	// events drive the Downloading phase, so its interval is only a safety net.
+	defaultRequeuePending     = 10 * time.Second
+	defaultRequeueDownloading = 5 * time.Minute
+	defaultRequeueReady       = 5 * time.Minute
+	defaultRequeueFailed      = 1 * time.Minute
+)
+
+// Config holds the operator-level settings of the Model controller. The zero
+// Config keeps every default.
+type Config struct {
+	// Resources configures the objects built for each Model
+	Resources resources.Config
+	// Requeue sets how long the controller waits between reconciles of a
+	// Model in each phase when no event arrives
+	Requeue RequeueIntervals
+	// OperatorVersion is recorded in the status of the Models the operator
+	// downloads
+	OperatorVersion string
+}
+
+// RequeueIntervals are the reconcile intervals per phase. Zero fields keep
+// the defaults.
+type RequeueIntervals struct {
+	Pending     time.Duration
+	Downloading time.Duration
+	Ready       time.Duration
+	Failed      time.Duration
+}
+
+func orDefaultInterval(value, fallback time.Duration) time.Duration {
+	if value > 0 {
+		return value
+	}
+	return fallback
+}
+
+func (i RequeueIntervals) pending() time.Duration {
+	return orDefaultInterval(i.Pending, defaultRequeuePending)
+}
+
+func (i RequeueIntervals) downloading() time.Duration {
+	return orDefaultInterval(i.Downloading, defaultRequeueDownloading)
+}
+
+func (i RequeueIntervals) ready() time.Duration {
+	return orDefaultInterval(i.Ready, defaultRequeueReady)
+}
+
+func (i RequeueIntervals) failed() time.Duration {
+	return orDefaultInterval(i.Failed, defaultRequeueFailed)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Controller configuration", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	newModel := func(name string, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	It("should default every requeue interval", func() {
+		var intervals RequeueIntervals
+		Expect(intervals.pending()).To(Equal(defaultRequeuePending))
+		Expect(intervals.downloading()).To(Equal(defaultRequeueDownloading))
+		Expect(intervals.ready()).To(Equal(defaultRequeueReady))
+		Expect(intervals.failed()).To(Equal(defaultRequeueFailed))
+	})
+
+	It("should apply the configured requeue interval and resources", func() {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(newModel("configured", modelsv1alpha1.ModelPhasePending)).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Config: Config{
+			Resources: resources.Config{
+				Images: resources.Images{Curl: "registry.internal/curl:8"},
+				Jobs:   resources.JobConfig{BackoffLimit: ptr.To(int32(1))},
+			},
+			Requeue: RequeueIntervals{Downloading: 30 * time.Second},
+		}}
+
+		key := types.NamespacedName{Name: "configured", Namespace: namespace}
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName("configured"), Namespace: namespace}, job)).To(Succeed())
+		Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("registry.internal/curl:8"))
+		Expect(*job.Spec.BackoffLimit).To(Equal(int32(1)))
+	})
+})
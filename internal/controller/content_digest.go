@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// downloaderContainerName is the name of the download Job's main container
+const downloaderContainerName = "downloader"
+
+// downloadContentDigest returns the content digest a succeeded download pod
+// reported in its termination message, or an empty string if none did.
+// The compress container reports it for compressed models.
+func downloadContentDigest(pods []corev1.Pod) string {
+	if digest := terminationDigest(pods, downloaderContainerName); digest != "" {
+		return digest
+	}
+	return terminationDigest(pods, resources.CompressorContainerName)
+}
+
+// terminationDigest returns the digest a succeeded pod reported in the
+// termination message of the named container, or an empty string if none did
+func terminationDigest(pods []corev1.Pod, containerName string) string {
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name != containerName || cs.State.Terminated == nil {
+				continue
+			}
+			if digest, err := marker.ParseDigest(cs.State.Terminated.Message); err == nil {
+				return digest
+			}
+		}
+	}
+	return ""
+}
+
+// recordContentDigest reads the content digest from the succeeded download
+// pod into the Model status and labels the Model with its short form.
+// The status change is persisted by the caller's next status update.
+func (r *ModelReconciler) recordContentDigest(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	pods, err := r.listDownloadPods(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	digest := downloadContentDigest(pods)
+	if digest == "" {
+		log.Info("Download pod did not report a content digest")
+		return nil
+	}
+
+	// Patch the label first: the patch response replaces the in-memory status
+	if err := r.setContentDigestLabel(ctx, model, marker.ShortDigest(digest)); err != nil {
+		return err
+	}
+	model.Status.ContentDigest = digest
+	return nil
+}
+
+// setContentDigestLabel sets the content digest label, removing it when value is empty
+func (r *ModelReconciler) setContentDigestLabel(ctx context.Context, model *modelsv1alpha1.Model, value string) error {
+	current, ok := model.Labels[modelsv1alpha1.LabelContentDigest]
+	if current == value && (ok || value == "") {
+		return nil
+	}
+
+	patch := client.MergeFrom(model.DeepCopy())
+	if value == "" {
+		delete(model.Labels, modelsv1alpha1.LabelContentDigest)
+	} else {
+		if model.Labels == nil {
+			model.Labels = map[string]string{}
+		}
+		model.Labels[modelsv1alpha1.LabelContentDigest] = value
+	}
+	return r.Patch(ctx, model, patch)
+}
+
+// contentMismatch describes how the downloaded content differs from the
+// content digest an imported Model expects, or returns an empty string if it
+// matches or no digest is expected
+func contentMismatch(model *modelsv1alpha1.Model) string {
+	expected := model.Annotations[modelsv1alpha1.AnnotationExpectedContentDigest]
+	if expected == "" || model.Status.ContentDigest == expected {
+		return ""
+	}
+	if model.Status.ContentDigest == "" {
+		return fmt.Sprintf("Download did not report a content digest, expected %s", expected)
+	}
+	return fmt.Sprintf("Downloaded content %s does not match the expected %s", model.Status.ContentDigest, expected)
+}
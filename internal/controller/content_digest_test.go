@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Content digest", func() {
+	digest := "sha256:" + strings.Repeat("0a", 32)
+
+	pod := func(phase corev1.PodPhase, container, message string) corev1.Pod {
+		return corev1.Pod{
+			Status: corev1.PodStatus{
+				Phase: phase,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{
+						Name: container,
+						State: corev1.ContainerState{
+							Terminated: &corev1.ContainerStateTerminated{Message: message},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should read the digest from a succeeded downloader", func() {
+		pods := []corev1.Pod{
+			pod(corev1.PodFailed, downloaderContainerName, ""),
+			pod(corev1.PodSucceeded, downloaderContainerName, digest+"\n"),
+		}
+		Expect(downloadContentDigest(pods)).To(Equal(digest))
+	})
+
+	It("should ignore other containers and invalid messages", func() {
+		pods := []corev1.Pod{
+			pod(corev1.PodSucceeded, "progress-reporter", digest),
+			pod(corev1.PodSucceeded, downloaderContainerName, "Download complete"),
+		}
+		Expect(downloadContentDigest(pods)).To(BeEmpty())
+	})
+
+	It("should read the digest of a compressed model from the compress container", func() {
+		pods := []corev1.Pod{pod(corev1.PodSucceeded, resources.CompressorContainerName, digest)}
+		Expect(downloadContentDigest(pods)).To(Equal(digest))
+	})
+
+	It("should ignore pods that have not succeeded", func() {
+		pods := []corev1.Pod{pod(corev1.PodRunning, downloaderContainerName, digest)}
+		Expect(downloadContentDigest(pods)).To(BeEmpty())
+	})
+
+	It("should accept the content an imported model expects", func() {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				modelsv1alpha1.AnnotationExpectedContentDigest: digest,
+			}},
+			Status: modelsv1alpha1.ModelStatus{ContentDigest: digest},
+		}
+		Expect(contentMismatch(model)).To(BeEmpty())
+
+		model.Annotations = nil
+		model.Status.ContentDigest = "sha256:" + strings.Repeat("0b", 32)
+		Expect(contentMismatch(model)).To(BeEmpty())
+	})
+
+	It("should reject other content for an imported model", func() {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+				modelsv1alpha1.AnnotationExpectedContentDigest: digest,
+			}},
+			Status: modelsv1alpha1.ModelStatus{ContentDigest: "sha256:" + strings.Repeat("0b", 32)},
+		}
+		Expect(contentMismatch(model)).To(ContainSubstring("does not match the expected " + digest))
+
+		model.Status.ContentDigest = ""
+		Expect(contentMismatch(model)).To(ContainSubstring("did not report a content digest"))
+	})
+})
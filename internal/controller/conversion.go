@@ -0,0 +1,149 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/conversion"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// conditionTypeConverted reports the state of the post-download conversion
+	conditionTypeConverted = "Converted"
+
+	// Converted condition reasons
+	reasonConverting       = "Converting"
+	reasonConversionFailed = "ConversionFailed"
+	reasonEngineBuilt      = "EngineBuilt"
+)
+
+// conversionInProgress reports whether a conversion was started for the
+// current download, so it can continue after the download Job is cleaned up
+func conversionInProgress(model *modelsv1alpha1.Model) bool {
+	cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeConverted)
+	return model.Spec.Conversion != nil && cond != nil && cond.Reason == reasonConverting
+}
+
+// conversionOutdated reports whether the recorded engine does not match spec.conversion
+func conversionOutdated(model *modelsv1alpha1.Model) bool {
+	if model.Spec.Conversion == nil {
+		return false
+	}
+	return model.Status.Conversion == nil || model.Status.Conversion.Target != model.Spec.Conversion.Target
+}
+
+// setConvertedCondition records the conversion state on the Model
+func setConvertedCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeConverted,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// clearConversion drops the recorded engine and Converted condition
+func clearConversion(model *modelsv1alpha1.Model) {
+	model.Status.Conversion = nil
+	meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeConverted)
+}
+
+// reconcileConversion runs the conversion Job after the download has
+// succeeded and moves the Model to Ready once the engine is built
+func (r *ModelReconciler) reconcileConversion(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	target := model.Spec.Conversion.Target
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.ConversionJobName(model.Name), Namespace: model.Namespace}, job)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to get conversion Job")
+		return ctrl.Result{}, err
+	}
+
+	// Replace a Job left over from a previous target
+	if err == nil && job.Labels[conversion.LabelTarget] != string(target) {
+		log.Info("Deleting conversion Job for previous target", "name", job.Name, "target", job.Labels[conversion.LabelTarget])
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete conversion Job")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.downloading()}, nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		job, err := conversion.BuildJob(model)
+		if err != nil {
+			log.Error(err, "Failed to build conversion Job")
+			setConvertedCondition(model, metav1.ConditionFalse, reasonConversionFailed, err.Error())
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
+				fmt.Sprintf("Failed to build conversion Job: %v", err))
+		}
+		if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
+			log.Error(err, "Failed to set owner reference on conversion Job")
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Creating conversion Job", "name", job.Name, "target", target)
+		if err := r.apply(ctx, job); err != nil {
+			log.Error(err, "Failed to create conversion Job")
+			return ctrl.Result{}, err
+		}
+
+		message := fmt.Sprintf("Converting to %s", target)
+		setConvertedCondition(model, metav1.ConditionFalse, reasonConverting, message)
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message)
+	}
+
+	if job.Status.Succeeded > 0 {
+		log.Info("Conversion Job succeeded", "target", target)
+		model.Status.Conversion = &modelsv1alpha1.ConversionStatus{
+			Target:       target,
+			ArtifactPath: conversion.ArtifactPath(target),
+		}
+		setConvertedCondition(model, metav1.ConditionTrue, reasonEngineBuilt,
+			fmt.Sprintf("Built %s engine at %s", target, model.Status.Conversion.ArtifactPath))
+		return r.markReady(ctx, model)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			log.Info("Conversion Job failed", "reason", cond.Reason, "message", cond.Message)
+			setConvertedCondition(model, metav1.ConditionFalse, reasonConversionFailed, cond.Message)
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
+				fmt.Sprintf("Conversion to %s failed: %s", target, cond.Message))
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: r.Config.Requeue.downloading()}, nil
+}
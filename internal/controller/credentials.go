@@ -0,0 +1,153 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"maps"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// annotationCredentialsVersion records a hash of the credentialsSecret's
+	// data on the download Job created with it
+	annotationCredentialsVersion = "models.main-currents.news/credentials-version"
+
+	// credentialsSecretIndex indexes Models by the name of their credentialsSecret
+	credentialsSecretIndex = "spec.credentialsSecret"
+)
+
+// indexCredentialsSecret returns the credentialsSecret of a Model for the
+// credentialsSecretIndex
+func indexCredentialsSecret(obj client.Object) []string {
+	model, ok := obj.(*modelsv1alpha1.Model)
+	if !ok || model.Spec.CredentialsSecret == "" {
+		return nil
+	}
+	return []string{model.Spec.CredentialsSecret}
+}
+
+// credentialsVersion returns a hash of the data of the Model's
+// credentialsSecret, or "" if it has none or the Secret does not exist
+func (r *ModelReconciler) credentialsVersion(ctx context.Context, model *modelsv1alpha1.Model) (string, error) {
+	if model.Spec.CredentialsSecret == "" {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: model.Spec.CredentialsSecret, Namespace: model.Namespace}, secret)
+	if apierrors.IsNotFound(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	// Hash the data only, so label or annotation changes restart nothing
+	h := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(secret.Data)) {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+		h.Write(secret.Data[key])
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8]), nil
+}
+
+// stampCredentialsVersion annotates a new download Job with the version of
+// the credentials its pods start with
+func (r *ModelReconciler) stampCredentialsVersion(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) error {
+	version, err := r.credentialsVersion(ctx, model)
+	if err != nil || version == "" {
+		return err
+	}
+	if job.Annotations == nil {
+		job.Annotations = map[string]string{}
+	}
+	job.Annotations[annotationCredentialsVersion] = version
+	return nil
+}
+
+// credentialsRotated reports whether the running download Job was created
+// with credentials that have changed since. Jobs created before the Secret
+// existed, and Secrets that have been deleted, do not count.
+func (r *ModelReconciler) credentialsRotated(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) (bool, error) {
+	used := job.Annotations[annotationCredentialsVersion]
+	if used == "" {
+		return false, nil
+	}
+	current, err := r.credentialsVersion(ctx, model)
+	if err != nil {
+		return false, err
+	}
+	return current != "" && current != used, nil
+}
+
+// restartRotatedDownload deletes a download Job running with stale
+// credentials and returns the Model to Pending, so a new Job starts with the
+// rotated ones. It does not count as a stall restart.
+func (r *ModelReconciler) restartRotatedDownload(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	log.Info("Credentials rotated, restarting download Job", "job", job.Name, "secret", model.Spec.CredentialsSecret)
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+		!apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to delete download Job with stale credentials")
+		return ctrl.Result{}, err
+	}
+	r.eventf(model, corev1.EventTypeNormal, reasonCredentialsRotated,
+		"Secret %s changed, restarting download", model.Spec.CredentialsSecret)
+	return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
+		"Credentials rotated, restarting download")
+}
+
+// modelsForSecret maps a Secret to the Models in this shard that download
+// with it and are not yet Ready. Ready Models keep their files as they are.
+func (r *ModelReconciler) modelsForSecret(ctx context.Context, obj client.Object) []reconcile.Request {
+	if !r.Shard.Owns(obj.GetNamespace()) {
+		return nil
+	}
+	models := &modelsv1alpha1.ModelList{}
+	if err := r.List(ctx, models, client.InNamespace(obj.GetNamespace()),
+		client.MatchingFields{credentialsSecretIndex: obj.GetName()}); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Models for Secret", "secret", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, model := range models.Items {
+		if model.Status.Phase == modelsv1alpha1.ModelPhasePending || model.Status.Phase == modelsv1alpha1.ModelPhaseDownloading {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: model.Name, Namespace: model.Namespace},
+			})
+		}
+	}
+	return requests
+}
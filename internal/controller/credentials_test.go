@@ -0,0 +1,163 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Credential rotation", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	newModel := func(name string, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/"},
+				},
+				Storage:           modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				CredentialsSecret: "s3-credentials",
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	newSecret := func(key string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "s3-credentials", Namespace: namespace},
+			Data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("AKIAEXAMPLE"),
+				"AWS_SECRET_ACCESS_KEY": []byte(key),
+			},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			WithIndex(&modelsv1alpha1.Model{}, credentialsSecretIndex, indexCredentialsSecret).
+			Build()
+	}
+
+	// runningJob returns a download Job started with the given credentials version
+	runningJob := func(name, version string) *batchv1.Job {
+		started := metav1.NewTime(time.Now().Add(-time.Minute))
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        resources.JobName(name),
+				Namespace:   namespace,
+				Annotations: map[string]string{annotationCredentialsVersion: version},
+			},
+			Status: batchv1.JobStatus{Active: 1, StartTime: &started},
+		}
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	versionOf := func(secret *corev1.Secret) string {
+		r := &ModelReconciler{Client: newClient(secret)}
+		version, err := r.credentialsVersion(ctx, newModel("any", ""))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(version).NotTo(BeEmpty())
+		return version
+	}
+
+	It("should record the credentials version on a new download Job", func() {
+		secret := newSecret("old-key")
+		c := newClient(newModel("new-model", modelsv1alpha1.ModelPhasePending), secret)
+		reconcileModel(c, "new-model")
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName("new-model"), Namespace: namespace}, job)).To(Succeed())
+		Expect(job.Annotations).To(HaveKeyWithValue(annotationCredentialsVersion, versionOf(secret)))
+	})
+
+	It("should restart a running download once its credentials are rotated", func() {
+		stale := versionOf(newSecret("old-key"))
+		c := newClient(newModel("rotated-model", modelsv1alpha1.ModelPhaseDownloading),
+			runningJob("rotated-model", stale), newSecret("new-key"))
+		model := reconcileModel(c, "rotated-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(ContainSubstring("Credentials rotated"))
+		Expect(model.Status.StallRestarts).To(BeZero())
+		err := c.Get(ctx, types.NamespacedName{Name: resources.JobName("rotated-model"), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should leave a download with current credentials running", func() {
+		secret := newSecret("old-key")
+		current := versionOf(secret)
+		// Metadata changes do not change the version
+		secret.Labels = map[string]string{"rotated-by": "vault"}
+		c := newClient(newModel("current-model", modelsv1alpha1.ModelPhaseDownloading),
+			runningJob("current-model", current), secret)
+		model := reconcileModel(c, "current-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName("current-model"), Namespace: namespace},
+			&batchv1.Job{})).To(Succeed())
+	})
+
+	It("should enqueue only the in-flight Models using a rotated Secret", func() {
+		other := newModel("other-model", modelsv1alpha1.ModelPhaseDownloading)
+		other.Spec.CredentialsSecret = "other-credentials"
+		c := newClient(
+			newModel("pending-model", modelsv1alpha1.ModelPhasePending),
+			newModel("downloading-model", modelsv1alpha1.ModelPhaseDownloading),
+			newModel("ready-model", modelsv1alpha1.ModelPhaseReady),
+			other,
+		)
+		r := &ModelReconciler{Client: c}
+
+		requests := r.modelsForSecret(ctx, newSecret("new-key"))
+		Expect(requests).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "pending-model", Namespace: namespace}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "downloading-model", Namespace: namespace}},
+		))
+	})
+})
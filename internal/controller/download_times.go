@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// recordDownloadTimes copies when the download Job started and finished to
+// the Model status, with how long it ran once it finished
+func recordDownloadTimes(model *modelsv1alpha1.Model, job *batchv1.Job) {
+	if job.Status.StartTime != nil {
+		model.Status.StartTime = job.Status.StartTime.DeepCopy()
+	}
+
+	// A failed Job has no completion time, only the time of its condition
+	completion := job.Status.CompletionTime
+	if completion == nil {
+		for i, cond := range job.Status.Conditions {
+			if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+				completion = &job.Status.Conditions[i].LastTransitionTime
+			}
+		}
+	}
+	if completion == nil {
+		return
+	}
+	model.Status.CompletionTime = completion.DeepCopy()
+	if start := model.Status.StartTime; start != nil {
+		model.Status.DownloadDuration = &metav1.Duration{Duration: completion.Sub(start.Time).Round(time.Second)}
+	}
+}
+
+// clearDownloadTimes forgets the times of the previous download when a new
+// one starts
+func clearDownloadTimes(model *modelsv1alpha1.Model) {
+	model.Status.StartTime = nil
+	model.Status.CompletionTime = nil
+	model.Status.DownloadDuration = nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Download times", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	started := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+	finished := metav1.NewTime(started.Add(95 * time.Second))
+
+	newModel := func(name string, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	newJob := func(name string, status batchv1.JobStatus) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(name), Namespace: namespace},
+			Status:     status,
+		}
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	build := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	It("should record when a running download started", func() {
+		c := build(newModel("running", modelsv1alpha1.ModelPhaseDownloading),
+			newJob("running", batchv1.JobStatus{Active: 1, StartTime: &started}))
+
+		model := reconcileModel(c, "running")
+		Expect(model.Status.StartTime).NotTo(BeNil())
+		Expect(model.Status.StartTime.Equal(&started)).To(BeTrue())
+		Expect(model.Status.CompletionTime).To(BeNil())
+		Expect(model.Status.DownloadDuration).To(BeNil())
+	})
+
+	It("should record how long a finished download ran", func() {
+		c := build(newModel("finished", modelsv1alpha1.ModelPhaseDownloading),
+			newJob("finished", batchv1.JobStatus{Succeeded: 1, StartTime: &started, CompletionTime: &finished}))
+
+		model := reconcileModel(c, "finished")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.CompletionTime.Equal(&finished)).To(BeTrue())
+		Expect(model.Status.DownloadDuration).To(Equal(&metav1.Duration{Duration: 95 * time.Second}))
+	})
+
+	It("should take the completion time of a failed download from its condition", func() {
+		c := build(newModel("failed", modelsv1alpha1.ModelPhaseDownloading),
+			newJob("failed", batchv1.JobStatus{
+				Failed:    1,
+				StartTime: &started,
+				Conditions: []batchv1.JobCondition{{
+					Type:               batchv1.JobFailed,
+					Status:             corev1.ConditionTrue,
+					Reason:             "BackoffLimitExceeded",
+					LastTransitionTime: finished,
+				}},
+			}))
+
+		model := reconcileModel(c, "failed")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		Expect(model.Status.CompletionTime.Equal(&finished)).To(BeTrue())
+		Expect(model.Status.DownloadDuration).To(Equal(&metav1.Duration{Duration: 95 * time.Second}))
+	})
+
+	It("should forget the times of the previous download when a new one starts", func() {
+		model := newModel("again", modelsv1alpha1.ModelPhasePending)
+		model.Status.StartTime = &started
+		model.Status.CompletionTime = &finished
+		model.Status.DownloadDuration = &metav1.Duration{Duration: 95 * time.Second}
+		c := build(model)
+
+		model = reconcileModel(c, "again")
+		Expect(model.Status.StartTime).To(BeNil())
+		Expect(model.Status.CompletionTime).To(BeNil())
+		Expect(model.Status.DownloadDuration).To(BeNil())
+	})
+})
@@ -0,0 +1,48 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	batchv1 "k8s.io/api/batch/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;create;patch
+
+// useDownloaderServiceAccount runs a download Job that names no
+// ServiceAccount as the operator's downloader ServiceAccount, creating or
+// updating it in the Model's namespace. The ServiceAccount is shared by the
+// namespace's Models, so it has no owner and outlives them.
+func (r *ModelReconciler) useDownloaderServiceAccount(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) error {
+	cfg := r.Config.Resources.DownloaderServiceAccount
+	if cfg.Name == "" || job.Spec.Template.Spec.ServiceAccountName != "" {
+		return nil
+	}
+	sa, err := resources.BuildDownloaderServiceAccount(model.Namespace, cfg)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, sa); err != nil {
+		return err
+	}
+	job.Spec.Template.Spec.ServiceAccountName = sa.Name
+	return nil
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Downloader ServiceAccount", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "identity", Namespace: namespace}
+	saKey := types.NamespacedName{Name: resources.DefaultDownloaderServiceAccount, Namespace: namespace}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	// reconcileJob reconciles the Pending Model with the downloader
+	// ServiceAccount cfg and returns the download Job it created
+	reconcileJob := func(c client.Client, cfg resources.ServiceAccountConfig) *batchv1.Job {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme,
+			Config: Config{Resources: resources.Config{DownloaderServiceAccount: cfg}}}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName(key.Name), Namespace: namespace}, job)).To(Succeed())
+		return job
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	It("should create the ServiceAccount and run the download as it", func() {
+		c := newClient(newModel())
+		job := reconcileJob(c, resources.ServiceAccountConfig{
+			Name:        resources.DefaultDownloaderServiceAccount,
+			Annotations: map[string]string{"iam.gke.io/gcp-service-account": "{{.Namespace}}@p.iam.gserviceaccount.com"},
+		})
+		Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal(resources.DefaultDownloaderServiceAccount))
+
+		sa := &corev1.ServiceAccount{}
+		Expect(c.Get(ctx, saKey, sa)).To(Succeed())
+		Expect(sa.Annotations).To(HaveKeyWithValue("iam.gke.io/gcp-service-account", "default@p.iam.gserviceaccount.com"))
+	})
+
+	It("should keep the ServiceAccount the Model names", func() {
+		model := newModel()
+		model.Spec.Download = &modelsv1alpha1.DownloadSpec{ServiceAccountName: "custom"}
+		c := newClient(model)
+		job := reconcileJob(c, resources.ServiceAccountConfig{Name: resources.DefaultDownloaderServiceAccount})
+		Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal("custom"))
+		Expect(c.Get(ctx, saKey, &corev1.ServiceAccount{})).NotTo(Succeed())
+	})
+
+	It("should use the namespace's default ServiceAccount when disabled", func() {
+		job := reconcileJob(newClient(newModel()), resources.ServiceAccountConfig{})
+		Expect(job.Spec.Template.Spec.ServiceAccountName).To(BeEmpty())
+	})
+})
@@ -0,0 +1,145 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/estimate"
+)
+
+const (
+	// conditionTypeSizeEstimated reports whether the download size was estimated
+	conditionTypeSizeEstimated = "SizeEstimated"
+
+	reasonEstimated           = "Estimated"
+	reasonExceedsStorage      = "ExceedsStorage"
+	reasonEstimateUnsupported = "Unsupported"
+	reasonEstimateFailed      = "EstimateFailed"
+)
+
+// setSizeEstimatedCondition records the size estimation state on the Model
+func setSizeEstimatedCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeSizeEstimated,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// sourceCredentials returns the keys of the credentials Secret, if any
+func (r *ModelReconciler) sourceCredentials(ctx context.Context, model *modelsv1alpha1.Model) (estimate.Credentials, error) {
+	creds := estimate.Credentials{}
+	if model.Spec.CredentialsSecret == "" {
+		return creds, nil
+	}
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: model.Spec.CredentialsSecret, Namespace: model.Namespace}, secret)
+	if apierrors.IsNotFound(err) {
+		return creds, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range secret.Data {
+		creds[key] = string(value)
+	}
+	return creds, nil
+}
+
+// estimateDownloadSize records the estimated size of the selected source in
+// the Model status once per generation, flagging estimates larger than the
+// requested storage. A failed estimate never blocks the download. The status
+// is persisted by the caller's next status update.
+func (r *ModelReconciler) estimateDownloadSize(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	if r.Estimator == nil {
+		return nil
+	}
+	if cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeSizeEstimated); cond != nil &&
+		cond.ObservedGeneration == model.Generation {
+		return nil
+	}
+
+	creds, err := r.sourceCredentials(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	source := resources.ForSource(model, model.Status.SourceIndex).Spec.Source
+	est, err := r.Estimator.Estimate(ctx, source, creds)
+	switch {
+	case errors.Is(err, estimate.ErrUnsupported):
+		model.Status.EstimatedSizeBytes = 0
+		setSizeEstimatedCondition(model, metav1.ConditionFalse, reasonEstimateUnsupported, err.Error())
+		return nil
+	case err != nil:
+		log.Info("Failed to estimate download size", "error", err.Error())
+		model.Status.EstimatedSizeBytes = 0
+		setSizeEstimatedCondition(model, metav1.ConditionFalse, reasonEstimateFailed, err.Error())
+		return nil
+	}
+
+	model.Status.EstimatedSizeBytes = est.SizeBytes
+	estimated := resource.NewQuantity(est.SizeBytes, resource.BinarySI)
+	message := fmt.Sprintf("%d files, %s", est.Files, estimated)
+	if size, err := resource.ParseQuantity(model.Spec.Storage.Size); err == nil && estimated.Cmp(size) > 0 {
+		log.Info("Estimated download size exceeds the requested storage", "estimate", estimated.String(), "size", size.String())
+		setSizeEstimatedCondition(model, metav1.ConditionTrue, reasonExceedsStorage,
+			fmt.Sprintf("%s, more than the requested storage of %s", message, model.Spec.Storage.Size))
+		return nil
+	}
+	setSizeEstimatedCondition(model, metav1.ConditionTrue, reasonEstimated, message)
+	return nil
+}
+
+// downloadETA returns the download progress in percent and the time left,
+// from the estimated size and the average rate since the Job started. Both
+// are zero when unknown. Progress stays below 100 until the Job succeeds.
+func downloadETA(model *modelsv1alpha1.Model, job *batchv1.Job, downloaded int64, now time.Time) (int, time.Duration) {
+	if model.Status.EstimatedSizeBytes <= 0 || downloaded <= 0 {
+		return 0, 0
+	}
+	progress := int(min(downloaded*100/model.Status.EstimatedSizeBytes, 99))
+
+	if job.Status.StartTime == nil {
+		return progress, 0
+	}
+	elapsed := now.Sub(job.Status.StartTime.Time).Seconds()
+	if elapsed <= 0 {
+		return progress, 0
+	}
+	est := estimate.Estimate{SizeBytes: model.Status.EstimatedSizeBytes}
+	return progress, est.ETA(downloaded, float64(downloaded)/elapsed).Round(time.Second)
+}
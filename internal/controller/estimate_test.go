@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/estimate"
+)
+
+// fakeEstimator returns a fixed estimate or error
+type fakeEstimator struct {
+	estimate *estimate.Estimate
+	err      error
+}
+
+func (f *fakeEstimator) Estimate(context.Context, modelsv1alpha1.ModelSource, estimate.Credentials) (*estimate.Estimate, error) {
+	return f.estimate, f.err
+}
+
+var _ = Describe("Download size estimation", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	reconcilePending := func(name string, estimator *fakeEstimator) *modelsv1alpha1.Model {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Estimator: estimator}
+
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should record the estimate before the download starts", func() {
+		estimator := &fakeEstimator{estimate: &estimate.Estimate{SizeBytes: 90 << 20, Files: 12}}
+		model := reconcilePending("estimated-model", estimator)
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(model.Status.EstimatedSizeBytes).To(Equal(int64(90 << 20)))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeSizeEstimated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonEstimated))
+		Expect(cond.Message).To(Equal("12 files, 90Mi"))
+	})
+
+	It("should flag an estimate larger than the requested storage", func() {
+		estimator := &fakeEstimator{estimate: &estimate.Estimate{SizeBytes: 2 << 30, Files: 3}}
+		model := reconcilePending("oversized-model", estimator)
+
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeSizeEstimated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonExceedsStorage))
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+	})
+
+	It("should download anyway when the source cannot be estimated", func() {
+		estimator := &fakeEstimator{err: fmt.Errorf("%w: self-hosted git", estimate.ErrUnsupported)}
+		model := reconcilePending("unsized-model", estimator)
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(model.Status.EstimatedSizeBytes).To(BeZero())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeSizeEstimated)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonEstimateUnsupported))
+	})
+
+	It("should derive progress and time left from the estimate", func() {
+		now := time.Now()
+		model := &modelsv1alpha1.Model{Status: modelsv1alpha1.ModelStatus{EstimatedSizeBytes: 1000}}
+		job := &batchv1.Job{Status: batchv1.JobStatus{StartTime: &metav1.Time{Time: now.Add(-40 * time.Second)}}}
+
+		progress, eta := downloadETA(model, job, 400, now)
+		Expect(progress).To(Equal(40))
+		Expect(eta).To(Equal(60 * time.Second))
+
+		progress, _ = downloadETA(model, job, 5000, now)
+		Expect(progress).To(Equal(99))
+
+		progress, eta = downloadETA(&modelsv1alpha1.Model{}, job, 400, now)
+		Expect(progress).To(BeZero())
+		Expect(eta).To(BeZero())
+	})
+})
@@ -0,0 +1,54 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"fmt"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// Event reasons, shown by kubectl describe model
+const (
+	reasonPVCCreated            = "PVCCreated"
+	reasonPVCDeleted            = "PVCDeleted"
+	reasonPVCRetained           = "PVCRetained"
+	reasonAdopted               = "Adopted"
+	reasonResourceConflict      = "ResourceConflict"
+	reasonJobCreated            = "JobCreated"
+	reasonFailedCreate          = "FailedCreate"
+	reasonDownloadSucceeded     = "DownloadSucceeded"
+	reasonDownloadAttemptFailed = "DownloadAttemptFailed"
+	reasonDownloadFailed        = "DownloadFailed"
+	reasonDownloadRetrying      = "DownloadRetrying"
+	reasonDownloadStalled       = "DownloadStalled"
+	reasonCredentialsRotated    = "CredentialsRotated"
+	reasonStorageLost           = "StorageLost"
+	reasonModelReady            = "Ready"
+)
+
+// event records an Event on the Model, if the reconciler has a Recorder
+func (r *ModelReconciler) event(model *modelsv1alpha1.Model, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(model, eventType, reason, message)
+	}
+}
+
+// eventf records an Event with a formatted message on the Model
+func (r *ModelReconciler) eventf(model *modelsv1alpha1.Model, eventType, reason, format string, args ...any) {
+	r.event(model, eventType, reason, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Model events", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "eventful", Namespace: namespace}
+
+	newModel := func(phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	// reconcileEvents reconciles the Model once and returns the Events it recorded
+	reconcileEvents := func(c client.Client) []string {
+		recorder := record.NewFakeRecorder(10)
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Recorder: recorder}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		close(recorder.Events)
+		var events []string
+		for event := range recorder.Events {
+			events = append(events, event)
+		}
+		return events
+	}
+
+	It("should record the PVC and Job it creates", func() {
+		events := reconcileEvents(newClient(newModel(modelsv1alpha1.ModelPhasePending)))
+		Expect(events).To(Equal([]string{
+			"Normal PVCCreated Created PVC " + resources.PVCName(key.Name),
+			"Normal JobCreated Created download Job " + resources.JobName(key.Name) + " for the primary source",
+		}))
+	})
+
+	It("should record a finished download", func() {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(key.Name), Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		}
+		events := reconcileEvents(newClient(newModel(modelsv1alpha1.ModelPhaseDownloading), job))
+		Expect(events).To(Equal([]string{
+			"Normal DownloadSucceeded Downloaded from primary source",
+			"Normal Ready Download complete",
+		}))
+	})
+
+	It("should record a retry when the failed Job is deleted", func() {
+		events := reconcileEvents(newClient(newModel(modelsv1alpha1.ModelPhaseFailed)))
+		Expect(events).To(Equal([]string{
+			"Normal DownloadRetrying Download Job was deleted, retrying download",
+		}))
+	})
+
+	It("should record lost storage", func() {
+		events := reconcileEvents(newClient(newModel(modelsv1alpha1.ModelPhaseReady)))
+		Expect(events).To(HaveLen(1))
+		Expect(events[0]).To(HavePrefix("Warning StorageLost "))
+	})
+})
@@ -0,0 +1,50 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// existingClaimMissing returns why the pre-provisioned PVC a Model names
+// cannot be used yet, or an empty string once it can. The PVC is never
+// created, adopted or modified.
+func (r *ModelReconciler) existingClaimMissing(ctx context.Context, model *modelsv1alpha1.Model) (string, error) {
+	name := model.Spec.Storage.ExistingClaim.Name
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: model.Namespace}, pvc)
+	if apierrors.IsNotFound(err) {
+		return fmt.Sprintf("Waiting for PVC %s", name), nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if pvc.DeletionTimestamp != nil {
+		return fmt.Sprintf("PVC %s is being deleted", name), nil
+	}
+	if pvc.Status.Phase == corev1.ClaimLost {
+		return fmt.Sprintf("PVC %s lost its volume", name), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Existing claims", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "restored", Namespace: namespace}
+	claimKey := types.NamespacedName{Name: "restored-from-backup", Namespace: namespace}
+
+	newModel := func(verifyOnly bool) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, UID: "restored-uid", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{
+					Size:          "1Gi",
+					ExistingClaim: &modelsv1alpha1.ExistingClaimSpec{Name: claimKey.Name, VerifyOnly: verifyOnly},
+				},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	newClaim := func() *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: claimKey.Name, Namespace: namespace},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	getJob := func(c client.Client) *batchv1.Job {
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName(key.Name), Namespace: namespace}, job)).To(Succeed())
+		return job
+	}
+
+	It("should wait for the existing claim without creating a PVC", func() {
+		c := newClient(newModel(false))
+
+		model := reconcileModel(c)
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(Equal("Waiting for PVC " + claimKey.Name))
+		err := c.Get(ctx, types.NamespacedName{Name: resources.PVCName(key.Name), Namespace: namespace}, &corev1.PersistentVolumeClaim{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = c.Get(ctx, types.NamespacedName{Name: resources.JobName(key.Name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should download into the existing claim without owning it", func() {
+		c := newClient(newModel(false), newClaim())
+
+		model := reconcileModel(c)
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(model.Status.PVCName).To(Equal(claimKey.Name))
+		volumes := getJob(c).Spec.Template.Spec.Volumes
+		Expect(volumes[0].PersistentVolumeClaim.ClaimName).To(Equal(claimKey.Name))
+
+		claim := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, claimKey, claim)).To(Succeed())
+		Expect(claim.OwnerReferences).To(BeEmpty())
+		err := c.Get(ctx, types.NamespacedName{Name: resources.PVCName(key.Name), Namespace: namespace}, &corev1.PersistentVolumeClaim{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should only verify the files of a verify-only claim", func() {
+		c := newClient(newModel(true), newClaim())
+
+		reconcileModel(c)
+
+		containers := getJob(c).Spec.Template.Spec.Containers
+		Expect(containers).To(HaveLen(1))
+		Expect(containers[0].Image).NotTo(ContainSubstring("curl"))
+		Expect(containers[0].Args[0]).To(ContainSubstring("do not match their completion marker"))
+		Expect(containers[0].Args[0]).NotTo(ContainSubstring("example.com"))
+	})
+})
@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// failureReason maps an error building the Model's resources to the reason
+// the Model is Failed
+func failureReason(err error) modelsv1alpha1.FailureReason {
+	switch {
+	case errors.Is(err, resources.ErrNoSource):
+		return modelsv1alpha1.FailureReasonNoSource
+	case errors.Is(err, resources.ErrMultipleSources):
+		return modelsv1alpha1.FailureReasonMultipleSources
+	case errors.Is(err, resources.ErrInvalidSize):
+		return modelsv1alpha1.FailureReasonInvalidStorageSize
+	case errors.Is(err, resources.ErrVolumeConflict):
+		return modelsv1alpha1.FailureReasonInvalidDownloadVolumes
+	default:
+		return modelsv1alpha1.FailureReasonDownloadFailed
+	}
+}
+
+// specFailed reports whether the Model failed for an error in the spec it
+// was last reconciled at, which retrying cannot fix until the spec changes
+func specFailed(model *modelsv1alpha1.Model) bool {
+	switch model.Status.FailureReason {
+	case modelsv1alpha1.FailureReasonNoSource, modelsv1alpha1.FailureReasonMultipleSources,
+		modelsv1alpha1.FailureReasonInvalidStorageSize, modelsv1alpha1.FailureReasonInvalidDownloadVolumes:
+		return model.Status.ObservedGeneration == model.Generation
+	}
+	return false
+}
+
+// fail moves the Model to the Failed phase with the reason err maps to
+func (r *ModelReconciler) fail(ctx context.Context, model *modelsv1alpha1.Model, err error, message string) (ctrl.Result, error) {
+	model.Status.FailureReason = failureReason(err)
+	return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed, message)
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// failureOutputLines and failureOutputBytes bound how much of the output
+	// of a failed download attempt is kept
+	failureOutputLines = 10
+	failureOutputBytes = 1024
+)
+
+// lastFailedAttempt returns the most recent termination of a download
+// container that failed with a termination message, or nil
+func lastFailedAttempt(pods []corev1.Pod) *corev1.ContainerStateTerminated {
+	var latest *corev1.ContainerStateTerminated
+	for i := range pods {
+		statuses := append(slices.Clone(pods[i].Status.InitContainerStatuses), pods[i].Status.ContainerStatuses...)
+		for _, status := range statuses {
+			for _, t := range []*corev1.ContainerStateTerminated{status.State.Terminated, status.LastTerminationState.Terminated} {
+				if t == nil || t.ExitCode == 0 || strings.TrimSpace(t.Message) == "" {
+					continue
+				}
+				if latest == nil || t.FinishedAt.After(latest.FinishedAt.Time) {
+					latest = t
+				}
+			}
+		}
+	}
+	return latest
+}
+
+// tailOutput returns the last lines of output, within failureOutputBytes
+func tailOutput(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) > failureOutputLines {
+		lines = lines[len(lines)-failureOutputLines:]
+	}
+	tail := strings.Join(lines, "\n")
+	if len(tail) > failureOutputBytes {
+		tail = strings.ToValidUTF8(tail[len(tail)-failureOutputBytes:], "")
+	}
+	return tail
+}
+
+// recordFailureOutput keeps the output of the download's latest failed
+// attempt in the status, with a Warning Event when it is new
+func (r *ModelReconciler) recordFailureOutput(ctx context.Context, model *modelsv1alpha1.Model) error {
+	pods, err := r.listDownloadPods(ctx, model)
+	if err != nil {
+		return err
+	}
+	attempt := lastFailedAttempt(pods)
+	if attempt == nil {
+		return nil
+	}
+	output := tailOutput(attempt.Message)
+	if output == model.Status.FailureOutput {
+		return nil
+	}
+	model.Status.FailureOutput = output
+	r.eventf(model, corev1.EventTypeWarning, reasonDownloadAttemptFailed,
+		"Download attempt exited with code %d:\n%s", attempt.ExitCode, output)
+	return nil
+}
+
+// withFailureOutput appends the output of the last failed attempt, if any,
+// to a failure message
+func withFailureOutput(model *modelsv1alpha1.Model, message string) string {
+	if model.Status.FailureOutput == "" {
+		return message
+	}
+	return message + ". Last output:\n" + model.Status.FailureOutput
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Failure output", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "broken", Namespace: namespace}
+	finished := metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+	model := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseDownloading},
+		}
+	}
+
+	// pod returns a download pod whose downloader last exited with output
+	pod := func(output string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "broken-download", Namespace: namespace, Labels: map[string]string{
+				"app.kubernetes.io/name":     downloaderAppName,
+				"app.kubernetes.io/instance": key.Name,
+			}},
+			Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name: "downloader",
+				LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{
+					ExitCode: 22, Message: output, FinishedAt: finished,
+				}},
+			}}},
+		}
+	}
+
+	job := func(status batchv1.JobStatus) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(key.Name), Namespace: namespace},
+			Status:     status,
+		}
+	}
+
+	reconcileModel := func(c client.Client, recorder record.EventRecorder) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Recorder: recorder}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		m := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, m)).To(Succeed())
+		return m
+	}
+
+	build := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	It("should keep the output of a failed attempt while the download retries", func() {
+		var lines []string
+		for i := range 30 {
+			lines = append(lines, "line "+string(rune('a'+i%26)))
+		}
+		recorder := record.NewFakeRecorder(10)
+		c := build(model(), job(batchv1.JobStatus{Active: 1}), pod(strings.Join(lines, "\n")))
+
+		m := reconcileModel(c, recorder)
+		Expect(m.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(strings.Split(m.Status.FailureOutput, "\n")).To(Equal(lines[20:]))
+		Expect(recorder.Events).To(Receive(ContainSubstring("DownloadAttemptFailed")))
+
+		// The same attempt is not reported again
+		reconcileModel(c, recorder)
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should show the output when the download fails", func() {
+		recorder := record.NewFakeRecorder(10)
+		c := build(model(), pod("curl: (22) The requested URL returned error: 403"), job(batchv1.JobStatus{
+			Failed: 1,
+			Conditions: []batchv1.JobCondition{{
+				Type:    batchv1.JobFailed,
+				Status:  corev1.ConditionTrue,
+				Reason:  "BackoffLimitExceeded",
+				Message: "Job has reached the specified backoff limit",
+			}},
+		}))
+
+		m := reconcileModel(c, recorder)
+		Expect(m.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		Expect(m.Status.Message).To(Equal("Download failed: Job has reached the specified backoff limit. " +
+			"Last output:\ncurl: (22) The requested URL returned error: 403"))
+		Expect(recorder.Events).To(Receive(ContainSubstring("DownloadAttemptFailed")))
+		Expect(recorder.Events).To(Receive(And(ContainSubstring("Warning DownloadFailed"), ContainSubstring("403"))))
+	})
+
+	It("should clear the output once the download succeeds", func() {
+		m := model()
+		m.Status.FailureOutput = "curl: (56) Recv failure"
+		c := build(m, job(batchv1.JobStatus{Succeeded: 1}))
+
+		Expect(reconcileModel(c, nil).Status.FailureOutput).To(BeEmpty())
+	})
+})
@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var _ = Describe("Failure reasons", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	newModel := func(name string, source modelsv1alpha1.ModelSource, size string) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source:  source,
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: size},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	url := &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"}
+
+	DescribeTable("should fail the Model with a reason naming the spec error",
+		func(model *modelsv1alpha1.Model, want modelsv1alpha1.FailureReason) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(model).
+				WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+				Build()
+
+			got := reconcileModel(c, model.Name)
+			Expect(got.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+			Expect(got.Status.FailureReason).To(Equal(want))
+			ready := meta.FindStatusCondition(got.Status.Conditions, conditionTypeReady)
+			Expect(ready).NotTo(BeNil())
+			Expect(ready.Reason).To(Equal(string(want)))
+
+			// It stays Failed rather than retrying until the spec is fixed
+			got = reconcileModel(c, model.Name)
+			Expect(got.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		},
+		Entry("no source", newModel("no-source", modelsv1alpha1.ModelSource{}, "1Gi"),
+			modelsv1alpha1.FailureReasonNoSource),
+		Entry("multiple sources", newModel("two-sources", modelsv1alpha1.ModelSource{
+			URL: url, HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"},
+		}, "1Gi"), modelsv1alpha1.FailureReasonMultipleSources),
+		Entry("invalid size", newModel("bad-size", modelsv1alpha1.ModelSource{URL: url}, "20K"),
+			modelsv1alpha1.FailureReasonInvalidStorageSize),
+		Entry("clashing download volume", func() *modelsv1alpha1.Model {
+			model := newModel("clashing-volume", modelsv1alpha1.ModelSource{URL: url}, "1Gi")
+			model.Spec.Download = &modelsv1alpha1.DownloadSpec{
+				ExtraVolumes: []corev1.Volume{{Name: "tmp"}},
+			}
+			return model
+		}(), modelsv1alpha1.FailureReasonInvalidDownloadVolumes),
+	)
+
+	It("should retry and clear the reason once the spec is fixed", func() {
+		model := newModel("fixed-source", modelsv1alpha1.ModelSource{URL: url}, "1Gi")
+		model.Generation = 2
+		model.Status.Phase = modelsv1alpha1.ModelPhaseFailed
+		model.Status.FailureReason = modelsv1alpha1.FailureReasonNoSource
+		model.Status.ObservedGeneration = 1
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+
+		got := reconcileModel(c, "fixed-source")
+		Expect(got.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(got.Status.FailureReason).To(BeEmpty())
+	})
+})
@@ -0,0 +1,63 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// failDownload handles a download that failed terminally: it moves on to the
+// next spec.source.fallbacks entry if there is one, otherwise fails the Model.
+// The message ends with the output of the last failed attempt.
+func (r *ModelReconciler) failDownload(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job, message string) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	failed := resources.SourceName(model.Status.SourceIndex)
+	if int(model.Status.SourceIndex) >= len(model.Spec.Source.Fallbacks) {
+		if model.Status.SourceIndex > 0 {
+			message = fmt.Sprintf("%s (all %d sources tried)", message, model.Status.SourceIndex+1)
+		}
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed, withFailureOutput(model, message))
+	}
+
+	// The Pending phase recreates the Job for the next source once this one is gone
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+		!apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to delete failed download Job")
+		return ctrl.Result{}, err
+	}
+
+	model.Status.SourceIndex++
+	model.Status.StallRestarts = 0
+	next := resources.SourceName(model.Status.SourceIndex)
+	log.Info("Download failed, trying fallback source", "failed", failed, "next", next)
+	r.eventf(model, corev1.EventTypeNormal, reasonDownloadRetrying, "Download from %s source failed, trying %s", failed, next)
+	message = withFailureOutput(model, fmt.Sprintf("%s from %s source, trying %s", message, failed, next))
+	return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, message)
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Source fallbacks", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	// newClient returns a downloading Model with one S3 fallback and a download Job in the given state
+	newClient := func(name string, sourceIndex int32, jobStatus batchv1.JobStatus) client.Client {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+					Fallbacks: []modelsv1alpha1.FallbackSource{
+						{S3: &modelsv1alpha1.S3Source{Bucket: "model-mirror", Key: "all-MiniLM-L6-v2/"}},
+					},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{
+				Phase:       modelsv1alpha1.ModelPhaseDownloading,
+				SourceIndex: sourceIndex,
+			},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(name), Namespace: namespace},
+			Status:     jobStatus,
+		}
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, job).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	failed := batchv1.JobStatus{
+		Failed: 1,
+		Conditions: []batchv1.JobCondition{{
+			Type:    batchv1.JobFailed,
+			Status:  corev1.ConditionTrue,
+			Reason:  "BackoffLimitExceeded",
+			Message: "Job has reached the specified backoff limit",
+		}},
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should move on to the next source when the primary fails", func() {
+		c := newClient("fallback-model", 0, failed)
+		model := reconcileModel(c, "fallback-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.SourceIndex).To(Equal(int32(1)))
+		Expect(model.Status.Message).To(ContainSubstring("trying fallbacks[0]"))
+
+		err := c.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail the Model when the last source fails", func() {
+		c := newClient("exhausted-model", 1, failed)
+		model := reconcileModel(c, "exhausted-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		Expect(model.Status.Message).To(ContainSubstring("all 2 sources tried"))
+	})
+
+	It("should record the source the content was downloaded from", func() {
+		c := newClient("mirrored-model", 1, batchv1.JobStatus{Succeeded: 1})
+		model := reconcileModel(c, "mirrored-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.DownloadedFrom).To(Equal("fallbacks[0]"))
+		Expect(model.Status.SourceRevision).To(BeEmpty())
+	})
+
+	It("should record the revision the content was downloaded at", func() {
+		c := newClient("primary-model", 0, batchv1.JobStatus{Succeeded: 1})
+		model := reconcileModel(c, "primary-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.SourceRevision).To(Equal("main"))
+	})
+})
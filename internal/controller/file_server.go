@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// conditionTypeFileServing reports whether the model files are served over HTTP
+const conditionTypeFileServing = "FileServing"
+
+// fileServerURL returns the in-cluster URL the model files are served at
+func fileServerURL(model *modelsv1alpha1.Model) string {
+	return fmt.Sprintf("http://%s.%s.svc:%d/", resources.FileServerName(model.Name), model.Namespace,
+		resources.FileServerPort(model))
+}
+
+// reconcileFileServer runs the file server of a Ready Model and removes it
+// in every other phase, so it never serves a partial download or holds on
+// to a volume being replaced. Its state is reflected in the FileServing
+// condition.
+func (r *ModelReconciler) reconcileFileServer(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	existing := &appsv1.Deployment{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.FileServerName(model.Name), Namespace: model.Namespace}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+
+	condition := metav1.Condition{
+		Type:               conditionTypeFileServing,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: model.Generation,
+	}
+	switch {
+	case model.Spec.FileServer == nil:
+	case model.Spec.Access != nil:
+		condition.Reason = "Restricted"
+		condition.Message = "Files are not served for models with spec.access, since any pod can reach the file server"
+	case !resources.FileServed(model):
+		condition.Reason = "Unsupported"
+		condition.Message = "Files are only served for uncompressed models in the pvc storage mode"
+	case model.Status.Phase != modelsv1alpha1.ModelPhaseReady:
+		condition.Reason = "WaitingForModel"
+		condition.Message = "The file server starts once the model is Ready"
+	default:
+		return r.applyFileServer(ctx, model, found)
+	}
+
+	if found && metav1.IsControlledBy(existing, model) {
+		log.Info("Deleting file server", "name", existing.Name)
+		if err := r.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: existing.Name, Namespace: model.Namespace}}
+		if err := r.Delete(ctx, service); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	var changed bool
+	if condition.Reason == "" {
+		changed = meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeFileServing)
+	} else {
+		changed = meta.SetStatusCondition(&model.Status.Conditions, condition)
+	}
+	if changed {
+		return r.writeStatus(ctx, model)
+	}
+	return nil
+}
+
+// applyFileServer applies the file server Deployment and Service and
+// reports whether the Deployment has an available replica
+func (r *ModelReconciler) applyFileServer(ctx context.Context, model *modelsv1alpha1.Model, found bool) error {
+	log := logf.FromContext(ctx)
+
+	deployment, service := resources.BuildFileServer(model, r.Config.Resources)
+	for _, obj := range []client.Object{deployment, service} {
+		if err := controllerutil.SetControllerReference(model, obj, r.Scheme); err != nil {
+			return err
+		}
+	}
+	if !found {
+		log.Info("Creating file server", "name", deployment.Name)
+	}
+	if err := r.apply(ctx, deployment); err != nil {
+		return err
+	}
+	if err := r.apply(ctx, service); err != nil {
+		return err
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionTypeFileServing,
+		ObservedGeneration: model.Generation,
+	}
+	if deployment.Status.AvailableReplicas > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Serving"
+		condition.Message = "Serving the model files at " + fileServerURL(model)
+	} else {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Starting"
+		condition.Message = "Waiting for the file server to become available"
+	}
+
+	if meta.SetStatusCondition(&model.Status.Conditions, condition) {
+		return r.writeStatus(ctx, model)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("File server", func() {
+	ctx := context.Background()
+	key := types.NamespacedName{Name: resources.FileServerName("gguf"), Namespace: "default"}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "gguf", Namespace: "default", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage:    modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"},
+				FileServer: &modelsv1alpha1.FileServerSpec{},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseReady},
+		}
+	}
+
+	setup := func(model *modelsv1alpha1.Model) (*ModelReconciler, client.Client) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &appsv1.Deployment{}).
+			Build()
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(model), model)).To(Succeed())
+		return &ModelReconciler{Client: c, Scheme: scheme.Scheme}, c
+	}
+
+	It("should serve the files of a Ready model", func() {
+		model := newModel()
+		r, c := setup(model)
+
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+		deployment := &appsv1.Deployment{}
+		Expect(c.Get(ctx, key, deployment)).To(Succeed())
+		Expect(metav1.IsControlledBy(deployment, model)).To(BeTrue())
+		Expect(c.Get(ctx, key, &corev1.Service{})).To(Succeed())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeFileServing)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal("Starting"))
+
+		deployment.Status.AvailableReplicas = 1
+		Expect(c.Status().Update(ctx, deployment)).To(Succeed())
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+		cond = meta.FindStatusCondition(model.Status.Conditions, conditionTypeFileServing)
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Message).To(ContainSubstring("http://model-files-gguf.default.svc:80/"))
+	})
+
+	It("should stop serving while the model is not Ready", func() {
+		model := newModel()
+		r, c := setup(model)
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+
+		model.Status.Phase = modelsv1alpha1.ModelPhaseDownloading
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &appsv1.Deployment{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &corev1.Service{}))).To(BeTrue())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeFileServing)
+		Expect(cond.Reason).To(Equal("WaitingForModel"))
+	})
+
+	It("should not serve compressed models", func() {
+		model := newModel()
+		model.Spec.Storage.Compression = modelsv1alpha1.CompressionZstd
+		r, c := setup(model)
+
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &appsv1.Deployment{}))).To(BeTrue())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeFileServing)
+		Expect(cond.Reason).To(Equal("Unsupported"))
+	})
+
+	It("should not serve models restricted by spec.access", func() {
+		model := newModel()
+		r, c := setup(model)
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+
+		model.Spec.Access = &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"inference"}}
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &appsv1.Deployment{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &corev1.Service{}))).To(BeTrue())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeFileServing)
+		Expect(cond.Reason).To(Equal("Restricted"))
+	})
+
+	It("should remove the file server and its condition once disabled", func() {
+		model := newModel()
+		r, c := setup(model)
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+
+		model.Spec.FileServer = nil
+		Expect(r.reconcileFileServer(ctx, model)).To(Succeed())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &appsv1.Deployment{}))).To(BeTrue())
+		Expect(meta.FindStatusCondition(model.Status.Conditions, conditionTypeFileServing)).To(BeNil())
+	})
+})
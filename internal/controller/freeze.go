@@ -0,0 +1,71 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// conditionTypeFrozen reports that changes to the model files are held
+	conditionTypeFrozen = "Frozen"
+
+	// reasonChangeFreeze is the Frozen condition reason
+	reasonChangeFreeze = "ChangeFreeze"
+)
+
+// frozen reports whether the Model is annotated with AnnotationFreeze
+func frozen(model *modelsv1alpha1.Model) bool {
+	return model.Annotations[modelsv1alpha1.AnnotationFreeze] == "true"
+}
+
+// reconcileFreeze reflects AnnotationFreeze in the Frozen condition, naming
+// the changes it currently holds
+func (r *ModelReconciler) reconcileFreeze(ctx context.Context, model *modelsv1alpha1.Model) error {
+	if !frozen(model) {
+		if meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeFrozen) {
+			return r.writeStatus(ctx, model)
+		}
+		return nil
+	}
+
+	message := "Changes to the model files are held by " + modelsv1alpha1.AnnotationFreeze
+	if revisionOutdated(model) {
+		message += fmt.Sprintf("; held: sync to revision %s", resources.SourceRevision(model))
+	}
+	if conversionOutdated(model) {
+		message += fmt.Sprintf("; held: conversion to %s", model.Spec.Conversion.Target)
+	}
+	changed := meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeFrozen,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonChangeFreeze,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+	if changed {
+		return r.writeStatus(ctx, model)
+	}
+	return nil
+}
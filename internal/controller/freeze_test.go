@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Change freeze", func() {
+	const (
+		namespace = "default"
+		name      = "frozen"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+
+	newModel := func(annotations map[string]string) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 2, Annotations: annotations},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.onnx"},
+				},
+				Storage:    modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				Conversion: &modelsv1alpha1.ConversionSpec{Target: modelsv1alpha1.ConversionTargetONNX},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseReady, PVCName: resources.PVCName(name)},
+		}
+	}
+
+	reconcileModel := func(c client.Client) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	newClient := func(model *modelsv1alpha1.Model) client.Client {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(name), Namespace: namespace},
+		}
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, pvc).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	It("should hold a conversion while frozen", func() {
+		c := newClient(newModel(map[string]string{modelsv1alpha1.AnnotationFreeze: "true"}))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeFrozen)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Message).To(ContainSubstring("held: conversion to onnx"))
+		Expect(meta.FindStatusCondition(model.Status.Conditions, conditionTypeConverted)).To(BeNil())
+	})
+
+	It("should apply held changes once unfrozen", func() {
+		model := newModel(nil)
+		model.Status.Conditions = []metav1.Condition{{
+			Type: conditionTypeFrozen, Status: metav1.ConditionTrue, Reason: reasonChangeFreeze,
+			LastTransitionTime: metav1.Now(),
+		}}
+		c := newClient(model)
+
+		model = reconcileModel(c)
+		Expect(meta.FindStatusCondition(model.Status.Conditions, conditionTypeFrozen)).To(BeNil())
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(conversionInProgress(model)).To(BeTrue())
+	})
+})
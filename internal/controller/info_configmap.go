@@ -0,0 +1,52 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// reconcileInfoConfigMap keeps the Model's info ConfigMap in sync with its
+// spec and status, writing it only when its data changed
+func (r *ModelReconciler) reconcileInfoConfigMap(ctx context.Context, model *modelsv1alpha1.Model) error {
+	cm, err := resources.BuildInfoConfigMap(model)
+	if err != nil {
+		return err
+	}
+
+	existing := &corev1.ConfigMap{}
+	err = r.Get(ctx, client.ObjectKeyFromObject(cm), existing)
+	if err == nil && maps.Equal(existing.Data, cm.Data) {
+		return nil
+	}
+	if client.IgnoreNotFound(err) != nil {
+		return err
+	}
+
+	if err := controllerutil.SetControllerReference(model, cm, r.Scheme); err != nil {
+		return err
+	}
+	return r.apply(ctx, cm)
+}
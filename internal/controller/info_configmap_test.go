@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Info ConfigMap", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	It("should export the Model's info and keep it in sync", func() {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "info-model", Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				Version: "1.0",
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		key := types.NamespacedName{Name: model.Name, Namespace: namespace}
+		infoKey := types.NamespacedName{Name: resources.InfoConfigMapName(model.Name), Namespace: namespace}
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, infoKey, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue(resources.InfoKeyVersion, "1.0"))
+		Expect(cm.Data).To(HaveKeyWithValue(resources.InfoKeyMountPath, "/models/info-model"))
+		Expect(cm.Data).NotTo(HaveKey(resources.InfoKeyDigest))
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+
+		// A new version is exported on the next reconcile
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		model.Spec.Version = "1.1"
+		Expect(c.Update(ctx, model)).To(Succeed())
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, infoKey, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue(resources.InfoKeyVersion, "1.1"))
+	})
+})
@@ -0,0 +1,118 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// statusPVCName returns the PVC reported in the status, empty for models
+// that are not kept on a PVC
+func statusPVCName(model *modelsv1alpha1.Model) string {
+	if !resources.UsesPVC(model) {
+		return ""
+	}
+	return resources.ClaimName(model)
+}
+
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings,verbs=get;list;watch;create;patch;delete
+
+// ensureInlineStorage creates the ConfigMap of a configmap-mode model, and
+// the ServiceAccount, Role and RoleBinding that let the download pod fill it
+func (r *ModelReconciler) ensureInlineStorage(ctx context.Context, model *modelsv1alpha1.Model) error {
+	if model.Spec.Storage.Mode != modelsv1alpha1.StorageModeConfigMap {
+		return nil
+	}
+
+	// Only create the ConfigMap: applying it again would drop the stored files
+	existing := &corev1.ConfigMap{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.ContentConfigMapName(model.Name), Namespace: model.Namespace}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	objects := []client.Object{
+		resources.BuildStoreServiceAccount(model),
+		resources.BuildStoreRole(model),
+		resources.BuildStoreRoleBinding(model),
+	}
+	if apierrors.IsNotFound(err) {
+		logf.FromContext(ctx).Info("Creating model ConfigMap", "name", resources.ContentConfigMapName(model.Name))
+		objects = append(objects, resources.BuildContentConfigMap(model))
+	}
+
+	for _, obj := range objects {
+		if err := controllerutil.SetControllerReference(model, obj, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.apply(ctx, obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordInlinePublication records the image an image-mode download pushed.
+// It returns false if the download pod did not report the image digest.
+func (r *ModelReconciler) recordInlinePublication(ctx context.Context, model *modelsv1alpha1.Model) (bool, error) {
+	pods, err := r.listDownloadPods(ctx, model)
+	if err != nil {
+		return false, err
+	}
+	digest := terminationDigest(pods, resources.PublisherContainerName)
+	if digest == "" {
+		return false, nil
+	}
+	setPublication(ctx, model, digest)
+	return true, nil
+}
+
+// storageLost returns why a Ready model's storage is gone, or an empty
+// string if it is still there
+func (r *ModelReconciler) storageLost(ctx context.Context, model *modelsv1alpha1.Model) (string, error) {
+	switch model.Spec.Storage.Mode {
+	case modelsv1alpha1.StorageModeImage:
+		if !isPublished(model) {
+			return "Image was not pushed to spec.publish.image", nil
+		}
+		return "", nil
+	case modelsv1alpha1.StorageModeConfigMap:
+		cm := &corev1.ConfigMap{}
+		err := r.Get(ctx, types.NamespacedName{Name: resources.ContentConfigMapName(model.Name), Namespace: model.Namespace}, cm)
+		if apierrors.IsNotFound(err) || (err == nil && len(cm.BinaryData) == 0) {
+			return "ConfigMap was deleted", nil
+		}
+		return "", err
+	default:
+		pvc := &corev1.PersistentVolumeClaim{}
+		err := r.Get(ctx, types.NamespacedName{Name: resources.ClaimName(model), Namespace: model.Namespace}, pvc)
+		if apierrors.IsNotFound(err) {
+			return "PVC was deleted", nil
+		}
+		return "", err
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Inline model storage", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	contentDigest := "sha256:" + strings.Repeat("1c", 32)
+	imageDigest := "sha256:" + strings.Repeat("2d", 32)
+
+	newModel := func(name string, mode modelsv1alpha1.StorageMode, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "bert-base-uncased", Include: []string{"tokenizer*.json"}},
+				},
+				Storage: modelsv1alpha1.StorageSpec{Mode: mode, Size: "1Mi"},
+				Publish: &modelsv1alpha1.PublishSpec{Image: "registry.internal/models/" + name + ":v1"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	// downloadPod returns a succeeded download pod whose containers reported the messages
+	downloadPod := func(model string, messages map[string]string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      model + "-download",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":     "model-downloader",
+					"app.kubernetes.io/instance": model,
+				},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+		}
+		for name, message := range messages {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, corev1.ContainerStatus{
+				Name:  name,
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: message}},
+			})
+		}
+		return pod
+	}
+
+	succeededJob := func(model string) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(model), Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		}
+	}
+
+	It("should store a configmap-mode model without a PVC", func() {
+		c := newClient(newModel("tokenizer", modelsv1alpha1.StorageModeConfigMap, modelsv1alpha1.ModelPhasePending))
+
+		model := reconcileModel(c, "tokenizer")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(model.Status.PVCName).To(BeEmpty())
+
+		err := c.Get(ctx, types.NamespacedName{Name: resources.PVCName("tokenizer"), Namespace: namespace},
+			&corev1.PersistentVolumeClaim{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		storeKey := types.NamespacedName{Name: resources.StoreName("tokenizer"), Namespace: namespace}
+		Expect(c.Get(ctx, storeKey, &corev1.ServiceAccount{})).To(Succeed())
+		Expect(c.Get(ctx, storeKey, &rbacv1.Role{})).To(Succeed())
+		Expect(c.Get(ctx, storeKey, &rbacv1.RoleBinding{})).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.ContentConfigMapName("tokenizer"), Namespace: namespace}, cm)).To(Succeed())
+		Expect(metav1.IsControlledBy(cm, model)).To(BeTrue())
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName("tokenizer"), Namespace: namespace}, job)).To(Succeed())
+		Expect(job.Spec.Template.Spec.ServiceAccountName).To(Equal(storeKey.Name))
+	})
+
+	It("should keep the stored files when the download is retried", func() {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.ContentConfigMapName("tokenizer"), Namespace: namespace},
+			BinaryData: map[string][]byte{"tokenizer.json": []byte("{}")},
+		}
+		c := newClient(newModel("tokenizer", modelsv1alpha1.StorageModeConfigMap, modelsv1alpha1.ModelPhasePending), cm)
+
+		reconcileModel(c, "tokenizer")
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		Expect(cm.BinaryData).To(HaveKey("tokenizer.json"))
+	})
+
+	It("should record the image an image-mode download pushed", func() {
+		c := newClient(newModel("classifier", modelsv1alpha1.StorageModeImage, modelsv1alpha1.ModelPhaseDownloading),
+			succeededJob("classifier"),
+			downloadPod("classifier", map[string]string{
+				downloaderContainerName:          contentDigest,
+				resources.PublisherContainerName: imageDigest,
+			}))
+
+		model := reconcileModel(c, "classifier")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.Publication).NotTo(BeNil())
+		Expect(model.Status.Publication.Image).To(Equal("registry.internal/models/classifier@" + imageDigest))
+		Expect(model.Status.Publication.ContentDigest).To(Equal(contentDigest))
+
+		// Ready without a publish Job, since the download already pushed
+		model = reconcileModel(c, "classifier")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		err := c.Get(ctx, types.NamespacedName{Name: resources.PublishJobName("classifier"), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail an image-mode download that did not report the pushed image", func() {
+		c := newClient(newModel("classifier", modelsv1alpha1.StorageModeImage, modelsv1alpha1.ModelPhaseDownloading),
+			succeededJob("classifier"),
+			downloadPod("classifier", map[string]string{downloaderContainerName: contentDigest}))
+
+		model := reconcileModel(c, "classifier")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		Expect(model.Status.Message).To(ContainSubstring("pushed image digest"))
+	})
+
+	It("should download again when the ConfigMap is deleted", func() {
+		c := newClient(newModel("tokenizer", modelsv1alpha1.StorageModeConfigMap, modelsv1alpha1.ModelPhaseReady))
+
+		model := reconcileModel(c, "tokenizer")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(Equal("ConfigMap was deleted, recreating"))
+	})
+})
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	modelwebhook "github.com/rsJames-ttrpg/model-operator/internal/webhook"
+)
+
+// leaseFinalizer holds a Model's deletion while Jobs hold unexpired leases on it
+const leaseFinalizer = "models.main-currents.news/leases"
+
+// injectedModels returns the names of the models the Job's pods ask the
+// webhook to inject
+func injectedModels(job *batchv1.Job) []string {
+	var names []string
+	for _, name := range strings.Split(job.Spec.Template.Annotations[modelwebhook.AnnotationInject], ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// jobLease returns the lease the Job holds on the model, or false if it holds
+// none: it does not consume the model, declares no valid lease, has finished
+// or its lease has lapsed
+func jobLease(job *batchv1.Job, modelName string, now time.Time) (modelsv1alpha1.ModelLease, bool) {
+	value, ok := job.Annotations[modelsv1alpha1.AnnotationLeaseDuration]
+	if !ok || job.DeletionTimestamp != nil || !slices.Contains(injectedModels(job), modelName) {
+		return modelsv1alpha1.ModelLease{}, false
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return modelsv1alpha1.ModelLease{}, false
+	}
+
+	// A finished Job releases its lease early
+	for _, cond := range job.Status.Conditions {
+		if (cond.Type == batchv1.JobComplete || cond.Type == batchv1.JobFailed) && cond.Status == corev1.ConditionTrue {
+			return modelsv1alpha1.ModelLease{}, false
+		}
+	}
+
+	expires := job.CreationTimestamp.Add(duration)
+	if !expires.After(now) {
+		return modelsv1alpha1.ModelLease{}, false
+	}
+	return modelsv1alpha1.ModelLease{Job: job.Name, ExpiresAt: metav1.NewTime(expires).Rfc3339Copy()}, true
+}
+
+// activeLeases returns the unexpired leases Jobs in the Model's namespace
+// hold on it, sorted by Job name
+func (r *ModelReconciler) activeLeases(ctx context.Context, model *modelsv1alpha1.Model, now time.Time) ([]modelsv1alpha1.ModelLease, error) {
+	jobs := &batchv1.JobList{}
+	if err := r.List(ctx, jobs, client.InNamespace(model.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var leases []modelsv1alpha1.ModelLease
+	for i := range jobs.Items {
+		if lease, ok := jobLease(&jobs.Items[i], model.Name, now); ok {
+			leases = append(leases, lease)
+		}
+	}
+	slices.SortFunc(leases, func(a, b modelsv1alpha1.ModelLease) int { return strings.Compare(a.Job, b.Job) })
+	return leases, nil
+}
+
+// reconcileLeases records the active leases in the Model status and holds
+// the Model's deletion with a finalizer while there are any. It returns how
+// long until the next lease lapses, or zero if there are none.
+func (r *ModelReconciler) reconcileLeases(ctx context.Context, model *modelsv1alpha1.Model) (time.Duration, error) {
+	now := time.Now()
+	leases, err := r.activeLeases(ctx, model, now)
+	if err != nil {
+		return 0, err
+	}
+
+	// Write the status first: releasing the last finalizer of a deleting
+	// Model removes it
+	if !equality.Semantic.DeepEqual(leases, model.Status.Leases) {
+		model.Status.Leases = leases
+		if err := r.writeStatus(ctx, model); err != nil {
+			return 0, err
+		}
+	}
+
+	changed := false
+	if len(leases) > 0 && model.DeletionTimestamp.IsZero() {
+		changed = controllerutil.AddFinalizer(model, leaseFinalizer)
+	} else if len(leases) == 0 {
+		changed = controllerutil.RemoveFinalizer(model, leaseFinalizer)
+	}
+	if changed {
+		if err := r.Update(ctx, model); err != nil {
+			return 0, err
+		}
+	}
+
+	var next time.Duration
+	for _, lease := range leases {
+		if until := lease.ExpiresAt.Sub(now); next == 0 || until < next {
+			next = until
+		}
+	}
+	return next, nil
+}
+
+// modelsForLeasedJob maps a Job declaring a lease to the Models it consumes
+func (r *ModelReconciler) modelsForLeasedJob(ctx context.Context, obj client.Object) []reconcile.Request {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return nil
+	}
+	if _, ok := job.Annotations[modelsv1alpha1.AnnotationLeaseDuration]; !ok {
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, name := range injectedModels(job) {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: name, Namespace: job.Namespace},
+		})
+	}
+	return requests
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	modelwebhook "github.com/rsJames-ttrpg/model-operator/internal/webhook"
+)
+
+var _ = Describe("Model leases", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	newModel := func(name string) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseFailed},
+		}
+	}
+
+	// batchJob returns a Job injecting the models, created age ago, with the lease duration
+	batchJob := func(name, models, lease string, age time.Duration) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+			},
+			Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{modelwebhook.AnnotationInject: models}},
+			}},
+		}
+		if lease != "" {
+			job.Annotations = map[string]string{modelsv1alpha1.AnnotationLeaseDuration: lease}
+		}
+		return job
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client, name string) reconcile.Result {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}})
+		Expect(err).NotTo(HaveOccurred())
+		return result
+	}
+
+	getModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, model)).To(Succeed())
+		return model
+	}
+
+	It("should record the leases of consuming Jobs and requeue by the time the first lapses", func() {
+		c := newClient(newModel("leased"),
+			batchJob("eval", "other, leased", "2h", time.Hour),
+			batchJob("embed", "leased", "30m", 0),
+			batchJob("forever", "leased", "", time.Hour),
+			batchJob("bad-duration", "leased", "soon", 0),
+			batchJob("unrelated", "other", "1h", 0))
+
+		result := reconcileModel(c, "leased")
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 30*time.Minute))
+
+		model := getModel(c, "leased")
+		Expect(model.Status.Leases).To(HaveLen(2))
+		Expect(model.Status.Leases[0].Job).To(Equal("embed"))
+		Expect(model.Status.Leases[1].Job).To(Equal("eval"))
+		Expect(model.Status.Leases[1].ExpiresAt.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(controllerutil.ContainsFinalizer(model, leaseFinalizer)).To(BeTrue())
+	})
+
+	It("should drop lapsed leases and leases of finished Jobs", func() {
+		model := newModel("released")
+		model.Finalizers = []string{leaseFinalizer}
+		model.Status.Leases = []modelsv1alpha1.ModelLease{{Job: "lapsed", ExpiresAt: metav1.Now()}}
+		finished := batchJob("finished", "released", "2h", 0)
+		finished.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+		c := newClient(model, batchJob("lapsed", "released", "1h", 2*time.Hour), finished)
+
+		reconcileModel(c, "released")
+
+		model = getModel(c, "released")
+		Expect(model.Status.Leases).To(BeEmpty())
+		Expect(controllerutil.ContainsFinalizer(model, leaseFinalizer)).To(BeFalse())
+	})
+
+	It("should hold deletion until the leases lapse", func() {
+		model := newModel("deleting")
+		model.Finalizers = []string{leaseFinalizer}
+		job := batchJob("eval", "deleting", "2h", 0)
+		c := newClient(model, job)
+		Expect(c.Delete(ctx, getModel(c, "deleting"))).To(Succeed())
+
+		result := reconcileModel(c, "deleting")
+		Expect(result.RequeueAfter).To(BeNumerically("~", 2*time.Hour, time.Minute))
+		Expect(getModel(c, "deleting").Status.Leases).To(HaveLen(1))
+
+		// The Job finishing releases its lease
+		job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}}
+		Expect(c.Status().Update(ctx, job)).To(Succeed())
+		reconcileModel(c, "deleting")
+
+		err := c.Get(ctx, types.NamespacedName{Name: "deleting", Namespace: namespace}, &modelsv1alpha1.Model{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should map leased Jobs to the Models they consume", func() {
+		r := &ModelReconciler{}
+		Expect(r.modelsForLeasedJob(ctx, batchJob("eval", "a, b", "1h", 0))).To(ConsistOf(
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "a", Namespace: namespace}},
+			reconcile.Request{NamespacedName: types.NamespacedName{Name: "b", Namespace: namespace}},
+		))
+		Expect(r.modelsForLeasedJob(ctx, batchJob("serve", "a", "", 0))).To(BeEmpty())
+	})
+})
@@ -0,0 +1,183 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// localStorageFinalizer lets the operator remove the cluster-scoped local PV
+// and the files on the node, which owner references cannot cover
+const localStorageFinalizer = "models.main-currents.news/local-storage"
+
+// ownsLocalPV reports whether the local PersistentVolume was created for the
+// Model, which only its labels record since PVs are cluster-scoped
+func ownsLocalPV(model *modelsv1alpha1.Model, pv *corev1.PersistentVolume) bool {
+	return pv.Labels[resources.LabelModelNamespace] == model.Namespace &&
+		pv.Labels[resources.LabelModelName] == model.Name
+}
+
+// ensureLocalPV creates the local PersistentVolume if it does not exist. It
+// refuses to use a PV of the same name created for anything else.
+func (r *ModelReconciler) ensureLocalPV(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	pv, err := resources.BuildLocalPV(model, r.Config.Resources)
+	if err != nil {
+		return err
+	}
+	existing := &corev1.PersistentVolume{}
+	err = r.Get(ctx, types.NamespacedName{Name: pv.Name}, existing)
+	if err == nil {
+		if !ownsLocalPV(model, existing) {
+			return fmt.Errorf("PersistentVolume %s exists and does not belong to this Model", pv.Name)
+		}
+		return nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	log.Info("Creating local PersistentVolume", "name", pv.Name, "node", model.Spec.Storage.Local.NodeName,
+		"path", pv.Spec.HostPath.Path)
+	return r.apply(ctx, pv)
+}
+
+// cleanUpLocalFiles runs the Job that removes the model files from the node.
+// It returns true once the Job has finished, whether or not it succeeded.
+func (r *ModelReconciler) cleanUpLocalFiles(ctx context.Context, model *modelsv1alpha1.Model) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.CleanupJobName(model.Name), Namespace: model.Namespace}, job)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		job = resources.BuildLocalCleanupJob(model, r.Config.Resources)
+		if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
+			return false, err
+		}
+		log.Info("Creating local storage cleanup Job", "name", job.Name)
+		return false, r.apply(ctx, job)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			// Do not block Model deletion on a node that is gone
+			path, _ := resources.LocalPath(model, r.Config.Resources)
+			log.Info("Local storage cleanup failed, leaving files on the node",
+				"node", model.Spec.Storage.Local.NodeName, "path", path, "message", cond.Message)
+			return true, nil
+		}
+	}
+	return job.Status.Succeeded > 0, nil
+}
+
+// finalizeLocalStorage removes the model files from the node and deletes the
+// local PV, then releases the finalizer. It returns true once done. The
+// cleanup Job cannot be created in a terminating namespace, so there the
+// files are left on the node rather than keep the namespace from going away.
+func (r *ModelReconciler) finalizeLocalStorage(ctx context.Context, model *modelsv1alpha1.Model, terminating bool) (bool, error) {
+	log := logf.FromContext(ctx)
+
+	if model.Spec.Storage.Local != nil {
+		if terminating {
+			path, _ := resources.LocalPath(model, r.Config.Resources)
+			log.Info("Namespace is terminating, leaving local storage files on the node",
+				"node", model.Spec.Storage.Local.NodeName, "path", path)
+		} else if done, err := r.cleanUpLocalFiles(ctx, model); err != nil || !done {
+			return false, err
+		}
+
+		pv := &corev1.PersistentVolume{}
+		err := r.Get(ctx, types.NamespacedName{Name: resources.LocalPVName(model.Namespace, model.Name)}, pv)
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			return false, err
+		case !ownsLocalPV(model, pv):
+			log.Info("Not deleting a local PersistentVolume that belongs to something else", "name", pv.Name)
+		default:
+			log.Info("Deleting local PersistentVolume", "name", pv.Name)
+			if err := r.Delete(ctx, pv); client.IgnoreNotFound(err) != nil {
+				return false, err
+			}
+		}
+	}
+
+	controllerutil.RemoveFinalizer(model, localStorageFinalizer)
+	if err := r.Update(ctx, model); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// reconcileDelete waits for leases to lapse, then runs the storage and local
+// storage finalizers for a Model that is being deleted, creating nothing if
+// its namespace is terminating
+func (r *ModelReconciler) reconcileDelete(ctx context.Context, model *modelsv1alpha1.Model, terminating bool) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	// Consuming Jobs keep the model until their leases lapse
+	if controllerutil.ContainsFinalizer(model, leaseFinalizer) {
+		requeue, err := r.reconcileLeases(ctx, model)
+		if err != nil {
+			log.Error(err, "Failed to reconcile leases")
+			return ctrl.Result{}, err
+		}
+		if len(model.Status.Leases) > 0 {
+			log.Info("Waiting for leases to lapse before deleting", "leases", len(model.Status.Leases))
+			return ctrl.Result{RequeueAfter: requeue}, nil
+		}
+	}
+
+	// Release retained PVCs before the garbage collector deletes them
+	if controllerutil.ContainsFinalizer(model, storageFinalizer) {
+		if err := r.retainStorage(ctx, model); err != nil {
+			log.Error(err, "Failed to retain PVCs")
+			return ctrl.Result{}, err
+		}
+	}
+
+	if !controllerutil.ContainsFinalizer(model, localStorageFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	done, err := r.finalizeLocalStorage(ctx, model, terminating)
+	if err != nil {
+		log.Error(err, "Failed to clean up local storage")
+		return ctrl.Result{}, err
+	}
+	if !done {
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.pending()}, nil
+	}
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Local storage", func() {
+	const (
+		namespace = "default"
+		name      = "local"
+	)
+
+	ctx := context.Background()
+	pvKey := types.NamespacedName{Name: resources.LocalPVName(namespace, name)}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  namespace,
+				Finalizers: []string{localStorageFinalizer},
+			},
+			Spec: modelsv1alpha1.ModelSpec{
+				Storage: modelsv1alpha1.StorageSpec{
+					Size:  "1Gi",
+					Local: &modelsv1alpha1.LocalStorageSpec{NodeName: "homelab-1"},
+				},
+			},
+		}
+	}
+
+	// foreignPV is a PersistentVolume of the Model's local PV name created
+	// for another Model
+	foreignPV := func() *corev1.PersistentVolume {
+		return &corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{
+			Name: pvKey.Name,
+			Labels: map[string]string{
+				resources.LabelModelNamespace: "other",
+				resources.LabelModelName:      name,
+			},
+		}}
+	}
+
+	newReconciler := func(objs ...client.Object) *ModelReconciler {
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).Build()
+		return &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+	}
+
+	It("should create the local PV and accept it once it exists", func() {
+		model := newModel()
+		r := newReconciler(model)
+
+		Expect(r.ensureLocalPV(ctx, model)).To(Succeed())
+		pv := &corev1.PersistentVolume{}
+		Expect(r.Get(ctx, pvKey, pv)).To(Succeed())
+		Expect(pv.Spec.HostPath.Path).To(Equal("/var/lib/model-operator/default/local"))
+		Expect(r.ensureLocalPV(ctx, model)).To(Succeed())
+	})
+
+	It("should refuse a PV of the same name that belongs to another Model", func() {
+		model := newModel()
+		r := newReconciler(model, foreignPV())
+
+		err := r.ensureLocalPV(ctx, model)
+		Expect(err).To(MatchError(ContainSubstring("does not belong to this Model")))
+	})
+
+	It("should not delete a PV that belongs to another Model", func() {
+		model := newModel()
+		cleanup := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.CleanupJobName(name), Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		}
+		r := newReconciler(model, cleanup, foreignPV())
+
+		done, err := r.finalizeLocalStorage(ctx, model, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(done).To(BeTrue())
+		Expect(r.Get(ctx, pvKey, &corev1.PersistentVolume{})).To(Succeed())
+	})
+
+	It("should release the finalizer without a cleanup Job in a terminating namespace", func() {
+		model := newModel()
+		r := newReconciler(model)
+		Expect(r.ensureLocalPV(ctx, model)).To(Succeed())
+
+		done, err := r.finalizeLocalStorage(ctx, model, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(done).To(BeTrue())
+		err = r.Get(ctx, types.NamespacedName{Name: resources.CleanupJobName(name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(apierrors.IsNotFound(r.Get(ctx, pvKey, &corev1.PersistentVolume{}))).To(BeTrue())
+
+		Expect(r.Get(ctx, client.ObjectKeyFromObject(model), model)).To(Succeed())
+		Expect(model.Finalizers).NotTo(ContainElement(localStorageFinalizer))
+	})
+})
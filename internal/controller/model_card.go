@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// conditionTypeCardFetched reports whether the model card was fetched
+const conditionTypeCardFetched = "CardFetched"
+
+// setCardFetchedCondition records the model card fetch state on the Model
+func setCardFetchedCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeCardFetched,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// huggingFaceToken returns the HF_TOKEN from the credentials Secret, if any
+func (r *ModelReconciler) huggingFaceToken(ctx context.Context, model *modelsv1alpha1.Model) (string, error) {
+	if model.Spec.CredentialsSecret == "" {
+		return "", nil
+	}
+	secret := &corev1.Secret{}
+	err := r.Get(ctx, types.NamespacedName{Name: model.Spec.CredentialsSecret, Namespace: model.Namespace}, secret)
+	if apierrors.IsNotFound(err) {
+		// The download Job treats the token as optional too
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(secret.Data["HF_TOKEN"]), nil
+}
+
+// reconcileModelCard fetches the Hugging Face model card once per source
+// revision. A failed fetch is recorded in the CardFetched condition and
+// retried when the spec changes; it never affects the download.
+func (r *ModelReconciler) reconcileModelCard(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+	hf := model.Spec.Source.HuggingFace
+
+	if r.CardFetcher == nil || hf == nil || !hf.FetchCard {
+		if model.Status.Card == nil {
+			return nil
+		}
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.CardConfigMapName(model.Name),
+			Namespace: model.Namespace,
+		}}
+		if err := r.Delete(ctx, cm); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+		model.Status.Card = nil
+		meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeCardFetched)
+		return r.writeStatus(ctx, model)
+	}
+
+	revision := hf.Revision
+	if revision == "" {
+		revision = "main"
+	}
+	if model.Status.Card != nil && model.Status.Card.Revision == revision {
+		return nil
+	}
+	if cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeCardFetched); cond != nil &&
+		cond.Status == metav1.ConditionFalse && cond.ObservedGeneration == model.Generation {
+		return nil
+	}
+
+	token, err := r.huggingFaceToken(ctx, model)
+	if err != nil {
+		return err
+	}
+
+	card, err := r.CardFetcher.Fetch(ctx, hf.Endpoint, hf.RepoID, revision, token)
+	if err != nil {
+		log.Info("Failed to fetch model card", "repo", hf.RepoID, "revision", revision, "error", err.Error())
+		setCardFetchedCondition(model, metav1.ConditionFalse, "FetchFailed", err.Error())
+		return r.writeStatus(ctx, model)
+	}
+
+	cm := resources.BuildCardConfigMap(model, card.README)
+	if err := controllerutil.SetControllerReference(model, cm, r.Scheme); err != nil {
+		return err
+	}
+	if err := r.apply(ctx, cm); err != nil {
+		return err
+	}
+
+	model.Status.Card = &modelsv1alpha1.ModelCardStatus{
+		Revision:    revision,
+		Summary:     card.Summary,
+		License:     card.License,
+		Tags:        card.Tags,
+		PipelineTag: card.PipelineTag,
+		ConfigMap:   cm.Name,
+	}
+	log.Info("Fetched model card", "repo", hf.RepoID, "revision", revision, "license", card.License)
+	setCardFetchedCondition(model, metav1.ConditionTrue, "Fetched", fmt.Sprintf("Fetched model card at %s", revision))
+	return r.writeStatus(ctx, model)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/modelcard"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// fakeCardFetcher returns a fixed card or error and counts fetches
+type fakeCardFetcher struct {
+	card    *modelcard.Card
+	err     error
+	fetches int
+	token   string
+}
+
+func (f *fakeCardFetcher) Fetch(_ context.Context, _, _, _, token string) (*modelcard.Card, error) {
+	f.fetches++
+	f.token = token
+	return f.card, f.err
+}
+
+var _ = Describe("Model card", func() {
+	ctx := context.Background()
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "qwen", Namespace: "default", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+						RepoID:    "Qwen/Qwen2.5-0.5B-Instruct",
+						FetchCard: true,
+					},
+				},
+				CredentialsSecret: "hf-token",
+			},
+		}
+	}
+
+	setup := func(fetcher *fakeCardFetcher, model *modelsv1alpha1.Model) (*ModelReconciler, client.Client) {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "hf-token", Namespace: "default"},
+			Data:       map[string][]byte{"HF_TOKEN": []byte("hf_secret")},
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, secret).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+		Expect(c.Get(ctx, types.NamespacedName{Name: model.Name, Namespace: model.Namespace}, model)).To(Succeed())
+		return &ModelReconciler{Client: c, Scheme: scheme.Scheme, CardFetcher: fetcher}, c
+	}
+
+	It("should store the summary in status and the card in a ConfigMap", func() {
+		fetcher := &fakeCardFetcher{card: &modelcard.Card{
+			License:     "apache-2.0",
+			PipelineTag: "text-generation",
+			Summary:     "Qwen2.5 is the latest series of Qwen large language models.",
+			README:      "# Qwen2.5",
+		}}
+		model := newModel()
+		r, c := setup(fetcher, model)
+
+		Expect(r.reconcileModelCard(ctx, model)).To(Succeed())
+		Expect(fetcher.token).To(Equal("hf_secret"))
+		Expect(model.Status.Card).NotTo(BeNil())
+		Expect(model.Status.Card.Revision).To(Equal("main"))
+		Expect(model.Status.Card.License).To(Equal("apache-2.0"))
+		Expect(meta.IsStatusConditionTrue(model.Status.Conditions, conditionTypeCardFetched)).To(BeTrue())
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.CardConfigMapName(model.Name), Namespace: "default"}, cm)).To(Succeed())
+		Expect(cm.Data[resources.CardConfigMapKey]).To(Equal("# Qwen2.5"))
+		Expect(metav1.IsControlledBy(cm, model)).To(BeTrue())
+
+		By("not fetching again for the same revision")
+		Expect(r.reconcileModelCard(ctx, model)).To(Succeed())
+		Expect(fetcher.fetches).To(Equal(1))
+	})
+
+	It("should record a failed fetch without retrying until the spec changes", func() {
+		fetcher := &fakeCardFetcher{err: errors.New("GET README.md: unexpected status 401 Unauthorized")}
+		model := newModel()
+		r, _ := setup(fetcher, model)
+
+		Expect(r.reconcileModelCard(ctx, model)).To(Succeed())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeCardFetched)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal("FetchFailed"))
+		Expect(model.Status.Card).To(BeNil())
+
+		Expect(r.reconcileModelCard(ctx, model)).To(Succeed())
+		Expect(fetcher.fetches).To(Equal(1))
+	})
+
+	It("should drop the card when fetching is disabled", func() {
+		fetcher := &fakeCardFetcher{card: &modelcard.Card{README: "# Qwen2.5"}}
+		model := newModel()
+		r, c := setup(fetcher, model)
+		Expect(r.reconcileModelCard(ctx, model)).To(Succeed())
+
+		model.Spec.Source.HuggingFace.FetchCard = false
+		Expect(r.reconcileModelCard(ctx, model)).To(Succeed())
+		Expect(model.Status.Card).To(BeNil())
+		Expect(meta.FindStatusCondition(model.Status.Conditions, conditionTypeCardFetched)).To(BeNil())
+
+		cm := &corev1.ConfigMap{}
+		err := c.Get(ctx, types.NamespacedName{Name: resources.CardConfigMapName(model.Name), Namespace: "default"}, cm)
+		Expect(client.IgnoreNotFound(err)).To(Succeed())
+		Expect(err).To(HaveOccurred())
+	})
+})
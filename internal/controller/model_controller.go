@@ -21,47 +21,96 @@ import (
 	"fmt"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
 	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/modelcard"
+	"github.com/rsJames-ttrpg/model-operator/internal/progress"
+	"github.com/rsJames-ttrpg/model-operator/internal/registration"
 	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/internal/sharding"
+	"github.com/rsJames-ttrpg/model-operator/pkg/estimate"
+	"github.com/rsJames-ttrpg/model-operator/pkg/presign"
 )
 
 const (
-	// Requeue intervals
-	requeuePending     = 10 * time.Second
-	requeueDownloading = 15 * time.Second
-	requeueReady       = 5 * time.Minute
-	requeueFailed      = 1 * time.Minute
-
 	// Condition types
-	conditionTypeReady = "Ready"
+	conditionTypeReady     = "Ready"
+	conditionTypePrewarmed = "Prewarmed"
 )
 
 // ModelReconciler reconciles a Model object
 type ModelReconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// ProgressReporter publishes and observes download progress (optional)
+	ProgressReporter progress.Reporter
+
+	// Config holds the operator-level settings: images, Job retries and
+	// cleanup, downloader resources and requeue intervals
+	Config Config
+
+	// CardFetcher fetches Hugging Face model cards (optional)
+	CardFetcher modelcard.Fetcher
+
+	// Estimator estimates the download size before the download starts (optional)
+	Estimator estimate.Estimator
+
+	// Presigner presigns the download URLs of S3 sources that ask for it
+	Presigner presign.Presigner
+
+	// Registrar registers downloaded models with the webhook of their
+	// spec.registration
+	Registrar registration.Registrar
+
+	// Recorder records Events on the Model as its download progresses.
+	// Nil records none.
+	Recorder record.EventRecorder
+
+	// StallTimeout restarts a download Job that moves no bytes for this long
+	// (zero disables stall detection)
+	StallTimeout time.Duration
+
+	// MaxStallRestarts is how many times a stalled download is restarted
+	// before the Model fails
+	MaxStallRestarts int32
+
+	// Shard limits this replica to the Models in its share of the namespaces
+	Shard sharding.Shard
 }
 
 // +kubebuilder:rbac:groups=models.main-currents.news,resources=models,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=models.main-currents.news,resources=models/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=models.main-currents.news,resources=models/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=persistentvolumes,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
-// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;patch;delete
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;patch;delete
 // +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=resourcequotas,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -79,6 +128,41 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 		return ctrl.Result{}, err
 	}
 
+	// Every PVC or Job created in a terminating namespace is forbidden, so
+	// finalize the Model without creating any, or wait quietly for the
+	// namespace controller to delete it
+	terminating, err := r.namespaceTerminating(ctx, model.Namespace)
+	if err != nil {
+		log.Error(err, "Failed to get namespace")
+		return ctrl.Result{}, err
+	}
+	if !model.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, model, terminating)
+	}
+	if terminating {
+		log.V(1).Info("Namespace is terminating, skipping reconcile")
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.failed()}, nil
+	}
+
+	// Local storage is cluster-scoped and on a node, so clean it up with a finalizer
+	if model.Spec.Storage.Local != nil && controllerutil.AddFinalizer(model, localStorageFinalizer) {
+		if err := r.Update(ctx, model); err != nil {
+			log.Error(err, "Failed to add local storage finalizer")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Keep the PVCs of Models that retain them past their deletion
+	if err := r.reconcileReclaimPolicy(ctx, model); err != nil {
+		log.Error(err, "Failed to update storage finalizer")
+		return ctrl.Result{}, err
+	}
+
+	// Rebuild a lost status from the cluster state on request
+	if _, ok := model.Annotations[modelsv1alpha1.AnnotationRebuildStatus]; ok {
+		return r.reconcileRebuild(ctx, model)
+	}
+
 	// Determine current phase (default to Pending)
 	phase := model.Status.Phase
 	if phase == "" {
@@ -87,54 +171,190 @@ func (r *ModelReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl
 
 	log.Info("Reconciling Model", "phase", phase)
 
+	// Pre-pull serving runtime images independently of the download
+	if err := r.reconcilePrewarm(ctx, model); err != nil {
+		log.Error(err, "Failed to reconcile image pre-pull DaemonSet")
+		return ctrl.Result{}, err
+	}
+
+	// Surface volume expansions the storage driver rejected
+	if err := r.reconcileStorageResize(ctx, model); err != nil {
+		log.Error(err, "Failed to check PVC expansion")
+		return ctrl.Result{}, err
+	}
+
+	// Track the leases of consuming Jobs in every phase
+	leaseRequeue, err := r.reconcileLeases(ctx, model)
+	if err != nil {
+		log.Error(err, "Failed to reconcile leases")
+		return ctrl.Result{}, err
+	}
+
+	var result ctrl.Result
 	switch phase {
 	case modelsv1alpha1.ModelPhasePending:
-		return r.reconcilePending(ctx, model)
+		result, err = r.reconcilePending(ctx, model)
 	case modelsv1alpha1.ModelPhaseDownloading:
-		return r.reconcileDownloading(ctx, model)
+		result, err = r.reconcileDownloading(ctx, model)
 	case modelsv1alpha1.ModelPhaseReady:
-		return r.reconcileReady(ctx, model)
+		result, err = r.reconcileReady(ctx, model)
 	case modelsv1alpha1.ModelPhaseFailed:
-		return r.reconcileFailed(ctx, model)
+		result, err = r.reconcileFailed(ctx, model)
 	default:
 		log.Info("Unknown phase, resetting to Pending", "phase", phase)
-		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, "Unknown phase, resetting")
+		result, err = r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, "Unknown phase, resetting")
 	}
+
+	// Serve the files of Ready models, after the phase has settled
+	if err == nil {
+		if err = r.reconcileFileServer(ctx, model); err != nil {
+			log.Error(err, "Failed to reconcile file server")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Export the Model's info to consumers that mount it as files
+	if err == nil {
+		if err = r.reconcileInfoConfigMap(ctx, model); err != nil {
+			log.Error(err, "Failed to reconcile info ConfigMap")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Come back when the next lease lapses
+	if leaseRequeue > 0 && (result.RequeueAfter == 0 || leaseRequeue < result.RequeueAfter) {
+		result.RequeueAfter = leaseRequeue
+	}
+	return result, err
+}
+
+// storageSizeError returns why the storage size of a Model stored on a PVC
+// is not a valid size, or nil
+func storageSizeError(model *modelsv1alpha1.Model) error {
+	if !resources.UsesPVC(model) {
+		return nil
+	}
+	_, err := resources.ParseStorageSize(model.Spec.Storage.Size)
+	return err
 }
 
 // reconcilePending handles the Pending phase: creates PVC and Job, transitions to Downloading
 func (r *ModelReconciler) reconcilePending(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
-	// Create PVC if not exists
-	pvc := resources.BuildPVC(model)
-	if err := controllerutil.SetControllerReference(model, pvc, r.Scheme); err != nil {
-		log.Error(err, "Failed to set owner reference on PVC")
+	// A new download invalidates the recorded content and any built engine
+	if err := r.setContentDigestLabel(ctx, model, ""); err != nil {
+		log.Error(err, "Failed to remove content digest label")
 		return ctrl.Result{}, err
 	}
+	model.Status.ContentDigest = ""
+	clearConversion(model)
+	clearVerification(model)
+	clearRegistration(model)
+	clearDownloadTimes(model)
+	model.Status.FailureOutput = ""
+	model.Status.Local = nil
+
+	// A size the CRD pattern let through fails this Model alone
+	if err := storageSizeError(model); err != nil {
+		log.Error(err, "Invalid storage size")
+		return r.fail(ctx, model, err, err.Error())
+	}
 
-	existingPVC := &corev1.PersistentVolumeClaim{}
-	err := r.Get(ctx, types.NamespacedName{Name: pvc.Name, Namespace: pvc.Namespace}, existingPVC)
-	if err != nil {
-		if apierrors.IsNotFound(err) {
-			log.Info("Creating PVC", "name", pvc.Name)
-			if err := r.Create(ctx, pvc); err != nil {
-				log.Error(err, "Failed to create PVC")
-				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
-					fmt.Sprintf("Failed to create PVC: %v", err))
+	// Provision the local PV the PVC binds to
+	if model.Spec.Storage.Local != nil {
+		if err := r.ensureLocalPV(ctx, model); err != nil {
+			log.Error(err, "Failed to create local PersistentVolume")
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
+				fmt.Sprintf("Failed to create local PersistentVolume: %v", err))
+		}
+	}
+
+	// Create PVC if not exists, or the ConfigMap a configmap-mode model is stored in
+	if !resources.UsesPVC(model) {
+		if err := r.ensureInlineStorage(ctx, model); err != nil {
+			log.Error(err, "Failed to create model storage")
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
+				fmt.Sprintf("Failed to create model storage: %v", err))
+		}
+	} else if resources.UsesExistingClaim(model) {
+		// Wait for the pre-provisioned PVC rather than create one
+		if waiting, err := r.existingClaimMissing(ctx, model); err != nil {
+			log.Error(err, "Failed to get existing PVC")
+			return ctrl.Result{}, err
+		} else if waiting != "" {
+			log.Info("Existing PVC is not usable, waiting", "reason", waiting)
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, waiting)
+		}
+	} else {
+		pvc, err := resources.BuildPVC(model)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := controllerutil.SetControllerReference(model, pvc, r.Scheme); err != nil {
+			log.Error(err, "Failed to set owner reference on PVC")
+			return ctrl.Result{}, err
+		}
+
+		existingPVC := &corev1.PersistentVolumeClaim{}
+		err = r.Get(ctx, types.NamespacedName{Name: pvc.Name, Namespace: pvc.Namespace}, existingPVC)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// Hold the download rather than leave a PVC the quota rejects
+				exceeded, err := r.quotaExceeded(ctx, pvc)
+				if err != nil {
+					log.Error(err, "Failed to check ResourceQuotas")
+					return ctrl.Result{}, err
+				}
+				setQuotaBlocked(model, exceeded)
+				if exceeded != "" {
+					log.Info("PVC would exceed ResourceQuota, waiting", "reason", exceeded)
+					return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, exceeded)
+				}
+				log.Info("Creating PVC", "name", pvc.Name)
+				if err := r.apply(ctx, pvc); err != nil {
+					log.Error(err, "Failed to create PVC")
+					r.eventf(model, corev1.EventTypeWarning, reasonFailedCreate, "Failed to create PVC %s: %v", pvc.Name, err)
+					return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
+						fmt.Sprintf("Failed to create PVC: %v", err))
+				}
+				r.eventf(model, corev1.EventTypeNormal, reasonPVCCreated, "Created PVC %s", pvc.Name)
+			} else {
+				log.Error(err, "Failed to get PVC")
+				return ctrl.Result{}, err
 			}
 		} else {
-			log.Error(err, "Failed to get PVC")
+			// Never download into a PVC that belongs to someone else
+			reason, conflict, err := r.claim(ctx, model, "PVC", existingPVC)
+			if err != nil {
+				log.Error(err, "Failed to adopt PVC")
+				return ctrl.Result{}, err
+			}
+			if conflict != "" {
+				log.Info("PVC is not owned by the Model, waiting", "reason", conflict)
+				r.setResourceConflict(model, reason, conflict)
+				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, conflict)
+			}
+		}
+	}
+
+	// Record the expected download size for capacity planning
+	if !resources.VerifyOnly(model) {
+		if err := r.estimateDownloadSize(ctx, model); err != nil {
+			log.Error(err, "Failed to estimate download size")
 			return ctrl.Result{}, err
 		}
 	}
 
 	// Create download Job if not exists
-	job, err := resources.BuildDownloadJob(model)
+	job, err := resources.BuildDownloadJob(resources.ForSource(model, model.Status.SourceIndex), r.Config.Resources)
 	if err != nil {
 		log.Error(err, "Failed to build download Job")
-		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
-			fmt.Sprintf("Failed to build download Job: %v", err))
+		return r.fail(ctx, model, err, fmt.Sprintf("Failed to build download Job: %v", err))
+	}
+
+	if r.ProgressReporter != nil {
+		r.ProgressReporter.ConfigureJob(model, job)
 	}
 
 	if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
@@ -146,17 +366,58 @@ func (r *ModelReconciler) reconcilePending(ctx context.Context, model *modelsv1a
 	err = r.Get(ctx, types.NamespacedName{Name: job.Name, Namespace: job.Namespace}, existingJob)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
+			// A verify-only model never reaches its source
+			if sourceModel := resources.ForSource(model, model.Status.SourceIndex); !resources.VerifyOnly(model) {
+				if err := r.presignDownload(ctx, sourceModel); err != nil {
+					log.Error(err, "Failed to presign download URLs")
+					return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
+						fmt.Sprintf("Failed to presign download URLs: %v", err))
+				}
+				if err := r.applyPatterns(ctx, sourceModel); err != nil {
+					log.Error(err, "Failed to write include and exclude patterns")
+					return ctrl.Result{}, err
+				}
+			}
+			if err := r.stampCredentialsVersion(ctx, model, job); err != nil {
+				log.Error(err, "Failed to read credentials Secret")
+				return ctrl.Result{}, err
+			}
+			if err := r.useDownloaderServiceAccount(ctx, model, job); err != nil {
+				log.Error(err, "Failed to apply downloader ServiceAccount")
+				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
+					fmt.Sprintf("Failed to create downloader ServiceAccount: %v", err))
+			}
 			log.Info("Creating download Job", "name", job.Name)
-			if err := r.Create(ctx, job); err != nil {
+			if err := r.apply(ctx, job); err != nil {
 				log.Error(err, "Failed to create Job")
+				r.eventf(model, corev1.EventTypeWarning, reasonFailedCreate, "Failed to create Job %s: %v", job.Name, err)
 				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending,
 					fmt.Sprintf("Failed to create Job: %v", err))
 			}
+			r.eventf(model, corev1.EventTypeNormal, reasonJobCreated, "Created download Job %s for the %s source",
+				job.Name, resources.SourceName(model.Status.SourceIndex))
 		} else {
 			log.Error(err, "Failed to get Job")
 			return ctrl.Result{}, err
 		}
+	} else if existingJob.DeletionTimestamp != nil {
+		// A restarted Job is recreated once the previous one is gone
+		log.Info("Waiting for previous download Job to be deleted", "name", job.Name)
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.pending()}, nil
+	} else {
+		// Never report a Job that belongs to someone else as the download
+		reason, conflict, err := r.claim(ctx, model, "Job", existingJob)
+		if err != nil {
+			log.Error(err, "Failed to adopt Job")
+			return ctrl.Result{}, err
+		}
+		if conflict != "" {
+			log.Info("Download Job is not owned by the Model, waiting", "reason", conflict)
+			r.setResourceConflict(model, reason, conflict)
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, conflict)
+		}
 	}
+	r.setResourceConflict(model, "", "")
 
 	// Transition to Downloading
 	return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, "Download started")
@@ -171,6 +432,16 @@ func (r *ModelReconciler) reconcileDownloading(ctx context.Context, model *model
 	err := r.Get(ctx, types.NamespacedName{Name: jobName, Namespace: model.Namespace}, job)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
+			// The download Job may be cleaned up while the verification or conversion runs
+			if verificationInProgress(model) {
+				return r.reconcileVerification(ctx, model)
+			}
+			if conversionInProgress(model) {
+				return r.reconcileConversion(ctx, model)
+			}
+			if registrationInProgress(model) {
+				return r.reconcileRegistration(ctx, model)
+			}
 			// Job was deleted, recreate by going back to Pending
 			log.Info("Download Job not found, resetting to Pending")
 			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, "Job not found, recreating")
@@ -179,10 +450,58 @@ func (r *ModelReconciler) reconcileDownloading(ctx context.Context, model *model
 		return ctrl.Result{}, err
 	}
 
+	recordDownloadTimes(model, job)
+
+	// Fetch the model card alongside the download
+	if err := r.reconcileModelCard(ctx, model); err != nil {
+		log.Error(err, "Failed to reconcile model card")
+		return ctrl.Result{}, err
+	}
+
 	// Check Job status
 	if job.Status.Succeeded > 0 {
 		log.Info("Download Job succeeded")
-		return r.updateStatusWithProgress(ctx, model, modelsv1alpha1.ModelPhaseReady, "Download complete", 100)
+		replaced := keptRevision(model)
+		if err := r.recordContentDigest(ctx, model); err != nil {
+			log.Error(err, "Failed to record content digest")
+			return ctrl.Result{}, err
+		}
+		if err := r.recordProvisionedBy(ctx, model, job); err != nil {
+			log.Error(err, "Failed to record what provisioned the download")
+			return ctrl.Result{}, err
+		}
+		if mismatch := contentMismatch(model); mismatch != "" {
+			log.Info("Downloaded content does not match the imported bundle", "digest", model.Status.ContentDigest)
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed, mismatch)
+		}
+		clearStalled(model)
+		model.Status.FailureOutput = ""
+		model.Status.DownloadedFrom = resources.SourceName(model.Status.SourceIndex)
+		model.Status.SourceRevision = resources.SourceRevision(resources.ForSource(model, model.Status.SourceIndex))
+		recordRevisions(model, replaced, time.Now())
+		if model.Spec.Storage.Mode == modelsv1alpha1.StorageModeImage {
+			published, err := r.recordInlinePublication(ctx, model)
+			if err != nil {
+				log.Error(err, "Failed to record pushed image")
+				return ctrl.Result{}, err
+			}
+			if !published {
+				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
+					"Download succeeded but did not report the pushed image digest")
+			}
+		}
+		if resources.VerifyOnly(model) {
+			r.eventf(model, corev1.EventTypeNormal, reasonDownloadSucceeded, "Verified the files on PVC %s", resources.ClaimName(model))
+		} else {
+			r.eventf(model, corev1.EventTypeNormal, reasonDownloadSucceeded, "Downloaded from %s source", model.Status.DownloadedFrom)
+		}
+		return r.completeDownload(ctx, model)
+	}
+
+	// Keep what the last failed attempt printed, before its pod is gone
+	if err := r.recordFailureOutput(ctx, model); err != nil {
+		log.Error(err, "Failed to list download pods")
+		return ctrl.Result{}, err
 	}
 
 	// Check if Job failed (exceeded backoff limit)
@@ -191,57 +510,231 @@ func (r *ModelReconciler) reconcileDownloading(ctx context.Context, model *model
 		for _, cond := range job.Status.Conditions {
 			if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
 				log.Info("Download Job failed", "reason", cond.Reason, "message", cond.Message)
-				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
-					fmt.Sprintf("Download failed: %s", cond.Message))
+				message := fmt.Sprintf("Download failed: %s", cond.Message)
+				r.event(model, corev1.EventTypeWarning, reasonDownloadFailed, withFailureOutput(model, message))
+				return r.failDownload(ctx, model, job, message)
 			}
 		}
 	}
 
+	// Restart downloads still running with credentials rotated since
+	rotated, err := r.credentialsRotated(ctx, model, job)
+	if err != nil {
+		log.Error(err, "Failed to read credentials Secret")
+		return ctrl.Result{}, err
+	}
+	if rotated {
+		return r.restartRotatedDownload(ctx, model, job)
+	}
+
 	// Still running, update status and requeue
 	message := "Download in progress"
 	if job.Status.Active > 0 {
 		message = fmt.Sprintf("Download in progress (active pods: %d)", job.Status.Active)
 	}
 
-	// Update status to ensure PVCName is set
-	if model.Status.PVCName == "" {
-		model.Status.PVCName = resources.PVCName(model.Name)
+	// Pick up the latest published progress
+	downloadedBytes := model.Status.DownloadedBytes
+	lastActivity := model.Status.LastActivityTime
+	if r.ProgressReporter != nil {
+		p, err := r.ProgressReporter.Observe(ctx, r.Client, model)
+		if err != nil {
+			log.Error(err, "Failed to observe download progress", "reporter", r.ProgressReporter.Name())
+		} else if p != nil {
+			downloadedBytes = p.BytesDownloaded
+			if !p.LastActivity.IsZero() {
+				lastActivity = &metav1.Time{Time: p.LastActivity}
+			}
+		}
+	}
+
+	// Restart downloads that hang without moving any bytes
+	if downloadStalled(job, lastActivity, r.StallTimeout, time.Now()) {
+		model.Status.DownloadedBytes = downloadedBytes
+		model.Status.LastActivityTime = lastActivity
+		return r.restartStalledDownload(ctx, model, job)
+	}
+
+	// Report progress against the estimated size
+	progressPercent, eta := downloadETA(model, job, downloadedBytes, time.Now())
+	if eta > 0 {
+		message = fmt.Sprintf("%s, %d%%, about %s left", message, progressPercent, eta)
+	}
+
+	// Surface scheduling and image pull problems of the download pod
+	healthChanged, err := r.updateDownloadPodHealth(ctx, model)
+	if err != nil {
+		log.Error(err, "Failed to check download pod health")
+		return ctrl.Result{}, err
+	}
+	if cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeDownloadPodHealthy); cond != nil &&
+		cond.Status == metav1.ConditionFalse {
+		message = fmt.Sprintf("Download pod unhealthy (%s): %s", cond.Reason, cond.Message)
+	}
+
+	// Update status to ensure PVCName is set and progress is current
+	if model.Status.PVCName != statusPVCName(model) || model.Status.DownloadedBytes != downloadedBytes ||
+		!lastActivity.Equal(model.Status.LastActivityTime) || healthChanged {
+		model.Status.PVCName = statusPVCName(model)
 		model.Status.Message = message
+		model.Status.Progress = progressPercent
+		model.Status.DownloadedBytes = downloadedBytes
+		model.Status.LastActivityTime = lastActivity
 		model.Status.ObservedGeneration = model.Generation
-		if err := r.Status().Update(ctx, model); err != nil {
+		if err := r.writeStatus(ctx, model); err != nil {
 			log.Error(err, "Failed to update Model status")
 			return ctrl.Result{}, err
 		}
 	}
 
-	return ctrl.Result{RequeueAfter: requeueDownloading}, nil
+	// Check for a stall once the download would exceed the stall timeout
+	requeueAfter := r.Config.Requeue.downloading()
+	if stallCheck := stallCheckAfter(job, lastActivity, r.StallTimeout, time.Now()); stallCheck > 0 {
+		requeueAfter = min(requeueAfter, stallCheck)
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
-// reconcileReady handles the Ready phase: verifies PVC still exists
+// reconcileReady handles the Ready phase: verifies the model storage still exists
 func (r *ModelReconciler) reconcileReady(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
-	// Verify PVC still exists
-	pvcName := resources.PVCName(model.Name)
-	pvc := &corev1.PersistentVolumeClaim{}
-	err := r.Get(ctx, types.NamespacedName{Name: pvcName, Namespace: model.Namespace}, pvc)
+	// Verify the PVC, or wherever else the model is kept, still exists
+	lost, err := r.storageLost(ctx, model)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			log.Info("PVC was deleted, resetting to Pending")
-			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, "PVC was deleted, recreating")
+		log.Error(err, "Failed to check model storage")
+		return ctrl.Result{}, err
+	}
+	if lost != "" {
+		log.Info("Model storage lost, resetting to Pending", "reason", lost)
+		r.event(model, corev1.EventTypeWarning, reasonStorageLost, lost+", downloading again")
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, lost+", recreating")
+	}
+
+	// Hold changes to the files during a change freeze
+	if err := r.reconcileFreeze(ctx, model); err != nil {
+		log.Error(err, "Failed to reconcile change freeze")
+		return ctrl.Result{}, err
+	}
+
+	// Download the changed files when the source revision moved
+	if revisionOutdated(model) && !frozen(model) {
+		message, err := r.syncRevision(ctx, model)
+		if err != nil {
+			log.Error(err, "Failed to sync to the new revision")
+			return ctrl.Result{}, err
 		}
-		log.Error(err, "Failed to get PVC")
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, message)
+	}
+
+	// Check the local files after their node rebooted or their volume was recreated
+	changed, err := r.reconcileReverify(ctx, model)
+	if err != nil {
+		log.Error(err, "Failed to reverify local files")
+		return ctrl.Result{}, err
+	}
+	if changed != "" {
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, changed+", downloading")
+	}
+
+	// Verify the signature if spec.verification was added after the download
+	if verifiesSignature(model) && !signatureVerified(model) {
+		spec := model.Spec.Verification.Signature
+		message := fmt.Sprintf("Verifying %s signature of %s", spec.Provider, spec.File)
+		log.Info("Signature not verified, verifying", "provider", spec.Provider)
+		setVerifiedCondition(model, metav1.ConditionFalse, reasonVerifying, message)
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message)
+	}
+	if !verifiesSignature(model) && meta.FindStatusCondition(model.Status.Conditions, conditionTypeVerified) != nil {
+		clearVerification(model)
+		if err := r.writeStatus(ctx, model); err != nil {
+			log.Error(err, "Failed to update Model status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Build the engine if spec.conversion was added or its target changed
+	if conversionOutdated(model) && !frozen(model) {
+		message := fmt.Sprintf("Converting to %s", model.Spec.Conversion.Target)
+		log.Info("Conversion outdated, converting", "target", model.Spec.Conversion.Target)
+		model.Status.Conversion = nil
+		setConvertedCondition(model, metav1.ConditionFalse, reasonConverting, message)
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message)
+	}
+	if model.Spec.Conversion == nil && model.Status.Conversion != nil {
+		clearConversion(model)
+		if err := r.writeStatus(ctx, model); err != nil {
+			log.Error(err, "Failed to update Model status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Register the model if spec.registration was added or its URL changed
+	if registers(model) && !registered(model) {
+		message := fmt.Sprintf("Registering with %s", model.Spec.Registration.Webhook.URL)
+		log.Info("Model not registered, registering", "url", model.Spec.Registration.Webhook.URL)
+		clearRegistration(model)
+		setRegisteredCondition(model, metav1.ConditionFalse, reasonRegistering, message)
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message)
+	}
+	if !registers(model) && (model.Status.Registration != nil ||
+		meta.FindStatusCondition(model.Status.Conditions, conditionTypeRegistered) != nil) {
+		clearRegistration(model)
+		if err := r.writeStatus(ctx, model); err != nil {
+			log.Error(err, "Failed to update Model status")
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Fetch the model card if it was enabled after the download
+	if err := r.reconcileModelCard(ctx, model); err != nil {
+		log.Error(err, "Failed to reconcile model card")
+		return ctrl.Result{}, err
+	}
+
+	// Keep warm standby replicas on other storage classes and zones
+	if err := r.reconcileReplicas(ctx, model); err != nil {
+		log.Error(err, "Failed to reconcile storage replicas")
+		return ctrl.Result{}, err
+	}
+
+	// Publish the model as an OCI image for image volume consumers
+	if err := r.reconcilePublish(ctx, model); err != nil {
+		log.Error(err, "Failed to reconcile OCI image publication")
 		return ctrl.Result{}, err
 	}
 
 	// Still ready, slow poll
-	return ctrl.Result{RequeueAfter: requeueReady}, nil
+	return ctrl.Result{RequeueAfter: r.Config.Requeue.ready()}, nil
 }
 
 // reconcileFailed handles the Failed phase: allows retry when Job is deleted
 func (r *ModelReconciler) reconcileFailed(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
+	// Retrying cannot help until the spec is fixed, which reconciles again
+	if storageSizeError(model) != nil || specFailed(model) {
+		return ctrl.Result{}, nil
+	}
+
+	// Retry a failed conversion when its Job is deleted
+	if cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeConverted); model.Spec.Conversion != nil &&
+		cond != nil && cond.Reason == reasonConversionFailed {
+		job := &batchv1.Job{}
+		err := r.Get(ctx, types.NamespacedName{Name: resources.ConversionJobName(model.Name), Namespace: model.Namespace}, job)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				log.Info("Conversion Job was deleted, retrying")
+				message := fmt.Sprintf("Retrying conversion to %s", model.Spec.Conversion.Target)
+				setConvertedCondition(model, metav1.ConditionFalse, reasonConverting, message)
+				return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message)
+			}
+			log.Error(err, "Failed to get conversion Job")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.failed()}, nil
+	}
+
 	// Check if Job was deleted (manual retry trigger)
 	jobName := resources.JobName(model.Name)
 	job := &batchv1.Job{}
@@ -249,6 +742,9 @@ func (r *ModelReconciler) reconcileFailed(ctx context.Context, model *modelsv1al
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			log.Info("Download Job was deleted, retrying")
+			r.event(model, corev1.EventTypeNormal, reasonDownloadRetrying, "Download Job was deleted, retrying download")
+			clearStalled(model)
+			model.Status.SourceIndex = 0
 			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, "Retrying download")
 		}
 		log.Error(err, "Failed to get Job")
@@ -256,7 +752,69 @@ func (r *ModelReconciler) reconcileFailed(ctx context.Context, model *modelsv1al
 	}
 
 	// Job still exists, stay in Failed state
-	return ctrl.Result{RequeueAfter: requeueFailed}, nil
+	return ctrl.Result{RequeueAfter: r.Config.Requeue.failed()}, nil
+}
+
+// reconcilePrewarm creates, updates or deletes the image pre-pull DaemonSet
+// and reflects its rollout in the Prewarmed condition
+func (r *ModelReconciler) reconcilePrewarm(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	existing := &appsv1.DaemonSet{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.PrewarmName(model.Name), Namespace: model.Namespace}, existing)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	found := err == nil
+
+	if model.Spec.Prewarm == nil || len(model.Spec.Prewarm.Images) == 0 {
+		if found && metav1.IsControlledBy(existing, model) {
+			log.Info("Deleting image pre-pull DaemonSet", "name", existing.Name)
+			if err := r.Delete(ctx, existing); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+		if meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypePrewarmed) {
+			return r.writeStatus(ctx, model)
+		}
+		return nil
+	}
+
+	ds := resources.BuildPrewarmDaemonSet(model, r.Config.Resources)
+	if err := controllerutil.SetControllerReference(model, ds, r.Scheme); err != nil {
+		return err
+	}
+
+	// Apply on every pass so drift is corrected without overwriting fields
+	// other controllers manage on the DaemonSet
+	if !found {
+		log.Info("Creating image pre-pull DaemonSet", "name", ds.Name)
+	}
+	if err := r.apply(ctx, ds); err != nil {
+		return err
+	}
+	existing = ds
+
+	condition := metav1.Condition{
+		Type:               conditionTypePrewarmed,
+		ObservedGeneration: model.Generation,
+	}
+	desired := existing.Status.DesiredNumberScheduled
+	ready := existing.Status.NumberReady
+	if desired > 0 && ready >= desired && existing.Status.ObservedGeneration >= existing.Generation {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "ImagesPulled"
+		condition.Message = fmt.Sprintf("Runtime images pulled on %d nodes", ready)
+	} else {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Pulling"
+		condition.Message = fmt.Sprintf("Runtime images pulled on %d/%d nodes", ready, desired)
+	}
+
+	if meta.SetStatusCondition(&model.Status.Conditions, condition) {
+		return r.writeStatus(ctx, model)
+	}
+	return nil
 }
 
 // updateStatus updates the Model status with a new phase and message
@@ -264,21 +822,50 @@ func (r *ModelReconciler) updateStatus(ctx context.Context, model *modelsv1alpha
 	return r.updateStatusWithProgress(ctx, model, phase, message, model.Status.Progress)
 }
 
+// writeStatus persists the Model status, with its stages and phase durations
+// brought up to date, unless it matches the stored status, so requeues that
+// change nothing do not write to the API server
+func (r *ModelReconciler) writeStatus(ctx context.Context, model *modelsv1alpha1.Model) error {
+	now := time.Now()
+	updateStages(model, now)
+	stored := &modelsv1alpha1.Model{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(model), stored); err != nil {
+		return r.Status().Update(ctx, model)
+	}
+	durations := updatePhaseDurations(model, &stored.Status, now)
+	if stored.ResourceVersion == model.ResourceVersion && equality.Semantic.DeepEqual(stored.Status, model.Status) {
+		return nil
+	}
+	if err := r.Status().Update(ctx, model); err != nil {
+		return err
+	}
+	observePhaseDurations(durations)
+	return nil
+}
+
 // updateStatusWithProgress updates the Model status with a new phase, message, and progress
 func (r *ModelReconciler) updateStatusWithProgress(ctx context.Context, model *modelsv1alpha1.Model, phase modelsv1alpha1.ModelPhase, message string, progress int) (ctrl.Result, error) {
 	log := logf.FromContext(ctx)
 
+	becameReady := phase == modelsv1alpha1.ModelPhaseReady && model.Status.Phase != phase
 	model.Status.Phase = phase
 	model.Status.Message = message
 	model.Status.Progress = progress
-	model.Status.PVCName = resources.PVCName(model.Name)
+	model.Status.PVCName = statusPVCName(model)
 	model.Status.ObservedGeneration = model.Generation
 
-	// Update condition
+	// Only a Failed Model has a failure reason, DownloadFailed unless the
+	// failure set a more specific one
+	if phase != modelsv1alpha1.ModelPhaseFailed {
+		model.Status.FailureReason = ""
+	} else if model.Status.FailureReason == "" {
+		model.Status.FailureReason = modelsv1alpha1.FailureReasonDownloadFailed
+	}
+
+	// Update condition; the transition time only moves when the status does
 	condition := metav1.Condition{
 		Type:               conditionTypeReady,
 		ObservedGeneration: model.Generation,
-		LastTransitionTime: metav1.Now(),
 	}
 
 	switch phase {
@@ -288,7 +875,7 @@ func (r *ModelReconciler) updateStatusWithProgress(ctx context.Context, model *m
 		condition.Message = message
 	case modelsv1alpha1.ModelPhaseFailed:
 		condition.Status = metav1.ConditionFalse
-		condition.Reason = "DownloadFailed"
+		condition.Reason = string(model.Status.FailureReason)
 		condition.Message = message
 	default:
 		condition.Status = metav1.ConditionFalse
@@ -298,22 +885,25 @@ func (r *ModelReconciler) updateStatusWithProgress(ctx context.Context, model *m
 
 	meta.SetStatusCondition(&model.Status.Conditions, condition)
 
-	if err := r.Status().Update(ctx, model); err != nil {
+	if err := r.writeStatus(ctx, model); err != nil {
 		log.Error(err, "Failed to update Model status")
 		return ctrl.Result{}, err
 	}
+	if becameReady {
+		r.event(model, corev1.EventTypeNormal, reasonModelReady, message)
+	}
 
 	// Determine requeue interval based on phase
 	var requeueAfter time.Duration
 	switch phase {
 	case modelsv1alpha1.ModelPhasePending:
-		requeueAfter = requeuePending
+		requeueAfter = r.Config.Requeue.pending()
 	case modelsv1alpha1.ModelPhaseDownloading:
-		requeueAfter = requeueDownloading
+		requeueAfter = r.Config.Requeue.downloading()
 	case modelsv1alpha1.ModelPhaseReady:
-		requeueAfter = requeueReady
+		requeueAfter = r.Config.Requeue.ready()
 	case modelsv1alpha1.ModelPhaseFailed:
-		requeueAfter = requeueFailed
+		requeueAfter = r.Config.Requeue.failed()
 	}
 
 	return ctrl.Result{RequeueAfter: requeueAfter}, nil
@@ -321,10 +911,26 @@ func (r *ModelReconciler) updateStatusWithProgress(ctx context.Context, model *m
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ModelReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &modelsv1alpha1.Model{},
+		credentialsSecretIndex, indexCredentialsSecret); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&modelsv1alpha1.Model{}).
 		Owns(&corev1.PersistentVolumeClaim{}).
 		Owns(&batchv1.Job{}).
+		Owns(&appsv1.DaemonSet{}).
+		Owns(&appsv1.Deployment{}).
+		Watches(&batchv1.Job{}, handler.EnqueueRequestsFromMapFunc(r.modelsForLeasedJob)).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(modelForDownloadPod)).
+		Watches(&corev1.ConfigMap{}, handler.EnqueueRequestForOwner(
+			mgr.GetScheme(), mgr.GetRESTMapper(), &modelsv1alpha1.Model{})).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(r.modelsOnNode),
+			builder.WithPredicates(nodeRebooted)).
+		Watches(&corev1.PersistentVolume{}, handler.EnqueueRequestsFromMapFunc(r.modelForLocalPV)).
+		Watches(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(r.modelsForSecret)).
+		WithEventFilter(r.Shard.Predicate()).
 		Named("model").
 		Complete(r)
 }
@@ -155,6 +155,41 @@ var _ = Describe("Model Controller", func() {
 			Expect(model.Status.PVCName).To(Equal(resources.PVCName(modelName)))
 		})
 
+		It("should apply owned resources with the operator field manager", func() {
+			reconciler := &ModelReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			appliedBy := func(fields []metav1.ManagedFieldsEntry) bool {
+				for _, entry := range fields {
+					if entry.Manager == FieldManager && entry.Operation == metav1.ManagedFieldsOperationApply {
+						return true
+					}
+				}
+				return false
+			}
+
+			pvc := &corev1.PersistentVolumeClaim{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      resources.PVCName(modelName),
+				Namespace: modelNamespace,
+			}, pvc)).To(Succeed())
+			Expect(appliedBy(pvc.ManagedFields)).To(BeTrue())
+
+			job := &batchv1.Job{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{
+				Name:      resources.JobName(modelName),
+				Namespace: modelNamespace,
+			}, job)).To(Succeed())
+			Expect(appliedBy(job.ManagedFields)).To(BeTrue())
+		})
+
 		It("should transition to Ready when Job succeeds", func() {
 			By("Reconciling to create resources")
 			reconciler := &ModelReconciler{
@@ -0,0 +1,221 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/sharding"
+)
+
+// phaseSeverity orders phases from best to worst for worst-of aggregation
+var phaseSeverity = map[modelsv1alpha1.ModelPhase]int{
+	modelsv1alpha1.ModelPhaseReady:       0,
+	modelsv1alpha1.ModelPhaseDownloading: 1,
+	modelsv1alpha1.ModelPhasePending:     2,
+	modelsv1alpha1.ModelPhaseFailed:      3,
+}
+
+// ModelFamilyReconciler reconciles a ModelFamily object
+type ModelFamilyReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Shard limits this replica to the ModelFamilies in its share of the namespaces
+	Shard sharding.Shard
+}
+
+// +kubebuilder:rbac:groups=models.main-currents.news,resources=modelfamilies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=models.main-currents.news,resources=modelfamilies/status,verbs=get;update;patch
+
+// Reconcile aggregates the status of all Models belonging to a ModelFamily
+func (r *ModelFamilyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	family := &modelsv1alpha1.ModelFamily{}
+	if err := r.Get(ctx, req.NamespacedName, family); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get ModelFamily")
+		return ctrl.Result{}, err
+	}
+
+	var selector labels.Selector
+	if family.Spec.Selector != nil {
+		s, err := metav1.LabelSelectorAsSelector(family.Spec.Selector)
+		if err != nil {
+			log.Error(err, "Invalid ModelFamily selector")
+			return ctrl.Result{}, nil
+		}
+		selector = s
+	}
+
+	models := &modelsv1alpha1.ModelList{}
+	if err := r.List(ctx, models, client.InNamespace(family.Namespace)); err != nil {
+		log.Error(err, "Failed to list Models")
+		return ctrl.Result{}, err
+	}
+
+	var members []modelsv1alpha1.Model
+	for _, model := range models.Items {
+		if isFamilyMember(family, selector, &model) {
+			members = append(members, model)
+		}
+	}
+
+	// Skip the write when nothing changed to keep requeues quiet
+	status := aggregateFamilyStatus(family, members)
+	if equality.Semantic.DeepEqual(family.Status, status) {
+		return ctrl.Result{}, nil
+	}
+	family.Status = status
+
+	if err := r.Status().Update(ctx, family); err != nil {
+		log.Error(err, "Failed to update ModelFamily status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// familyOf returns the family a Model declares, preferring spec.family over the label
+func familyOf(model *modelsv1alpha1.Model) string {
+	if model.Spec.Family != "" {
+		return model.Spec.Family
+	}
+	return model.Labels[modelsv1alpha1.LabelFamily]
+}
+
+// isFamilyMember reports whether the Model belongs to the family
+func isFamilyMember(family *modelsv1alpha1.ModelFamily, selector labels.Selector, model *modelsv1alpha1.Model) bool {
+	if familyOf(model) == family.Name {
+		return true
+	}
+	return selector != nil && !selector.Empty() && selector.Matches(labels.Set(model.Labels))
+}
+
+// aggregateFamilyStatus computes phase counts and the worst-of phase for the given members
+func aggregateFamilyStatus(family *modelsv1alpha1.ModelFamily, members []modelsv1alpha1.Model) modelsv1alpha1.ModelFamilyStatus {
+	status := modelsv1alpha1.ModelFamilyStatus{
+		Conditions:         append([]metav1.Condition(nil), family.Status.Conditions...),
+		ObservedGeneration: family.Generation,
+	}
+
+	sort.Slice(members, func(i, j int) bool { return members[i].Name < members[j].Name })
+
+	worst := modelsv1alpha1.ModelPhaseReady
+	var notReady []string
+	for _, model := range members {
+		phase := model.Status.Phase
+		if phase == "" {
+			phase = modelsv1alpha1.ModelPhasePending
+		}
+
+		switch phase {
+		case modelsv1alpha1.ModelPhaseReady:
+			status.Ready++
+		case modelsv1alpha1.ModelPhaseDownloading:
+			status.Downloading++
+		case modelsv1alpha1.ModelPhaseFailed:
+			status.Failed++
+		default:
+			status.Pending++
+		}
+		if phase != modelsv1alpha1.ModelPhaseReady {
+			notReady = append(notReady, model.Name)
+		}
+		if phaseSeverity[phase] > phaseSeverity[worst] {
+			worst = phase
+		}
+
+		status.Members = append(status.Members, modelsv1alpha1.ModelFamilyMember{
+			Name:    model.Name,
+			Phase:   phase,
+			Version: model.Spec.Version,
+		})
+	}
+	status.Total = len(members)
+
+	condition := metav1.Condition{
+		Type:               conditionTypeReady,
+		ObservedGeneration: family.Generation,
+	}
+	switch {
+	case status.Total == 0:
+		status.Phase = modelsv1alpha1.ModelPhasePending
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NoMembers"
+		condition.Message = "No Models belong to this family"
+	case worst == modelsv1alpha1.ModelPhaseReady:
+		status.Phase = worst
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "AllReady"
+		condition.Message = fmt.Sprintf("All %d models are ready", status.Total)
+	default:
+		status.Phase = worst
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Model" + string(worst)
+		condition.Message = fmt.Sprintf("%d/%d models ready, not ready: %v", status.Ready, status.Total, notReady)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	return status
+}
+
+// familiesForModel maps a Model event to the ModelFamilies in its namespace
+func (r *ModelFamilyReconciler) familiesForModel(ctx context.Context, obj client.Object) []reconcile.Request {
+	families := &modelsv1alpha1.ModelFamilyList{}
+	if err := r.List(ctx, families, client.InNamespace(obj.GetNamespace())); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list ModelFamilies")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(families.Items))
+	for _, family := range families.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: family.Name, Namespace: family.Namespace},
+		})
+	}
+	return requests
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ModelFamilyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&modelsv1alpha1.ModelFamily{}).
+		Watches(&modelsv1alpha1.Model{}, handler.EnqueueRequestsFromMapFunc(r.familiesForModel)).
+		WithEventFilter(r.Shard.Predicate()).
+		Named("modelfamily").
+		Complete(r)
+}
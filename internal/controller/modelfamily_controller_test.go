@@ -0,0 +1,137 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var _ = Describe("ModelFamily Controller", func() {
+	Context("When aggregating family members", func() {
+		const familyName = "llama-3-1"
+		const namespace = "default"
+
+		ctx := context.Background()
+
+		newModel := func(name string, family string, labels map[string]string) *modelsv1alpha1.Model {
+			return &modelsv1alpha1.Model{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+					Labels:    labels,
+				},
+				Spec: modelsv1alpha1.ModelSpec{
+					Source: modelsv1alpha1.ModelSource{
+						HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+							RepoID: "meta-llama/" + name,
+						},
+					},
+					Storage: modelsv1alpha1.StorageSpec{
+						StorageClass: "standard",
+						Size:         "1Gi",
+					},
+					Family: family,
+				},
+			}
+		}
+
+		setPhase := func(name string, phase modelsv1alpha1.ModelPhase) {
+			model := &modelsv1alpha1.Model{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, model)).To(Succeed())
+			model.Status.Phase = phase
+			Expect(k8sClient.Status().Update(ctx, model)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			Expect(k8sClient.Create(ctx, &modelsv1alpha1.ModelFamily{
+				ObjectMeta: metav1.ObjectMeta{Name: familyName, Namespace: namespace},
+			})).To(Succeed())
+			Expect(k8sClient.Create(ctx, newModel("family-8b", familyName, nil))).To(Succeed())
+			Expect(k8sClient.Create(ctx, newModel("family-70b", "", map[string]string{
+				modelsv1alpha1.LabelFamily: familyName,
+			}))).To(Succeed())
+			Expect(k8sClient.Create(ctx, newModel("unrelated", "other", nil))).To(Succeed())
+		})
+
+		AfterEach(func() {
+			for _, name := range []string{"family-8b", "family-70b", "unrelated"} {
+				model := &modelsv1alpha1.Model{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, model); err == nil {
+					Expect(k8sClient.Delete(ctx, model)).To(Succeed())
+				}
+			}
+			family := &modelsv1alpha1.ModelFamily{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Name: familyName, Namespace: namespace}, family); err == nil {
+				Expect(k8sClient.Delete(ctx, family)).To(Succeed())
+			}
+		})
+
+		It("should count members and report the worst phase", func() {
+			setPhase("family-8b", modelsv1alpha1.ModelPhaseReady)
+			setPhase("family-70b", modelsv1alpha1.ModelPhaseFailed)
+
+			reconciler := &ModelFamilyReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: familyName, Namespace: namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			family := &modelsv1alpha1.ModelFamily{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: familyName, Namespace: namespace}, family)).To(Succeed())
+			Expect(family.Status.Total).To(Equal(2))
+			Expect(family.Status.Ready).To(Equal(1))
+			Expect(family.Status.Failed).To(Equal(1))
+			Expect(family.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+			Expect(family.Status.Members).To(HaveLen(2))
+
+			cond := meta.FindStatusCondition(family.Status.Conditions, conditionTypeReady)
+			Expect(cond).NotTo(BeNil())
+			Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		})
+
+		It("should be Ready when all members are Ready", func() {
+			setPhase("family-8b", modelsv1alpha1.ModelPhaseReady)
+			setPhase("family-70b", modelsv1alpha1.ModelPhaseReady)
+
+			reconciler := &ModelFamilyReconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err := reconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: familyName, Namespace: namespace},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			family := &modelsv1alpha1.ModelFamily{}
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Name: familyName, Namespace: namespace}, family)).To(Succeed())
+			Expect(family.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+			Expect(meta.IsStatusConditionTrue(family.Status.Conditions, conditionTypeReady)).To(BeTrue())
+		})
+	})
+})
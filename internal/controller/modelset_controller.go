@@ -0,0 +1,360 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/sharding"
+)
+
+var (
+	// paramPattern matches a $(name) parameter reference in a ModelSet template
+	paramPattern = regexp.MustCompile(`\$\(([A-Za-z0-9_.-]+)\)`)
+
+	// invalidNameChars matches runs of characters not allowed in a Model name
+	invalidNameChars = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// maxModelNameLength keeps generated Model names usable as label values
+const maxModelNameLength = 63
+
+// ModelSetReconciler reconciles a ModelSet object
+type ModelSetReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Shard limits this replica to the ModelSets in its share of the namespaces
+	Shard sharding.Shard
+}
+
+// +kubebuilder:rbac:groups=models.main-currents.news,resources=modelsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=models.main-currents.news,resources=modelsets/status,verbs=get;update;patch
+
+// modelSetEntry is one Model a ModelSet asks for
+type modelSetEntry struct {
+	name       string
+	parameters map[string]string
+}
+
+// Reconcile creates the Models of a ModelSet, removes the ones it no longer
+// lists and aggregates their status
+func (r *ModelSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	set := &modelsv1alpha1.ModelSet{}
+	if err := r.Get(ctx, req.NamespacedName, set); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get ModelSet")
+		return ctrl.Result{}, err
+	}
+
+	entries := expandModelSet(set)
+	members := make([]modelsv1alpha1.ModelSetMember, 0, len(entries))
+	desired := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		if desired[entry.name] {
+			members = append(members, modelsv1alpha1.ModelSetMember{
+				Name:    entry.name,
+				Phase:   modelsv1alpha1.ModelPhaseFailed,
+				Message: "Another entry of the ModelSet creates a Model of the same name",
+			})
+			continue
+		}
+		desired[entry.name] = true
+
+		member, err := r.reconcileMember(ctx, set, entry)
+		if err != nil {
+			log.Error(err, "Failed to apply Model", "model", entry.name)
+			return ctrl.Result{}, err
+		}
+		members = append(members, member)
+	}
+
+	if err := r.pruneMembers(ctx, set, desired); err != nil {
+		log.Error(err, "Failed to remove Models no longer in the ModelSet")
+		return ctrl.Result{}, err
+	}
+
+	// Skip the write when nothing changed to keep requeues quiet
+	status := aggregateSetStatus(set, members)
+	if equality.Semantic.DeepEqual(set.Status, status) {
+		return ctrl.Result{}, nil
+	}
+	set.Status = status
+
+	if err := r.Status().Update(ctx, set); err != nil {
+		log.Error(err, "Failed to update ModelSet status")
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileMember applies the Model of entry and summarizes its state. A
+// Model the set cannot create is reported as a Failed member rather than
+// holding back the others.
+func (r *ModelSetReconciler) reconcileMember(ctx context.Context, set *modelsv1alpha1.ModelSet, entry modelSetEntry) (modelsv1alpha1.ModelSetMember, error) {
+	member := modelsv1alpha1.ModelSetMember{Name: entry.name}
+	failed := func(message string) (modelsv1alpha1.ModelSetMember, error) {
+		member.Phase = modelsv1alpha1.ModelPhaseFailed
+		member.Message = message
+		return member, nil
+	}
+
+	model, err := renderModel(set, entry)
+	if err != nil {
+		return failed(err.Error())
+	}
+
+	existing := &modelsv1alpha1.Model{}
+	err = r.Get(ctx, types.NamespacedName{Name: model.Name, Namespace: model.Namespace}, existing)
+	if err == nil && !metav1.IsControlledBy(existing, set) {
+		return failed(fmt.Sprintf("Model %s already exists and is not managed by this ModelSet", model.Name))
+	}
+	if client.IgnoreNotFound(err) != nil {
+		return member, err
+	}
+
+	if err := controllerutil.SetControllerReference(set, model, r.Scheme); err != nil {
+		return member, err
+	}
+	if err := apply(ctx, r.Client, r.Scheme, model); err != nil {
+		if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) || apierrors.IsBadRequest(err) {
+			return failed(err.Error())
+		}
+		return member, err
+	}
+
+	member.Phase = model.Status.Phase
+	if member.Phase == "" {
+		member.Phase = modelsv1alpha1.ModelPhasePending
+	}
+	if member.Phase == modelsv1alpha1.ModelPhaseFailed {
+		member.Message = model.Status.Message
+	}
+	return member, nil
+}
+
+// pruneMembers deletes the Models the set created that it no longer lists
+func (r *ModelSetReconciler) pruneMembers(ctx context.Context, set *modelsv1alpha1.ModelSet, desired map[string]bool) error {
+	models := &modelsv1alpha1.ModelList{}
+	if err := r.List(ctx, models, client.InNamespace(set.Namespace),
+		client.MatchingLabels{modelsv1alpha1.LabelModelSet: set.Name}); err != nil {
+		return err
+	}
+	for _, model := range models.Items {
+		if desired[model.Name] || !metav1.IsControlledBy(&model, set) {
+			continue
+		}
+		logf.FromContext(ctx).Info("Removing Model no longer in the ModelSet", "model", model.Name)
+		if err := r.Delete(ctx, &model); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expandModelSet lists the entries of a ModelSet: its items, then every
+// combination of its matrix
+func expandModelSet(set *modelsv1alpha1.ModelSet) []modelSetEntry {
+	entries := make([]modelSetEntry, 0, len(set.Spec.Items))
+	for _, item := range set.Spec.Items {
+		entries = append(entries, modelSetEntry{name: item.Name, parameters: item.Parameters})
+	}
+
+	if len(set.Spec.Matrix) > 0 {
+		combinations := []map[string]string{{}}
+		for _, name := range slices.Sorted(maps.Keys(set.Spec.Matrix)) {
+			var next []map[string]string
+			for _, combination := range combinations {
+				for _, value := range set.Spec.Matrix[name] {
+					c := maps.Clone(combination)
+					c[name] = value
+					next = append(next, c)
+				}
+			}
+			combinations = next
+		}
+		for _, combination := range combinations {
+			entries = append(entries, modelSetEntry{parameters: combination})
+		}
+	}
+
+	for i := range entries {
+		if entries[i].name == "" {
+			entries[i].name = memberName(set.Name, entries[i].parameters)
+		}
+	}
+	return entries
+}
+
+// memberName returns the set name followed by the parameter values, in the
+// order of their names, as a valid Model name. Names that would be too long
+// are shortened and suffixed with a hash of the full name.
+func memberName(setName string, parameters map[string]string) string {
+	parts := []string{setName}
+	for _, name := range slices.Sorted(maps.Keys(parameters)) {
+		parts = append(parts, parameters[name])
+	}
+	full := strings.Join(parts, "-")
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(full), "-"), "-")
+	if len(name) <= maxModelNameLength {
+		return name
+	}
+	sum := sha256.Sum256([]byte(full))
+	return strings.TrimRight(name[:maxModelNameLength-9], "-") + "-" + hex.EncodeToString(sum[:4])
+}
+
+// renderModel returns the Model the set's template gives for entry, with
+// its parameters substituted
+func renderModel(set *modelsv1alpha1.ModelSet, entry modelSetEntry) (*modelsv1alpha1.Model, error) {
+	raw, err := json.Marshal(set.Spec.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	var undefined []string
+	rendered := paramPattern.ReplaceAllFunc(raw, func(ref []byte) []byte {
+		name := string(paramPattern.FindSubmatch(ref)[1])
+		value, ok := entry.parameters[name]
+		if !ok {
+			undefined = append(undefined, name)
+			return ref
+		}
+		quoted, _ := json.Marshal(value)
+		return quoted[1 : len(quoted)-1]
+	})
+	if len(undefined) > 0 {
+		slices.Sort(undefined)
+		return nil, fmt.Errorf("template uses undefined parameters: %s", strings.Join(slices.Compact(undefined), ", "))
+	}
+
+	template := modelsv1alpha1.ModelTemplate{}
+	if err := json.Unmarshal(rendered, &template); err != nil {
+		return nil, err
+	}
+
+	labels := maps.Clone(template.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[modelsv1alpha1.LabelModelSet] = set.Name
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        entry.name,
+			Namespace:   set.Namespace,
+			Labels:      labels,
+			Annotations: template.Annotations,
+		},
+		Spec: template.Spec,
+	}, nil
+}
+
+// aggregateSetStatus computes phase counts and the worst-of phase for the members
+func aggregateSetStatus(set *modelsv1alpha1.ModelSet, members []modelsv1alpha1.ModelSetMember) modelsv1alpha1.ModelSetStatus {
+	status := modelsv1alpha1.ModelSetStatus{
+		Total:              len(members),
+		Members:            members,
+		Conditions:         append([]metav1.Condition(nil), set.Status.Conditions...),
+		ObservedGeneration: set.Generation,
+	}
+
+	worst := modelsv1alpha1.ModelPhaseReady
+	var failed []string
+	for _, member := range members {
+		switch member.Phase {
+		case modelsv1alpha1.ModelPhaseReady:
+			status.Ready++
+		case modelsv1alpha1.ModelPhaseDownloading:
+			status.Downloading++
+		case modelsv1alpha1.ModelPhaseFailed:
+			status.Failed++
+			failed = append(failed, member.Name)
+		default:
+			status.Pending++
+		}
+		if phaseSeverity[member.Phase] > phaseSeverity[worst] {
+			worst = member.Phase
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionTypeReady,
+		ObservedGeneration: set.Generation,
+	}
+	switch {
+	case status.Total == 0:
+		status.Phase = modelsv1alpha1.ModelPhasePending
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "NoMembers"
+		condition.Message = "The ModelSet lists no Models"
+	case worst == modelsv1alpha1.ModelPhaseReady:
+		status.Phase = worst
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "AllReady"
+		condition.Message = fmt.Sprintf("All %d models are ready", status.Total)
+	case len(failed) > 0:
+		status.Phase = worst
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "PartialFailure"
+		if len(failed) == status.Total {
+			condition.Reason = "AllFailed"
+		}
+		condition.Message = fmt.Sprintf("%d/%d models ready, failed: %s", status.Ready, status.Total, strings.Join(failed, ", "))
+	default:
+		status.Phase = worst
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = "Model" + string(worst)
+		condition.Message = fmt.Sprintf("%d/%d models ready", status.Ready, status.Total)
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	return status
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ModelSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&modelsv1alpha1.ModelSet{}).
+		Owns(&modelsv1alpha1.Model{}).
+		WithEventFilter(r.Shard.Predicate()).
+		Named("modelset").
+		Complete(r)
+}
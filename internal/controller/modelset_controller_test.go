@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var _ = Describe("ModelSet Controller", func() {
+	const (
+		namespace = "default"
+		setName   = "llama-gguf"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: setName, Namespace: namespace}
+
+	newSet := func() *modelsv1alpha1.ModelSet {
+		return &modelsv1alpha1.ModelSet{
+			ObjectMeta: metav1.ObjectMeta{Name: setName, Namespace: namespace, UID: "modelset-uid", Generation: 1},
+			Spec: modelsv1alpha1.ModelSetSpec{
+				Matrix: map[string][]string{"quant": {"Q4_K_M", "Q8_0"}},
+				Template: modelsv1alpha1.ModelTemplate{
+					Labels: map[string]string{"quantization": "$(quant)"},
+					Spec: modelsv1alpha1.ModelSpec{
+						Source: modelsv1alpha1.ModelSource{
+							HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+								RepoID:  "bartowski/Meta-Llama-3.1-8B-Instruct-GGUF",
+								Include: []string{"*-$(quant).gguf"},
+							},
+						},
+						Version: "3.1-$(quant)",
+						Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"},
+					},
+				},
+			},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.ModelSet{}, &modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	reconcileSet := func(c client.Client) *modelsv1alpha1.ModelSet {
+		r := &ModelSetReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		set := &modelsv1alpha1.ModelSet{}
+		Expect(c.Get(ctx, key, set)).To(Succeed())
+		return set
+	}
+
+	getModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, model)).To(Succeed())
+		return model
+	}
+
+	It("should create a Model for each matrix combination", func() {
+		c := newClient(newSet())
+
+		set := reconcileSet(c)
+		Expect(set.Status.Total).To(Equal(2))
+		Expect(set.Status.Pending).To(Equal(2))
+		Expect(set.Status.Members).To(HaveLen(2))
+
+		model := getModel(c, "llama-gguf-q4-k-m")
+		Expect(model.Spec.Source.HuggingFace.Include).To(Equal([]string{"*-Q4_K_M.gguf"}))
+		Expect(model.Spec.Version).To(Equal("3.1-Q4_K_M"))
+		Expect(model.Labels).To(HaveKeyWithValue("quantization", "Q4_K_M"))
+		Expect(model.Labels).To(HaveKeyWithValue(modelsv1alpha1.LabelModelSet, setName))
+		Expect(metav1.IsControlledBy(model, set)).To(BeTrue())
+
+		Expect(getModel(c, "llama-gguf-q8-0").Spec.Version).To(Equal("3.1-Q8_0"))
+	})
+
+	It("should aggregate the phases of its Models", func() {
+		c := newClient(newSet())
+		reconcileSet(c)
+
+		for name, phase := range map[string]modelsv1alpha1.ModelPhase{
+			"llama-gguf-q4-k-m": modelsv1alpha1.ModelPhaseReady,
+			"llama-gguf-q8-0":   modelsv1alpha1.ModelPhaseReady,
+		} {
+			model := getModel(c, name)
+			model.Status.Phase = phase
+			Expect(c.Status().Update(ctx, model)).To(Succeed())
+		}
+
+		set := reconcileSet(c)
+		Expect(set.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(meta.IsStatusConditionTrue(set.Status.Conditions, conditionTypeReady)).To(BeTrue())
+	})
+
+	It("should report entries it cannot create without holding back the others", func() {
+		set := newSet()
+		set.Spec.Items = []modelsv1alpha1.ModelSetItem{
+			{Name: "taken", Parameters: map[string]string{"quant": "F16"}},
+			{Name: "unparameterized"},
+		}
+		taken := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "taken", Namespace: namespace},
+			Spec:       set.Spec.Template.Spec,
+		}
+		c := newClient(set, taken)
+
+		set = reconcileSet(c)
+		Expect(set.Status.Total).To(Equal(4))
+		Expect(set.Status.Failed).To(Equal(2))
+		Expect(set.Status.Pending).To(Equal(2))
+		Expect(set.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		Expect(set.Status.Members[0].Message).To(ContainSubstring("not managed by this ModelSet"))
+		Expect(set.Status.Members[1].Message).To(Equal("template uses undefined parameters: quant"))
+
+		cond := meta.FindStatusCondition(set.Status.Conditions, conditionTypeReady)
+		Expect(cond.Reason).To(Equal("PartialFailure"))
+		Expect(cond.Message).To(Equal("0/4 models ready, failed: taken, unparameterized"))
+		Expect(metav1.GetControllerOf(getModel(c, "taken"))).To(BeNil())
+	})
+
+	It("should remove Models no longer in the set", func() {
+		set := newSet()
+		c := newClient(set)
+		reconcileSet(c)
+
+		set = &modelsv1alpha1.ModelSet{}
+		Expect(c.Get(ctx, key, set)).To(Succeed())
+		set.Spec.Matrix["quant"] = []string{"Q4_K_M"}
+		Expect(c.Update(ctx, set)).To(Succeed())
+
+		set = reconcileSet(c)
+		Expect(set.Status.Total).To(Equal(1))
+		models := &modelsv1alpha1.ModelList{}
+		Expect(c.List(ctx, models, client.InNamespace(namespace))).To(Succeed())
+		Expect(models.Items).To(HaveLen(1))
+		Expect(models.Items[0].Name).To(Equal("llama-gguf-q4-k-m"))
+	})
+
+	It("should shorten long generated names", func() {
+		name := memberName(strings.Repeat("model-set", 6), map[string]string{"quant": "Q4_K_M"})
+		Expect(len(name)).To(BeNumerically("<=", maxModelNameLength))
+		Expect(name).To(MatchRegexp(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`))
+		Expect(name).NotTo(Equal(memberName(strings.Repeat("model-set", 6), map[string]string{"quant": "Q8_0"})))
+	})
+})
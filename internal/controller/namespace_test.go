@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Terminating namespaces", func() {
+	const namespace = "leaving"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "llama", Namespace: namespace}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	newNamespace := func(phase corev1.NamespacePhase) *corev1.Namespace {
+		return &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Status:     corev1.NamespaceStatus{Phase: phase},
+		}
+	}
+
+	It("should requeue quietly without creating the PVC or Job", func() {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(newModel(), newNamespace(corev1.NamespaceTerminating)).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+
+		err = c.Get(ctx, types.NamespacedName{Name: resources.PVCName(key.Name), Namespace: namespace},
+			&corev1.PersistentVolumeClaim{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = c.Get(ctx, types.NamespacedName{Name: resources.JobName(key.Name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+	})
+
+	It("should release the local storage finalizer of a deleted Model", func() {
+		model := newModel()
+		model.Finalizers = []string{localStorageFinalizer}
+		model.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		model.Spec.Storage = modelsv1alpha1.StorageSpec{
+			Size:  "1Gi",
+			Local: &modelsv1alpha1.LocalStorageSpec{NodeName: "homelab-1"},
+		}
+		// The API server forbids creating anything in a terminating namespace
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, newNamespace(corev1.NamespaceTerminating)).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					if obj.GetNamespace() == namespace {
+						return apierrors.NewForbidden(schema.GroupResource{}, obj.GetName(),
+							errors.New("namespace is being terminated"))
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		// Releasing the last finalizer removes the Model
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &modelsv1alpha1.Model{}))).To(BeTrue())
+	})
+
+	It("should download in an active namespace", func() {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(newModel(), newNamespace(corev1.NamespaceActive)).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName(key.Name), Namespace: namespace},
+			&batchv1.Job{})).To(Succeed())
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// conditionTypeResourceConflict reports that an object with the name of one
+// the Model needs already exists and belongs to someone else
+const conditionTypeResourceConflict = "ResourceConflict"
+
+// Reasons of the ResourceConflict condition
+const (
+	reasonNotOwned     = "NotOwned"
+	reasonOwnedByOther = "OwnedByOther"
+)
+
+// retainedByOperator reports whether obj was created by the operator for a
+// Model of this name that retained it on deletion
+func retainedByOperator(model *modelsv1alpha1.Model, obj client.Object) bool {
+	labels := obj.GetLabels()
+	return labels["app.kubernetes.io/managed-by"] == "model-operator" && labels["app.kubernetes.io/instance"] == model.Name
+}
+
+// claim makes sure the Model controls an existing obj of kind before using
+// it. An object without a controller is adopted when it carries
+// AnnotationAdopt or the operator retained it for a Model of this name. It
+// returns why the Model cannot use obj, or empty if it can.
+func (r *ModelReconciler) claim(ctx context.Context, model *modelsv1alpha1.Model, kind string, obj client.Object) (string, string, error) {
+	if metav1.IsControlledBy(obj, model) {
+		return "", "", nil
+	}
+	if owner := metav1.GetControllerOf(obj); owner != nil {
+		return reasonOwnedByOther, fmt.Sprintf("%s %s already exists and is controlled by %s %s",
+			kind, obj.GetName(), owner.Kind, owner.Name), nil
+	}
+	if obj.GetAnnotations()[modelsv1alpha1.AnnotationAdopt] != "true" && !retainedByOperator(model, obj) {
+		return reasonNotOwned, fmt.Sprintf("%s %s already exists and is not owned by this Model; "+
+			"annotate it with %s=true to adopt it", kind, obj.GetName(), modelsv1alpha1.AnnotationAdopt), nil
+	}
+
+	base, ok := obj.DeepCopyObject().(client.Object)
+	if !ok {
+		return "", "", fmt.Errorf("cannot copy %s %s", kind, obj.GetName())
+	}
+	if err := controllerutil.SetControllerReference(model, obj, r.Scheme); err != nil {
+		return "", "", err
+	}
+	logf.FromContext(ctx).Info("Adopting existing object", "kind", kind, "name", obj.GetName())
+	if err := r.Patch(ctx, obj, client.MergeFrom(base)); err != nil {
+		return "", "", err
+	}
+	r.eventf(model, corev1.EventTypeNormal, reasonAdopted, "Adopted %s %s", kind, obj.GetName())
+	return "", "", nil
+}
+
+// setResourceConflict records on the Model that an object it needs belongs
+// to someone else, with a Warning Event when that is news, or clears the
+// ResourceConflict condition when message is empty
+func (r *ModelReconciler) setResourceConflict(model *modelsv1alpha1.Model, reason, message string) {
+	if message == "" {
+		meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeResourceConflict)
+		return
+	}
+	if cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeResourceConflict); cond == nil ||
+		cond.Message != message {
+		r.event(model, corev1.EventTypeWarning, reasonResourceConflict, message)
+	}
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeResourceConflict,
+		Status:             metav1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// deleteOwnedJob deletes the Job of that name in the Model's namespace if
+// the Model controls it, leaving Jobs that belong to others alone
+func (r *ModelReconciler) deleteOwnedJob(ctx context.Context, model *modelsv1alpha1.Model, name string) error {
+	job := &batchv1.Job{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name, Namespace: model.Namespace}, job); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	if !metav1.IsControlledBy(job, model) {
+		logf.FromContext(ctx).Info("Not deleting Job the Model does not own", "name", name)
+		return nil
+	}
+	err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground),
+		client.Preconditions{UID: &job.UID})
+	if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+		return nil
+	}
+	return err
+}
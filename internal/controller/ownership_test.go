@@ -0,0 +1,158 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Resource ownership", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "claimed", Namespace: namespace}
+	pvcKey := types.NamespacedName{Name: resources.PVCName(key.Name), Namespace: namespace}
+	jobKey := types.NamespacedName{Name: resources.JobName(key.Name), Namespace: namespace}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, UID: "claimed-uid", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	// userPVC returns a PVC someone created with the name the Model expects
+	userPVC := func(annotations map[string]string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: pvcKey.Name, Namespace: namespace, Annotations: annotations},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client, recorder record.EventRecorder) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Recorder: recorder}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should report a PVC it does not own instead of downloading into it", func() {
+		recorder := record.NewFakeRecorder(10)
+		c := newClient(newModel(), userPVC(nil))
+
+		model := reconcileModel(c, recorder)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeResourceConflict)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonNotOwned))
+		Expect(cond.Message).To(ContainSubstring(modelsv1alpha1.AnnotationAdopt))
+		Expect(recorder.Events).To(Receive(HavePrefix("Warning ResourceConflict PVC " + pvcKey.Name)))
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, pvcKey, pvc)).To(Succeed())
+		Expect(pvc.OwnerReferences).To(BeEmpty())
+		Expect(apierrors.IsNotFound(c.Get(ctx, jobKey, &batchv1.Job{}))).To(BeTrue())
+
+		// The conflict is only announced once
+		reconcileModel(c, recorder)
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should never adopt a PVC another object controls", func() {
+		pvc := userPVC(map[string]string{modelsv1alpha1.AnnotationAdopt: "true"})
+		pvc.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1", Kind: "StatefulSet", Name: "vector-db", UID: "sts-uid", Controller: ptr.To(true),
+		}}
+		c := newClient(newModel(), pvc)
+
+		model := reconcileModel(c, nil)
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeResourceConflict)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonOwnedByOther))
+		Expect(cond.Message).To(ContainSubstring("StatefulSet vector-db"))
+	})
+
+	It("should adopt a PVC annotated for adoption", func() {
+		model := newModel()
+		model.Status.Conditions = []metav1.Condition{{
+			Type: conditionTypeResourceConflict, Status: metav1.ConditionTrue, Reason: reasonNotOwned,
+			Message: "PVC already exists", LastTransitionTime: metav1.Now(),
+		}}
+		c := newClient(model, userPVC(map[string]string{modelsv1alpha1.AnnotationAdopt: "true"}))
+
+		model = reconcileModel(c, nil)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(meta.FindStatusCondition(model.Status.Conditions, conditionTypeResourceConflict)).To(BeNil())
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, pvcKey, pvc)).To(Succeed())
+		Expect(metav1.IsControlledBy(pvc, model)).To(BeTrue())
+	})
+
+	It("should not report a Job it does not own as the download", func() {
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobKey.Name, Namespace: namespace}}
+		c := newClient(newModel(), job)
+
+		model := reconcileModel(c, nil)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(HavePrefix("Job " + jobKey.Name + " already exists"))
+	})
+
+	It("should only delete download Jobs it owns", func() {
+		model := newModel()
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobKey.Name, Namespace: namespace}}
+		c := newClient(model, job)
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+
+		Expect(r.deleteOwnedJob(ctx, model, jobKey.Name)).To(Succeed())
+		Expect(c.Get(ctx, jobKey, &batchv1.Job{})).To(Succeed())
+	})
+})
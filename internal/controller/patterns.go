@@ -0,0 +1,41 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// applyPatterns writes the patterns ConfigMap of a model whose include and
+// exclude patterns are mounted into the download pod rather than embedded
+// in its script. It runs whenever a download Job is created, so the Job
+// always mounts the patterns of the spec it was built from.
+func (r *ModelReconciler) applyPatterns(ctx context.Context, model *modelsv1alpha1.Model) error {
+	if !resources.PatternsMounted(model) {
+		return nil
+	}
+	cm := resources.BuildPatternsConfigMap(model)
+	if err := controllerutil.SetControllerReference(model, cm, r.Scheme); err != nil {
+		return err
+	}
+	return r.apply(ctx, cm)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Mounted include and exclude patterns", func() {
+	ctx := context.Background()
+
+	It("should write long pattern lists to a ConfigMap the download Job mounts", func() {
+		include := make([]string, 300)
+		for i := range include {
+			include[i] = fmt.Sprintf("shards/model-%05d-of-00300.safetensors", i+1)
+		}
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "sharded", Namespace: "default", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/sharded", Include: include},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+
+		key := types.NamespacedName{Name: model.Name, Namespace: model.Namespace}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		cm := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.PatternsConfigMapName(model.Name), Namespace: model.Namespace}, cm)).To(Succeed())
+		Expect(strings.Split(strings.TrimSpace(cm.Data["include"]), "\n")).To(Equal(include))
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: model.Namespace}, job)).To(Succeed())
+		Expect(job.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("ConfigMap.Name", cm.Name)))
+		Expect(job.Spec.Template.Spec.Containers[0].Args[0]).NotTo(ContainSubstring(include[0]))
+	})
+})
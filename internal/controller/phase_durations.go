@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// phaseDurationSeconds observes every phase and stage a Model completes
+var phaseDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name: "model_operator_phase_duration_seconds",
+	Help: "How long Models spent in each phase and stage of provisioning.",
+	// From a second to about three days
+	Buckets: prometheus.ExponentialBuckets(1, 4, 10),
+}, []string{"phase"})
+
+func init() {
+	metrics.Registry.MustRegister(phaseDurationSeconds)
+}
+
+// stagePhases names the phase durations of the stages
+var stagePhases = map[modelsv1alpha1.StageName]string{
+	modelsv1alpha1.StageDownload: "Downloading",
+	modelsv1alpha1.StageVerify:   "Verifying",
+	modelsv1alpha1.StageConvert:  "Converting",
+	modelsv1alpha1.StagePublish:  "Publishing",
+}
+
+// updatePhaseDurations records in Model.Status.PhaseDurations how long the
+// Model was Pending if it just left Pending, and how long each stage that
+// just completed since the stored status ran. It also moves
+// PhaseTransitionTime on a phase change, and returns the new durations.
+func updatePhaseDurations(model *modelsv1alpha1.Model, stored *modelsv1alpha1.ModelStatus, now time.Time) []modelsv1alpha1.PhaseDuration {
+	status := &model.Status
+	timestamp := metav1.NewTime(now).Rfc3339Copy()
+	if status.PhaseTransitionTime == nil {
+		since := model.CreationTimestamp
+		if since.IsZero() {
+			since = timestamp
+		}
+		status.PhaseTransitionTime = &since
+	}
+
+	var recorded []modelsv1alpha1.PhaseDuration
+	previous, current := stored.Phase, status.Phase
+	if previous == "" {
+		previous = modelsv1alpha1.ModelPhasePending
+	}
+	if current == "" {
+		current = modelsv1alpha1.ModelPhasePending
+	}
+	if current != previous {
+		if previous == modelsv1alpha1.ModelPhasePending {
+			recorded = append(recorded, phaseDuration(string(previous), status.PhaseTransitionTime.Time, now))
+		}
+		status.PhaseTransitionTime = &timestamp
+	}
+
+	storedStates := make(map[modelsv1alpha1.StageName]modelsv1alpha1.StageState, len(stored.Stages))
+	for _, s := range stored.Stages {
+		storedStates[s.Name] = s.State
+	}
+	for _, s := range status.Stages {
+		completed := s.State == modelsv1alpha1.StageStateSucceeded || s.State == modelsv1alpha1.StageStateFailed
+		if !completed || storedStates[s.Name] != modelsv1alpha1.StageStateRunning || s.StartTime == nil || s.CompletionTime == nil {
+			continue
+		}
+		recorded = append(recorded, phaseDuration(stagePhases[s.Name], s.StartTime.Time, s.CompletionTime.Time))
+	}
+
+	for _, d := range recorded {
+		setPhaseDuration(status, d)
+	}
+	return recorded
+}
+
+// phaseDuration returns the duration of a phase, to the second
+func phaseDuration(phase string, start, end time.Time) modelsv1alpha1.PhaseDuration {
+	return modelsv1alpha1.PhaseDuration{
+		Phase:    phase,
+		Duration: metav1.Duration{Duration: end.Sub(start).Round(time.Second)},
+	}
+}
+
+// setPhaseDuration adds or replaces the duration of a phase
+func setPhaseDuration(status *modelsv1alpha1.ModelStatus, d modelsv1alpha1.PhaseDuration) {
+	for i := range status.PhaseDurations {
+		if status.PhaseDurations[i].Phase == d.Phase {
+			status.PhaseDurations[i] = d
+			return
+		}
+	}
+	status.PhaseDurations = append(status.PhaseDurations, d)
+}
+
+// observePhaseDurations exports durations written to the status
+func observePhaseDurations(durations []modelsv1alpha1.PhaseDuration) {
+	for _, d := range durations {
+		phaseDurationSeconds.WithLabelValues(d.Phase).Observe(d.Duration.Seconds())
+	}
+}
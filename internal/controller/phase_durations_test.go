@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var _ = Describe("Phase durations", func() {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "timed", Namespace: "default", CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+			},
+		}
+	}
+
+	// advance moves the model to a phase at a time, returning the recorded durations
+	advance := func(model *modelsv1alpha1.Model, phase modelsv1alpha1.ModelPhase, at time.Time) []modelsv1alpha1.PhaseDuration {
+		stored := model.Status.DeepCopy()
+		model.Status.Phase = phase
+		updateStages(model, at)
+		return updatePhaseDurations(model, stored, at)
+	}
+
+	durationOf := func(model *modelsv1alpha1.Model, phase string) time.Duration {
+		for _, d := range model.Status.PhaseDurations {
+			if d.Phase == phase {
+				return d.Duration.Duration
+			}
+		}
+		return -1
+	}
+
+	It("should record how long the model was pending and downloading", func() {
+		model := newModel()
+
+		Expect(advance(model, modelsv1alpha1.ModelPhasePending, created.Add(time.Second))).To(BeEmpty())
+		Expect(model.Status.PhaseTransitionTime.Time).To(Equal(created))
+
+		recorded := advance(model, modelsv1alpha1.ModelPhaseDownloading, created.Add(90*time.Second))
+		Expect(recorded).To(HaveLen(1))
+		Expect(durationOf(model, "Pending")).To(Equal(90 * time.Second))
+		Expect(model.Status.PhaseTransitionTime.Time).To(Equal(created.Add(90 * time.Second)))
+
+		// Progress within the phase records nothing
+		Expect(advance(model, modelsv1alpha1.ModelPhaseDownloading, created.Add(10*time.Minute))).To(BeEmpty())
+
+		recorded = advance(model, modelsv1alpha1.ModelPhaseReady, created.Add(time.Hour+90*time.Second))
+		Expect(recorded).To(Equal([]modelsv1alpha1.PhaseDuration{
+			{Phase: "Downloading", Duration: metav1.Duration{Duration: time.Hour}},
+		}))
+		Expect(durationOf(model, "Pending")).To(Equal(90 * time.Second))
+		Expect(durationOf(model, "Downloading")).To(Equal(time.Hour))
+	})
+
+	It("should replace the durations of a new download", func() {
+		model := newModel()
+		advance(model, modelsv1alpha1.ModelPhaseDownloading, created.Add(time.Minute))
+		advance(model, modelsv1alpha1.ModelPhaseReady, created.Add(time.Hour))
+
+		resync := created.Add(24 * time.Hour)
+		advance(model, modelsv1alpha1.ModelPhasePending, resync)
+		advance(model, modelsv1alpha1.ModelPhaseDownloading, resync.Add(5*time.Second))
+		advance(model, modelsv1alpha1.ModelPhaseFailed, resync.Add(5*time.Minute))
+
+		Expect(model.Status.PhaseDurations).To(HaveLen(2))
+		Expect(durationOf(model, "Pending")).To(Equal(5 * time.Second))
+		Expect(durationOf(model, "Downloading")).To(Equal(5*time.Minute - 5*time.Second))
+	})
+
+	It("should export recorded durations", func() {
+		count := func() uint64 {
+			families, err := metrics.Registry.Gather()
+			Expect(err).NotTo(HaveOccurred())
+			for _, family := range families {
+				if family.GetName() != "model_operator_phase_duration_seconds" {
+					continue
+				}
+				for _, metric := range family.GetMetric() {
+					if metric.GetLabel()[0].GetValue() == "Publishing" {
+						return metric.GetHistogram().GetSampleCount()
+					}
+				}
+			}
+			return 0
+		}
+
+		before := count()
+		observePhaseDurations([]modelsv1alpha1.PhaseDuration{
+			{Phase: "Publishing", Duration: metav1.Duration{Duration: time.Minute}},
+		})
+		Expect(count()).To(Equal(before + 1))
+	})
+})
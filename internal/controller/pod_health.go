@@ -0,0 +1,136 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// conditionTypeDownloadPodHealthy reports scheduling and image pull problems of the download pod
+	conditionTypeDownloadPodHealthy = "DownloadPodHealthy"
+
+	// reasonFailedScheduling mirrors the scheduler event reason
+	reasonFailedScheduling = "FailedScheduling"
+
+	// downloaderAppName is the app name label of download pods
+	downloaderAppName = "model-downloader"
+)
+
+// unhealthyWaitingReasons are container waiting reasons that will not resolve on their own
+var unhealthyWaitingReasons = map[string]bool{
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// downloadPodHealth inspects the download pods and returns the reason and
+// message of the first problem found, or an empty reason when healthy
+func downloadPodHealth(pods []corev1.Pod) (reason, message string) {
+	for _, pod := range pods {
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		for _, cond := range pod.Status.Conditions {
+			if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse &&
+				cond.Reason == corev1.PodReasonUnschedulable {
+				return reasonFailedScheduling, fmt.Sprintf("pod %s: %s", pod.Name, cond.Message)
+			}
+		}
+
+		statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+		statuses = append(statuses, pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if cs.State.Waiting != nil && unhealthyWaitingReasons[cs.State.Waiting.Reason] {
+				return cs.State.Waiting.Reason, fmt.Sprintf("pod %s container %s: %s",
+					pod.Name, cs.Name, cs.State.Waiting.Message)
+			}
+		}
+	}
+	return "", ""
+}
+
+// modelForDownloadPod maps a download pod to its Model, so scheduling and
+// image pull problems are reported without waiting for the Job to change
+func modelForDownloadPod(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	if labels["app.kubernetes.io/name"] != downloaderAppName || labels["app.kubernetes.io/managed-by"] != "model-operator" ||
+		labels["app.kubernetes.io/instance"] == "" {
+		return nil
+	}
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Name: labels["app.kubernetes.io/instance"], Namespace: obj.GetNamespace()},
+	}}
+}
+
+// listDownloadPods returns the pods of the Model's download Job
+func (r *ModelReconciler) listDownloadPods(ctx context.Context, model *modelsv1alpha1.Model) ([]corev1.Pod, error) {
+	return r.listJobPods(ctx, model, downloaderAppName)
+}
+
+// listJobPods returns the pods of one of the Model's Jobs, selected by app name
+func (r *ModelReconciler) listJobPods(ctx context.Context, model *modelsv1alpha1.Model, appName string) ([]corev1.Pod, error) {
+	pods := &corev1.PodList{}
+	if err := r.List(ctx, pods, client.InNamespace(model.Namespace), client.MatchingLabels{
+		"app.kubernetes.io/name":     appName,
+		"app.kubernetes.io/instance": model.Name,
+	}); err != nil {
+		return nil, err
+	}
+	return pods.Items, nil
+}
+
+// updateDownloadPodHealth sets the DownloadPodHealthy condition from the
+// current download pods. It returns true if the condition changed.
+func (r *ModelReconciler) updateDownloadPodHealth(ctx context.Context, model *modelsv1alpha1.Model) (bool, error) {
+	pods, err := r.listDownloadPods(ctx, model)
+	if err != nil {
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionTypeDownloadPodHealthy,
+		ObservedGeneration: model.Generation,
+	}
+	if reason, message := downloadPodHealth(pods); reason != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	} else {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = "Healthy"
+		condition.Message = "Download pod is scheduled and running"
+		if len(pods) == 0 {
+			condition.Message = "Download pod not created yet"
+		}
+	}
+
+	return meta.SetStatusCondition(&model.Status.Conditions, condition), nil
+}
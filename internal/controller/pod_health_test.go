@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("Download pod health", func() {
+	pod := func(status corev1.PodStatus) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "model-download-llama-abcde"},
+			Status:     status,
+		}
+	}
+
+	It("should be healthy with no pods", func() {
+		reason, _ := downloadPodHealth(nil)
+		Expect(reason).To(BeEmpty())
+	})
+
+	It("should be healthy for a running pod", func() {
+		reason, _ := downloadPodHealth([]corev1.Pod{pod(corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "downloader", State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}},
+			},
+		})})
+		Expect(reason).To(BeEmpty())
+	})
+
+	It("should report image pull failures", func() {
+		reason, message := downloadPodHealth([]corev1.Pod{pod(corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "downloader", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason:  "ImagePullBackOff",
+					Message: "Back-off pulling image \"python:3.11-slim\"",
+				}}},
+			},
+		})})
+		Expect(reason).To(Equal("ImagePullBackOff"))
+		Expect(message).To(ContainSubstring("downloader"))
+		Expect(message).To(ContainSubstring("python:3.11-slim"))
+	})
+
+	It("should report image pull failures of sidecars", func() {
+		reason, _ := downloadPodHealth([]corev1.Pod{pod(corev1.PodStatus{
+			InitContainerStatuses: []corev1.ContainerStatus{
+				{Name: "progress-reporter", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason: "ErrImagePull",
+				}}},
+			},
+		})})
+		Expect(reason).To(Equal("ErrImagePull"))
+	})
+
+	It("should report scheduling failures", func() {
+		reason, message := downloadPodHealth([]corev1.Pod{pod(corev1.PodStatus{
+			Phase: corev1.PodPending,
+			Conditions: []corev1.PodCondition{
+				{
+					Type:    corev1.PodScheduled,
+					Status:  corev1.ConditionFalse,
+					Reason:  corev1.PodReasonUnschedulable,
+					Message: "0/3 nodes are available: 3 node(s) had untolerated taint",
+				},
+			},
+		})})
+		Expect(reason).To(Equal(reasonFailedScheduling))
+		Expect(message).To(ContainSubstring("untolerated taint"))
+	})
+
+	It("should ignore transient waiting reasons", func() {
+		reason, _ := downloadPodHealth([]corev1.Pod{pod(corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "downloader", State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{
+					Reason: "ContainerCreating",
+				}}},
+			},
+		})})
+		Expect(reason).To(BeEmpty())
+	})
+
+	It("should map download pods to their Model", func() {
+		labels := map[string]string{
+			"app.kubernetes.io/name":       "model-downloader",
+			"app.kubernetes.io/instance":   "llama",
+			"app.kubernetes.io/managed-by": "model-operator",
+		}
+		downloadPod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "model-download-llama-abcde", Namespace: "ml", Labels: labels}}
+		Expect(modelForDownloadPod(context.Background(), downloadPod)).To(Equal([]reconcile.Request{{
+			NamespacedName: types.NamespacedName{Name: "llama", Namespace: "ml"},
+		}}))
+
+		otherPod := downloadPod.DeepCopy()
+		otherPod.Labels["app.kubernetes.io/name"] = "model-converter"
+		Expect(modelForDownloadPod(context.Background(), otherPod)).To(BeEmpty())
+		Expect(modelForDownloadPod(context.Background(), &corev1.Pod{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,64 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/presign"
+)
+
+// presignDownload presigns the download URLs of a model whose S3 source asks
+// for it with the credentials Secret, and hands only the URLs to the download
+// pod through its presigned Secret. It runs whenever a download Job is
+// created, so every retry starts with fresh URLs.
+func (r *ModelReconciler) presignDownload(ctx context.Context, model *modelsv1alpha1.Model) error {
+	s3 := model.Spec.Source.S3
+	if s3 == nil || s3.Presign == nil {
+		return nil
+	}
+	if r.Presigner == nil {
+		return errors.New("no presigner is configured")
+	}
+
+	creds, err := r.sourceCredentials(ctx, model)
+	if err != nil {
+		return err
+	}
+	expiry := time.Duration(s3.Presign.ExpirySeconds) * time.Second
+	if expiry <= 0 {
+		expiry = presign.DefaultExpiry
+	}
+	files, err := r.Presigner.Presign(ctx, s3, creds, expiry)
+	if err != nil {
+		return err
+	}
+
+	secret := resources.BuildPresignedSecret(model, files)
+	if err := controllerutil.SetControllerReference(model, secret, r.Scheme); err != nil {
+		return err
+	}
+	logf.FromContext(ctx).Info("Presigned download URLs", "files", len(files), "expiry", expiry.String())
+	return r.apply(ctx, secret)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/estimate"
+	"github.com/rsJames-ttrpg/model-operator/pkg/presign"
+)
+
+// fakePresigner returns fixed files or an error, recording what it was asked
+type fakePresigner struct {
+	files  []presign.File
+	err    error
+	creds  estimate.Credentials
+	expiry time.Duration
+}
+
+func (f *fakePresigner) Presign(_ context.Context, _ *modelsv1alpha1.S3Source, creds estimate.Credentials, expiry time.Duration) ([]presign.File, error) {
+	f.creds, f.expiry = creds, expiry
+	return f.files, f.err
+}
+
+var _ = Describe("Presigned S3 downloads", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	reconcilePending := func(name string, presigner *fakePresigner) (client.Client, *modelsv1alpha1.Model) {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					S3: &modelsv1alpha1.S3Source{
+						Bucket:  "model-bucket",
+						Key:     "models/llama/",
+						Presign: &modelsv1alpha1.S3Presign{ExpirySeconds: 900},
+					},
+				},
+				Storage:           modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				CredentialsSecret: "aws-credentials",
+			},
+		}
+		credentials := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-credentials", Namespace: namespace},
+			Data: map[string][]byte{
+				"AWS_ACCESS_KEY_ID":     []byte("AKID"),
+				"AWS_SECRET_ACCESS_KEY": []byte("secret"),
+			},
+		}
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, credentials).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Presigner: presigner}
+
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return c, model
+	}
+
+	It("should hand only the presigned URLs to the download Job", func() {
+		presigner := &fakePresigner{files: []presign.File{
+			{Path: "config.json", URL: "https://model-bucket.s3.us-east-1.amazonaws.com/models/llama/config.json?X-Amz-Signature=a"},
+		}}
+		c, model := reconcilePending("presigned-model", presigner)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+
+		// The operator signs with the credentials Secret and the requested expiry
+		Expect(presigner.creds).To(HaveKeyWithValue("AWS_ACCESS_KEY_ID", "AKID"))
+		Expect(presigner.expiry).To(Equal(15 * time.Minute))
+
+		secret := &corev1.Secret{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.PresignedSecretName(model.Name), Namespace: namespace}, secret)).To(Succeed())
+		Expect(string(secret.Data[resources.PresignedURLsKey])).To(ContainSubstring("X-Amz-Signature=a config.json"))
+		Expect(secret.OwnerReferences).To(HaveLen(1))
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: namespace}, job)).To(Succeed())
+		for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+			Expect(env.Name).NotTo(HavePrefix("AWS_"))
+		}
+	})
+
+	It("should stay Pending without a Job when presigning fails", func() {
+		c, model := reconcilePending("unsigned-model", &fakePresigner{err: errors.New("access denied")})
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(ContainSubstring("access denied"))
+
+		err := c.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,77 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// recordProvisionedBy records the operator version, downloader image and
+// download script that produced the content in the Model status.
+// The status change is persisted by the caller's next status update.
+func (r *ModelReconciler) recordProvisionedBy(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) error {
+	pods, err := r.listDownloadPods(ctx, model)
+	if err != nil {
+		return err
+	}
+	model.Status.ProvisionedBy = provisionedBy(job, pods, r.Config.OperatorVersion)
+	return nil
+}
+
+// provisionedBy describes what ran the download Job, preferring the image
+// digest the kubelet reported for a succeeded pod over the image reference
+func provisionedBy(job *batchv1.Job, pods []corev1.Pod, version string) *modelsv1alpha1.ProvisionedBy {
+	provisioned := &modelsv1alpha1.ProvisionedBy{OperatorVersion: version}
+
+	for _, c := range job.Spec.Template.Spec.Containers {
+		if c.Name != downloaderContainerName {
+			continue
+		}
+		provisioned.DownloaderImage = c.Image
+		provisioned.ScriptHash = scriptHash(c)
+	}
+
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodSucceeded {
+			continue
+		}
+		for _, cs := range pod.Status.ContainerStatuses {
+			if cs.Name == downloaderContainerName && strings.Contains(cs.ImageID, "@sha256:") {
+				provisioned.DownloaderImage = strings.TrimPrefix(cs.ImageID, "docker-pullable://")
+			}
+		}
+	}
+	return provisioned
+}
+
+// scriptHash returns the sha256 digest of a container's command and arguments
+func scriptHash(c corev1.Container) string {
+	sum := sha256.New()
+	for _, arg := range append(append([]string{}, c.Command...), c.Args...) {
+		sum.Write([]byte(arg))
+		sum.Write([]byte{0})
+	}
+	return "sha256:" + hex.EncodeToString(sum.Sum(nil))
+}
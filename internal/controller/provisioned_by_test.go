@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Provisioned by", func() {
+	const (
+		namespace = "default"
+		name      = "provenance"
+	)
+
+	ctx := context.Background()
+	imageID := "docker.io/curlimages/curl@sha256:" + strings.Repeat("0b", 32)
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseDownloading, PVCName: resources.PVCName(name)},
+		}
+	}
+
+	downloadPod := func(phase corev1.PodPhase, imageID string) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resources.JobName(name) + "-" + string(phase),
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":     downloaderAppName,
+					"app.kubernetes.io/instance": name,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase:             phase,
+				ContainerStatuses: []corev1.ContainerStatus{{Name: downloaderContainerName, ImageID: imageID}},
+			},
+		}
+	}
+
+	It("should prefer the image digest of the succeeded pod", func() {
+		job, err := resources.BuildDownloadJob(newModel(), resources.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		pods := []corev1.Pod{downloadPod(corev1.PodFailed, "docker.io/curlimages/curl@sha256:stale"), downloadPod(corev1.PodSucceeded, imageID)}
+
+		provisioned := provisionedBy(job, pods, "v1.2.3")
+		Expect(provisioned.OperatorVersion).To(Equal("v1.2.3"))
+		Expect(provisioned.DownloaderImage).To(Equal(imageID))
+		Expect(provisioned.ScriptHash).To(HavePrefix("sha256:"))
+
+		// Without a reported digest the image reference is kept
+		provisioned = provisionedBy(job, nil, "v1.2.3")
+		Expect(provisioned.DownloaderImage).To(Equal(job.Spec.Template.Spec.Containers[0].Image))
+	})
+
+	It("should hash the download script", func() {
+		container := corev1.Container{Command: []string{"sh", "-c"}, Args: []string{"curl -o /models/model"}}
+		changed := container.DeepCopy()
+		changed.Args = []string{"curl -L -o /models/model"}
+		Expect(scriptHash(container)).To(Equal(scriptHash(*container.DeepCopy())))
+		Expect(scriptHash(container)).NotTo(Equal(scriptHash(*changed)))
+	})
+
+	It("should record what provisioned a completed download", func() {
+		model := newModel()
+		job, err := resources.BuildDownloadJob(model, resources.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		job.Status.Succeeded = 1
+		pod := downloadPod(corev1.PodSucceeded, imageID)
+
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, job, &pod).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Config: Config{OperatorVersion: "v1.2.3"}}
+
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		_, err = r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.ProvisionedBy).NotTo(BeNil())
+		Expect(model.Status.ProvisionedBy.OperatorVersion).To(Equal("v1.2.3"))
+		Expect(model.Status.ProvisionedBy.DownloaderImage).To(Equal(imageID))
+	})
+})
@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// conditionTypePublished reports whether the model is available as an OCI image
+	conditionTypePublished = "Published"
+
+	// annotationPublishKey records what a publish Job was created for
+	annotationPublishKey = "models.main-currents.news/publish-key"
+)
+
+// publishKey identifies a publication by the pushed reference and the content
+func publishKey(model *modelsv1alpha1.Model) string {
+	return model.Spec.Publish.Image + "|" + model.Status.ContentDigest
+}
+
+// isPublished reports whether the current content was pushed to spec.publish.image
+func isPublished(model *modelsv1alpha1.Model) bool {
+	pub := model.Status.Publication
+	return pub != nil && pub.Reference == model.Spec.Publish.Image && pub.ContentDigest == model.Status.ContentDigest
+}
+
+// setPublishedCondition records the publication state on the Model
+func setPublishedCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) bool {
+	return meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypePublished,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// setPublication records that the current content was pushed with digest
+func setPublication(ctx context.Context, model *modelsv1alpha1.Model, digest string) {
+	model.Status.Publication = &modelsv1alpha1.PublicationStatus{
+		Image:         resources.PublishedImage(model.Spec.Publish.Image, digest),
+		Reference:     model.Spec.Publish.Image,
+		ContentDigest: model.Status.ContentDigest,
+	}
+	logf.FromContext(ctx).Info("Model published", "image", model.Status.Publication.Image)
+	setPublishedCondition(model, metav1.ConditionTrue, "Published",
+		fmt.Sprintf("Published as %s", model.Status.Publication.Image))
+}
+
+// reconcilePublish pushes a Ready model to the registry as an OCI image and
+// records the pinned image in the status. It does not affect the Ready phase.
+func (r *ModelReconciler) reconcilePublish(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	if model.Spec.Publish == nil {
+		removed := meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypePublished)
+		if model.Status.Publication != nil || removed {
+			model.Status.Publication = nil
+			return r.writeStatus(ctx, model)
+		}
+		return nil
+	}
+
+	if isPublished(model) {
+		return nil
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.PublishJobName(model.Name), Namespace: model.Namespace}, job)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	// Replace a Job that published other content or to another reference
+	if err == nil && job.Annotations[annotationPublishKey] != publishKey(model) {
+		log.Info("Deleting outdated publish Job", "name", job.Name)
+		return client.IgnoreNotFound(r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)))
+	}
+
+	if apierrors.IsNotFound(err) {
+		job = resources.BuildPublishJob(model, r.Config.Resources)
+		job.Annotations = map[string]string{annotationPublishKey: publishKey(model)}
+		if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
+			return err
+		}
+		log.Info("Creating publish Job", "name", job.Name, "image", model.Spec.Publish.Image)
+		if err := r.apply(ctx, job); err != nil {
+			return err
+		}
+		setPublishedCondition(model, metav1.ConditionFalse, "Publishing",
+			fmt.Sprintf("Publishing to %s", model.Spec.Publish.Image))
+		return r.writeStatus(ctx, model)
+	}
+
+	if job.Status.Succeeded > 0 {
+		pods, err := r.listJobPods(ctx, model, "model-publisher")
+		if err != nil {
+			return err
+		}
+		digest := terminationDigest(pods, resources.PublisherContainerName)
+		if digest == "" {
+			if setPublishedCondition(model, metav1.ConditionFalse, "DigestUnavailable",
+				"Publish Job succeeded but did not report the image digest") {
+				return r.writeStatus(ctx, model)
+			}
+			return nil
+		}
+
+		setPublication(ctx, model, digest)
+		return r.writeStatus(ctx, model)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			// Deleting the Job retries the publication
+			if setPublishedCondition(model, metav1.ConditionFalse, "PublishFailed", cond.Message) {
+				return r.writeStatus(ctx, model)
+			}
+		}
+	}
+	return nil
+}
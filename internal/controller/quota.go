@@ -0,0 +1,107 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// conditionTypeQuotaBlocked reports that creating the model PVC would
+	// exceed a ResourceQuota of its namespace
+	conditionTypeQuotaBlocked = "QuotaBlocked"
+
+	// reasonExceedsQuota is the QuotaBlocked condition reason
+	reasonExceedsQuota = "ExceedsQuota"
+)
+
+// storageClassQuotaSuffix is appended to a storage class name to form its
+// quota resource names
+const storageClassQuotaSuffix = ".storageclass.storage.k8s.io/"
+
+// pvcQuotaUsage returns the quota resources one more PVC like pvc would use
+func pvcQuotaUsage(pvc *corev1.PersistentVolumeClaim) corev1.ResourceList {
+	one := resource.MustParse("1")
+	storage := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	usage := corev1.ResourceList{
+		corev1.ResourcePersistentVolumeClaims: one,
+		"count/persistentvolumeclaims":        one,
+		corev1.ResourceRequestsStorage:        storage,
+	}
+	if class := pvc.Spec.StorageClassName; class != nil && *class != "" {
+		usage[corev1.ResourceName(*class+storageClassQuotaSuffix+string(corev1.ResourcePersistentVolumeClaims))] = one
+		usage[corev1.ResourceName(*class+storageClassQuotaSuffix+string(corev1.ResourceRequestsStorage))] = storage
+	}
+	return usage
+}
+
+// quotaExceeded returns why creating pvc would exceed a ResourceQuota of its
+// namespace, going by the usage the quota controller last recorded, or an
+// empty string if it fits
+func (r *ModelReconciler) quotaExceeded(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (string, error) {
+	quotas := &corev1.ResourceQuotaList{}
+	if err := r.List(ctx, quotas, client.InNamespace(pvc.Namespace)); err != nil {
+		return "", err
+	}
+
+	usage := pvcQuotaUsage(pvc)
+	for _, quota := range quotas.Items {
+		// Scoped quotas only select pods, or PVCs of a volume attributes class
+		if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+			continue
+		}
+		for name, requested := range usage {
+			hard, ok := quota.Status.Hard[name]
+			if !ok {
+				continue
+			}
+			used := quota.Status.Used[name]
+			total := used.DeepCopy()
+			total.Add(requested)
+			if total.Cmp(hard) > 0 {
+				return fmt.Sprintf("Creating PVC %s would exceed ResourceQuota %s: %s used %s of %s, requested %s",
+					pvc.Name, quota.Name, name, used.String(), hard.String(), requested.String()), nil
+			}
+		}
+	}
+	return "", nil
+}
+
+// setQuotaBlocked records on the Model why its PVC is held back, or removes
+// the QuotaBlocked condition when message is empty
+func setQuotaBlocked(model *modelsv1alpha1.Model, message string) {
+	if message == "" {
+		meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeQuotaBlocked)
+		return
+	}
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeQuotaBlocked,
+		Status:             metav1.ConditionTrue,
+		Reason:             reasonExceedsQuota,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("PVC quota back-pressure", func() {
+	const (
+		namespace = "default"
+		name      = "quota"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+	pvcKey := types.NamespacedName{Name: resources.PVCName(name), Namespace: namespace}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "20Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	newQuota := func(hard, used corev1.ResourceList) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "storage", Namespace: namespace},
+			Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+			Status:     corev1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should hold the PVC while it would exceed the storage quota", func() {
+		c := newClient(newModel(), newQuota(
+			corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("100Gi")},
+			corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("90Gi")},
+		))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeQuotaBlocked)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonExceedsQuota))
+		Expect(cond.Message).To(ContainSubstring("requests.storage used 90Gi of 100Gi, requested 20Gi"))
+
+		err := c.Get(ctx, pvcKey, &corev1.PersistentVolumeClaim{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		err = c.Get(ctx, types.NamespacedName{Name: resources.JobName(name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should hold the PVC at the storage class PVC count limit", func() {
+		count := corev1.ResourceName("standard.storageclass.storage.k8s.io/persistentvolumeclaims")
+		c := newClient(newModel(), newQuota(
+			corev1.ResourceList{count: resource.MustParse("2")},
+			corev1.ResourceList{count: resource.MustParse("2")},
+		))
+
+		model := reconcileModel(c)
+		Expect(meta.IsStatusConditionTrue(model.Status.Conditions, conditionTypeQuotaBlocked)).To(BeTrue())
+	})
+
+	It("should create the PVC once the quota has room", func() {
+		model := newModel()
+		setQuotaBlocked(model, "Creating PVC would exceed ResourceQuota storage")
+		c := newClient(model, newQuota(
+			corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("100Gi")},
+			corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse("50Gi")},
+		))
+
+		model = reconcileModel(c)
+		Expect(meta.FindStatusCondition(model.Status.Conditions, conditionTypeQuotaBlocked)).To(BeNil())
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(c.Get(ctx, pvcKey, &corev1.PersistentVolumeClaim{})).To(Succeed())
+	})
+})
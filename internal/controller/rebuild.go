@@ -0,0 +1,156 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// reconcileRebuild re-derives the status of a Model annotated with
+// AnnotationRebuildStatus. The download Job decides the phase while it
+// exists; otherwise a rebuild Job reads the completion marker on the PVC.
+func (r *ModelReconciler) reconcileRebuild(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: model.Namespace}, job)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to get Job")
+		return ctrl.Result{}, err
+	}
+	if err == nil {
+		recordDownloadTimes(model, job)
+		if job.Status.Succeeded == 0 {
+			for _, cond := range job.Status.Conditions {
+				if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+					return r.finishRebuild(ctx, model, modelsv1alpha1.ModelPhaseFailed,
+						fmt.Sprintf("Download failed: %s", cond.Message))
+				}
+			}
+			return r.finishRebuild(ctx, model, modelsv1alpha1.ModelPhaseDownloading, "Download in progress")
+		}
+
+		// The digest is only known while the download pods are kept
+		pods, err := r.listDownloadPods(ctx, model)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if digest := downloadContentDigest(pods); digest != "" {
+			model.Status.ContentDigest = digest
+			return r.finishRebuild(ctx, model, modelsv1alpha1.ModelPhaseReady, "Download complete")
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err = r.Get(ctx, types.NamespacedName{Name: resources.ClaimName(model), Namespace: model.Namespace}, pvc)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.finishRebuild(ctx, model, modelsv1alpha1.ModelPhasePending, "No PVC found, downloading")
+		}
+		log.Error(err, "Failed to get PVC")
+		return ctrl.Result{}, err
+	}
+
+	rebuildJob := &batchv1.Job{}
+	err = r.Get(ctx, types.NamespacedName{Name: resources.RebuildJobName(model.Name), Namespace: model.Namespace}, rebuildJob)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		rebuildJob = resources.BuildRebuildJob(model, r.Config.Resources)
+		if err := controllerutil.SetControllerReference(model, rebuildJob, r.Scheme); err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("Creating status rebuild Job", "name", rebuildJob.Name)
+		if err := r.apply(ctx, rebuildJob); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.pending()}, nil
+	}
+
+	phase, message := modelsv1alpha1.ModelPhase(""), ""
+	if rebuildJob.Status.Succeeded > 0 {
+		pods, err := r.listJobPods(ctx, model, "model-rebuild")
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if digest := terminationDigest(pods, resources.RebuildContainerName); digest != "" {
+			model.Status.ContentDigest = digest
+			phase, message = modelsv1alpha1.ModelPhaseReady, "Download complete"
+		}
+	}
+	for _, cond := range rebuildJob.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			phase, message = modelsv1alpha1.ModelPhasePending, "No complete download on the PVC, downloading"
+		}
+	}
+	if phase == "" {
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.pending()}, nil
+	}
+
+	if err := r.Delete(ctx, rebuildJob, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+		!apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	return r.finishRebuild(ctx, model, phase, message)
+}
+
+// finishRebuild writes the rebuilt status, then removes the rebuild
+// annotation and syncs the content digest label
+func (r *ModelReconciler) finishRebuild(ctx context.Context, model *modelsv1alpha1.Model,
+	phase modelsv1alpha1.ModelPhase, message string) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	log.Info("Rebuilt Model status", "phase", phase, "contentDigest", model.Status.ContentDigest)
+
+	progress := 0
+	if phase == modelsv1alpha1.ModelPhaseReady {
+		progress = 100
+	}
+	result, err := r.updateStatusWithProgress(ctx, model, phase, "Status rebuilt: "+message, progress)
+	if err != nil {
+		return result, err
+	}
+
+	patch := client.MergeFrom(model.DeepCopy())
+	delete(model.Annotations, modelsv1alpha1.AnnotationRebuildStatus)
+	if model.Status.ContentDigest != "" {
+		if model.Labels == nil {
+			model.Labels = map[string]string{}
+		}
+		model.Labels[modelsv1alpha1.LabelContentDigest] = marker.ShortDigest(model.Status.ContentDigest)
+	}
+	if err := r.Patch(ctx, model, patch); err != nil {
+		log.Error(err, "Failed to remove the rebuild annotation")
+		return ctrl.Result{}, err
+	}
+	return result, nil
+}
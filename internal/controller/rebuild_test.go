@@ -0,0 +1,184 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+var _ = Describe("Status rebuild", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	digest := "sha256:" + strings.Repeat("0b", 32)
+
+	// newModel returns a Model with a wiped status and the rebuild annotation
+	newModel := func(name string) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Namespace:   namespace,
+				Generation:  1,
+				Annotations: map[string]string{modelsv1alpha1.AnnotationRebuildStatus: "true"},
+			},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+		}
+	}
+
+	newPVC := func(model string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(model), Namespace: namespace},
+		}
+	}
+
+	// succeededPod returns a pod of the named Job that reported digest
+	succeededPod := func(name, appName, model, container string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":     appName,
+					"app.kubernetes.io/instance": model,
+				},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  container,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: digest}},
+				}},
+			},
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should take the digest from a succeeded download Job", func() {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName("restored-model"), Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		}
+		c := newClient(newModel("restored-model"), newPVC("restored-model"), job,
+			succeededPod("download", "model-downloader", "restored-model", downloaderContainerName))
+
+		model := reconcileModel(c, "restored-model")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.ContentDigest).To(Equal(digest))
+		Expect(model.Status.PVCName).To(Equal(resources.PVCName("restored-model")))
+		Expect(model.Annotations).NotTo(HaveKey(modelsv1alpha1.AnnotationRebuildStatus))
+		Expect(model.Labels).To(HaveKeyWithValue(modelsv1alpha1.LabelContentDigest, marker.ShortDigest(digest)))
+	})
+
+	It("should keep a running download Job", func() {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName("running-model"), Namespace: namespace},
+			Status:     batchv1.JobStatus{Active: 1},
+		}
+		c := newClient(newModel("running-model"), newPVC("running-model"), job)
+
+		model := reconcileModel(c, "running-model")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+	})
+
+	It("should read the completion marker on the PVC once the Jobs are gone", func() {
+		c := newClient(newModel("backup-model"), newPVC("backup-model"))
+
+		model := reconcileModel(c, "backup-model")
+		Expect(model.Status.Phase).To(BeEmpty())
+		Expect(model.Annotations).To(HaveKey(modelsv1alpha1.AnnotationRebuildStatus))
+
+		rebuildJob := &batchv1.Job{}
+		key := types.NamespacedName{Name: resources.RebuildJobName("backup-model"), Namespace: namespace}
+		Expect(c.Get(ctx, key, rebuildJob)).To(Succeed())
+		Expect(rebuildJob.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName).
+			To(Equal(resources.PVCName("backup-model")))
+
+		rebuildJob.Status.Succeeded = 1
+		Expect(c.Status().Update(ctx, rebuildJob)).To(Succeed())
+		Expect(c.Create(ctx, succeededPod("rebuild", "model-rebuild", "backup-model", resources.RebuildContainerName))).
+			To(Succeed())
+
+		model = reconcileModel(c, "backup-model")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.ContentDigest).To(Equal(digest))
+		Expect(model.Annotations).NotTo(HaveKey(modelsv1alpha1.AnnotationRebuildStatus))
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &batchv1.Job{}))).To(BeTrue())
+	})
+
+	It("should download again when the PVC holds no complete download", func() {
+		rebuildJob := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.RebuildJobName("partial-model"), Namespace: namespace},
+			Status: batchv1.JobStatus{
+				Failed:     1,
+				Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue}},
+			},
+		}
+		c := newClient(newModel("partial-model"), newPVC("partial-model"), rebuildJob)
+
+		model := reconcileModel(c, "partial-model")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(ContainSubstring("No complete download"))
+	})
+
+	It("should download again when the PVC is gone", func() {
+		c := newClient(newModel("lost-model"))
+
+		model := reconcileModel(c, "lost-model")
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Annotations).NotTo(HaveKey(modelsv1alpha1.AnnotationRebuildStatus))
+	})
+})
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// storageFinalizer keeps the PVCs of a Model with the Retain reclaim policy
+// when it is deleted, by releasing them from its owner references before the
+// garbage collector gets to them
+const storageFinalizer = "models.main-currents.news/storage"
+
+// retainsStorage reports whether deleting the Model keeps its PVCs
+func retainsStorage(model *modelsv1alpha1.Model) bool {
+	return model.Spec.Storage.ReclaimPolicy == modelsv1alpha1.ReclaimPolicyRetain &&
+		resources.UsesPVC(model) && model.Spec.Storage.Local == nil
+}
+
+// reconcileReclaimPolicy holds the deletion of Models that retain their PVCs
+// with the storage finalizer, and releases the others
+func (r *ModelReconciler) reconcileReclaimPolicy(ctx context.Context, model *modelsv1alpha1.Model) error {
+	var changed bool
+	if retainsStorage(model) {
+		changed = controllerutil.AddFinalizer(model, storageFinalizer)
+	} else {
+		changed = controllerutil.RemoveFinalizer(model, storageFinalizer)
+	}
+	if !changed {
+		return nil
+	}
+	return r.Update(ctx, model)
+}
+
+// retainStorage releases the PVCs of a deleted Model that retains them from
+// its owner references, then removes the storage finalizer. Foreground
+// deletion removes the PVCs before this runs.
+func (r *ModelReconciler) retainStorage(ctx context.Context, model *modelsv1alpha1.Model) error {
+	log := logf.FromContext(ctx)
+
+	if retainsStorage(model) {
+		pvcs := &corev1.PersistentVolumeClaimList{}
+		if err := r.List(ctx, pvcs, client.InNamespace(model.Namespace),
+			client.MatchingLabels{"app.kubernetes.io/instance": model.Name}); err != nil {
+			return err
+		}
+		for i := range pvcs.Items {
+			pvc := &pvcs.Items[i]
+			if !metav1.IsControlledBy(pvc, model) {
+				continue
+			}
+			base := pvc.DeepCopy()
+			if err := controllerutil.RemoveOwnerReference(model, pvc, r.Scheme); err != nil {
+				return err
+			}
+			log.Info("Retaining PVC of deleted Model", "name", pvc.Name)
+			if err := r.Patch(ctx, pvc, client.MergeFrom(base)); client.IgnoreNotFound(err) != nil {
+				return err
+			}
+			r.eventf(model, corev1.EventTypeNormal, reasonPVCRetained, "Retained PVC %s", pvc.Name)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(model, storageFinalizer)
+	return r.Update(ctx, model)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Storage reclaim policy", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: "keeper", Namespace: namespace}
+	pvcKey := types.NamespacedName{Name: resources.PVCName(key.Name), Namespace: namespace}
+
+	newModel := func(policy modelsv1alpha1.ReclaimPolicy, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: namespace, UID: "keeper-uid", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi", ReclaimPolicy: policy},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	// newPVC returns the Model's PVC, controlled by owner if set
+	newPVC := func(owner *modelsv1alpha1.Model) *corev1.PersistentVolumeClaim {
+		pvc, err := resources.BuildPVC(newModel(modelsv1alpha1.ReclaimPolicyRetain, ""))
+		Expect(err).NotTo(HaveOccurred())
+		if owner != nil {
+			Expect(controllerutil.SetControllerReference(owner, pvc, scheme.Scheme)).To(Succeed())
+		}
+		return pvc
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client, recorder record.EventRecorder) {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Recorder: recorder}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should hold the deletion of a Model that retains its PVCs", func() {
+		c := newClient(newModel(modelsv1alpha1.ReclaimPolicyRetain, modelsv1alpha1.ModelPhasePending))
+		reconcileModel(c, nil)
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		Expect(model.Finalizers).To(ContainElement(storageFinalizer))
+
+		// Switching back to Delete releases it
+		model.Spec.Storage.ReclaimPolicy = modelsv1alpha1.ReclaimPolicyDelete
+		Expect(c.Update(ctx, model)).To(Succeed())
+		reconcileModel(c, nil)
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		Expect(model.Finalizers).NotTo(ContainElement(storageFinalizer))
+	})
+
+	It("should release the PVC from a deleted Model that retains it", func() {
+		model := newModel(modelsv1alpha1.ReclaimPolicyRetain, modelsv1alpha1.ModelPhaseReady)
+		model.Finalizers = []string{storageFinalizer}
+		model.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+		recorder := record.NewFakeRecorder(10)
+		c := newClient(model, newPVC(model))
+
+		reconcileModel(c, recorder)
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, pvcKey, pvc)).To(Succeed())
+		Expect(pvc.OwnerReferences).To(BeEmpty())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &modelsv1alpha1.Model{}))).To(BeTrue())
+		Expect(recorder.Events).To(Receive(Equal("Normal PVCRetained Retained PVC " + pvcKey.Name)))
+	})
+
+	It("should leave the PVC to the garbage collector once the policy is Delete", func() {
+		model := newModel(modelsv1alpha1.ReclaimPolicyDelete, modelsv1alpha1.ModelPhaseReady)
+		model.Finalizers = []string{storageFinalizer}
+		model.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+		c := newClient(model, newPVC(model))
+
+		reconcileModel(c, nil)
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, pvcKey, pvc)).To(Succeed())
+		Expect(metav1.IsControlledBy(pvc, model)).To(BeTrue())
+	})
+
+	It("should adopt a retained PVC", func() {
+		model := newModel(modelsv1alpha1.ReclaimPolicyRetain, modelsv1alpha1.ModelPhasePending)
+		recorder := record.NewFakeRecorder(10)
+		c := newClient(model, newPVC(nil))
+
+		reconcileModel(c, recorder)
+
+		pvc := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, pvcKey, pvc)).To(Succeed())
+		Expect(metav1.IsControlledBy(pvc, model)).To(BeTrue())
+		Expect(recorder.Events).To(Receive(Equal("Normal Adopted Adopted PVC " + pvcKey.Name)))
+	})
+
+})
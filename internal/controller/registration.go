@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/registration"
+)
+
+const (
+	// conditionTypeRegistered reports the registration with spec.registration.webhook
+	conditionTypeRegistered = "Registered"
+
+	// Registered condition reasons, also used for the Events of each attempt
+	reasonRegistering        = "Registering"
+	reasonRegistrationFailed = "RegistrationFailed"
+	reasonRegistered         = "Registered"
+
+	// Backoff between failed registration attempts, doubling up to the maximum
+	registrationBackoffBase = 10 * time.Second
+	registrationBackoffMax  = 10 * time.Minute
+)
+
+// registers reports whether the Model asks to be registered before it is Ready
+func registers(model *modelsv1alpha1.Model) bool {
+	return model.Spec.Registration != nil
+}
+
+// registered reports whether the current download was registered with the
+// current webhook
+func registered(model *modelsv1alpha1.Model) bool {
+	status := model.Status.Registration
+	return status != nil && status.RegisteredTime != nil &&
+		status.URL == model.Spec.Registration.Webhook.URL &&
+		status.ContentDigest == model.Status.ContentDigest
+}
+
+// registrationInProgress reports whether the registration of the current
+// download was started, so it can continue after the download Job is
+// cleaned up. Registration only starts once verification and conversion are done.
+func registrationInProgress(model *modelsv1alpha1.Model) bool {
+	cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeRegistered)
+	return registers(model) && cond != nil && cond.Status == metav1.ConditionFalse
+}
+
+// setRegisteredCondition records the registration state on the Model
+func setRegisteredCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeRegistered,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// clearRegistration drops the recorded registration and Registered
+// condition, so the next download is registered again
+func clearRegistration(model *modelsv1alpha1.Model) {
+	model.Status.Registration = nil
+	meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeRegistered)
+}
+
+// registrationBackoff returns how long to wait after the given number of
+// failed attempts
+func registrationBackoff(failed int32) time.Duration {
+	backoff := registrationBackoffBase
+	for i := int32(1); i < failed && backoff < registrationBackoffMax; i++ {
+		backoff *= 2
+	}
+	return min(backoff, registrationBackoffMax)
+}
+
+// readyMessage returns the message of a Model that became Ready
+func readyMessage(model *modelsv1alpha1.Model) string {
+	if model.Status.Conversion != nil {
+		return "Download and conversion complete"
+	}
+	return "Download complete"
+}
+
+// markReady moves the Model to Ready once everything after the download is
+// done, registering it first when spec.registration asks for it
+func (r *ModelReconciler) markReady(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
+	if registers(model) && !registered(model) {
+		return r.reconcileRegistration(ctx, model)
+	}
+	return r.updateStatusWithProgress(ctx, model, modelsv1alpha1.ModelPhaseReady, readyMessage(model), 100)
+}
+
+// reconcileRegistration calls the registration webhook and moves the Model
+// to Ready once it accepts the download. Failed calls keep the Model
+// Downloading and are retried with exponential backoff.
+func (r *ModelReconciler) reconcileRegistration(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	url := model.Spec.Registration.Webhook.URL
+
+	if model.Status.Registration == nil {
+		model.Status.Registration = &modelsv1alpha1.RegistrationStatus{}
+	}
+	status := model.Status.Registration
+
+	// Wait out the backoff of the last failed attempt
+	if status.FailedAttempts > 0 && status.LastAttemptTime != nil {
+		if wait := time.Until(status.LastAttemptTime.Add(registrationBackoff(status.FailedAttempts))); wait > 0 {
+			return ctrl.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	now := metav1.Now()
+	status.LastAttemptTime = &now
+	if err := r.register(ctx, model); err != nil {
+		status.FailedAttempts++
+		wait := registrationBackoff(status.FailedAttempts)
+		message := fmt.Sprintf("Registration with %s failed (attempt %d), retrying in %s: %v",
+			url, status.FailedAttempts, wait, err)
+		log.Info("Registration failed, retrying", "url", url, "attempts", status.FailedAttempts, "error", err.Error())
+		setRegisteredCondition(model, metav1.ConditionFalse, reasonRegistrationFailed, message)
+		r.event(model, corev1.EventTypeWarning, reasonRegistrationFailed, message)
+		if _, err := r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: wait}, nil
+	}
+
+	log.Info("Registered model", "url", url, "digest", model.Status.ContentDigest)
+	status.URL = url
+	status.ContentDigest = model.Status.ContentDigest
+	status.RegisteredTime = &now
+	status.FailedAttempts = 0
+	setRegisteredCondition(model, metav1.ConditionTrue, reasonRegistered, fmt.Sprintf("Registered with %s", url))
+	r.eventf(model, corev1.EventTypeNormal, reasonRegistered, "Registered with %s", url)
+	return r.updateStatusWithProgress(ctx, model, modelsv1alpha1.ModelPhaseReady, readyMessage(model), 100)
+}
+
+// register calls the registration webhook with the Model's digest and location
+func (r *ModelReconciler) register(ctx context.Context, model *modelsv1alpha1.Model) error {
+	if r.Registrar == nil {
+		return errors.New("no registrar is configured")
+	}
+
+	webhook := model.Spec.Registration.Webhook
+	var token string
+	if ref := webhook.TokenSecret; ref != nil {
+		secret := &corev1.Secret{}
+		if err := r.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: model.Namespace}, secret); err != nil {
+			return fmt.Errorf("failed to read token Secret %s: %w", ref.Name, err)
+		}
+		value, ok := secret.Data[ref.Key]
+		if !ok {
+			return fmt.Errorf("token Secret %s has no key %s", ref.Name, ref.Key)
+		}
+		token = string(value)
+	}
+	return r.Registrar.Register(ctx, webhook.URL, token, registration.NewRequest(model))
+}
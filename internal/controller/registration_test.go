@@ -0,0 +1,181 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/registration"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// fakeRegistrar records the registrations and fails them with err if set
+type fakeRegistrar struct {
+	err      error
+	tokens   []string
+	requests []registration.Request
+}
+
+func (f *fakeRegistrar) Register(_ context.Context, _, token string, req registration.Request) error {
+	f.tokens = append(f.tokens, token)
+	f.requests = append(f.requests, req)
+	return f.err
+}
+
+var _ = Describe("Registration", func() {
+	const (
+		namespace = "default"
+		name      = "cataloged"
+		url       = "https://catalog.example.com/models"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+
+	newModel := func(phase modelsv1alpha1.ModelPhase, conditions ...metav1.Condition) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.safetensors"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				Registration: &modelsv1alpha1.RegistrationSpec{
+					Webhook: modelsv1alpha1.RegistrationWebhook{
+						URL: url,
+						TokenSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "catalog"},
+							Key:                  "token",
+						},
+					},
+				},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase, PVCName: resources.PVCName(name), Conditions: conditions},
+		}
+	}
+
+	failed := metav1.Condition{Type: conditionTypeRegistered, Status: metav1.ConditionFalse, Reason: reasonRegistrationFailed}
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "catalog", Namespace: namespace},
+		Data:       map[string][]byte{"token": []byte("s3cret")},
+	}
+
+	downloadJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(name), Namespace: namespace},
+		Status:     batchv1.JobStatus{Succeeded: 1},
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client, registrar registration.Registrar) (*modelsv1alpha1.Model, reconcile.Result) {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme, Registrar: registrar}
+		result, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model, result
+	}
+
+	It("should register the model before it becomes Ready", func() {
+		registrar := &fakeRegistrar{}
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading), downloadJob.DeepCopy(), tokenSecret.DeepCopy())
+
+		model, _ := reconcileModel(c, registrar)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(meta.IsStatusConditionTrue(model.Status.Conditions, conditionTypeRegistered)).To(BeTrue())
+		Expect(model.Status.Registration.URL).To(Equal(url))
+		Expect(model.Status.Registration.RegisteredTime).NotTo(BeNil())
+
+		Expect(registrar.requests).To(HaveLen(1))
+		Expect(registrar.requests[0].Name).To(Equal(name))
+		Expect(registrar.requests[0].PVCName).To(Equal(resources.PVCName(name)))
+		Expect(registrar.tokens).To(Equal([]string{"s3cret"}))
+	})
+
+	It("should stay Downloading and back off while the webhook fails", func() {
+		registrar := &fakeRegistrar{err: errors.New("503 Service Unavailable")}
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading), downloadJob.DeepCopy(), tokenSecret.DeepCopy())
+
+		model, result := reconcileModel(c, registrar)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(model.Status.Message).To(ContainSubstring("503 Service Unavailable"))
+		Expect(model.Status.Registration.FailedAttempts).To(Equal(int32(1)))
+		Expect(result.RequeueAfter).To(Equal(registrationBackoffBase))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeRegistered)
+		Expect(cond.Reason).To(Equal(reasonRegistrationFailed))
+
+		// The next reconcile waits out the backoff
+		_, result = reconcileModel(c, registrar)
+		Expect(registrar.requests).To(HaveLen(1))
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+	})
+
+	It("should retry the registration after the download Job is cleaned up", func() {
+		model := newModel(modelsv1alpha1.ModelPhaseDownloading, failed)
+		model.Status.Registration = &modelsv1alpha1.RegistrationStatus{
+			FailedAttempts:  3,
+			LastAttemptTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+		}
+		registrar := &fakeRegistrar{}
+		c := newClient(model, tokenSecret.DeepCopy())
+
+		model, _ = reconcileModel(c, registrar)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.Registration.FailedAttempts).To(BeZero())
+		Expect(registrar.requests).To(HaveLen(1))
+	})
+
+	It("should register a Ready Model when registration is added", func() {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(name), Namespace: namespace},
+		}
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseReady), pvc)
+
+		model, _ := reconcileModel(c, &fakeRegistrar{})
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(registrationInProgress(model)).To(BeTrue())
+	})
+
+	It("should double the backoff up to its maximum", func() {
+		Expect(registrationBackoff(1)).To(Equal(registrationBackoffBase))
+		Expect(registrationBackoff(3)).To(Equal(4 * registrationBackoffBase))
+		Expect(registrationBackoff(50)).To(Equal(registrationBackoffMax))
+	})
+})
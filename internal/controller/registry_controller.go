@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/internal/sharding"
+)
+
+// RegistryReconciler keeps a model-registry Service in every namespace with
+// Models, pointing at the manager that serves the registry. Requests are
+// keyed by namespace alone.
+type RegistryReconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+
+	// Address is the pod address of this manager, which the registry
+	// Services point at
+	Address string
+	// Port the manager serves the registry on
+	Port int32
+
+	// Shard limits this replica to its share of the namespaces
+	Shard sharding.Shard
+}
+
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch;create;patch;delete
+
+// Reconcile creates the registry Service and EndpointSlice of a namespace
+// with Models, and removes them once its last Model is gone
+func (r *RegistryReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	namespace := req.Name
+
+	models := &modelsv1alpha1.ModelList{}
+	if err := r.List(ctx, models, client.InNamespace(namespace), client.Limit(1)); err != nil {
+		log.Error(err, "Failed to list Models")
+		return ctrl.Result{}, err
+	}
+
+	if len(models.Items) == 0 {
+		for _, obj := range []client.Object{
+			&discoveryv1.EndpointSlice{ObjectMeta: metav1.ObjectMeta{Name: resources.RegistryName, Namespace: namespace}},
+			&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: resources.RegistryName, Namespace: namespace}},
+		} {
+			if err := r.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+				log.Error(err, "Failed to remove the model registry")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if err := apply(ctx, r.Client, r.Scheme, resources.BuildRegistryService(namespace, r.Port)); err != nil {
+		log.Error(err, "Failed to apply the model registry Service")
+		return ctrl.Result{}, err
+	}
+	if err := apply(ctx, r.Client, r.Scheme,
+		resources.BuildRegistryEndpointSlice(namespace, r.Address, r.Port)); err != nil {
+		log.Error(err, "Failed to apply the model registry EndpointSlice")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// namespaceForModel maps a Model to its namespace
+func namespaceForModel(_ context.Context, obj client.Object) []reconcile.Request {
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: obj.GetNamespace()}}}
+}
+
+// modelCreatedOrDeleted passes Model creations and deletions only, which are
+// all that decide whether a namespace has a registry. Creations include those
+// seen on start, so a new leader points every registry at itself.
+var modelCreatedOrDeleted = predicate.Funcs{
+	UpdateFunc: func(event.UpdateEvent) bool { return false },
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RegistryReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("registry").
+		Watches(&modelsv1alpha1.Model{}, handler.EnqueueRequestsFromMapFunc(namespaceForModel),
+			builder.WithPredicates(modelCreatedOrDeleted)).
+		WithEventFilter(r.Shard.Predicate()).
+		Complete(r)
+}
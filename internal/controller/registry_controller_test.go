@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Registry Controller", func() {
+	const namespace = "registry-ns"
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: resources.RegistryName, Namespace: namespace}
+
+	It("should point the namespace's registry at the manager while it has Models", func() {
+		model := &modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: namespace}}
+		c := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(model).Build()
+		r := &RegistryReconciler{Client: c, Scheme: scheme.Scheme, Address: "10.0.0.9", Port: resources.DefaultRegistryPort}
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: namespace}}
+
+		_, err := r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		service := &corev1.Service{}
+		Expect(c.Get(ctx, key, service)).To(Succeed())
+		Expect(service.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+		Expect(service.Spec.Selector).To(BeEmpty())
+		Expect(service.Spec.Ports).To(HaveLen(1))
+		Expect(service.Spec.Ports[0].Port).To(Equal(int32(resources.DefaultRegistryPort)))
+
+		slice := &discoveryv1.EndpointSlice{}
+		Expect(c.Get(ctx, key, slice)).To(Succeed())
+		Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, resources.RegistryName))
+		Expect(slice.Endpoints).To(HaveLen(1))
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.0.0.9"))
+
+		// A new leader points the registry at itself
+		r.Address = "10.0.0.10"
+		_, err = r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, slice)).To(Succeed())
+		Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.0.0.10"))
+
+		// The registry goes with the namespace's last Model
+		Expect(c.Delete(ctx, model)).To(Succeed())
+		_, err = r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &corev1.Service{}))).To(BeTrue())
+		Expect(apierrors.IsNotFound(c.Get(ctx, key, &discoveryv1.EndpointSlice{}))).To(BeTrue())
+	})
+})
@@ -0,0 +1,208 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// reconcileReplicas keeps the storage replicas of a Ready Model downloaded
+// and removes the PVCs of replicas dropped from the spec
+func (r *ModelReconciler) reconcileReplicas(ctx context.Context, model *modelsv1alpha1.Model) error {
+	previous := make(map[string]modelsv1alpha1.ReplicaStatus, len(model.Status.Replicas))
+	for _, status := range model.Status.Replicas {
+		previous[status.Name] = status
+	}
+
+	var statuses []modelsv1alpha1.ReplicaStatus
+	for _, replica := range model.Spec.Replicas {
+		status, err := r.reconcileReplica(ctx, model, replica, previous[replica.Name])
+		if err != nil {
+			return fmt.Errorf("replica %s: %w", replica.Name, err)
+		}
+		statuses = append(statuses, status)
+	}
+
+	if err := r.deleteStaleReplicas(ctx, model); err != nil {
+		return err
+	}
+
+	model.Status.Replicas = statuses
+	return r.writeStatus(ctx, model)
+}
+
+// reconcileReplica creates the PVC and download Job of one replica and
+// reflects the Job in the replica status
+func (r *ModelReconciler) reconcileReplica(ctx context.Context, model *modelsv1alpha1.Model,
+	replica modelsv1alpha1.StorageReplica, status modelsv1alpha1.ReplicaStatus) (modelsv1alpha1.ReplicaStatus, error) {
+	log := logf.FromContext(ctx).WithValues("replica", replica.Name)
+
+	status.Name = replica.Name
+	status.PVCName = resources.ReplicaPVCName(model.Name, replica.Name)
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, types.NamespacedName{Name: status.PVCName, Namespace: model.Namespace}, pvc)
+	if apierrors.IsNotFound(err) {
+		pvc, err = resources.BuildReplicaPVC(model, replica)
+		if err != nil {
+			// Only this replica is affected
+			status.Phase = modelsv1alpha1.ModelPhaseFailed
+			status.Message = err.Error()
+			return status, nil
+		}
+		if err := controllerutil.SetControllerReference(model, pvc, r.Scheme); err != nil {
+			return status, err
+		}
+		log.Info("Creating replica PVC", "name", pvc.Name)
+		if err := r.apply(ctx, pvc); err != nil {
+			return status, err
+		}
+		status.Phase = modelsv1alpha1.ModelPhasePending
+	} else if err != nil {
+		return status, err
+	} else {
+		// Only this replica waits for a PVC that belongs to someone else
+		_, conflict, err := r.claim(ctx, model, "PVC", pvc)
+		if err != nil {
+			return status, err
+		}
+		if conflict != "" {
+			status.Phase = modelsv1alpha1.ModelPhasePending
+			status.Message = conflict
+			return status, nil
+		}
+	}
+
+	jobKey := types.NamespacedName{Name: resources.ReplicaJobName(model.Name, replica.Name), Namespace: model.Namespace}
+
+	// Refresh a replica left behind by a change of content
+	if status.Phase == modelsv1alpha1.ModelPhaseReady {
+		if status.ContentDigest == model.Status.ContentDigest {
+			return status, nil
+		}
+		log.Info("Model content changed, refreshing replica")
+		job := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobKey.Name, Namespace: jobKey.Namespace}}
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+			!apierrors.IsNotFound(err) {
+			return status, err
+		}
+		status.Phase = modelsv1alpha1.ModelPhasePending
+		status.Message = "Model content changed, refreshing replica"
+		return status, nil
+	}
+
+	job := &batchv1.Job{}
+	err = r.Get(ctx, jobKey, job)
+	if apierrors.IsNotFound(err) {
+		sourceModel := resources.ForSource(model, model.Status.SourceIndex)
+		job, err := resources.BuildReplicaJob(sourceModel, replica, r.Config.Resources)
+		if err != nil {
+			return status, err
+		}
+		if err := r.presignDownload(ctx, sourceModel); err != nil {
+			return status, err
+		}
+		if err := r.applyPatterns(ctx, sourceModel); err != nil {
+			return status, err
+		}
+		if err := r.useDownloaderServiceAccount(ctx, model, job); err != nil {
+			return status, err
+		}
+		if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
+			return status, err
+		}
+		log.Info("Creating replica download Job", "name", job.Name)
+		if err := r.apply(ctx, job); err != nil {
+			return status, err
+		}
+		status.Phase = modelsv1alpha1.ModelPhaseDownloading
+		status.Message = "Replica download started"
+		status.ContentDigest = model.Status.ContentDigest
+		return status, nil
+	}
+	if err != nil {
+		return status, err
+	}
+	if job.DeletionTimestamp != nil {
+		return status, nil
+	}
+
+	if job.Status.Succeeded > 0 {
+		status.Phase = modelsv1alpha1.ModelPhaseReady
+		status.Message = "Replica ready"
+		return status, nil
+	}
+
+	status.Phase = modelsv1alpha1.ModelPhaseDownloading
+	status.Message = "Replica download in progress"
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			// Deleting the Job retries the replica download
+			status.Phase = modelsv1alpha1.ModelPhaseFailed
+			status.Message = fmt.Sprintf("Replica download failed: %s", cond.Message)
+		}
+	}
+	return status, nil
+}
+
+// deleteStaleReplicas deletes the PVCs of replicas no longer in the spec,
+// once no Job holds a lease on the model
+func (r *ModelReconciler) deleteStaleReplicas(ctx context.Context, model *modelsv1alpha1.Model) error {
+	// A leased Job may still mount any replica
+	if len(model.Status.Leases) > 0 {
+		return nil
+	}
+
+	wanted := make(map[string]bool, len(model.Spec.Replicas))
+	for _, replica := range model.Spec.Replicas {
+		wanted[replica.Name] = true
+	}
+
+	pvcs := &corev1.PersistentVolumeClaimList{}
+	if err := r.List(ctx, pvcs, client.InNamespace(model.Namespace),
+		client.MatchingLabels{"app.kubernetes.io/instance": model.Name},
+		client.HasLabels{resources.LabelReplica}); err != nil {
+		return err
+	}
+
+	for i := range pvcs.Items {
+		pvc := &pvcs.Items[i]
+		if wanted[pvc.Labels[resources.LabelReplica]] || !metav1.IsControlledBy(pvc, model) {
+			continue
+		}
+		logf.FromContext(ctx).Info("Deleting replica PVC removed from spec", "name", pvc.Name)
+		if err := r.Delete(ctx, pvc); err != nil && !apierrors.IsNotFound(err) {
+			return err
+		}
+		r.eventf(model, corev1.EventTypeNormal, reasonPVCDeleted, "Deleted PVC %s of removed replica %s",
+			pvc.Name, pvc.Labels[resources.LabelReplica])
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Storage replicas", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	newReadyModel := func(name string) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1, UID: types.UID(name)},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "nfs", Size: "1Gi"},
+				Replicas: []modelsv1alpha1.StorageReplica{
+					{Name: "nvme", StorageClass: "local-nvme", Zone: "zone-a"},
+				},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseReady, ContentDigest: "sha256:abc"},
+		}
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	It("should download each replica into its own PVC", func() {
+		model := newReadyModel("replicated-model")
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(model.Name), Namespace: namespace},
+		}
+		c := newClient(model, pvc)
+
+		model = reconcileModel(c, model.Name)
+		Expect(model.Status.Replicas).To(HaveLen(1))
+		Expect(model.Status.Replicas[0].Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(model.Status.Replicas[0].PVCName).To(Equal("model-replica-replicated-model-nvme"))
+
+		replicaPVC := &corev1.PersistentVolumeClaim{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: "model-replica-replicated-model-nvme", Namespace: namespace},
+			replicaPVC)).To(Succeed())
+		Expect(*replicaPVC.Spec.StorageClassName).To(Equal("local-nvme"))
+
+		job := &batchv1.Job{}
+		jobKey := types.NamespacedName{Name: resources.ReplicaJobName(model.Name, "nvme"), Namespace: namespace}
+		Expect(c.Get(ctx, jobKey, job)).To(Succeed())
+
+		job.Status.Succeeded = 1
+		Expect(c.Status().Update(ctx, job)).To(Succeed())
+
+		model = reconcileModel(c, model.Name)
+		Expect(model.Status.Replicas[0].Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.Replicas[0].ContentDigest).To(Equal("sha256:abc"))
+	})
+
+	It("should delete the PVC of a replica removed from the spec", func() {
+		model := newReadyModel("shrunk-model")
+		model.Spec.Replicas = nil
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(model.Name), Namespace: namespace},
+		}
+		stale, err := resources.BuildReplicaPVC(model, modelsv1alpha1.StorageReplica{Name: "nvme", StorageClass: "local-nvme"})
+		Expect(err).NotTo(HaveOccurred())
+		stale.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: modelsv1alpha1.GroupVersion.String(),
+			Kind:       "Model",
+			Name:       model.Name,
+			UID:        model.UID,
+			Controller: ptr.To(true),
+		}}
+		c := newClient(model, pvc, stale)
+
+		model = reconcileModel(c, model.Name)
+		Expect(model.Status.Replicas).To(BeEmpty())
+
+		err = c.Get(ctx, client.ObjectKeyFromObject(stale), &corev1.PersistentVolumeClaim{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
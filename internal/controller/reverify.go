@@ -0,0 +1,225 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// conditionTypeLocalVerified reports the check of the local files after
+	// their node rebooted or their volume was recreated
+	conditionTypeLocalVerified = "LocalContentVerified"
+
+	// LocalContentVerified condition reasons
+	reasonReverifying    = "Reverifying"
+	reasonContentIntact  = "ContentIntact"
+	reasonContentChanged = "ContentChanged"
+
+	// reverifyAppName labels the pods of the re-verification Job
+	reverifyAppName = "model-reverify"
+)
+
+// reverifiesLocal reports whether the Model asks for its local files to be
+// checked after a node reboot or volume recreation
+func reverifiesLocal(model *modelsv1alpha1.Model) bool {
+	local := model.Spec.Storage.Local
+	return local != nil && local.Reverify
+}
+
+// setLocalVerifiedCondition records the re-verification state on the Model
+func setLocalVerifiedCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeLocalVerified,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// reconcileReverify checks the local files of a Ready Model with a
+// re-verification Job whenever the node's boot ID or the local PV's UID
+// differs from the ones recorded at the last check. It returns a message
+// when the files no longer match their manifest and the model must be
+// downloaded again.
+func (r *ModelReconciler) reconcileReverify(ctx context.Context, model *modelsv1alpha1.Model) (string, error) {
+	log := logf.FromContext(ctx)
+
+	if !reverifiesLocal(model) {
+		if model.Status.Local == nil && meta.FindStatusCondition(model.Status.Conditions, conditionTypeLocalVerified) == nil {
+			return "", nil
+		}
+		model.Status.Local = nil
+		meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeLocalVerified)
+		return "", r.writeStatus(ctx, model)
+	}
+	// A re-download would replace the files, so wait out a change freeze
+	if frozen(model) {
+		return "", nil
+	}
+
+	nodeName := model.Spec.Storage.Local.NodeName
+	node := &corev1.Node{}
+	if err := r.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+		// Nothing can be checked until the node registers again
+		return "", client.IgnoreNotFound(err)
+	}
+	pv := &corev1.PersistentVolume{}
+	if err := r.Get(ctx, types.NamespacedName{Name: resources.LocalPVName(model.Namespace, model.Name)}, pv); err != nil {
+		return "", client.IgnoreNotFound(err)
+	}
+	// Another Model's volume says nothing about this Model's files
+	if !ownsLocalPV(model, pv) {
+		return "", nil
+	}
+	current := modelsv1alpha1.LocalStorageStatus{NodeBootID: node.Status.NodeInfo.BootID, VolumeUID: string(pv.UID)}
+
+	// The download itself vouches for the files on the current boot and volume
+	if model.Status.Local == nil {
+		model.Status.Local = &current
+		return "", r.writeStatus(ctx, model)
+	}
+	if *model.Status.Local == current {
+		return "", nil
+	}
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.ReverifyJobName(model.Name), Namespace: model.Namespace}, job)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", err
+		}
+		job, err = resources.BuildLocalReverifyJob(model, r.Config.Resources)
+		if err != nil {
+			return "", err
+		}
+		if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
+			return "", err
+		}
+		log.Info("Node rebooted or local volume recreated, verifying files", "node", nodeName,
+			"bootID", current.NodeBootID, "volumeUID", current.VolumeUID)
+		if err := r.apply(ctx, job); err != nil {
+			return "", err
+		}
+		setLocalVerifiedCondition(model, metav1.ConditionFalse, reasonReverifying,
+			fmt.Sprintf("Verifying the files on node %s after a reboot or volume change", nodeName))
+		return "", r.writeStatus(ctx, model)
+	}
+
+	intact, done, err := r.reverifyResult(ctx, model, job)
+	if err != nil || !done {
+		return "", err
+	}
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+		return "", err
+	}
+
+	if intact {
+		log.Info("Local files verified", "node", nodeName)
+		model.Status.Local = &current
+		setLocalVerifiedCondition(model, metav1.ConditionTrue, reasonContentIntact,
+			fmt.Sprintf("Files on node %s match their manifest", nodeName))
+		return "", r.writeStatus(ctx, model)
+	}
+
+	// Remove the finished download Job so the model is downloaded again
+	log.Info("Local files changed, downloading again", "node", nodeName)
+	if err := r.deleteOwnedJob(ctx, model, resources.JobName(model.Name)); err != nil {
+		return "", err
+	}
+	message := fmt.Sprintf("Files on node %s no longer match their manifest", nodeName)
+	setLocalVerifiedCondition(model, metav1.ConditionFalse, reasonContentChanged, message)
+	return message, nil
+}
+
+// reverifyResult reports whether the re-verification Job has finished and,
+// if so, whether it found the files intact and matching the recorded digest
+func (r *ModelReconciler) reverifyResult(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) (intact, done bool, err error) {
+	if job.Status.Succeeded > 0 {
+		pods, err := r.listJobPods(ctx, model, reverifyAppName)
+		if err != nil {
+			return false, false, err
+		}
+		digest := terminationDigest(pods, resources.ReverifyContainerName)
+		return digest != "" && (model.Status.ContentDigest == "" || digest == model.Status.ContentDigest), true, nil
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return false, true, nil
+		}
+	}
+	return false, false, nil
+}
+
+// modelsOnNode maps a Node to the Models in this shard whose local files it
+// holds and asks to be verified after a reboot
+func (r *ModelReconciler) modelsOnNode(ctx context.Context, obj client.Object) []reconcile.Request {
+	models := &modelsv1alpha1.ModelList{}
+	if err := r.List(ctx, models); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list Models for node", "node", obj.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, model := range models.Items {
+		if reverifiesLocal(&model) && model.Spec.Storage.Local.NodeName == obj.GetName() && r.Shard.Owns(model.Namespace) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: model.Name, Namespace: model.Namespace},
+			})
+		}
+	}
+	return requests
+}
+
+// modelForLocalPV maps a local PersistentVolume to the Model it belongs to,
+// if that Model is in this shard
+func (r *ModelReconciler) modelForLocalPV(_ context.Context, obj client.Object) []reconcile.Request {
+	labels := obj.GetLabels()
+	namespace, name := labels[resources.LabelModelNamespace], labels[resources.LabelModelName]
+	if labels["app.kubernetes.io/managed-by"] != "model-operator" || name == "" || !r.Shard.Owns(namespace) {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}}}
+}
+
+// nodeRebooted passes node events that change the boot ID, so node status
+// heartbeats do not reconcile every local Model
+var nodeRebooted = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldNode, ok := e.ObjectOld.(*corev1.Node)
+		newNode, ok2 := e.ObjectNew.(*corev1.Node)
+		return ok && ok2 && oldNode.Status.NodeInfo.BootID != newNode.Status.NodeInfo.BootID
+	},
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Local storage re-verification", func() {
+	const (
+		namespace = "default"
+		name      = "local"
+		nodeName  = "homelab-1"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+	reverifyKey := types.NamespacedName{Name: resources.ReverifyJobName(name), Namespace: namespace}
+	digest := "sha256:" + strings.Repeat("0c", 32)
+	verified := &modelsv1alpha1.LocalStorageStatus{NodeBootID: "boot-1", VolumeUID: "pv-uid"}
+
+	newModel := func(local *modelsv1alpha1.LocalStorageStatus) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{
+					Size:  "1Gi",
+					Local: &modelsv1alpha1.LocalStorageSpec{NodeName: nodeName, Reverify: true},
+				},
+			},
+			Status: modelsv1alpha1.ModelStatus{
+				Phase:         modelsv1alpha1.ModelPhaseReady,
+				PVCName:       resources.PVCName(name),
+				ContentDigest: digest,
+				Local:         local,
+			},
+		}
+	}
+
+	node := func(bootID string) *corev1.Node {
+		n := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+		n.Status.NodeInfo.BootID = bootID
+		return n
+	}
+
+	storage := func() []client.Object {
+		return []client.Object{
+			&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(name), Namespace: namespace}},
+			&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{
+				Name: resources.LocalPVName(namespace, name),
+				UID:  "pv-uid",
+				Labels: map[string]string{
+					resources.LabelModelNamespace: namespace,
+					resources.LabelModelName:      name,
+				},
+			}},
+		}
+	}
+
+	reverifyJob := func(status batchv1.JobStatus) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: reverifyKey.Name, Namespace: namespace},
+			Status:     status,
+		}
+	}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(append(objs, storage()...)...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should trust the download on the boot it finished on", func() {
+		c := newClient(newModel(nil), node("boot-1"))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Local).To(Equal(verified))
+		err := c.Get(ctx, reverifyKey, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should verify the files after the node reboots", func() {
+		c := newClient(newModel(verified), node("boot-2"))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeLocalVerified)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonReverifying))
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, reverifyKey, job)).To(Succeed())
+		Expect(job.Spec.Template.Spec.NodeName).To(Equal(nodeName))
+	})
+
+	It("should record the new boot once the files match", func() {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      reverifyKey.Name + "-abcde",
+				Namespace: namespace,
+				Labels:    map[string]string{"app.kubernetes.io/name": reverifyAppName, "app.kubernetes.io/instance": name},
+			},
+			Status: corev1.PodStatus{
+				Phase: corev1.PodSucceeded,
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  resources.ReverifyContainerName,
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Message: digest}},
+				}},
+			},
+		}
+		c := newClient(newModel(verified), node("boot-2"), reverifyJob(batchv1.JobStatus{Succeeded: 1}), pod)
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.Local.NodeBootID).To(Equal("boot-2"))
+		Expect(meta.IsStatusConditionTrue(model.Status.Conditions, conditionTypeLocalVerified)).To(BeTrue())
+		err := c.Get(ctx, reverifyKey, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should download the model again when the files changed", func() {
+		download := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(name), Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		}
+		failed := reverifyJob(batchv1.JobStatus{
+			Failed: 1,
+			Conditions: []batchv1.JobCondition{{
+				Type:   batchv1.JobFailed,
+				Status: corev1.ConditionTrue,
+			}},
+		})
+		model := newModel(verified)
+		Expect(controllerutil.SetControllerReference(model, download, scheme.Scheme)).To(Succeed())
+		c := newClient(model, node("boot-2"), failed, download)
+
+		model = reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(ContainSubstring("no longer match their manifest"))
+		err := c.Get(ctx, types.NamespacedName{Name: resources.JobName(name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should map a node and a local PV to their Models", func() {
+		c := newClient(newModel(verified), node("boot-1"))
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+
+		Expect(r.modelsOnNode(ctx, node("boot-1"))).To(ConsistOf(reconcile.Request{NamespacedName: key}))
+		Expect(r.modelsOnNode(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "other"}})).To(BeEmpty())
+
+		pv, err := resources.BuildLocalPV(newModel(nil), resources.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.modelForLocalPV(ctx, pv)).To(ConsistOf(reconcile.Request{NamespacedName: key}))
+	})
+})
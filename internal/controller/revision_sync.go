@@ -0,0 +1,94 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// revisionOutdated reports whether spec.source.huggingFace.revision moved
+// away from the revision the content was downloaded at. Content downloaded
+// from a fallback is left alone, as is content downloaded before the
+// revision was recorded.
+func revisionOutdated(model *modelsv1alpha1.Model) bool {
+	if model.Spec.Source.HuggingFace == nil || model.Status.SourceIndex != 0 || model.Status.SourceRevision == "" {
+		return false
+	}
+	return model.Status.SourceRevision != resources.SourceRevision(model)
+}
+
+// syncRevision removes the finished download Job so the model is downloaded
+// again at the new revision. The new download reuses the files already on
+// the volume, fetching only the ones whose ETag changed. It returns the
+// status message for the sync.
+func (r *ModelReconciler) syncRevision(ctx context.Context, model *modelsv1alpha1.Model) (string, error) {
+	revision := resources.SourceRevision(model)
+	logf.FromContext(ctx).Info("Source revision changed, syncing",
+		"from", model.Status.SourceRevision, "to", revision)
+
+	if err := r.deleteOwnedJob(ctx, model, resources.JobName(model.Name)); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Syncing from revision %s to %s", model.Status.SourceRevision, revision), nil
+}
+
+// keptRevision returns the revision the content on the volume is at, to be
+// kept when a download of another revision succeeds, or nil if the model
+// keeps no revisions. It must be read before the download is recorded.
+func keptRevision(model *modelsv1alpha1.Model) *modelsv1alpha1.ModelRevision {
+	if model.Status.SourceRevision == "" || model.Status.SourceIndex != 0 {
+		return nil
+	}
+	return &modelsv1alpha1.ModelRevision{
+		Revision:      model.Status.SourceRevision,
+		ContentDigest: model.Status.ContentDigest,
+		Path:          resources.RevisionPath(model.Status.SourceRevision),
+	}
+}
+
+// recordRevisions updates status.revisions the way the download pod's
+// revisions container updated the volume: the replaced revision is kept, a
+// kept revision downloaded again is restored, and only the most recently
+// replaced revisions up to the limit remain
+func recordRevisions(model *modelsv1alpha1.Model, replaced *modelsv1alpha1.ModelRevision, now time.Time) {
+	limit := int(resources.RevisionHistoryLimit(model))
+	current := model.Status.SourceRevision
+
+	revisions := slices.DeleteFunc(slices.Clone(model.Status.Revisions), func(rev modelsv1alpha1.ModelRevision) bool {
+		return rev.Revision == current || (replaced != nil && rev.Revision == replaced.Revision)
+	})
+	if limit > 0 && replaced != nil && replaced.Revision != current {
+		replaced.ReplacedAt = metav1.NewTime(now).Rfc3339Copy()
+		revisions = append([]modelsv1alpha1.ModelRevision{*replaced}, revisions...)
+	}
+	if len(revisions) > limit {
+		revisions = revisions[:limit]
+	}
+	if len(revisions) == 0 {
+		revisions = nil
+	}
+	model.Status.Revisions = revisions
+}
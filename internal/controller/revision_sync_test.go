@@ -0,0 +1,167 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Revision sync", func() {
+	const (
+		namespace = "default"
+		name      = "synced"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+	jobKey := types.NamespacedName{Name: resources.JobName(name), Namespace: namespace}
+
+	newModel := func(annotations map[string]string) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 2, Annotations: annotations},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+						RepoID:   "sentence-transformers/all-MiniLM-L6-v2",
+						Revision: "v2",
+					},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{
+				Phase:          modelsv1alpha1.ModelPhaseReady,
+				PVCName:        resources.PVCName(name),
+				SourceRevision: "v1",
+			},
+		}
+	}
+
+	newClient := func(model *modelsv1alpha1.Model) client.Client {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(name), Namespace: namespace},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: jobKey.Name, Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		}
+		Expect(controllerutil.SetControllerReference(model, job, scheme.Scheme)).To(Succeed())
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, pvc, job).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should download again when the revision changes", func() {
+		c := newClient(newModel(nil))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.Message).To(Equal("Syncing from revision v1 to v2"))
+		err := c.Get(ctx, jobKey, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should hold the sync while frozen", func() {
+		c := newClient(newModel(map[string]string{modelsv1alpha1.AnnotationFreeze: "true"}))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeFrozen)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Message).To(ContainSubstring("held: sync to revision v2"))
+		Expect(c.Get(ctx, jobKey, &batchv1.Job{})).To(Succeed())
+	})
+
+	It("should leave content downloaded from a fallback alone", func() {
+		model := newModel(nil)
+		model.Status.SourceIndex = 1
+		Expect(revisionOutdated(model)).To(BeFalse())
+
+		model.Status.SourceIndex = 0
+		model.Status.SourceRevision = ""
+		Expect(revisionOutdated(model)).To(BeFalse())
+	})
+
+	It("should keep the replaced revision once the new one is downloaded", func() {
+		model := newModel(nil)
+		model.Spec.RevisionHistoryLimit = ptr.To(int32(2))
+		model.Status.Phase = modelsv1alpha1.ModelPhaseDownloading
+		model.Status.ContentDigest = "sha256:v1"
+		c := newClient(model)
+
+		model = reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(model.Status.SourceRevision).To(Equal("v2"))
+		Expect(model.Status.Revisions).To(HaveLen(1))
+		Expect(model.Status.Revisions[0].Revision).To(Equal("v1"))
+		Expect(model.Status.Revisions[0].ContentDigest).To(Equal("sha256:v1"))
+		Expect(model.Status.Revisions[0].Path).To(Equal(resources.RevisionPath("v1")))
+	})
+
+	It("should restore kept revisions and drop those over the limit", func() {
+		now := time.Now()
+		kept := func(revision string) modelsv1alpha1.ModelRevision {
+			return modelsv1alpha1.ModelRevision{Revision: revision, Path: resources.RevisionPath(revision)}
+		}
+		model := newModel(nil)
+		model.Spec.RevisionHistoryLimit = ptr.To(int32(2))
+		model.Status.Revisions = []modelsv1alpha1.ModelRevision{kept("v2"), kept("v0")}
+
+		// v1 is replaced by v2, which is restored from the kept revisions
+		replaced := keptRevision(model)
+		model.Status.SourceRevision = "v2"
+		recordRevisions(model, replaced, now)
+		Expect(model.Status.Revisions).To(HaveLen(2))
+		Expect(model.Status.Revisions[0].Revision).To(Equal("v1"))
+		Expect(model.Status.Revisions[1].Revision).To(Equal("v0"))
+
+		// A limit of 0 drops every kept revision
+		model.Spec.RevisionHistoryLimit = ptr.To(int32(0))
+		recordRevisions(model, nil, now)
+		Expect(model.Status.Revisions).To(BeNil())
+	})
+})
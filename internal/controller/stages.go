@@ -0,0 +1,131 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// stage derives one provisioning stage of Model.Status.Stages from the rest
+// of the status. A new stage is added here, in pipeline order.
+type stage struct {
+	name modelsv1alpha1.StageName
+	// applies reports whether the spec asks for the stage
+	applies func(model *modelsv1alpha1.Model) bool
+	// state returns the state of the stage and a message
+	state func(model *modelsv1alpha1.Model) (modelsv1alpha1.StageState, string)
+}
+
+var stages = []stage{
+	{
+		name:    modelsv1alpha1.StageDownload,
+		applies: func(*modelsv1alpha1.Model) bool { return true },
+		state:   downloadStageState,
+	},
+	{
+		name:    modelsv1alpha1.StageVerify,
+		applies: verifiesSignature,
+		state: func(model *modelsv1alpha1.Model) (modelsv1alpha1.StageState, string) {
+			return conditionStageState(model, conditionTypeVerified, reasonVerifying)
+		},
+	},
+	{
+		name:    modelsv1alpha1.StageConvert,
+		applies: func(model *modelsv1alpha1.Model) bool { return model.Spec.Conversion != nil },
+		state: func(model *modelsv1alpha1.Model) (modelsv1alpha1.StageState, string) {
+			return conditionStageState(model, conditionTypeConverted, reasonConverting)
+		},
+	},
+	{
+		name:    modelsv1alpha1.StagePublish,
+		applies: func(model *modelsv1alpha1.Model) bool { return model.Spec.Publish != nil },
+		state: func(model *modelsv1alpha1.Model) (modelsv1alpha1.StageState, string) {
+			return conditionStageState(model, conditionTypePublished, "Publishing")
+		},
+	},
+}
+
+// downloadStageState returns the state of the download, which succeeded once
+// a later stage started
+func downloadStageState(model *modelsv1alpha1.Model) (modelsv1alpha1.StageState, string) {
+	for _, conditionType := range []string{conditionTypeVerified, conditionTypeConverted} {
+		if meta.FindStatusCondition(model.Status.Conditions, conditionType) != nil {
+			return modelsv1alpha1.StageStateSucceeded, ""
+		}
+	}
+	switch model.Status.Phase {
+	case modelsv1alpha1.ModelPhaseDownloading:
+		return modelsv1alpha1.StageStateRunning, model.Status.Message
+	case modelsv1alpha1.ModelPhaseReady:
+		return modelsv1alpha1.StageStateSucceeded, ""
+	case modelsv1alpha1.ModelPhaseFailed:
+		return modelsv1alpha1.StageStateFailed, model.Status.Message
+	}
+	return modelsv1alpha1.StageStatePending, ""
+}
+
+// conditionStageState returns the state of a stage reported by a condition,
+// which is running while it has the running reason
+func conditionStageState(model *modelsv1alpha1.Model, conditionType, running string) (modelsv1alpha1.StageState, string) {
+	cond := meta.FindStatusCondition(model.Status.Conditions, conditionType)
+	switch {
+	case cond == nil:
+		return modelsv1alpha1.StageStatePending, ""
+	case cond.Status == metav1.ConditionTrue:
+		return modelsv1alpha1.StageStateSucceeded, cond.Message
+	case cond.Reason == running:
+		return modelsv1alpha1.StageStateRunning, cond.Message
+	}
+	return modelsv1alpha1.StageStateFailed, cond.Message
+}
+
+// updateStages sets Model.Status.Stages from the rest of the status,
+// recording when each stage started and completed
+func updateStages(model *modelsv1alpha1.Model, now time.Time) {
+	previous := make(map[modelsv1alpha1.StageName]modelsv1alpha1.ModelStage, len(model.Status.Stages))
+	for _, s := range model.Status.Stages {
+		previous[s.Name] = s
+	}
+	timestamp := metav1.NewTime(now).Rfc3339Copy()
+
+	var updated []modelsv1alpha1.ModelStage
+	for _, st := range stages {
+		if !st.applies(model) {
+			continue
+		}
+		state, message := st.state(model)
+		s := previous[st.name]
+		if s.Name == "" || s.State != state {
+			switch state {
+			case modelsv1alpha1.StageStatePending:
+				s.StartTime, s.CompletionTime = nil, nil
+			case modelsv1alpha1.StageStateRunning:
+				s.StartTime, s.CompletionTime = &timestamp, nil
+			default:
+				s.CompletionTime = &timestamp
+			}
+		}
+		s.Name, s.State, s.Message = st.name, state, message
+		updated = append(updated, s)
+	}
+	model.Status.Stages = updated
+}
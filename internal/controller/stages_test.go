@@ -0,0 +1,150 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var _ = Describe("Provisioning stages", func() {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "staged", Namespace: "default", Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.safetensors"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				Verification: &modelsv1alpha1.VerificationSpec{
+					Signature: &modelsv1alpha1.SignatureVerification{
+						Provider: modelsv1alpha1.SignatureProviderCosign,
+						PublicKey: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "signing-key"},
+							Key:                  "cosign.pub",
+						},
+						File: "model",
+						URL:  "https://example.com/model.safetensors.sig",
+					},
+				},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhasePending},
+		}
+	}
+
+	stageOf := func(model *modelsv1alpha1.Model, name modelsv1alpha1.StageName) modelsv1alpha1.ModelStage {
+		for _, s := range model.Status.Stages {
+			if s.Name == name {
+				return s
+			}
+		}
+		Fail("no stage " + string(name))
+		return modelsv1alpha1.ModelStage{}
+	}
+
+	It("should list the stages the spec asks for in pipeline order", func() {
+		model := newModel()
+		updateStages(model, start)
+
+		Expect(model.Status.Stages).To(HaveLen(2))
+		Expect(model.Status.Stages[0].Name).To(Equal(modelsv1alpha1.StageDownload))
+		Expect(model.Status.Stages[1].Name).To(Equal(modelsv1alpha1.StageVerify))
+		for _, s := range model.Status.Stages {
+			Expect(s.State).To(Equal(modelsv1alpha1.StageStatePending))
+			Expect(s.StartTime).To(BeNil())
+		}
+	})
+
+	It("should time each stage as the Model moves through them", func() {
+		model := newModel()
+		model.Status.Phase = modelsv1alpha1.ModelPhaseDownloading
+		model.Status.Message = "Download started"
+		updateStages(model, start)
+		download := stageOf(model, modelsv1alpha1.StageDownload)
+		Expect(download.State).To(Equal(modelsv1alpha1.StageStateRunning))
+		Expect(download.StartTime.Time).To(Equal(start))
+		Expect(download.Message).To(Equal("Download started"))
+
+		// The start is kept while the stage keeps running
+		updateStages(model, start.Add(time.Minute))
+		Expect(stageOf(model, modelsv1alpha1.StageDownload).StartTime.Time).To(Equal(start))
+
+		setVerifiedCondition(model, metav1.ConditionFalse, reasonVerifying, "Verifying cosign signature of model")
+		updateStages(model, start.Add(10*time.Minute))
+		download = stageOf(model, modelsv1alpha1.StageDownload)
+		Expect(download.State).To(Equal(modelsv1alpha1.StageStateSucceeded))
+		Expect(download.CompletionTime.Time).To(Equal(start.Add(10 * time.Minute)))
+		verify := stageOf(model, modelsv1alpha1.StageVerify)
+		Expect(verify.State).To(Equal(modelsv1alpha1.StageStateRunning))
+		Expect(verify.StartTime.Time).To(Equal(start.Add(10 * time.Minute)))
+
+		setVerifiedCondition(model, metav1.ConditionFalse, reasonVerificationFailed, "signature mismatch")
+		model.Status.Phase = modelsv1alpha1.ModelPhaseFailed
+		updateStages(model, start.Add(11*time.Minute))
+		Expect(stageOf(model, modelsv1alpha1.StageDownload).State).To(Equal(modelsv1alpha1.StageStateSucceeded))
+		verify = stageOf(model, modelsv1alpha1.StageVerify)
+		Expect(verify.State).To(Equal(modelsv1alpha1.StageStateFailed))
+		Expect(verify.Message).To(Equal("signature mismatch"))
+		Expect(verify.CompletionTime.Time).To(Equal(start.Add(11 * time.Minute)))
+	})
+
+	It("should reset the stages for a new download", func() {
+		model := newModel()
+		model.Status.Phase = modelsv1alpha1.ModelPhaseReady
+		setVerifiedCondition(model, metav1.ConditionTrue, reasonSignatureVerified, "Signature verified")
+		updateStages(model, start)
+		Expect(stageOf(model, modelsv1alpha1.StageVerify).State).To(Equal(modelsv1alpha1.StageStateSucceeded))
+
+		model.Status.Phase = modelsv1alpha1.ModelPhasePending
+		meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeVerified)
+		updateStages(model, start.Add(time.Hour))
+		for _, s := range model.Status.Stages {
+			Expect(s.State).To(Equal(modelsv1alpha1.StageStatePending))
+			Expect(s.CompletionTime).To(BeNil())
+		}
+	})
+
+	It("should record the stages when the status is written", func() {
+		model := newModel()
+		model.Spec.Verification = nil
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		Expect(r.writeStatus(context.Background(), model)).To(Succeed())
+
+		stored := &modelsv1alpha1.Model{}
+		Expect(c.Get(context.Background(), client.ObjectKeyFromObject(model), stored)).To(Succeed())
+		Expect(stored.Status.Stages).To(HaveLen(1))
+		Expect(stored.Status.Stages[0].Name).To(Equal(modelsv1alpha1.StageDownload))
+	})
+})
@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// conditionTypeStalled reports a download that stopped making progress
+	conditionTypeStalled = "Stalled"
+
+	reasonNoProgress          = "NoProgress"
+	reasonRestartLimitReached = "RestartLimitReached"
+)
+
+// setStalledCondition records a stalled download on the Model
+func setStalledCondition(model *modelsv1alpha1.Model, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeStalled,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: model.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// downloadStalled reports whether the running download Job has moved no bytes
+// for longer than timeout. Activity before the Job started does not count, so
+// a restarted Job gets a full timeout of its own.
+func downloadStalled(job *batchv1.Job, lastActivity *metav1.Time, timeout time.Duration, now time.Time) bool {
+	if !canStall(job, lastActivity, timeout) {
+		return false
+	}
+	return now.Sub(activitySince(job, lastActivity)) > timeout
+}
+
+// stallCheckAfter returns how long until the running download Job would
+// count as stalled, or zero if it cannot stall
+func stallCheckAfter(job *batchv1.Job, lastActivity *metav1.Time, timeout time.Duration, now time.Time) time.Duration {
+	if !canStall(job, lastActivity, timeout) {
+		return 0
+	}
+	// Land just past the deadline, since downloadStalled needs it exceeded
+	return max(activitySince(job, lastActivity).Add(timeout).Sub(now)+time.Second, time.Second)
+}
+
+// canStall reports whether stall detection applies to the download Job
+func canStall(job *batchv1.Job, lastActivity *metav1.Time, timeout time.Duration) bool {
+	return timeout > 0 && lastActivity != nil && job.Status.Active > 0 && job.DeletionTimestamp == nil
+}
+
+// activitySince returns the last activity of the download, or the Job's
+// start when that is later
+func activitySince(job *batchv1.Job, lastActivity *metav1.Time) time.Time {
+	since := lastActivity.Time
+	if job.Status.StartTime != nil && job.Status.StartTime.After(since) {
+		since = job.Status.StartTime.Time
+	}
+	return since
+}
+
+// restartStalledDownload deletes a stalled download Job so the Pending phase
+// recreates it, failing the Model once MaxStallRestarts is reached
+func (r *ModelReconciler) restartStalledDownload(ctx context.Context, model *modelsv1alpha1.Model, job *batchv1.Job) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+
+	if model.Status.StallRestarts >= r.MaxStallRestarts {
+		message := fmt.Sprintf("Download stalled: no progress for %s after %d restarts",
+			r.StallTimeout, model.Status.StallRestarts)
+		log.Info("Download stalled, giving up", "restarts", model.Status.StallRestarts)
+		setStalledCondition(model, reasonRestartLimitReached, message)
+		return r.failDownload(ctx, model, job, message)
+	}
+
+	log.Info("Download stalled, restarting Job", "job", job.Name, "restarts", model.Status.StallRestarts)
+	if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil &&
+		!apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to delete stalled download Job")
+		return ctrl.Result{}, err
+	}
+
+	model.Status.StallRestarts++
+	message := fmt.Sprintf("Download stalled: no progress for %s, restarting (%d/%d)",
+		r.StallTimeout, model.Status.StallRestarts, r.MaxStallRestarts)
+	r.event(model, corev1.EventTypeWarning, reasonDownloadStalled, message)
+	setStalledCondition(model, reasonNoProgress, message)
+	return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhasePending, message)
+}
+
+// clearStalled resets the stall bookkeeping once a download is not stalled
+func clearStalled(model *modelsv1alpha1.Model) {
+	model.Status.StallRestarts = 0
+	model.Status.LastActivityTime = nil
+	meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeStalled)
+}
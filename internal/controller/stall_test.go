@@ -0,0 +1,154 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/progress"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Stalled downloads", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	// newStalledClient returns a Model whose running download last moved bytes an hour ago
+	newStalledClient := func(name string, restarts int32) client.Client {
+		started := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{
+				Phase:         modelsv1alpha1.ModelPhaseDownloading,
+				StallRestarts: restarts,
+			},
+		}
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(name), Namespace: namespace},
+			Status:     batchv1.JobStatus{Active: 1, StartTime: &started},
+		}
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: progress.ConfigMapName(name), Namespace: namespace},
+			Data: map[string]string{
+				progress.KeyBytes:   "1048576",
+				progress.KeyUpdated: time.Now().UTC().Format(time.RFC3339),
+				progress.KeyActive:  time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+			},
+		}
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, job, cm).
+			WithStatusSubresource(&modelsv1alpha1.Model{}).
+			Build()
+	}
+
+	newReconciler := func(c client.Client) *ModelReconciler {
+		reporter, err := progress.New(progress.Config{Type: progress.TypeConfigMap})
+		Expect(err).NotTo(HaveOccurred())
+		return &ModelReconciler{
+			Client:           c,
+			Scheme:           scheme.Scheme,
+			ProgressReporter: reporter,
+			StallTimeout:     30 * time.Minute,
+			MaxStallRestarts: 2,
+		}
+	}
+
+	reconcileModel := func(c client.Client, name string) *modelsv1alpha1.Model {
+		key := types.NamespacedName{Name: name, Namespace: namespace}
+		_, err := newReconciler(c).Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	It("should restart a download that made no progress", func() {
+		c := newStalledClient("stalled-model", 0)
+		model := reconcileModel(c, "stalled-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhasePending))
+		Expect(model.Status.StallRestarts).To(Equal(int32(1)))
+		Expect(model.Status.LastActivityTime).NotTo(BeNil())
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeStalled)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonNoProgress))
+
+		err := c.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: namespace}, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should fail the Model once the restart limit is reached", func() {
+		c := newStalledClient("stuck-model", 2)
+		model := reconcileModel(c, "stuck-model")
+
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeStalled)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonRestartLimitReached))
+
+		Expect(c.Get(ctx, types.NamespacedName{Name: resources.JobName(model.Name), Namespace: namespace},
+			&batchv1.Job{})).To(Succeed())
+	})
+
+	It("should only consider activity since the Job started", func() {
+		now := time.Now()
+		started := metav1.NewTime(now.Add(-10 * time.Minute))
+		lastActivity := metav1.NewTime(now.Add(-time.Hour))
+		job := &batchv1.Job{Status: batchv1.JobStatus{Active: 1, StartTime: &started}}
+
+		Expect(downloadStalled(job, &lastActivity, 30*time.Minute, now)).To(BeFalse())
+		Expect(downloadStalled(job, &lastActivity, 5*time.Minute, now)).To(BeTrue())
+		Expect(downloadStalled(job, nil, 5*time.Minute, now)).To(BeFalse())
+		Expect(downloadStalled(job, &lastActivity, 0, now)).To(BeFalse())
+	})
+
+	It("should schedule the next stall check at the deadline", func() {
+		now := time.Now()
+		started := metav1.NewTime(now.Add(-10 * time.Minute))
+		lastActivity := metav1.NewTime(now.Add(-time.Hour))
+		job := &batchv1.Job{Status: batchv1.JobStatus{Active: 1, StartTime: &started}}
+
+		Expect(stallCheckAfter(job, &lastActivity, 30*time.Minute, now)).To(Equal(20*time.Minute + time.Second))
+		Expect(stallCheckAfter(job, &lastActivity, 5*time.Minute, now)).To(Equal(time.Second))
+		Expect(stallCheckAfter(job, nil, 30*time.Minute, now)).To(BeZero())
+		Expect(stallCheckAfter(job, &lastActivity, 0, now)).To(BeZero())
+	})
+})
@@ -0,0 +1,161 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("Status writes", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	// newCountingClient returns a fake client that counts every write
+	newCountingClient := func(writes *int, objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &modelsv1alpha1.ModelFamily{}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					*writes++
+					return c.Create(ctx, obj, opts...)
+				},
+				Update: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+					*writes++
+					return c.Update(ctx, obj, opts...)
+				},
+				Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+					*writes++
+					return c.Patch(ctx, obj, patch, opts...)
+				},
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResource string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					*writes++
+					return c.SubResource(subResource).Update(ctx, obj, opts...)
+				},
+				SubResourcePatch: func(ctx context.Context, c client.Client, subResource string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+					*writes++
+					return c.SubResource(subResource).Patch(ctx, obj, patch, opts...)
+				},
+			}).
+			Build()
+	}
+
+	newModel := func(name string, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "sentence-transformers/all-MiniLM-L6-v2"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase},
+		}
+	}
+
+	reconcileTwice := func(r reconcile.Reconciler, name string, writes *int) {
+		req := reconcile.Request{NamespacedName: types.NamespacedName{Name: name, Namespace: namespace}}
+		_, err := r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+
+		*writes = 0
+		_, err = r.Reconcile(ctx, req)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	It("should not write when a Ready Model is unchanged", func() {
+		model := newModel("ready-model", modelsv1alpha1.ModelPhaseReady)
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(model.Name), Namespace: namespace},
+		}
+
+		writes := 0
+		c := newCountingClient(&writes, model, pvc)
+		reconcileTwice(&ModelReconciler{Client: c, Scheme: scheme.Scheme}, model.Name, &writes)
+		Expect(writes).To(BeZero())
+	})
+
+	It("should not write when a running download makes no progress", func() {
+		model := newModel("downloading-model", modelsv1alpha1.ModelPhaseDownloading)
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(model.Name), Namespace: namespace},
+			Status:     batchv1.JobStatus{Active: 1},
+		}
+
+		writes := 0
+		c := newCountingClient(&writes, model, job)
+		reconcileTwice(&ModelReconciler{Client: c, Scheme: scheme.Scheme}, model.Name, &writes)
+		Expect(writes).To(BeZero())
+	})
+
+	It("should write when the phase changes", func() {
+		model := newModel("failed-model", modelsv1alpha1.ModelPhaseFailed)
+		info, err := resources.BuildInfoConfigMap(model)
+		Expect(err).NotTo(HaveOccurred())
+
+		writes := 0
+		c := newCountingClient(&writes, model, info)
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err = r.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: model.Name, Namespace: namespace},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(writes).To(Equal(1))
+	})
+
+	It("should not write or move the transition time for an unchanged ModelFamily", func() {
+		family := &modelsv1alpha1.ModelFamily{
+			ObjectMeta: metav1.ObjectMeta{Name: "quiet-family", Namespace: namespace, Generation: 1},
+		}
+
+		writes := 0
+		c := newCountingClient(&writes, family)
+		r := &ModelFamilyReconciler{Client: c, Scheme: scheme.Scheme}
+		key := types.NamespacedName{Name: family.Name, Namespace: namespace}
+
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Get(ctx, key, family)).To(Succeed())
+		before := meta.FindStatusCondition(family.Status.Conditions, conditionTypeReady)
+		Expect(before).NotTo(BeNil())
+
+		reconcileTwice(r, family.Name, &writes)
+		Expect(writes).To(BeZero())
+
+		Expect(c.Get(ctx, key, family)).To(Succeed())
+		after := meta.FindStatusCondition(family.Status.Conditions, conditionTypeReady)
+		Expect(after.LastTransitionTime).To(Equal(before.LastTransitionTime))
+	})
+})
@@ -0,0 +1,129 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// conditionTypeStorageResizeFailed reports a volume expansion of the model PVC
+	// that the storage driver rejected
+	conditionTypeStorageResizeFailed = "StorageResizeFailed"
+
+	// reasonResized is set once no expansion is failing or in progress
+	reasonResized = "Resized"
+)
+
+// pvcResizeFailure returns the reason and message of a failed expansion of
+// the PVC, or an empty reason if none failed
+func pvcResizeFailure(pvc *corev1.PersistentVolumeClaim) (reason, message string) {
+	for _, cond := range pvc.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		if cond.Type == corev1.PersistentVolumeClaimControllerResizeError ||
+			cond.Type == corev1.PersistentVolumeClaimNodeResizeError {
+			return string(cond.Type), cond.Message
+		}
+	}
+
+	switch status := pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage]; status {
+	case corev1.PersistentVolumeClaimControllerResizeInfeasible, corev1.PersistentVolumeClaimNodeResizeInfeasible:
+		requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+		return string(status), fmt.Sprintf("The storage driver cannot expand the volume to %s", requested.String())
+	}
+	return "", ""
+}
+
+// pvcResizing returns the reason and message of an expansion of the PVC that
+// is still in progress, or an empty reason if none is
+func pvcResizing(pvc *corev1.PersistentVolumeClaim) (reason, message string) {
+	for _, cond := range pvc.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case corev1.PersistentVolumeClaimResizing:
+			return string(cond.Type), "The storage driver is expanding the volume"
+		case corev1.PersistentVolumeClaimFileSystemResizePending:
+			return string(cond.Type), "Waiting for a pod to mount the volume to expand its file system"
+		}
+	}
+	return "", ""
+}
+
+// updateStorageResize sets the StorageResizeFailed condition from the model
+// PVC's expansion state. The condition is only added once an expansion has
+// been seen. It returns true if the condition changed.
+func (r *ModelReconciler) updateStorageResize(ctx context.Context, model *modelsv1alpha1.Model) (bool, error) {
+	// The operator never resizes an existing claim, so its expansion is
+	// none of the Model's business
+	if !resources.UsesPVC(model) || resources.UsesExistingClaim(model) {
+		return meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeStorageResizeFailed), nil
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.PVCName(model.Name), Namespace: model.Namespace}, pvc)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	condition := metav1.Condition{
+		Type:               conditionTypeStorageResizeFailed,
+		ObservedGeneration: model.Generation,
+	}
+	if reason, message := pvcResizeFailure(pvc); reason != "" {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = reason
+		condition.Message = message
+	} else if reason, message := pvcResizing(pvc); reason != "" {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reason
+		condition.Message = message
+	} else if meta.FindStatusCondition(model.Status.Conditions, conditionTypeStorageResizeFailed) != nil {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = reasonResized
+		condition.Message = "No volume expansion is failing or in progress"
+	} else {
+		return false, nil
+	}
+
+	return meta.SetStatusCondition(&model.Status.Conditions, condition), nil
+}
+
+// reconcileStorageResize surfaces volume expansion failures of the model PVC
+func (r *ModelReconciler) reconcileStorageResize(ctx context.Context, model *modelsv1alpha1.Model) error {
+	changed, err := r.updateStorageResize(ctx, model)
+	if err != nil || !changed {
+		return err
+	}
+	return r.writeStatus(ctx, model)
+}
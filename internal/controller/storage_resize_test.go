@@ -0,0 +1,132 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var _ = Describe("PVC volume expansion", func() {
+	const namespace = "default"
+
+	ctx := context.Background()
+
+	newPVC := func(status corev1.PersistentVolumeClaimStatus) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName("llama"), Namespace: namespace},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("200Gi")},
+				},
+			},
+			Status: status,
+		}
+	}
+
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: namespace, Generation: 2},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "meta-llama/Llama-3-8B"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "longhorn", Size: "200Gi"},
+			},
+		}
+	}
+
+	updateCondition := func(model *modelsv1alpha1.Model, pvc *corev1.PersistentVolumeClaim) (bool, *metav1.Condition) {
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(model, pvc).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		changed, err := r.updateStorageResize(ctx, model)
+		Expect(err).NotTo(HaveOccurred())
+		return changed, meta.FindStatusCondition(model.Status.Conditions, conditionTypeStorageResizeFailed)
+	}
+
+	It("should not add the condition before any expansion", func() {
+		changed, cond := updateCondition(newModel(), newPVC(corev1.PersistentVolumeClaimStatus{}))
+		Expect(changed).To(BeFalse())
+		Expect(cond).To(BeNil())
+	})
+
+	It("should report expansion errors with the driver's message", func() {
+		changed, cond := updateCondition(newModel(), newPVC(corev1.PersistentVolumeClaimStatus{
+			Conditions: []corev1.PersistentVolumeClaimCondition{{
+				Type:    corev1.PersistentVolumeClaimControllerResizeError,
+				Status:  corev1.ConditionTrue,
+				Message: "volume size exceeds the limit of the storage pool",
+			}},
+		}))
+		Expect(changed).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal("ControllerResizeError"))
+		Expect(cond.Message).To(Equal("volume size exceeds the limit of the storage pool"))
+		Expect(cond.ObservedGeneration).To(Equal(int64(2)))
+	})
+
+	It("should report infeasible expansions", func() {
+		_, cond := updateCondition(newModel(), newPVC(corev1.PersistentVolumeClaimStatus{
+			AllocatedResourceStatuses: map[corev1.ResourceName]corev1.ClaimResourceStatus{
+				corev1.ResourceStorage: corev1.PersistentVolumeClaimNodeResizeInfeasible,
+			},
+		}))
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal("NodeResizeInfeasible"))
+		Expect(cond.Message).To(ContainSubstring("200Gi"))
+	})
+
+	It("should report expansions in progress", func() {
+		_, cond := updateCondition(newModel(), newPVC(corev1.PersistentVolumeClaimStatus{
+			Conditions: []corev1.PersistentVolumeClaimCondition{{
+				Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+				Status: corev1.ConditionTrue,
+			}},
+		}))
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal("FileSystemResizePending"))
+	})
+
+	It("should clear a failure once the expansion completes", func() {
+		model := newModel()
+		meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+			Type:   conditionTypeStorageResizeFailed,
+			Status: metav1.ConditionTrue,
+			Reason: "ControllerResizeError",
+		})
+		changed, cond := updateCondition(model, newPVC(corev1.PersistentVolumeClaimStatus{}))
+		Expect(changed).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Reason).To(Equal(reasonResized))
+	})
+})
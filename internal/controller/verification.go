@@ -0,0 +1,165 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/internal/verification"
+)
+
+const (
+	// conditionTypeVerified reports the state of the post-download signature verification
+	conditionTypeVerified = "Verified"
+
+	// Verified condition reasons
+	reasonVerifying          = "Verifying"
+	reasonVerificationFailed = "VerificationFailed"
+	reasonSignatureVerified  = "SignatureVerified"
+)
+
+// verifiesSignature reports whether the Model asks for signature verification
+func verifiesSignature(model *modelsv1alpha1.Model) bool {
+	return model.Spec.Verification != nil && model.Spec.Verification.Signature != nil
+}
+
+// signatureVerified reports whether the current download passed signature verification
+func signatureVerified(model *modelsv1alpha1.Model) bool {
+	return meta.IsStatusConditionTrue(model.Status.Conditions, conditionTypeVerified)
+}
+
+// verificationInProgress reports whether a verification was started for the
+// current download, so it can continue after the download Job is cleaned up
+func verificationInProgress(model *modelsv1alpha1.Model) bool {
+	cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeVerified)
+	return verifiesSignature(model) && cond != nil && cond.Reason == reasonVerifying
+}
+
+// setVerifiedCondition records the verification state on the Model
+func setVerifiedCondition(model *modelsv1alpha1.Model, status metav1.ConditionStatus, reason, message string) {
+	meta.SetStatusCondition(&model.Status.Conditions, metav1.Condition{
+		Type:               conditionTypeVerified,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: model.Generation,
+	})
+}
+
+// clearVerification drops the Verified condition
+func clearVerification(model *modelsv1alpha1.Model) {
+	meta.RemoveStatusCondition(&model.Status.Conditions, conditionTypeVerified)
+}
+
+// completeDownload verifies the signature, builds the engine and registers
+// the model, when the Model asks for them, before moving it to Ready
+func (r *ModelReconciler) completeDownload(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
+	// A registration is only started once the rest is done
+	if registrationInProgress(model) {
+		return r.reconcileRegistration(ctx, model)
+	}
+	if verifiesSignature(model) && !signatureVerified(model) {
+		return r.reconcileVerification(ctx, model)
+	}
+	if model.Spec.Conversion != nil {
+		return r.reconcileConversion(ctx, model)
+	}
+	return r.markReady(ctx, model)
+}
+
+// reconcileVerification runs the verification Job after the download has
+// succeeded and continues with completeDownload once the signature is verified
+func (r *ModelReconciler) reconcileVerification(ctx context.Context, model *modelsv1alpha1.Model) (ctrl.Result, error) {
+	log := logf.FromContext(ctx)
+	spec := model.Spec.Verification.Signature
+
+	job := &batchv1.Job{}
+	err := r.Get(ctx, types.NamespacedName{Name: resources.VerifyJobName(model.Name), Namespace: model.Namespace}, job)
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "Failed to get verification Job")
+		return ctrl.Result{}, err
+	}
+
+	// Replace a Job left over from a previous download
+	if err == nil && job.Annotations[verification.AnnotationContentDigest] != model.Status.ContentDigest {
+		log.Info("Deleting verification Job for previous download", "name", job.Name)
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to delete verification Job")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.Config.Requeue.downloading()}, nil
+	}
+
+	if apierrors.IsNotFound(err) {
+		job, err := verification.BuildJob(model)
+		if err != nil {
+			log.Error(err, "Failed to build verification Job")
+			setVerifiedCondition(model, metav1.ConditionFalse, reasonVerificationFailed, err.Error())
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
+				fmt.Sprintf("Failed to build verification Job: %v", err))
+		}
+		if err := controllerutil.SetControllerReference(model, job, r.Scheme); err != nil {
+			log.Error(err, "Failed to set owner reference on verification Job")
+			return ctrl.Result{}, err
+		}
+
+		log.Info("Creating verification Job", "name", job.Name, "provider", spec.Provider)
+		if err := r.apply(ctx, job); err != nil {
+			log.Error(err, "Failed to create verification Job")
+			return ctrl.Result{}, err
+		}
+
+		message := fmt.Sprintf("Verifying %s signature of %s", spec.Provider, spec.File)
+		setVerifiedCondition(model, metav1.ConditionFalse, reasonVerifying, message)
+		return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseDownloading, message)
+	}
+
+	if job.Status.Succeeded > 0 {
+		log.Info("Verification Job succeeded", "provider", spec.Provider)
+		message := fmt.Sprintf("Verified %s signature of %s", spec.Provider, spec.File)
+		if spec.Checksums {
+			message += " and the checksums it lists"
+		}
+		setVerifiedCondition(model, metav1.ConditionTrue, reasonSignatureVerified, message)
+		return r.completeDownload(ctx, model)
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			log.Info("Verification Job failed", "reason", cond.Reason, "message", cond.Message)
+			setVerifiedCondition(model, metav1.ConditionFalse, reasonVerificationFailed, cond.Message)
+			return r.updateStatus(ctx, model, modelsv1alpha1.ModelPhaseFailed,
+				fmt.Sprintf("Signature verification failed: %s", cond.Message))
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: r.Config.Requeue.downloading()}, nil
+}
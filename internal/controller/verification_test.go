@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/internal/verification"
+)
+
+var _ = Describe("Signature verification", func() {
+	const (
+		namespace = "default"
+		name      = "signed"
+	)
+
+	ctx := context.Background()
+	key := types.NamespacedName{Name: name, Namespace: namespace}
+	verifyKey := types.NamespacedName{Name: resources.VerifyJobName(name), Namespace: namespace}
+
+	newModel := func(phase modelsv1alpha1.ModelPhase, conditions ...metav1.Condition) *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.safetensors"},
+				},
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+				Verification: &modelsv1alpha1.VerificationSpec{
+					Signature: &modelsv1alpha1.SignatureVerification{
+						Provider: modelsv1alpha1.SignatureProviderCosign,
+						PublicKey: corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "signing-key"},
+							Key:                  "cosign.pub",
+						},
+						File: "model",
+						URL:  "https://example.com/model.safetensors.sig",
+					},
+				},
+			},
+			Status: modelsv1alpha1.ModelStatus{Phase: phase, PVCName: resources.PVCName(name), Conditions: conditions},
+		}
+	}
+
+	verifying := metav1.Condition{Type: conditionTypeVerified, Status: metav1.ConditionFalse, Reason: reasonVerifying}
+
+	newClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&modelsv1alpha1.Model{}, &batchv1.Job{}).
+			Build()
+	}
+
+	reconcileModel := func(c client.Client) *modelsv1alpha1.Model {
+		r := &ModelReconciler{Client: c, Scheme: scheme.Scheme}
+		_, err := r.Reconcile(ctx, reconcile.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		model := &modelsv1alpha1.Model{}
+		Expect(c.Get(ctx, key, model)).To(Succeed())
+		return model
+	}
+
+	downloadJob := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: resources.JobName(name), Namespace: namespace},
+		Status:     batchv1.JobStatus{Succeeded: 1},
+	}
+
+	verifyJob := func(status batchv1.JobStatus) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        verifyKey.Name,
+				Namespace:   namespace,
+				Annotations: map[string]string{verification.AnnotationContentDigest: ""},
+			},
+			Status: status,
+		}
+	}
+
+	It("should verify the signature before the Model becomes Ready", func() {
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading), downloadJob.DeepCopy())
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeVerified)
+		Expect(cond).NotTo(BeNil())
+		Expect(cond.Reason).To(Equal(reasonVerifying))
+
+		job := &batchv1.Job{}
+		Expect(c.Get(ctx, verifyKey, job)).To(Succeed())
+		Expect(metav1.IsControlledBy(job, model)).To(BeTrue())
+	})
+
+	It("should become Ready once the signature is verified", func() {
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading, verifying),
+			downloadJob.DeepCopy(), verifyJob(batchv1.JobStatus{Succeeded: 1}))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+		Expect(meta.IsStatusConditionTrue(model.Status.Conditions, conditionTypeVerified)).To(BeTrue())
+	})
+
+	It("should continue the verification after the download Job is cleaned up", func() {
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading, verifying),
+			verifyJob(batchv1.JobStatus{Succeeded: 1}))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseReady))
+	})
+
+	It("should fail the Model when the signature does not verify", func() {
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading, verifying),
+			downloadJob.DeepCopy(), verifyJob(batchv1.JobStatus{
+				Failed: 2,
+				Conditions: []batchv1.JobCondition{{
+					Type:    batchv1.JobFailed,
+					Status:  corev1.ConditionTrue,
+					Message: "Job has reached the specified backoff limit",
+				}},
+			}))
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseFailed))
+		Expect(model.Status.Message).To(HavePrefix("Signature verification failed"))
+		cond := meta.FindStatusCondition(model.Status.Conditions, conditionTypeVerified)
+		Expect(cond.Reason).To(Equal(reasonVerificationFailed))
+	})
+
+	It("should not trust a verification Job of a previous download", func() {
+		stale := verifyJob(batchv1.JobStatus{Succeeded: 1})
+		stale.Annotations[verification.AnnotationContentDigest] = "sha256:previous"
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseDownloading, verifying), downloadJob.DeepCopy(), stale)
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		err := c.Get(ctx, verifyKey, &batchv1.Job{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should verify a Ready Model when verification is added", func() {
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: resources.PVCName(name), Namespace: namespace},
+		}
+		c := newClient(newModel(modelsv1alpha1.ModelPhaseReady), pvc)
+
+		model := reconcileModel(c)
+		Expect(model.Status.Phase).To(Equal(modelsv1alpha1.ModelPhaseDownloading))
+		Expect(verificationInProgress(model)).To(BeTrue())
+	})
+})
@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// Builder images
+	tensorRTLLMImage = "nvcr.io/nvidia/tensorrt-llm/release:latest"
+	onnxImage        = "python:3.11-slim"
+
+	// resourceGPU is the extended resource requested for GPU builds
+	resourceGPU corev1.ResourceName = "nvidia.com/gpu"
+)
+
+func init() {
+	Register(tensorRTLLMBuilder{})
+	Register(onnxBuilder{})
+}
+
+// tensorRTLLMBuilder builds a TensorRT-LLM engine with the LLM API. Engines
+// are specific to the GPU they are built on, so the Job should be scheduled
+// onto the GPU type that will serve the model.
+type tensorRTLLMBuilder struct{}
+
+func (tensorRTLLMBuilder) Target() modelsv1alpha1.ConversionTarget {
+	return modelsv1alpha1.ConversionTargetTensorRTLLM
+}
+
+func (tensorRTLLMBuilder) DefaultImage() string { return tensorRTLLMImage }
+
+func (tensorRTLLMBuilder) DefaultResources() corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("16Gi"),
+			corev1.ResourceCPU:    resource.MustParse("4"),
+		},
+		Limits: corev1.ResourceList{
+			resourceGPU: resource.MustParse("1"),
+		},
+	}
+}
+
+// Command builds and saves the engine. Extra args from spec.conversion.args
+// are passed to the LLM constructor as key=value keyword arguments
+// (e.g. "tensor_parallel_size=2").
+func (tensorRTLLMBuilder) Command(_ *modelsv1alpha1.Model, input, output string) []string {
+	script := fmt.Sprintf(`import ast
+import sys
+from tensorrt_llm import LLM
+
+kwargs = {}
+for arg in sys.argv[1:]:
+    key, _, value = arg.partition("=")
+    try:
+        kwargs[key] = ast.literal_eval(value)
+    except (ValueError, SyntaxError):
+        kwargs[key] = value
+
+llm = LLM(model=%q, **kwargs)
+llm.save(%q)
+print("Engine build complete")
+`, input, output)
+	return []string{"python3", "-c", script}
+}
+
+// onnxBuilder exports the model to ONNX with Hugging Face Optimum
+type onnxBuilder struct{}
+
+func (onnxBuilder) Target() modelsv1alpha1.ConversionTarget {
+	return modelsv1alpha1.ConversionTargetONNX
+}
+
+func (onnxBuilder) DefaultImage() string { return onnxImage }
+
+func (onnxBuilder) DefaultResources() corev1.ResourceRequirements {
+	return corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("8Gi"),
+			corev1.ResourceCPU:    resource.MustParse("2"),
+		},
+	}
+}
+
+// Command installs optimum and exports the model; extra args from
+// spec.conversion.args are passed to optimum-cli.
+func (onnxBuilder) Command(_ *modelsv1alpha1.Model, input, output string) []string {
+	script := fmt.Sprintf(`set -e
+pip install --no-cache-dir 'optimum[exporters]'
+exec optimum-cli export onnx --model %s "$@" %s`, input, output)
+	return []string{"sh", "-c", script, "optimum-cli"}
+}
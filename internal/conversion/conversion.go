@@ -0,0 +1,214 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion builds post-download Jobs that convert a Model into a
+// hardware-specific engine (TensorRT-LLM, ONNX).
+//
+// Each conversion target is implemented by a Builder registered under its
+// target name. The Job mounts the model volume at /models and writes the
+// engine to ArtifactPath(target), inside the operator metadata directory so
+// the downloaded files and their completion marker digest are left untouched.
+package conversion
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+const (
+	// Job configuration
+	backoffLimit            = int32(1)
+	ttlSecondsAfterFinished = int32(3600)
+
+	// Volume and mount names, matching the download Job
+	modelVolumeName = "model-storage"
+	modelMountPath  = "/models"
+
+	// enginesDir holds converted engines inside the metadata directory
+	enginesDir = "engines"
+
+	// LabelTarget records the conversion target on the Job
+	LabelTarget = "models.main-currents.news/conversion-target"
+)
+
+// Builder produces the container that converts a model to one target
+type Builder interface {
+	// Target returns the conversion target this builder implements
+	Target() modelsv1alpha1.ConversionTarget
+	// DefaultImage returns the builder image used unless spec.conversion.image is set
+	DefaultImage() string
+	// DefaultResources returns the resources used unless spec.conversion.resources is set
+	DefaultResources() corev1.ResourceRequirements
+	// Command returns the command converting the model in input to an engine in output
+	Command(model *modelsv1alpha1.Model, input, output string) []string
+}
+
+var (
+	mu       sync.RWMutex
+	builders = map[modelsv1alpha1.ConversionTarget]Builder{}
+)
+
+// Register makes a Builder available for its target, replacing any existing one
+func Register(b Builder) {
+	mu.Lock()
+	defer mu.Unlock()
+	builders[b.Target()] = b
+}
+
+// Lookup returns the Builder registered for target
+func Lookup(target modelsv1alpha1.ConversionTarget) (Builder, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	b, ok := builders[target]
+	if !ok {
+		return nil, fmt.Errorf("no conversion builder registered for target %q", target)
+	}
+	return b, nil
+}
+
+// Targets returns the registered conversion targets, sorted
+func Targets() []modelsv1alpha1.ConversionTarget {
+	mu.RLock()
+	defer mu.RUnlock()
+	targets := make([]modelsv1alpha1.ConversionTarget, 0, len(builders))
+	for t := range builders {
+		targets = append(targets, t)
+	}
+	sort.Slice(targets, func(i, j int) bool { return targets[i] < targets[j] })
+	return targets
+}
+
+// ArtifactPath returns the engine directory for target, relative to the model volume root
+func ArtifactPath(target modelsv1alpha1.ConversionTarget) string {
+	return path.Join(marker.Dir, enginesDir, string(target))
+}
+
+// gpuToleration tolerates the taint GPU node pools commonly carry
+var gpuToleration = corev1.Toleration{
+	Key:      string(resourceGPU),
+	Operator: corev1.TolerationOpExists,
+	Effect:   corev1.TaintEffectNoSchedule,
+}
+
+// requestsGPU reports whether the resources request or limit GPUs
+func requestsGPU(res corev1.ResourceRequirements) bool {
+	if q, ok := res.Limits[resourceGPU]; ok && !q.IsZero() {
+		return true
+	}
+	if q, ok := res.Requests[resourceGPU]; ok && !q.IsZero() {
+		return true
+	}
+	return false
+}
+
+// BuildJob creates the conversion Job for the Model's spec.conversion
+func BuildJob(model *modelsv1alpha1.Model) (*batchv1.Job, error) {
+	spec := model.Spec.Conversion
+	if spec == nil {
+		return nil, fmt.Errorf("no conversion specified in model %s", model.Name)
+	}
+
+	builder, err := Lookup(spec.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	image := spec.Image
+	if image == "" {
+		image = builder.DefaultImage()
+	}
+
+	res := builder.DefaultResources()
+	if spec.Resources != nil {
+		res = *spec.Resources.DeepCopy()
+	}
+
+	output := path.Join(modelMountPath, ArtifactPath(spec.Target))
+	command := append(builder.Command(model, modelMountPath, output), spec.Args...)
+
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-converter",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+		LabelTarget:                    string(spec.Target),
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      resources.ConversionJobName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(backoffLimit),
+			TTLSecondsAfterFinished: ptr.To(ttlSecondsAfterFinished),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:      "converter",
+							Image:     image,
+							Command:   command,
+							Resources: res,
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: modelVolumeName, MountPath: modelMountPath},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: modelVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: resources.ClaimName(model),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if len(spec.NodeSelector) > 0 {
+		job.Spec.Template.Spec.NodeSelector = spec.NodeSelector
+	}
+	if len(spec.Tolerations) > 0 {
+		job.Spec.Template.Spec.Tolerations = spec.Tolerations
+	} else if requestsGPU(res) {
+		job.Spec.Template.Spec.Tolerations = []corev1.Toleration{gpuToleration}
+	}
+	if spec.Affinity != nil {
+		job.Spec.Template.Spec.Affinity = spec.Affinity.DeepCopy()
+	}
+
+	return job, nil
+}
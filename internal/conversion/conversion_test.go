@@ -0,0 +1,251 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package conversion
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func testModel(spec *modelsv1alpha1.ConversionSpec) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Conversion: spec,
+		},
+	}
+}
+
+func TestTargets(t *testing.T) {
+	targets := Targets()
+	if len(targets) != 2 {
+		t.Fatalf("Targets() = %v, want 2 builtin targets", targets)
+	}
+	if targets[0] != modelsv1alpha1.ConversionTargetONNX || targets[1] != modelsv1alpha1.ConversionTargetTensorRTLLM {
+		t.Errorf("Targets() = %v, want [onnx tensorrt-llm]", targets)
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	if _, err := Lookup("openvino"); err == nil {
+		t.Error("Lookup() expected error for unregistered target")
+	}
+}
+
+func TestArtifactPath(t *testing.T) {
+	if got := ArtifactPath(modelsv1alpha1.ConversionTargetTensorRTLLM); got != ".model-operator/engines/tensorrt-llm" {
+		t.Errorf("ArtifactPath() = %v, want .model-operator/engines/tensorrt-llm", got)
+	}
+}
+
+func TestBuildJob(t *testing.T) {
+	tests := []struct {
+		name      string
+		spec      *modelsv1alpha1.ConversionSpec
+		wantImage string
+		wantGPU   bool
+		wantInCmd string
+	}{
+		{
+			name:      "tensorrt-llm defaults",
+			spec:      &modelsv1alpha1.ConversionSpec{Target: modelsv1alpha1.ConversionTargetTensorRTLLM},
+			wantImage: tensorRTLLMImage,
+			wantGPU:   true,
+			wantInCmd: "/models/.model-operator/engines/tensorrt-llm",
+		},
+		{
+			name:      "onnx defaults",
+			spec:      &modelsv1alpha1.ConversionSpec{Target: modelsv1alpha1.ConversionTargetONNX},
+			wantImage: onnxImage,
+			wantInCmd: "optimum-cli export onnx --model /models",
+		},
+		{
+			name: "image override",
+			spec: &modelsv1alpha1.ConversionSpec{
+				Target: modelsv1alpha1.ConversionTargetONNX,
+				Image:  "registry.example.com/optimum:1.23",
+			},
+			wantImage: "registry.example.com/optimum:1.23",
+			wantInCmd: "/models/.model-operator/engines/onnx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := BuildJob(testModel(tt.spec))
+			if err != nil {
+				t.Fatalf("BuildJob() error = %v", err)
+			}
+
+			if job.Name != "model-convert-llama" {
+				t.Errorf("Name = %v, want model-convert-llama", job.Name)
+			}
+			if job.Labels[LabelTarget] != string(tt.spec.Target) {
+				t.Errorf("Labels[%s] = %v, want %v", LabelTarget, job.Labels[LabelTarget], tt.spec.Target)
+			}
+
+			container := job.Spec.Template.Spec.Containers[0]
+			if container.Image != tt.wantImage {
+				t.Errorf("Image = %v, want %v", container.Image, tt.wantImage)
+			}
+			if !strings.Contains(strings.Join(container.Command, " "), tt.wantInCmd) {
+				t.Errorf("Command should contain %q, got %v", tt.wantInCmd, container.Command)
+			}
+			_, hasGPU := container.Resources.Limits[resourceGPU]
+			if hasGPU != tt.wantGPU {
+				t.Errorf("GPU limit = %v, want %v", hasGPU, tt.wantGPU)
+			}
+			if claim := job.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim; claim == nil || claim.ClaimName != "model-llama" {
+				t.Errorf("Volume should mount PVC model-llama, got %+v", job.Spec.Template.Spec.Volumes[0])
+			}
+		})
+	}
+}
+
+func TestBuildJobOverrides(t *testing.T) {
+	job, err := BuildJob(testModel(&modelsv1alpha1.ConversionSpec{
+		Target: modelsv1alpha1.ConversionTargetTensorRTLLM,
+		Args:   []string{"tensor_parallel_size=2"},
+		Resources: &corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{resourceGPU: resource.MustParse("2")},
+		},
+		NodeSelector: map[string]string{"nvidia.com/gpu.product": "NVIDIA-H100-80GB-HBM3"},
+		Tolerations:  []corev1.Toleration{{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists}},
+	}))
+	if err != nil {
+		t.Fatalf("BuildJob() error = %v", err)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	container := podSpec.Containers[0]
+	if last := container.Command[len(container.Command)-1]; last != "tensor_parallel_size=2" {
+		t.Errorf("Command should end with args, got %v", last)
+	}
+	if gpus := container.Resources.Limits[resourceGPU]; gpus.String() != "2" {
+		t.Errorf("GPU limit = %v, want 2", gpus.String())
+	}
+	if _, ok := container.Resources.Requests[corev1.ResourceMemory]; ok {
+		t.Error("Resources override should replace the builder defaults")
+	}
+	if podSpec.NodeSelector["nvidia.com/gpu.product"] != "NVIDIA-H100-80GB-HBM3" {
+		t.Errorf("NodeSelector = %v", podSpec.NodeSelector)
+	}
+	if len(podSpec.Tolerations) != 1 {
+		t.Errorf("Tolerations = %v, want 1", podSpec.Tolerations)
+	}
+}
+
+func TestBuildJobGPUScheduling(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           *modelsv1alpha1.ConversionSpec
+		wantToleration bool
+	}{
+		{
+			name:           "gpu build tolerates gpu taint",
+			spec:           &modelsv1alpha1.ConversionSpec{Target: modelsv1alpha1.ConversionTargetTensorRTLLM},
+			wantToleration: true,
+		},
+		{
+			name: "gpu requested via override",
+			spec: &modelsv1alpha1.ConversionSpec{
+				Target: modelsv1alpha1.ConversionTargetONNX,
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{resourceGPU: resource.MustParse("1")},
+					Limits:   corev1.ResourceList{resourceGPU: resource.MustParse("1")},
+				},
+			},
+			wantToleration: true,
+		},
+		{
+			name: "cpu build",
+			spec: &modelsv1alpha1.ConversionSpec{Target: modelsv1alpha1.ConversionTargetONNX},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := BuildJob(testModel(tt.spec))
+			if err != nil {
+				t.Fatalf("BuildJob() error = %v", err)
+			}
+			tolerations := job.Spec.Template.Spec.Tolerations
+			got := len(tolerations) == 1 && tolerations[0].Key == "nvidia.com/gpu"
+			if got != tt.wantToleration {
+				t.Errorf("GPU toleration = %v, want %v (tolerations: %v)", got, tt.wantToleration, tolerations)
+			}
+		})
+	}
+}
+
+func TestBuildJobIgnoresDownloadScheduling(t *testing.T) {
+	model := testModel(&modelsv1alpha1.ConversionSpec{
+		Target:       modelsv1alpha1.ConversionTargetTensorRTLLM,
+		NodeSelector: map[string]string{"node-pool": "gpu"},
+		Affinity: &corev1.Affinity{
+			NodeAffinity: &corev1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      "nvidia.com/gpu.memory",
+							Operator: corev1.NodeSelectorOpGt,
+							Values:   []string{"40000"},
+						}},
+					}},
+				},
+			},
+		},
+	})
+	model.Spec.NodeSelector = map[string]string{"node-pool": "cheap"}
+	model.Spec.Download = &modelsv1alpha1.DownloadSpec{
+		Tolerations: []corev1.Toleration{{Key: "spot", Operator: corev1.TolerationOpExists}},
+	}
+
+	job, err := BuildJob(model)
+	if err != nil {
+		t.Fatalf("BuildJob() error = %v", err)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if podSpec.NodeSelector["node-pool"] != "gpu" {
+		t.Errorf("NodeSelector = %v, want conversion node pool", podSpec.NodeSelector)
+	}
+	if len(podSpec.Tolerations) != 1 || podSpec.Tolerations[0].Key != "nvidia.com/gpu" {
+		t.Errorf("Tolerations = %v, want only the GPU toleration", podSpec.Tolerations)
+	}
+	if podSpec.Affinity == nil || podSpec.Affinity.NodeAffinity == nil {
+		t.Fatal("Affinity should be applied")
+	}
+	if podSpec.Affinity == model.Spec.Conversion.Affinity {
+		t.Error("Affinity should be copied, not shared with the Model spec")
+	}
+}
+
+func TestBuildJobNoConversion(t *testing.T) {
+	if _, err := BuildJob(testModel(nil)); err == nil {
+		t.Error("BuildJob() expected error without spec.conversion")
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package health checks the dependencies the webhooks and controllers rely
+// on, for the manager's readiness probe.
+//
+// The pod injector webhook uses failurePolicy: Ignore, so while the operator
+// cannot serve it the API server admits pods without their models. Failing
+// readiness takes the replica out of the webhook Service, and the
+// model_operator_webhook_fail_open metric lets that be alerted on.
+package health
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	modelwebhook "github.com/rsJames-ttrpg/model-operator/internal/webhook"
+)
+
+// checkTimeout bounds the wait for the informer caches in one probe
+const checkTimeout = 5 * time.Second
+
+var (
+	checkFailingDesc = prometheus.NewDesc("model_operator_readiness_check_failing",
+		"1 while the named readiness check fails",
+		[]string{"check"}, nil)
+	failOpenDesc = prometheus.NewDesc("model_operator_webhook_fail_open",
+		"1 while a webhook with failurePolicy Ignore is skipped because the operator is not ready",
+		[]string{"webhook"}, nil)
+)
+
+// Readiness records the result of each readiness check it wraps and exports
+// them as metrics
+type Readiness struct {
+	mu      sync.Mutex
+	failing map[string]bool
+}
+
+// NewReadiness returns a Readiness with no checks recorded yet
+func NewReadiness() *Readiness {
+	return &Readiness{failing: map[string]bool{}}
+}
+
+// Track wraps check so that its result is recorded under name
+func (r *Readiness) Track(name string, check healthz.Checker) healthz.Checker {
+	r.record(name, false)
+	return func(req *http.Request) error {
+		err := check(req)
+		r.record(name, err != nil)
+		return err
+	}
+}
+
+// record sets whether the named check fails
+func (r *Readiness) record(name string, failing bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.failing[name] = failing
+}
+
+// Ready reports whether every tracked check passed when it last ran
+func (r *Readiness) Ready() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, failing := range r.failing {
+		if failing {
+			return false
+		}
+	}
+	return true
+}
+
+// Describe implements prometheus.Collector
+func (r *Readiness) Describe(ch chan<- *prometheus.Desc) {
+	ch <- checkFailingDesc
+	ch <- failOpenDesc
+}
+
+// Collect implements prometheus.Collector
+func (r *Readiness) Collect(ch chan<- prometheus.Metric) {
+	r.mu.Lock()
+	names := make([]string, 0, len(r.failing))
+	for name := range r.failing {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		ch <- prometheus.MustNewConstMetric(checkFailingDesc, prometheus.GaugeValue, gauge(r.failing[name]), name)
+	}
+	r.mu.Unlock()
+
+	failOpen := gauge(!r.Ready())
+	for _, reg := range modelwebhook.Registrations {
+		if reg.FailurePolicy == admissionregistrationv1.Ignore {
+			ch <- prometheus.MustNewConstMetric(failOpenDesc, prometheus.GaugeValue, failOpen, reg.Name)
+		}
+	}
+}
+
+// gauge returns 1 for true and 0 for false
+func gauge(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// CertificateValid checks that the PEM certificate at path is within its
+// validity window
+func CertificateValid(path string, now func() time.Time) healthz.Checker {
+	return func(_ *http.Request) error {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("reading webhook certificate: %w", err)
+		}
+		block, _ := pem.Decode(data)
+		if block == nil || block.Type != "CERTIFICATE" {
+			return fmt.Errorf("no PEM certificate in %s", path)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("parsing webhook certificate: %w", err)
+		}
+		t := now()
+		if t.Before(cert.NotBefore) {
+			return fmt.Errorf("webhook certificate is not valid before %s", cert.NotBefore.UTC().Format(time.RFC3339))
+		}
+		if t.After(cert.NotAfter) {
+			return fmt.Errorf("webhook certificate expired at %s", cert.NotAfter.UTC().Format(time.RFC3339))
+		}
+		return nil
+	}
+}
+
+// CacheSyncer is the part of the manager's cache CacheSynced waits on
+type CacheSyncer interface {
+	WaitForCacheSync(ctx context.Context) bool
+}
+
+// CacheSynced checks that the informer caches have synced
+func CacheSynced(cache CacheSyncer) healthz.Checker {
+	return func(req *http.Request) error {
+		ctx, cancel := context.WithTimeout(req.Context(), checkTimeout)
+		defer cancel()
+		if !cache.WaitForCacheSync(ctx) {
+			return errors.New("informer caches have not synced")
+		}
+		return nil
+	}
+}
+
+// ResourceLister is the part of the discovery client CRDsAvailable uses
+type ResourceLister interface {
+	ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+}
+
+// CRDsAvailable checks that the API server serves each of the resources in
+// groupVersion, i.e. that their CRDs are installed
+func CRDsAvailable(discovery ResourceLister, groupVersion string, resources ...string) healthz.Checker {
+	return func(_ *http.Request) error {
+		list, err := discovery.ServerResourcesForGroupVersion(groupVersion)
+		if err != nil {
+			return fmt.Errorf("discovering %s: %w", groupVersion, err)
+		}
+		for _, resource := range resources {
+			if !slices.ContainsFunc(list.APIResources, func(r metav1.APIResource) bool { return r.Name == resource }) {
+				return fmt.Errorf("%s is not served in %s", resource, groupVersion)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// writeCertificate writes a self-signed certificate valid from notBefore to notAfter
+func writeCertificate(t *testing.T, notBefore, notAfter time.Time) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "model-operator-webhook-service"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "tls.crt")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestCertificateValid(t *testing.T) {
+	now := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		notBefore time.Time
+		notAfter  time.Time
+		wantErr   string
+	}{
+		{name: "valid", notBefore: now.Add(-time.Hour), notAfter: now.Add(time.Hour)},
+		{name: "not yet valid", notBefore: now.Add(time.Hour), notAfter: now.Add(2 * time.Hour), wantErr: "not valid before"},
+		{name: "expired", notBefore: now.Add(-2 * time.Hour), notAfter: now.Add(-time.Hour), wantErr: "expired"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeCertificate(t, tt.notBefore, tt.notAfter)
+			err := CertificateValid(path, func() time.Time { return now })(nil)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("CertificateValid() error = %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("CertificateValid() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	if err := CertificateValid(filepath.Join(t.TempDir(), "tls.crt"), time.Now)(nil); err == nil {
+		t.Error("CertificateValid() should fail without a certificate")
+	}
+}
+
+// fakeCache reports whether the caches synced
+type fakeCache bool
+
+func (c fakeCache) WaitForCacheSync(context.Context) bool { return bool(c) }
+
+func TestCacheSynced(t *testing.T) {
+	req, _ := http.NewRequest(http.MethodGet, "/readyz", nil)
+	if err := CacheSynced(fakeCache(true))(req); err != nil {
+		t.Errorf("CacheSynced() error = %v", err)
+	}
+	if err := CacheSynced(fakeCache(false))(req); err == nil {
+		t.Error("CacheSynced() should fail before the caches sync")
+	}
+}
+
+// fakeDiscovery serves the given resources, or fails with err
+type fakeDiscovery struct {
+	resources []string
+	err       error
+}
+
+func (d fakeDiscovery) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	if d.err != nil {
+		return nil, d.err
+	}
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, r := range d.resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{Name: r})
+	}
+	return list, nil
+}
+
+func TestCRDsAvailable(t *testing.T) {
+	const gv = "models.main-currents.news/v1alpha1"
+	tests := []struct {
+		name      string
+		discovery fakeDiscovery
+		wantErr   bool
+	}{
+		{name: "installed", discovery: fakeDiscovery{resources: []string{"models", "models/status", "modelfamilies"}}},
+		{name: "missing resource", discovery: fakeDiscovery{resources: []string{"models"}}, wantErr: true},
+		{name: "group not served", discovery: fakeDiscovery{err: errors.New("the server could not find the requested resource")}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CRDsAvailable(tt.discovery, gv, "models", "modelfamilies")(nil)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CRDsAvailable() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadiness(t *testing.T) {
+	readiness := NewReadiness()
+	synced := false
+	check := readiness.Track("informer-cache", func(*http.Request) error {
+		if !synced {
+			return errors.New("informer caches have not synced")
+		}
+		return nil
+	})
+
+	if !readiness.Ready() {
+		t.Error("Ready() = false before any check ran, want true")
+	}
+
+	_ = check(nil)
+	if readiness.Ready() {
+		t.Error("Ready() = true with a failing check, want false")
+	}
+	want := `
+# HELP model_operator_webhook_fail_open 1 while a webhook with failurePolicy Ignore is skipped because the operator is not ready
+# TYPE model_operator_webhook_fail_open gauge
+model_operator_webhook_fail_open{webhook="model-audit.models.main-currents.news"} 1
+model_operator_webhook_fail_open{webhook="model-injector.models.main-currents.news"} 1
+`
+	if err := testutil.CollectAndCompare(readiness, strings.NewReader(want), "model_operator_webhook_fail_open"); err != nil {
+		t.Error(err)
+	}
+
+	synced = true
+	_ = check(nil)
+	want = `
+# HELP model_operator_readiness_check_failing 1 while the named readiness check fails
+# TYPE model_operator_readiness_check_failing gauge
+model_operator_readiness_check_failing{check="informer-cache"} 0
+`
+	if err := testutil.CollectAndCompare(readiness, strings.NewReader(want), "model_operator_readiness_check_failing"); err != nil {
+		t.Error(err)
+	}
+}
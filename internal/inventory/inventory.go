@@ -0,0 +1,258 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package inventory lists every Model in the cluster for external ML
+// catalogs, as JSON and as metrics.
+//
+// It is served on the metrics server, so a catalog only needs access to the
+// endpoint rather than RBAC grants to read Models and pods in each namespace.
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// Path is where the inventory is served on the metrics server
+const Path = "/models/inventory"
+
+// listTimeout bounds the reads behind one inventory request or scrape
+const listTimeout = 30 * time.Second
+
+// cacheTTL is how long a listing is reused, so the scrapes and requests
+// arriving together read every Model and pod in the cluster once
+const cacheTTL = 15 * time.Second
+
+// Entry describes one Model in the inventory
+type Entry struct {
+	Namespace     string                    `json:"namespace"`
+	Name          string                    `json:"name"`
+	Phase         modelsv1alpha1.ModelPhase `json:"phase"`
+	ContentDigest string                    `json:"contentDigest,omitempty"`
+	// StorageSize is the requested size of the model's storage
+	StorageSize string `json:"storageSize"`
+	// SizeBytes is the size of the download: the bytes written when a
+	// progress reporter counted them, otherwise the estimate made before it
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+	// Consumers is the number of running pods mounting the model
+	Consumers int `json:"consumers"`
+	// LastSyncTime is when the model last became Ready
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// DownloadStartTime is when the running download started, to find
+	// downloads that are stuck
+	DownloadStartTime *metav1.Time `json:"downloadStartTime,omitempty"`
+	// DownloadDuration is how long the last finished download ran
+	DownloadDuration *metav1.Duration `json:"downloadDuration,omitempty"`
+	// Deprecated is set for a model being retired, with the Model to move to
+	Deprecated  bool   `json:"deprecated,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// Inventory lists the Models in the cluster. It serves the inventory as
+// JSON, or as OpenMetrics when asked for it, and collects it as metrics.
+type Inventory struct {
+	reader   client.Reader
+	pods     client.Reader
+	registry *prometheus.Registry
+
+	mu       sync.Mutex
+	entries  []Entry
+	listedAt time.Time
+
+	now func() time.Time
+}
+
+// New returns an Inventory reading Models from reader and pods from pods.
+// The manager only caches the operator's own pods, so pods should be an
+// uncached reader that sees the pods mounting the models.
+func New(reader, pods client.Reader) *Inventory {
+	inv := &Inventory{reader: reader, pods: pods, registry: prometheus.NewRegistry()}
+	inv.registry.MustRegister(inv)
+	return inv
+}
+
+func (i *Inventory) clock() time.Time {
+	if i.now != nil {
+		return i.now()
+	}
+	return time.Now()
+}
+
+// List returns an entry per Model, sorted by namespace and name. A listing
+// is reused for cacheTTL; the entries are shared, so callers must not
+// modify them.
+func (i *Inventory) List(ctx context.Context) ([]Entry, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	if i.entries != nil && i.clock().Sub(i.listedAt) < cacheTTL {
+		return i.entries, nil
+	}
+
+	entries, err := i.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	i.entries, i.listedAt = entries, i.clock()
+	return entries, nil
+}
+
+// list reads the Models and pods and builds the entries
+func (i *Inventory) list(ctx context.Context) ([]Entry, error) {
+	models := &modelsv1alpha1.ModelList{}
+	if err := i.reader.List(ctx, models); err != nil {
+		return nil, err
+	}
+	pods := &corev1.PodList{}
+	if err := i.pods.List(ctx, pods); err != nil {
+		return nil, err
+	}
+	consumers := countConsumers(models.Items, pods.Items)
+
+	entries := make([]Entry, 0, len(models.Items))
+	for k := range models.Items {
+		model := &models.Items[k]
+		entry := Entry{
+			Namespace:     model.Namespace,
+			Name:          model.Name,
+			Phase:         model.Status.Phase,
+			ContentDigest: model.Status.ContentDigest,
+			StorageSize:   model.Spec.Storage.Size,
+			SizeBytes:     model.Status.DownloadedBytes,
+			Consumers:     consumers[client.ObjectKeyFromObject(model)],
+			Deprecated:    model.Spec.Deprecated,
+			Replacement:   model.Spec.Replacement,
+		}
+		if entry.SizeBytes == 0 {
+			entry.SizeBytes = model.Status.EstimatedSizeBytes
+		}
+		if model.Status.CompletionTime == nil {
+			entry.DownloadStartTime = model.Status.StartTime
+		}
+		entry.DownloadDuration = model.Status.DownloadDuration
+		if cond := meta.FindStatusCondition(model.Status.Conditions, "Ready"); cond != nil && cond.Status == metav1.ConditionTrue {
+			entry.LastSyncTime = &cond.LastTransitionTime
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(a, b int) bool {
+		if entries[a].Namespace != entries[b].Namespace {
+			return entries[a].Namespace < entries[b].Namespace
+		}
+		return entries[a].Name < entries[b].Name
+	})
+	return entries, nil
+}
+
+// ServeHTTP writes the inventory as JSON, or as OpenMetrics when the request
+// accepts application/openmetrics-text
+func (i *Inventory) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if strings.Contains(req.Header.Get("Accept"), "application/openmetrics-text") {
+		promhttp.HandlerFor(i.registry, promhttp.HandlerOpts{
+			EnableOpenMetrics: true,
+			ErrorHandling:     promhttp.HTTPErrorOnError,
+		}).ServeHTTP(w, req)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), listTimeout)
+	defer cancel()
+	entries, err := i.List(ctx)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list the model inventory")
+		http.Error(w, "failed to list models", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(struct {
+		Models []Entry `json:"models"`
+	}{Models: entries})
+}
+
+// countConsumers returns the number of consumer pods mounting each Model,
+// by the rules of resources.MountsModel. It looks the names of each pod's
+// volumes and claims up rather than matching every pod against every Model.
+func countConsumers(models []modelsv1alpha1.Model, pods []corev1.Pod) map[client.ObjectKey]int {
+	names := map[client.ObjectKey]bool{}
+	byVolume := map[client.ObjectKey][]client.ObjectKey{}
+	byClaim := map[client.ObjectKey][]client.ObjectKey{}
+	for k := range models {
+		model := &models[k]
+		key := client.ObjectKeyFromObject(model)
+		names[key] = true
+		volume := client.ObjectKey{Namespace: model.Namespace, Name: resources.VolumeName(model.Name)}
+		byVolume[volume] = append(byVolume[volume], key)
+		claim := client.ObjectKey{Namespace: model.Namespace, Name: resources.ClaimName(model)}
+		byClaim[claim] = append(byClaim[claim], key)
+		if model.Status.PVCName != "" {
+			claim := client.ObjectKey{Namespace: model.Namespace, Name: model.Status.PVCName}
+			byClaim[claim] = append(byClaim[claim], key)
+		}
+	}
+
+	consumers := map[client.ObjectKey]int{}
+	for j := range pods {
+		pod := &pods[j]
+		if !resources.IsConsumerPod(pod) {
+			continue
+		}
+		mounted := map[client.ObjectKey]bool{}
+		for _, volume := range pod.Spec.Volumes {
+			for _, key := range byVolume[client.ObjectKey{Namespace: pod.Namespace, Name: volume.Name}] {
+				mounted[key] = true
+			}
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			claim := volume.PersistentVolumeClaim.ClaimName
+			for _, key := range byClaim[client.ObjectKey{Namespace: pod.Namespace, Name: claim}] {
+				mounted[key] = true
+			}
+			// Replica claims are named after the model and then the node, and
+			// model names may contain dashes, so try the name before each one
+			rest, ok := strings.CutPrefix(claim, resources.ReplicaPrefix)
+			if !ok {
+				continue
+			}
+			for n := range len(rest) {
+				key := client.ObjectKey{Namespace: pod.Namespace, Name: rest[:n]}
+				if rest[n] == '-' && names[key] {
+					mounted[key] = true
+				}
+			}
+		}
+		for key := range mounted {
+			consumers[key]++
+		}
+	}
+	return consumers
+}
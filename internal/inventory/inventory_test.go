@@ -0,0 +1,264 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+var (
+	started = metav1.NewTime(time.Date(2026, 3, 1, 11, 58, 30, 0, time.UTC))
+	synced  = metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+)
+
+func testInventory(t *testing.T) *Inventory {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ready := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Storage:     modelsv1alpha1.StorageSpec{Size: "20Gi"},
+			Deprecated:  true,
+			Replacement: "llama-3",
+		},
+		Status: modelsv1alpha1.ModelStatus{
+			Phase:              modelsv1alpha1.ModelPhaseReady,
+			ContentDigest:      "sha256:abc",
+			EstimatedSizeBytes: 1000,
+			StartTime:          &started,
+			CompletionTime:     &synced,
+			DownloadDuration:   &metav1.Duration{Duration: 90 * time.Second},
+			Conditions: []metav1.Condition{{
+				Type: "Ready", Status: metav1.ConditionTrue, Reason: "DownloadComplete", LastTransitionTime: synced,
+			}},
+		},
+	}
+	pending := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "bge", Namespace: "default"},
+		Spec:       modelsv1alpha1.ModelSpec{Storage: modelsv1alpha1.StorageSpec{Size: "1Gi"}},
+		Status: modelsv1alpha1.ModelStatus{
+			Phase: modelsv1alpha1.ModelPhaseDownloading, DownloadedBytes: 10, StartTime: &started,
+		},
+	}
+
+	pod := func(name, namespace string, phase corev1.PodPhase) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name: resources.VolumeName("llama"),
+				VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: resources.PVCName("llama"),
+				}},
+			}}},
+			Status: corev1.PodStatus{Phase: phase},
+		}
+	}
+
+	objs := []client.Object{
+		ready, pending,
+		pod("vllm-1", "ml", corev1.PodRunning),
+		pod("vllm-2", "ml", corev1.PodRunning),
+		pod("finished", "ml", corev1.PodSucceeded),
+		pod("elsewhere", "default", corev1.PodRunning),
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+	return New(c, c)
+}
+
+func TestList(t *testing.T) {
+	entries, err := testInventory(t).List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(entries) != 2 || entries[0].Name != "bge" || entries[1].Name != "llama" {
+		t.Fatalf("List() = %+v, want bge then llama", entries)
+	}
+
+	llama := entries[1]
+	if llama.Phase != modelsv1alpha1.ModelPhaseReady || llama.ContentDigest != "sha256:abc" || llama.StorageSize != "20Gi" {
+		t.Errorf("List()[llama] = %+v, want the Model's phase, digest and storage size", llama)
+	}
+	if llama.SizeBytes != 1000 {
+		t.Errorf("SizeBytes = %v, want the estimate without a downloaded byte count", llama.SizeBytes)
+	}
+	if llama.Consumers != 2 {
+		t.Errorf("Consumers = %v, want the 2 running pods in the Model's namespace", llama.Consumers)
+	}
+	if llama.LastSyncTime == nil || !llama.LastSyncTime.Equal(&synced) {
+		t.Errorf("LastSyncTime = %v, want %v", llama.LastSyncTime, synced)
+	}
+
+	if llama.DownloadStartTime != nil || llama.DownloadDuration == nil || llama.DownloadDuration.Duration != 90*time.Second {
+		t.Errorf("List()[llama] = %+v, want the duration of the finished download only", llama)
+	}
+
+	bge := entries[0]
+	if bge.SizeBytes != 10 || bge.LastSyncTime != nil || bge.Consumers != 0 {
+		t.Errorf("List()[bge] = %+v, want the downloaded bytes and no sync time or consumers", bge)
+	}
+	if bge.DownloadStartTime == nil || !bge.DownloadStartTime.Equal(&started) || bge.DownloadDuration != nil {
+		t.Errorf("List()[bge] = %+v, want the start of the running download", bge)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	inv := testInventory(t)
+
+	rec := httptest.NewRecorder()
+	inv.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusOK || rec.Header().Get("Content-Type") != "application/json" {
+		t.Fatalf("ServeHTTP() = %d %s, want JSON", rec.Code, rec.Header().Get("Content-Type"))
+	}
+	var body struct {
+		Models []Entry `json:"models"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || len(body.Models) != 2 {
+		t.Errorf("ServeHTTP() body = %s, want both models", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, Path, nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=1.0.0")
+	inv.ServeHTTP(rec, req)
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/openmetrics-text") {
+		t.Errorf("ServeHTTP() Content-Type = %s, want OpenMetrics", rec.Header().Get("Content-Type"))
+	}
+	for _, want := range []string{
+		`model_operator_model_info{content_digest="sha256:abc",name="llama",namespace="ml",phase="Ready"} 1.0`,
+		`model_operator_model_consumers{name="llama",namespace="ml"} 2.0`,
+		`model_operator_model_last_sync_timestamp_seconds{name="llama",namespace="ml"} 1.7723664e+09`,
+		`model_operator_deprecated_model_consumers{name="llama",namespace="ml",replacement="llama-3"} 2.0`,
+		`model_operator_download_start_timestamp_seconds{name="bge",namespace="default"} 1.77236631e+09`,
+		`model_operator_download_duration_seconds{name="llama",namespace="ml"} 90.0`,
+		"# EOF",
+	} {
+		if !strings.Contains(rec.Body.String(), want) {
+			t.Errorf("ServeHTTP() OpenMetrics body is missing %q:\n%s", want, rec.Body.String())
+		}
+	}
+	if strings.Contains(rec.Body.String(), `model_operator_deprecated_model_consumers{name="bge"`) {
+		t.Errorf("ServeHTTP() reports consumers of bge as deprecated:\n%s", rec.Body.String())
+	}
+}
+
+func TestListCached(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	now := synced.Time
+	inv := New(c, c)
+	inv.now = func() time.Time { return now }
+
+	if entries, err := inv.List(t.Context()); err != nil || len(entries) != 0 {
+		t.Fatalf("List() = %v, %v, want no entries", entries, err)
+	}
+	if err := c.Create(t.Context(), &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	now = now.Add(cacheTTL / 2)
+	if entries, _ := inv.List(t.Context()); len(entries) != 0 {
+		t.Errorf("List() = %v, want the cached listing within the TTL", entries)
+	}
+	now = now.Add(cacheTTL)
+	if entries, _ := inv.List(t.Context()); len(entries) != 1 {
+		t.Errorf("List() = %v, want a new listing after the TTL", entries)
+	}
+}
+
+func TestCountConsumers(t *testing.T) {
+	model := func(name string, status modelsv1alpha1.ModelStatus) modelsv1alpha1.Model {
+		return modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ml"}, Status: status}
+	}
+	models := []modelsv1alpha1.Model{
+		model("llama", modelsv1alpha1.ModelStatus{}),
+		model("llama-3", modelsv1alpha1.ModelStatus{PVCName: "restored-llama-3"}),
+		model("bge", modelsv1alpha1.ModelStatus{}),
+	}
+	claim := func(name string) corev1.Volume {
+		return corev1.Volume{Name: "data", VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: name},
+		}}
+	}
+	pod := func(name, namespace string, volumes ...corev1.Volume) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       corev1.PodSpec{Volumes: volumes},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	pods := []corev1.Pod{
+		pod("injected", "ml", corev1.Volume{Name: resources.VolumeName("llama")}),
+		pod("by-claim", "ml", claim(resources.PVCName("llama"))),
+		pod("both", "ml", corev1.Volume{Name: resources.VolumeName("llama")}, claim(resources.PVCName("llama"))),
+		pod("restored", "ml", claim("restored-llama-3")),
+		// A replica claim of llama-3 also starts with the prefix of llama's
+		pod("replica", "ml", claim(resources.ReplicaPrefix+"llama-3-node-a")),
+		pod("elsewhere", "default", claim(resources.PVCName("bge"))),
+		pod("unrelated", "ml", claim("scratch")),
+	}
+
+	want := map[client.ObjectKey]int{}
+	for j := range pods {
+		for k := range models {
+			if pods[j].Namespace != models[k].Namespace {
+				continue
+			}
+			if _, ok := resources.MountsModel(&pods[j], &models[k]); ok {
+				want[client.ObjectKeyFromObject(&models[k])]++
+			}
+		}
+	}
+	got := countConsumers(models, pods)
+	if len(got) != len(want) {
+		t.Fatalf("countConsumers() = %v, want %v", got, want)
+	}
+	for key, n := range want {
+		if got[key] != n {
+			t.Errorf("countConsumers()[%s] = %d, want %d as resources.MountsModel counts", key, got[key], n)
+		}
+	}
+}
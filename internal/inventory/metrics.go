@@ -0,0 +1,95 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inventory
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+var (
+	modelInfoDesc = prometheus.NewDesc("model_operator_model_info",
+		"Information about a Model, always 1",
+		[]string{"namespace", "name", "phase", "content_digest"}, nil)
+	modelSizeDesc = prometheus.NewDesc("model_operator_model_size_bytes",
+		"Size of the Model download in bytes",
+		[]string{"namespace", "name"}, nil)
+	modelConsumersDesc = prometheus.NewDesc("model_operator_model_consumers",
+		"Number of running pods mounting the Model",
+		[]string{"namespace", "name"}, nil)
+	deprecatedConsumersDesc = prometheus.NewDesc("model_operator_deprecated_model_consumers",
+		"Number of running pods still mounting a deprecated Model",
+		[]string{"namespace", "name", "replacement"}, nil)
+	modelLastSyncDesc = prometheus.NewDesc("model_operator_model_last_sync_timestamp_seconds",
+		"Time the Model last became Ready, in seconds since the epoch",
+		[]string{"namespace", "name"}, nil)
+	downloadStartDesc = prometheus.NewDesc("model_operator_download_start_timestamp_seconds",
+		"Time the running download of the Model started, in seconds since the epoch",
+		[]string{"namespace", "name"}, nil)
+	downloadDurationDesc = prometheus.NewDesc("model_operator_download_duration_seconds",
+		"How long the last finished download of the Model ran",
+		[]string{"namespace", "name"}, nil)
+)
+
+// Describe implements prometheus.Collector
+func (i *Inventory) Describe(ch chan<- *prometheus.Desc) {
+	ch <- modelInfoDesc
+	ch <- modelSizeDesc
+	ch <- modelConsumersDesc
+	ch <- deprecatedConsumersDesc
+	ch <- modelLastSyncDesc
+	ch <- downloadStartDesc
+	ch <- downloadDurationDesc
+}
+
+// Collect implements prometheus.Collector, listing the Models on every scrape
+func (i *Inventory) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+	entries, err := i.List(ctx)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list the model inventory")
+		return
+	}
+
+	for _, e := range entries {
+		ch <- prometheus.MustNewConstMetric(modelInfoDesc, prometheus.GaugeValue, 1,
+			e.Namespace, e.Name, string(e.Phase), e.ContentDigest)
+		ch <- prometheus.MustNewConstMetric(modelSizeDesc, prometheus.GaugeValue, float64(e.SizeBytes),
+			e.Namespace, e.Name)
+		ch <- prometheus.MustNewConstMetric(modelConsumersDesc, prometheus.GaugeValue, float64(e.Consumers),
+			e.Namespace, e.Name)
+		if e.Deprecated {
+			ch <- prometheus.MustNewConstMetric(deprecatedConsumersDesc, prometheus.GaugeValue, float64(e.Consumers),
+				e.Namespace, e.Name, e.Replacement)
+		}
+		if e.LastSyncTime != nil {
+			ch <- prometheus.MustNewConstMetric(modelLastSyncDesc, prometheus.GaugeValue,
+				float64(e.LastSyncTime.Unix()), e.Namespace, e.Name)
+		}
+		if e.DownloadStartTime != nil {
+			ch <- prometheus.MustNewConstMetric(downloadStartDesc, prometheus.GaugeValue,
+				float64(e.DownloadStartTime.Unix()), e.Namespace, e.Name)
+		}
+		if e.DownloadDuration != nil {
+			ch <- prometheus.MustNewConstMetric(downloadDurationDesc, prometheus.GaugeValue,
+				e.DownloadDuration.Seconds(), e.Namespace, e.Name)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func loadCRDs(t *testing.T) []*apiextensionsv1.CustomResourceDefinition {
+	t.Helper()
+	crds, err := LoadCRDs(filepath.Join("..", "..", "config", "crd", "bases"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crds
+}
+
+func TestSamples_MatchCRDs(t *testing.T) {
+	crds := loadCRDs(t)
+	for _, sample := range Samples() {
+		if err := Validate(crds, sample.Model); err != nil {
+			t.Errorf("Sample %s does not match the CRD: %v", sample.File, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	crds := loadCRDs(t)
+	valid := Samples()[0].Model
+
+	tests := []struct {
+		name    string
+		mutate  func(m *modelsv1alpha1.Model)
+		wantErr string
+	}{
+		{
+			name:   "valid",
+			mutate: func(m *modelsv1alpha1.Model) {},
+		},
+		{
+			name:    "pattern",
+			mutate:  func(m *modelsv1alpha1.Model) { m.Spec.Storage.Size = "lots" },
+			wantErr: "spec.storage.size",
+		},
+		{
+			name:    "required",
+			mutate:  func(m *modelsv1alpha1.Model) { m.Spec.Source.HuggingFace.RepoID = "" },
+			wantErr: "spec.source.huggingFace.repoId",
+		},
+		{
+			name: "CEL rule",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Download = &modelsv1alpha1.DownloadSpec{DNSPolicy: "None"}
+			},
+			wantErr: "dnsConfig is required when dnsPolicy is None",
+		},
+		{
+			name: "configmap storage",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeConfigMap, Size: "512Ki"}
+			},
+		},
+		{
+			name: "configmap storage too large",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeConfigMap, Size: "2Mi"}
+			},
+			wantErr: "configmap storage holds at most 1Mi",
+		},
+		{
+			name: "image storage without publish",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeImage, Size: "1Gi"}
+			},
+			wantErr: "image storage requires spec.publish",
+		},
+		{
+			name: "image storage with replicas",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeImage, Size: "1Gi"}
+				m.Spec.Publish = &modelsv1alpha1.PublishSpec{Image: "registry.internal/models/tokenizer:v1"}
+				m.Spec.Replicas = []modelsv1alpha1.StorageReplica{{Name: "nvme", StorageClass: "local-nvme"}}
+			},
+			wantErr: "replicas and conversion require the pvc storage mode",
+		},
+		{
+			name: "ownership",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage.Ownership = &modelsv1alpha1.StorageOwnership{UID: ptr.To[int64](1000), Mode: "0640"}
+			},
+		},
+		{
+			name: "empty ownership",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage.Ownership = &modelsv1alpha1.StorageOwnership{}
+			},
+			wantErr: "ownership must set a uid, gid or mode",
+		},
+		{
+			name: "ownership in the configmap mode",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage = modelsv1alpha1.StorageSpec{
+					Mode:      modelsv1alpha1.StorageModeConfigMap,
+					Size:      "512Ki",
+					Ownership: &modelsv1alpha1.StorageOwnership{UID: ptr.To[int64](1000)},
+				}
+			},
+			wantErr: "ownership is not supported in the configmap mode",
+		},
+		{
+			name: "presigned S3 without credentials",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Source = modelsv1alpha1.ModelSource{S3: &modelsv1alpha1.S3Source{
+					Bucket:  "model-bucket",
+					Key:     "models/llama/",
+					Presign: &modelsv1alpha1.S3Presign{ExpirySeconds: 3600},
+				}}
+				m.Spec.CredentialsSecret = ""
+			},
+			wantErr: "presigned S3 downloads require credentialsSecret",
+		},
+		{
+			name: "presigned S3 expiry too long",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Source = modelsv1alpha1.ModelSource{S3: &modelsv1alpha1.S3Source{
+					Bucket:  "model-bucket",
+					Key:     "models/llama/",
+					Presign: &modelsv1alpha1.S3Presign{ExpirySeconds: 8 * 24 * 3600},
+				}}
+				m.Spec.CredentialsSecret = "aws-credentials"
+			},
+			wantErr: "spec.source.s3.presign.expirySeconds",
+		},
+		{
+			name: "signature verification",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Verification = &modelsv1alpha1.VerificationSpec{Signature: testSignature()}
+			},
+		},
+		{
+			name: "signature without location",
+			mutate: func(m *modelsv1alpha1.Model) {
+				sig := testSignature()
+				sig.URL = ""
+				m.Spec.Verification = &modelsv1alpha1.VerificationSpec{Signature: sig}
+			},
+			wantErr: "signature must set exactly one of url or s3Key",
+		},
+		{
+			name: "signature s3Key without S3 source",
+			mutate: func(m *modelsv1alpha1.Model) {
+				sig := testSignature()
+				sig.URL = ""
+				sig.S3Key = "models/llama/SHA256SUMS.sig"
+				m.Spec.Verification = &modelsv1alpha1.VerificationSpec{Signature: sig}
+			},
+			wantErr: "signature s3Key requires an S3 source",
+		},
+		{
+			name: "signed file outside the model directory",
+			mutate: func(m *modelsv1alpha1.Model) {
+				sig := testSignature()
+				sig.File = "../etc/passwd"
+				m.Spec.Verification = &modelsv1alpha1.VerificationSpec{Signature: sig}
+			},
+			wantErr: "file must be a relative path inside the model directory",
+		},
+		{
+			name: "verification in the configmap mode",
+			mutate: func(m *modelsv1alpha1.Model) {
+				m.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeConfigMap, Size: "512Ki"}
+				m.Spec.Verification = &modelsv1alpha1.VerificationSpec{Signature: testSignature()}
+			},
+			wantErr: "verification requires the pvc storage mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := valid.DeepCopy()
+			tt.mutate(model)
+			err := Validate(crds, model)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	opts := Options{Namespace: "models", NamePrefix: "model-operator-", CertManager: true}
+	if err := Write(dir, loadCRDs(t), opts); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	install, err := os.ReadFile(filepath.Join(dir, "install.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"name: models.models.main-currents.news",
+		"name: model-operator-mutating-webhook-configuration",
+		"name: model-operator-webhook-service",
+		AnnotationInjectCA + ": models/model-operator-serving-cert",
+	} {
+		if !strings.Contains(string(install), want) {
+			t.Errorf("install.yaml should contain %q", want)
+		}
+	}
+
+	for _, sample := range Samples() {
+		raw, err := os.ReadFile(filepath.Join(dir, "samples", sample.File))
+		if err != nil {
+			t.Fatal(err)
+		}
+		model := &modelsv1alpha1.Model{}
+		if err := yaml.UnmarshalStrict(raw, model); err != nil {
+			t.Errorf("Sample %s does not round trip: %v", sample.File, err)
+		}
+		if model.Name != sample.Model.Name {
+			t.Errorf("Sample %s name = %q, want %q", sample.File, model.Name, sample.Model.Name)
+		}
+	}
+}
+
+func testSignature() *modelsv1alpha1.SignatureVerification {
+	return &modelsv1alpha1.SignatureVerification{
+		Provider: modelsv1alpha1.SignatureProviderGPG,
+		PublicKey: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "release-keyring"},
+			Key:                  "pubring.gpg",
+		},
+		File:      "SHA256SUMS",
+		URL:       "https://example.com/SHA256SUMS.sig",
+		Checksums: true,
+	}
+}
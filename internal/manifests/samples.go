@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// Sample is a Model written out as an example of one source type
+type Sample struct {
+	// File is the name the sample is written to
+	File  string
+	Model *modelsv1alpha1.Model
+}
+
+// sampleModel returns a Model with the given source and storage
+func sampleModel(name, version string, source modelsv1alpha1.ModelSource, storage modelsv1alpha1.StorageSpec) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: modelsv1alpha1.GroupVersion.String(),
+			Kind:       "Model",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source:  source,
+			Version: version,
+			Storage: storage,
+		},
+	}
+}
+
+// Samples returns one sample Model for each source type
+func Samples() []Sample {
+	huggingFace := sampleModel("llama-3-8b", "3.1", modelsv1alpha1.ModelSource{
+		HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+			RepoID:    "meta-llama/Llama-3.1-8B-Instruct",
+			Revision:  "main",
+			FetchCard: true,
+		},
+	}, modelsv1alpha1.StorageSpec{StorageClass: "longhorn", Size: "20Gi"})
+	huggingFace.Spec.CredentialsSecret = "hf-credentials"
+
+	url := sampleModel("gguf-model", "2.0-Q4", modelsv1alpha1.ModelSource{
+		URL: &modelsv1alpha1.URLSource{
+			URL: "https://huggingface.co/TheBloke/Llama-2-7B-GGUF/resolve/main/llama-2-7b.Q4_K_M.gguf",
+		},
+	}, modelsv1alpha1.StorageSpec{StorageClass: "longhorn", Size: "5Gi"})
+
+	s3 := sampleModel("custom-model", "1.0", modelsv1alpha1.ModelSource{
+		S3: &modelsv1alpha1.S3Source{
+			Bucket: "my-models-bucket",
+			Key:    "models/custom-model/",
+			Region: "us-east-1",
+		},
+	}, modelsv1alpha1.StorageSpec{StorageClass: "gp3", Size: "10Gi"})
+	s3.Spec.CredentialsSecret = "aws-credentials"
+
+	git := sampleModel("llama-git", "3.1", modelsv1alpha1.ModelSource{
+		Git: &modelsv1alpha1.GitSource{
+			URL:     "https://huggingface.co/meta-llama/Llama-3.1-8B-Instruct",
+			Ref:     "main",
+			LFS:     ptr.To(true),
+			Depth:   ptr.To(1),
+			Include: []string{"*.safetensors", "*.json", "tokenizer*"},
+			Exclude: []string{"*.bin", "*.h5"},
+		},
+	}, modelsv1alpha1.StorageSpec{StorageClass: "local-path", Size: "20Gi"})
+
+	dvc := sampleModel("dvc-classifier", "2.0", modelsv1alpha1.ModelSource{
+		DVC: &modelsv1alpha1.DVCSource{
+			URL:                "https://github.com/example/research.git",
+			Path:               "models/classifier",
+			Rev:                "v2.0",
+			RemoteConfigSecret: "dvc-remote-config",
+		},
+	}, modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"})
+
+	return []Sample{
+		{File: "models_v1alpha1_model_huggingface.yaml", Model: huggingFace},
+		{File: "models_v1alpha1_model_url.yaml", Model: url},
+		{File: "models_v1alpha1_model_s3.yaml", Model: s3},
+		{File: "models_v1alpha1_model_git.yaml", Model: git},
+		{File: "models_v1alpha1_model_dvc.yaml", Model: dvc},
+	}
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manifests
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/rsJames-ttrpg/model-operator/internal/webhook"
+)
+
+// AnnotationInjectCA asks cert-manager to inject the serving certificate's CA
+const AnnotationInjectCA = "cert-manager.io/inject-ca-from"
+
+// Options configures the generated install manifests
+type Options struct {
+	// Namespace the operator is installed in
+	Namespace string
+	// NamePrefix is prepended to resource names, as kustomize's namePrefix
+	// does in config/default
+	NamePrefix string
+	// CertManager injects the CA of the cert-manager serving certificate into
+	// the webhook configurations
+	CertManager bool
+}
+
+// toContent converts obj to the fields written to a manifest, without status
+// or server-populated metadata
+func toContent(obj runtime.Object) (map[string]interface{}, error) {
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	delete(content, "status")
+	unstructured.RemoveNestedField(content, "metadata", "creationTimestamp")
+	return content, nil
+}
+
+// Marshal writes objs as a multi-document YAML stream
+func Marshal(objs ...runtime.Object) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, obj := range objs {
+		content, err := toContent(obj)
+		if err != nil {
+			return nil, err
+		}
+		doc, err := yaml.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteString("---\n")
+		buf.Write(doc)
+	}
+	return buf.Bytes(), nil
+}
+
+// Install returns the CRDs and webhook configurations to install
+func Install(crds []*apiextensionsv1.CustomResourceDefinition, opts Options) []runtime.Object {
+	mutating, validating := webhook.Configurations(opts.NamePrefix+"webhook-service", opts.Namespace)
+	mutating.Name = opts.NamePrefix + mutating.Name
+	validating.Name = opts.NamePrefix + validating.Name
+	if opts.CertManager {
+		ca := map[string]string{AnnotationInjectCA: opts.Namespace + "/" + opts.NamePrefix + "serving-cert"}
+		mutating.Annotations = ca
+		validating.Annotations = ca
+	}
+
+	objs := make([]runtime.Object, 0, len(crds)+2)
+	for _, crd := range crds {
+		objs = append(objs, crd)
+	}
+	return append(objs, mutating, validating)
+}
+
+// Write validates the samples against crds and writes install.yaml and the
+// samples directory to dir
+func Write(dir string, crds []*apiextensionsv1.CustomResourceDefinition, opts Options) error {
+	install, err := Marshal(Install(crds, opts)...)
+	if err != nil {
+		return err
+	}
+
+	samplesDir := filepath.Join(dir, "samples")
+	if err := os.MkdirAll(samplesDir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "install.yaml"), install, 0o644); err != nil {
+		return err
+	}
+
+	kustomization := []byte("resources:\n")
+	for _, sample := range Samples() {
+		if err := Validate(crds, sample.Model); err != nil {
+			return fmt.Errorf("sample %s does not match the CRD: %w", sample.File, err)
+		}
+		doc, err := Marshal(sample.Model)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(samplesDir, sample.File), doc, 0o644); err != nil {
+			return err
+		}
+		kustomization = append(kustomization, "- "+sample.File+"\n"...)
+	}
+	return os.WriteFile(filepath.Join(samplesDir, "kustomization.yaml"), kustomization, 0o644)
+}
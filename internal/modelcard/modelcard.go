@@ -0,0 +1,285 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package modelcard fetches Hugging Face model cards, so catalogs can show
+// what a model is without visiting the Hub.
+//
+// The card metadata (license, tags, pipeline) comes from the Hub API and the
+// full card from the repository's README.md.
+package modelcard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultEndpoint is the public Hugging Face Hub
+	DefaultEndpoint = "https://huggingface.co"
+
+	// MaxSummaryLength bounds the summary kept in the Model status
+	MaxSummaryLength = 500
+
+	// MaxREADMESize bounds the card kept in a ConfigMap, well below the
+	// 1MiB object size limit
+	MaxREADMESize = 512 * 1024
+)
+
+// Card is a fetched model card
+type Card struct {
+	// License is the license identifier (e.g. "apache-2.0")
+	License string
+	// Tags are the tags declared in the card metadata
+	Tags []string
+	// PipelineTag is the task the model is for (e.g. "text-generation")
+	PipelineTag string
+	// Summary is the first paragraph of the card
+	Summary string
+	// README is the full card, truncated to MaxREADMESize
+	README string
+}
+
+// Fetcher fetches model cards
+type Fetcher interface {
+	// Fetch returns the card of repoID at revision from the Hub at endpoint.
+	// The token is optional and only needed for gated or private repos.
+	Fetch(ctx context.Context, endpoint, repoID, revision, token string) (*Card, error)
+}
+
+// NewFetcher returns a Fetcher that uses the Hub HTTP API of the allowed
+// endpoints. Without any, only DefaultEndpoint is allowed. Cards are fetched
+// with the Model's Hub token, so the operator must not send requests to
+// endpoints a Model author picks freely.
+func NewFetcher(httpClient *http.Client, allowedEndpoints []string) (Fetcher, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	if len(allowedEndpoints) == 0 {
+		allowedEndpoints = []string{DefaultEndpoint}
+	}
+	f := &hubFetcher{client: httpClient, allowed: map[string]bool{}}
+	for _, endpoint := range allowedEndpoints {
+		u, err := parseEndpoint(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		f.allowed[u.String()] = true
+	}
+	return f, nil
+}
+
+type hubFetcher struct {
+	client *http.Client
+	// allowed holds the normalized endpoints cards may be fetched from
+	allowed map[string]bool
+}
+
+// parseEndpoint parses a Hub endpoint, which is an http or https URL
+// without credentials, query or fragment. The trailing slash is dropped so
+// equivalent endpoints compare equal.
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return nil, fmt.Errorf("endpoint %q must be an http or https URL", endpoint)
+	case u.Host == "":
+		return nil, fmt.Errorf("endpoint %q has no host", endpoint)
+	case u.User != nil:
+		return nil, errors.New("endpoint must not contain credentials")
+	case u.RawQuery != "" || u.ForceQuery || u.Fragment != "" || u.RawFragment != "":
+		return nil, fmt.Errorf("endpoint %q must not have a query or fragment", endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u, nil
+}
+
+// validSegment reports whether s can be joined into a URL path without
+// changing which resource it names
+func validSegment(s string) bool {
+	return s != "" && s != "." && s != ".." && !strings.ContainsAny(s, "/?#\\")
+}
+
+// modelInfo is the subset of the Hub model API response that is used
+type modelInfo struct {
+	PipelineTag string `json:"pipeline_tag"`
+	CardData    struct {
+		License json.RawMessage `json:"license"`
+		Tags    []string        `json:"tags"`
+	} `json:"cardData"`
+}
+
+func (f *hubFetcher) Fetch(ctx context.Context, endpoint, repoID, revision, token string) (*Card, error) {
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	base, err := parseEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	if !f.allowed[base.String()] {
+		return nil, fmt.Errorf("endpoint %s is not an allowed model card endpoint", base)
+	}
+	owner, name, ok := strings.Cut(repoID, "/")
+	if !ok || !validSegment(owner) || !validSegment(name) {
+		return nil, fmt.Errorf("invalid repository ID %q", repoID)
+	}
+	if revision == "" {
+		revision = "main"
+	}
+	if revision == "." || revision == ".." {
+		return nil, fmt.Errorf("invalid revision %q", revision)
+	}
+	// JoinPath takes escaped segments, so a revision like refs/pr/1 stays a
+	// single segment
+	rev := url.PathEscape(revision)
+
+	body, found, err := f.get(ctx, base.JoinPath("api", "models", owner, name, "revision", rev).String(), token, 1<<20)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("model %s@%s not found on %s", repoID, revision, endpoint)
+	}
+	info := &modelInfo{}
+	if err := json.Unmarshal(body, info); err != nil {
+		return nil, fmt.Errorf("failed to parse model info: %w", err)
+	}
+
+	// A repository without a README has no card text, which is not an error
+	readme, _, err := f.get(ctx, base.JoinPath(owner, name, "resolve", rev, "README.md").String(), token, MaxREADMESize)
+	if err != nil {
+		return nil, err
+	}
+
+	// The size limit may have cut the last character
+	card := strings.ToValidUTF8(string(readme), "")
+	return &Card{
+		License:     parseLicense(info.CardData.License),
+		Tags:        info.CardData.Tags,
+		PipelineTag: info.PipelineTag,
+		Summary:     Summarize(card),
+		README:      card,
+	}, nil
+}
+
+// get returns up to limit bytes of the response body, or found=false on 404
+func (f *hubFetcher) get(ctx context.Context, rawURL, token string, limit int64) ([]byte, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, false, fmt.Errorf("GET %s: unexpected status %s", rawURL, resp.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	if err != nil {
+		return nil, false, err
+	}
+	return body, true, nil
+}
+
+// parseLicense accepts the license as a string or a list of strings
+func parseLicense(raw json.RawMessage) string {
+	var license string
+	if err := json.Unmarshal(raw, &license); err == nil {
+		return license
+	}
+	var licenses []string
+	if err := json.Unmarshal(raw, &licenses); err == nil {
+		return strings.Join(licenses, ",")
+	}
+	return ""
+}
+
+// Summarize returns the first paragraph of prose in a model card, skipping
+// the YAML front matter, headings, images, badges and HTML
+func Summarize(readme string) string {
+	lines := strings.Split(strings.ReplaceAll(readme, "\r\n", "\n"), "\n")
+
+	// Skip the front matter
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "---" {
+				lines = lines[i+1:]
+				break
+			}
+		}
+	}
+
+	var paragraph []string
+	inComment := false
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case inComment:
+			inComment = !strings.Contains(line, "-->")
+			continue
+		case strings.HasPrefix(line, "<!--"):
+			inComment = !strings.Contains(line, "-->")
+			continue
+		case line == "":
+			if len(paragraph) > 0 {
+				return truncate(strings.Join(paragraph, " "), MaxSummaryLength)
+			}
+			continue
+		case strings.HasPrefix(line, "#"), strings.HasPrefix(line, "!["), strings.HasPrefix(line, "[!["),
+			strings.HasPrefix(line, "<"), strings.HasPrefix(line, "|"), strings.HasPrefix(line, "```"):
+			if len(paragraph) > 0 {
+				return truncate(strings.Join(paragraph, " "), MaxSummaryLength)
+			}
+			continue
+		}
+		paragraph = append(paragraph, line)
+	}
+	return truncate(strings.Join(paragraph, " "), MaxSummaryLength)
+}
+
+// truncate shortens s to at most n bytes on a rune boundary, marking the cut
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	cut := n - len("…")
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + "…"
+}
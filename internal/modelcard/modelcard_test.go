@@ -0,0 +1,209 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelcard
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testREADME = `---
+license: apache-2.0
+tags:
+- text-generation
+---
+
+# Qwen2.5-0.5B-Instruct
+
+<a href="https://chat.qwen.ai/"><img src="badge.svg"/></a>
+
+## Introduction
+
+Qwen2.5 is the latest series of Qwen large language models.
+It brings significantly more knowledge.
+
+More details follow.
+`
+
+func TestSummarize(t *testing.T) {
+	tests := []struct {
+		name   string
+		readme string
+		want   string
+	}{
+		{
+			name:   "skips front matter, headings and html",
+			readme: testREADME,
+			want:   "Qwen2.5 is the latest series of Qwen large language models. It brings significantly more knowledge.",
+		},
+		{
+			name:   "skips comments and badges",
+			readme: "<!-- generated\nby a tool -->\n[![CI](ci.svg)](ci)\n\nA small embedding model.\n",
+			want:   "A small embedding model.",
+		},
+		{
+			name:   "empty",
+			readme: "",
+			want:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Summarize(tt.readme); got != tt.want {
+				t.Errorf("Summarize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarize_Truncates(t *testing.T) {
+	got := Summarize(strings.Repeat("é", MaxSummaryLength))
+	if len(got) > MaxSummaryLength {
+		t.Errorf("Summarize() length = %d, want at most %d", len(got), MaxSummaryLength)
+	}
+	if !strings.HasSuffix(got, "…") {
+		t.Errorf("Summarize() should mark the truncation, got %q", got)
+	}
+}
+
+func TestFetch(t *testing.T) {
+	var gotAuth string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case "/api/models/Qwen/Qwen2.5-0.5B-Instruct/revision/main":
+			_, _ = w.Write([]byte(`{"pipeline_tag":"text-generation","cardData":{"license":"apache-2.0","tags":["chat"]}}`))
+		case "/Qwen/Qwen2.5-0.5B-Instruct/resolve/main/README.md":
+			_, _ = w.Write([]byte(testREADME))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher, err := NewFetcher(server.Client(), []string{server.URL + "/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := fetcher.Fetch(context.Background(), server.URL, "Qwen/Qwen2.5-0.5B-Instruct", "", "hf_secret")
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if card.License != "apache-2.0" {
+		t.Errorf("License = %v, want apache-2.0", card.License)
+	}
+	if card.PipelineTag != "text-generation" {
+		t.Errorf("PipelineTag = %v, want text-generation", card.PipelineTag)
+	}
+	if len(card.Tags) != 1 || card.Tags[0] != "chat" {
+		t.Errorf("Tags = %v, want [chat]", card.Tags)
+	}
+	if card.README != testREADME {
+		t.Errorf("README was not stored in full")
+	}
+	if !strings.HasPrefix(card.Summary, "Qwen2.5 is the latest") {
+		t.Errorf("Summary = %q", card.Summary)
+	}
+	if gotAuth != "Bearer hf_secret" {
+		t.Errorf("Authorization = %q, want Bearer hf_secret", gotAuth)
+	}
+}
+
+func TestFetch_Errors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/models/org/no-readme/revision/main":
+			_, _ = w.Write([]byte(`{"cardData":{"license":["mit","cc-by-4.0"]}}`))
+		case "/api/models/org/gated/revision/main":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	fetcher, err := NewFetcher(server.Client(), []string{server.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	card, err := fetcher.Fetch(context.Background(), server.URL, "org/no-readme", "main", "")
+	if err != nil {
+		t.Fatalf("Fetch() without README error = %v", err)
+	}
+	if card.README != "" || card.License != "mit,cc-by-4.0" {
+		t.Errorf("Fetch() without README = %+v", card)
+	}
+
+	if _, err := fetcher.Fetch(context.Background(), server.URL, "org/gated", "main", ""); err == nil {
+		t.Error("Fetch() expected error for an unauthorized repo")
+	}
+	if _, err := fetcher.Fetch(context.Background(), server.URL, "org/missing", "main", ""); err == nil {
+		t.Error("Fetch() expected error for a missing repo")
+	}
+}
+
+func TestFetch_Endpoints(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.EscapedPath() == "/hub/api/models/org/model/revision/refs%2Fpr%2F1" {
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	fetcher, err := NewFetcher(server.Client(), []string{server.URL + "/hub"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fetcher.Fetch(context.Background(), server.URL+"/hub/", "org/model", "refs/pr/1", ""); err != nil {
+		t.Errorf("Fetch() from an allowed endpoint error = %v", err)
+	}
+
+	requests = 0
+	for _, tt := range []struct {
+		name, endpoint, repoID, revision string
+	}{
+		{"not allowed", server.URL + "/other", "org/model", "main"},
+		{"default endpoint", "", "org/model", "main"},
+		{"credentials", strings.Replace(server.URL, "://", "://user:pass@", 1) + "/hub", "org/model", "main"},
+		{"query", server.URL + "/hub?x=1", "org/model", "main"},
+		{"fragment", server.URL + "/hub#x", "org/model", "main"},
+		{"scheme", "file:///etc/passwd", "org/model", "main"},
+		{"repository outside the API", server.URL + "/hub", "../../admin", "main"},
+		{"repository with a query", server.URL + "/hub", "org/model?x=1", "main"},
+		{"parent revision", server.URL + "/hub", "org/model", ".."},
+	} {
+		if _, err := fetcher.Fetch(context.Background(), tt.endpoint, tt.repoID, tt.revision, "hf_secret"); err == nil {
+			t.Errorf("Fetch() with %s should fail", tt.name)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("rejected fetches sent %d requests", requests)
+	}
+
+	if _, err := NewFetcher(nil, []string{"https://user@hub.internal"}); err == nil {
+		t.Error("NewFetcher() should reject an endpoint with credentials")
+	}
+}
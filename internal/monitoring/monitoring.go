@@ -0,0 +1,317 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package monitoring keeps a ServiceMonitor for the operator's metrics and a
+// PrometheusRule with its default alerts, for clusters running the
+// Prometheus Operator
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;create;update
+
+// Defaults for the Manager
+const (
+	DefaultName             = "model-operator"
+	DefaultPort             = "https"
+	DefaultStuckAfter       = time.Hour
+	DefaultFailureRatio     = 0.1
+	DefaultStorageFullRatio = 0.9
+	DefaultInterval         = 10 * time.Minute
+)
+
+// tokenFile is the operator's ServiceAccount token Prometheus presents when
+// scraping the secure metrics endpoint
+const tokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+var (
+	serviceMonitorGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "ServiceMonitor"}
+	prometheusRuleGVK = schema.GroupVersionKind{Group: "monitoring.coreos.com", Version: "v1", Kind: "PrometheusRule"}
+)
+
+// Config describes the ServiceMonitor and PrometheusRule
+type Config struct {
+	// Namespace is the operator's namespace, where both objects are created
+	Namespace string
+	// Name names both objects, DefaultName by default
+	Name string
+	// ServiceSelector selects the metrics Service
+	ServiceSelector map[string]string
+	// Port is the name of the metrics port, DefaultPort by default
+	Port string
+	// Secure scrapes over HTTPS with the ServiceAccount token
+	Secure bool
+	// Labels are added to both objects, e.g. to match the selectors of a
+	// Prometheus instance
+	Labels map[string]string
+	// StuckAfter is how long a Model may download before it alerts,
+	// DefaultStuckAfter by default
+	StuckAfter time.Duration
+	// FailureRatio is the share of Failed Models that alerts,
+	// DefaultFailureRatio by default
+	FailureRatio float64
+	// StorageFullRatio is how full a model PVC may get before it alerts,
+	// DefaultStorageFullRatio by default
+	StorageFullRatio float64
+}
+
+func (c Config) name() string {
+	return orDefault(c.Name, DefaultName)
+}
+
+func (c Config) port() string {
+	return orDefault(c.Port, DefaultPort)
+}
+
+func (c Config) stuckAfter() time.Duration {
+	if c.StuckAfter > 0 {
+		return c.StuckAfter
+	}
+	return DefaultStuckAfter
+}
+
+func (c Config) failureRatio() float64 {
+	if c.FailureRatio > 0 {
+		return c.FailureRatio
+	}
+	return DefaultFailureRatio
+}
+
+func (c Config) storageFullRatio() float64 {
+	if c.StorageFullRatio > 0 {
+		return c.StorageFullRatio
+	}
+	return DefaultStorageFullRatio
+}
+
+func orDefault(s, def string) string {
+	if s != "" {
+		return s
+	}
+	return def
+}
+
+// Validate checks the ratios are between 0 and 1
+func (c Config) Validate() error {
+	if c.FailureRatio < 0 || c.FailureRatio > 1 {
+		return fmt.Errorf("failure ratio %v is not between 0 and 1", c.FailureRatio)
+	}
+	if c.StorageFullRatio < 0 || c.StorageFullRatio > 1 {
+		return fmt.Errorf("storage full ratio %v is not between 0 and 1", c.StorageFullRatio)
+	}
+	return nil
+}
+
+// labels returns the labels of both objects
+func (c Config) labels() map[string]string {
+	labels := map[string]string{"app.kubernetes.io/managed-by": "model-operator"}
+	maps.Copy(labels, c.Labels)
+	return labels
+}
+
+// newObject returns an empty object of kind gvk named by the Config
+func (c Config) newObject(gvk schema.GroupVersionKind) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(c.name())
+	obj.SetNamespace(c.Namespace)
+	obj.SetLabels(c.labels())
+	return obj
+}
+
+// BuildServiceMonitor returns the ServiceMonitor scraping the operator's
+// metrics Service
+func BuildServiceMonitor(c Config) *unstructured.Unstructured {
+	endpoint := map[string]any{
+		"path": "/metrics",
+		"port": c.port(),
+	}
+	if c.Secure {
+		endpoint["scheme"] = "https"
+		endpoint["bearerTokenFile"] = tokenFile
+		endpoint["tlsConfig"] = map[string]any{"insecureSkipVerify": true}
+	}
+	selector := map[string]any{}
+	for k, v := range c.ServiceSelector {
+		selector[k] = v
+	}
+
+	obj := c.newObject(serviceMonitorGVK)
+	obj.Object["spec"] = map[string]any{
+		"endpoints": []any{endpoint},
+		"selector":  map[string]any{"matchLabels": selector},
+		"namespaceSelector": map[string]any{
+			"matchNames": []any{c.Namespace},
+		},
+	}
+	return obj
+}
+
+// models deduplicates model_operator_model_info across operator replicas,
+// which all export the whole inventory
+const models = `max by (namespace, name) (model_operator_model_info%s)`
+
+// BuildPrometheusRule returns the PrometheusRule with the default alerts:
+// downloads stuck longer than StuckAfter, too many Failed Models and model
+// PVCs nearly full
+func BuildPrometheusRule(c Config) *unstructured.Unstructured {
+	ratio := func(f float64) string {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	percent := func(f float64) string {
+		return strconv.FormatFloat(f*100, 'f', -1, 64) + "%"
+	}
+	pvcs := fmt.Sprintf(`persistentvolumeclaim=~"%s.+"`, regexp.QuoteMeta(resources.PVCPrefix))
+
+	rules := []any{
+		map[string]any{
+			"alert": "ModelDownloadStuck",
+			"expr":  fmt.Sprintf(models, `{phase="Downloading"}`),
+			"for":   c.stuckAfter().String(),
+			"labels": map[string]any{
+				"severity": "warning",
+			},
+			"annotations": map[string]any{
+				"summary": "Model {{ $labels.namespace }}/{{ $labels.name }} has been downloading for over " +
+					c.stuckAfter().String(),
+			},
+		},
+		map[string]any{
+			"alert": "ModelFailureRatioHigh",
+			"expr": fmt.Sprintf("count(%s) / count(%s) > %s",
+				fmt.Sprintf(models, `{phase="Failed"}`), fmt.Sprintf(models, ""), ratio(c.failureRatio())),
+			"for": "15m",
+			"labels": map[string]any{
+				"severity": "warning",
+			},
+			"annotations": map[string]any{
+				"summary": "Over " + percent(c.failureRatio()) + " of Models are Failed",
+			},
+		},
+		map[string]any{
+			"alert": "ModelStorageNearlyFull",
+			"expr": fmt.Sprintf("kubelet_volume_stats_used_bytes{%s} / kubelet_volume_stats_capacity_bytes{%s} > %s",
+				pvcs, pvcs, ratio(c.storageFullRatio())),
+			"for": "15m",
+			"labels": map[string]any{
+				"severity": "warning",
+			},
+			"annotations": map[string]any{
+				"summary": "PVC {{ $labels.namespace }}/{{ $labels.persistentvolumeclaim }} is over " +
+					percent(c.storageFullRatio()) + " full",
+			},
+		},
+	}
+
+	obj := c.newObject(prometheusRuleGVK)
+	obj.Object["spec"] = map[string]any{
+		"groups": []any{
+			map[string]any{"name": "model-operator", "rules": rules},
+		},
+	}
+	return obj
+}
+
+// Manager creates the ServiceMonitor and PrometheusRule and keeps them as
+// configured, undoing edits every Interval
+type Manager struct {
+	Client client.Client
+	Config Config
+	// Interval is how often the objects are checked, DefaultInterval by default
+	Interval time.Duration
+}
+
+// Start implements manager.Runnable, ensuring the objects every Interval.
+// Failures are logged, e.g. while the Prometheus Operator CRDs are missing.
+func (m *Manager) Start(ctx context.Context) error {
+	log := logf.FromContext(ctx).WithName("monitoring")
+	interval := m.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := m.Ensure(ctx); err != nil {
+			log.Error(err, "Failed to ensure the ServiceMonitor and PrometheusRule")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable so only the
+// leader writes the objects
+func (m *Manager) NeedLeaderElection() bool {
+	return true
+}
+
+// Ensure creates or updates the ServiceMonitor and PrometheusRule
+func (m *Manager) Ensure(ctx context.Context) error {
+	if err := m.apply(ctx, BuildServiceMonitor(m.Config)); err != nil {
+		return fmt.Errorf("ServiceMonitor: %w", err)
+	}
+	if err := m.apply(ctx, BuildPrometheusRule(m.Config)); err != nil {
+		return fmt.Errorf("PrometheusRule: %w", err)
+	}
+	return nil
+}
+
+// apply creates desired, or updates the labels and spec of the existing
+// object if they differ
+func (m *Manager) apply(ctx context.Context, desired *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(desired.GroupVersionKind())
+	err := m.Client.Get(ctx, client.ObjectKeyFromObject(desired), existing)
+	if apierrors.IsNotFound(err) {
+		return m.Client.Create(ctx, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	labels := existing.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	updated := maps.Clone(labels)
+	maps.Copy(updated, desired.GetLabels())
+	if maps.Equal(labels, updated) && equality.Semantic.DeepEqual(existing.Object["spec"], desired.Object["spec"]) {
+		return nil
+	}
+	existing.SetLabels(updated)
+	existing.Object["spec"] = desired.Object["spec"]
+	return m.Client.Update(ctx, existing)
+}
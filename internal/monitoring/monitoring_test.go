@@ -0,0 +1,133 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package monitoring
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testConfig() Config {
+	return Config{
+		Namespace:       "model-operator-system",
+		ServiceSelector: map[string]string{"control-plane": "controller-manager"},
+		Secure:          true,
+		Labels:          map[string]string{"release": "prometheus"},
+		StuckAfter:      2 * time.Hour,
+		FailureRatio:    0.25,
+	}
+}
+
+func get(t *testing.T, c client.Client, obj *unstructured.Unstructured) *unstructured.Unstructured {
+	t.Helper()
+	got := &unstructured.Unstructured{}
+	got.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := c.Get(context.Background(), client.ObjectKeyFromObject(obj), got); err != nil {
+		t.Fatalf("getting %s: %v", obj.GetKind(), err)
+	}
+	return got
+}
+
+func TestBuildServiceMonitor(t *testing.T) {
+	sm := BuildServiceMonitor(testConfig())
+	if sm.GetName() != DefaultName || sm.GetLabels()["release"] != "prometheus" {
+		t.Fatalf("unexpected metadata: %s %v", sm.GetName(), sm.GetLabels())
+	}
+	endpoints, _, _ := unstructured.NestedSlice(sm.Object, "spec", "endpoints")
+	endpoint := endpoints[0].(map[string]any)
+	if endpoint["port"] != DefaultPort || endpoint["scheme"] != "https" || endpoint["bearerTokenFile"] != tokenFile {
+		t.Errorf("unexpected endpoint: %v", endpoint)
+	}
+	selector, _, _ := unstructured.NestedStringMap(sm.Object, "spec", "selector", "matchLabels")
+	if selector["control-plane"] != "controller-manager" {
+		t.Errorf("unexpected selector: %v", selector)
+	}
+
+	insecure := testConfig()
+	insecure.Secure = false
+	endpoints, _, _ = unstructured.NestedSlice(BuildServiceMonitor(insecure).Object, "spec", "endpoints")
+	if _, ok := endpoints[0].(map[string]any)["bearerTokenFile"]; ok {
+		t.Error("an insecure endpoint should not send the token")
+	}
+}
+
+func TestBuildPrometheusRule(t *testing.T) {
+	groups, _, _ := unstructured.NestedSlice(BuildPrometheusRule(testConfig()).Object, "spec", "groups")
+	rules := map[string]map[string]any{}
+	for _, r := range groups[0].(map[string]any)["rules"].([]any) {
+		rule := r.(map[string]any)
+		rules[rule["alert"].(string)] = rule
+	}
+
+	stuck := rules["ModelDownloadStuck"]
+	if stuck["for"] != "2h0m0s" || !strings.Contains(stuck["expr"].(string), `phase="Downloading"`) {
+		t.Errorf("unexpected stuck rule: %v", stuck)
+	}
+	if expr := rules["ModelFailureRatioHigh"]["expr"].(string); !strings.HasSuffix(expr, "> 0.25") {
+		t.Errorf("unexpected failure ratio expr: %s", expr)
+	}
+	if expr := rules["ModelStorageNearlyFull"]["expr"].(string); !strings.Contains(expr, `persistentvolumeclaim=~"model-.+"`) ||
+		!strings.HasSuffix(expr, "> 0.9") {
+		t.Errorf("unexpected storage expr: %s", expr)
+	}
+}
+
+func TestManager_Ensure(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(runtime.NewScheme()).Build()
+	m := &Manager{Client: c, Config: testConfig()}
+	ctx := context.Background()
+
+	if err := m.Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	rule := get(t, c, BuildPrometheusRule(m.Config))
+
+	// Edits to the spec are undone, extra labels are kept
+	unstructured.RemoveNestedField(rule.Object, "spec", "groups")
+	rule.SetLabels(map[string]string{"team": "ml"})
+	if err := c.Update(ctx, rule); err != nil {
+		t.Fatal(err)
+	}
+	m.Config.StuckAfter = 3 * time.Hour
+	if err := m.Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	rule = get(t, c, rule)
+	if rule.GetLabels()["team"] != "ml" || rule.GetLabels()["release"] != "prometheus" {
+		t.Errorf("unexpected labels: %v", rule.GetLabels())
+	}
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	if len(groups) != 1 || !strings.Contains(groups[0].(map[string]any)["rules"].([]any)[0].(map[string]any)["for"].(string), "3h") {
+		t.Errorf("the rules were not restored: %v", groups)
+	}
+
+	// An unchanged object is not written again
+	version := rule.GetResourceVersion()
+	if err := m.Ensure(ctx); err != nil {
+		t.Fatalf("Ensure: %v", err)
+	}
+	if get(t, c, rule).GetResourceVersion() != version {
+		t.Error("an unchanged PrometheusRule should not be updated")
+	}
+}
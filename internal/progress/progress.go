@@ -0,0 +1,201 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package progress implements pluggable download progress reporters.
+//
+// A Reporter has two halves: ConfigureJob injects a sidecar into the download
+// Job that periodically measures the bytes written to the model volume and
+// publishes them, and Observe lets the controller read the published value
+// back into the Model status.
+package progress
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// Reporter types selectable via operator configuration
+const (
+	TypeNone        = "none"
+	TypeConfigMap   = "configmap"
+	TypeStatus      = "status"
+	TypePushgateway = "pushgateway"
+)
+
+const (
+	// DefaultImage is the image used for the progress sidecar
+	DefaultImage = "curlimages/curl:latest"
+	// DefaultInterval is how often the sidecar publishes progress
+	DefaultInterval = 15 * time.Second
+
+	sidecarName = "progress-reporter"
+)
+
+// Progress is a single progress observation
+type Progress struct {
+	// BytesDownloaded is the number of bytes written to the model volume
+	BytesDownloaded int64
+	// UpdatedAt is when the sidecar last published progress
+	UpdatedAt time.Time
+	// LastActivity is when the sidecar last saw the byte count change
+	LastActivity time.Time
+}
+
+// Reporter publishes download progress from the Job and reads it back
+type Reporter interface {
+	// Name returns the reporter type
+	Name() string
+	// ConfigureJob injects the sidecar and env the downloader needs to publish progress
+	ConfigureJob(model *modelsv1alpha1.Model, job *batchv1.Job)
+	// Observe returns the latest published progress, or nil if the controller
+	// cannot read it back (e.g. progress is pushed to an external system)
+	Observe(ctx context.Context, c client.Reader, model *modelsv1alpha1.Model) (*Progress, error)
+}
+
+// Config selects and configures a Reporter
+type Config struct {
+	// Type is one of none, configmap, status, pushgateway
+	Type string
+	// Image overrides the sidecar image
+	Image string
+	// Interval between progress publications
+	Interval time.Duration
+	// PushgatewayURL is the base URL of the Prometheus pushgateway (pushgateway only)
+	PushgatewayURL string
+	// ServiceAccountName is set on the download pod so the sidecar can reach the
+	// Kubernetes API (configmap and status only). It needs permission to create and
+	// patch ConfigMaps or patch models/status respectively.
+	ServiceAccountName string
+}
+
+// New returns the Reporter selected by the configuration
+func New(cfg Config) (Reporter, error) {
+	if cfg.Image == "" {
+		cfg.Image = DefaultImage
+	}
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+
+	switch cfg.Type {
+	case "", TypeNone:
+		return noopReporter{}, nil
+	case TypeConfigMap:
+		return &configMapReporter{cfg: cfg}, nil
+	case TypeStatus:
+		return &statusReporter{cfg: cfg}, nil
+	case TypePushgateway:
+		if cfg.PushgatewayURL == "" {
+			return nil, fmt.Errorf("progress reporter %q requires a pushgateway URL", TypePushgateway)
+		}
+		return &pushgatewayReporter{cfg: cfg}, nil
+	default:
+		return nil, fmt.Errorf("unknown progress reporter %q", cfg.Type)
+	}
+}
+
+// ConfigMapName returns the name of the ConfigMap the configmap reporter writes to
+func ConfigMapName(modelName string) string {
+	return "model-progress-" + modelName
+}
+
+// noopReporter disables progress reporting
+type noopReporter struct{}
+
+func (noopReporter) Name() string { return TypeNone }
+
+func (noopReporter) ConfigureJob(*modelsv1alpha1.Model, *batchv1.Job) {}
+
+func (noopReporter) Observe(context.Context, client.Reader, *modelsv1alpha1.Model) (*Progress, error) {
+	return nil, nil
+}
+
+// addSidecar appends the progress sidecar running publish in a loop.
+// The sidecar is a native sidecar (restartable init container) so it does not
+// block Job completion.
+func addSidecar(cfg Config, model *modelsv1alpha1.Model, job *batchv1.Job, publish string, env ...corev1.EnvVar) {
+	podSpec := &job.Spec.Template.Spec
+
+	// Mount the same volumes as the downloader, read-only
+	var mounts []corev1.VolumeMount
+	var securityContext *corev1.SecurityContext
+	if len(podSpec.Containers) > 0 {
+		for _, m := range podSpec.Containers[0].VolumeMounts {
+			m.ReadOnly = true
+			mounts = append(mounts, m)
+		}
+		// Run as restricted as the downloader, e.g. in a restricted namespace
+		if sc := podSpec.Containers[0].SecurityContext; sc != nil {
+			securityContext = sc.DeepCopy()
+		}
+	}
+
+	// ACTIVE is when the byte count last changed, for stall detection
+	script := fmt.Sprintf(`LAST=-1
+while true; do
+BYTES=$(( $(du -sk /models 2>/dev/null | cut -f1) * 1024 ))
+NOW=$(date -u +%%Y-%%m-%%dT%%H:%%M:%%SZ)
+if [ "$BYTES" != "$LAST" ]; then LAST=$BYTES; ACTIVE=$NOW; fi
+%s
+sleep %d
+done`, publish, int(cfg.Interval.Seconds()))
+
+	baseEnv := []corev1.EnvVar{
+		{Name: "MODEL_NAME", Value: model.Name},
+		{Name: "MODEL_NAMESPACE", Value: model.Namespace},
+		{Name: "MODEL_UID", Value: string(model.UID)},
+	}
+
+	podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+		Name:            sidecarName,
+		Image:           cfg.Image,
+		Command:         []string{"sh", "-c"},
+		Args:            []string{script},
+		Env:             append(baseEnv, env...),
+		VolumeMounts:    mounts,
+		SecurityContext: securityContext,
+		RestartPolicy:   ptr.To(corev1.ContainerRestartPolicyAlways),
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("16Mi"),
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+			},
+		},
+	})
+
+	// Keep a ServiceAccount the Job already needs, such as the one storing a
+	// configmap-mode model; the sidecar then publishes on a best-effort basis
+	if cfg.ServiceAccountName != "" && podSpec.ServiceAccountName == "" {
+		podSpec.ServiceAccountName = cfg.ServiceAccountName
+	}
+}
+
+// kubeAPICurl is the curl prefix used by sidecars talking to the Kubernetes API
+const kubeAPICurl = `curl -sf --cacert /var/run/secrets/kubernetes.io/serviceaccount/ca.crt ` +
+	`-H "Authorization: Bearer $(cat /var/run/secrets/kubernetes.io/serviceaccount/token)"`
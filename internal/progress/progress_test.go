@@ -0,0 +1,224 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func testModelAndJob() (*modelsv1alpha1.Model, *batchv1.Job) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama",
+			Namespace: "default",
+			UID:       "1234",
+		},
+	}
+	job := &batchv1.Job{
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "downloader",
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "model-storage", MountPath: "/models"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return model, job
+}
+
+func TestNew(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      Config
+		wantName string
+		wantErr  bool
+	}{
+		{"default is none", Config{}, TypeNone, false},
+		{"configmap", Config{Type: TypeConfigMap}, TypeConfigMap, false},
+		{"status", Config{Type: TypeStatus}, TypeStatus, false},
+		{"pushgateway", Config{Type: TypePushgateway, PushgatewayURL: "http://pgw:9091"}, TypePushgateway, false},
+		{"pushgateway without URL", Config{Type: TypePushgateway}, "", true},
+		{"unknown", Config{Type: "carrier-pigeon"}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := New(tt.cfg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && r.Name() != tt.wantName {
+				t.Errorf("Name() = %v, want %v", r.Name(), tt.wantName)
+			}
+		})
+	}
+}
+
+func TestNoopReporter_ConfigureJob(t *testing.T) {
+	r, _ := New(Config{})
+	model, job := testModelAndJob()
+
+	r.ConfigureJob(model, job)
+
+	if len(job.Spec.Template.Spec.InitContainers) != 0 {
+		t.Errorf("Expected no sidecar, got %d init containers", len(job.Spec.Template.Spec.InitContainers))
+	}
+}
+
+func TestConfigMapReporter_ConfigureJob(t *testing.T) {
+	r, _ := New(Config{Type: TypeConfigMap, ServiceAccountName: "model-progress"})
+	model, job := testModelAndJob()
+
+	r.ConfigureJob(model, job)
+
+	podSpec := job.Spec.Template.Spec
+	if len(podSpec.InitContainers) != 1 {
+		t.Fatalf("Expected 1 sidecar, got %d", len(podSpec.InitContainers))
+	}
+
+	sidecar := podSpec.InitContainers[0]
+	if sidecar.RestartPolicy == nil || *sidecar.RestartPolicy != corev1.ContainerRestartPolicyAlways {
+		t.Errorf("Sidecar should be a restartable init container")
+	}
+	if len(sidecar.VolumeMounts) != 1 || !sidecar.VolumeMounts[0].ReadOnly {
+		t.Errorf("Sidecar should mount the model volume read-only")
+	}
+	if !strings.Contains(sidecar.Args[0], "configmaps") {
+		t.Errorf("Script should publish to a ConfigMap")
+	}
+	if !strings.Contains(sidecar.Args[0], `\"active\":\"$ACTIVE\"`) {
+		t.Errorf("Script should publish the last activity time")
+	}
+
+	envMap := make(map[string]string)
+	for _, e := range sidecar.Env {
+		envMap[e.Name] = e.Value
+	}
+	if envMap["PROGRESS_CONFIGMAP"] != ConfigMapName(model.Name) {
+		t.Errorf("PROGRESS_CONFIGMAP = %v, want %v", envMap["PROGRESS_CONFIGMAP"], ConfigMapName(model.Name))
+	}
+	if envMap["MODEL_UID"] != "1234" {
+		t.Errorf("MODEL_UID = %v, want 1234", envMap["MODEL_UID"])
+	}
+
+	if podSpec.ServiceAccountName != "model-progress" {
+		t.Errorf("ServiceAccountName = %v, want model-progress", podSpec.ServiceAccountName)
+	}
+}
+
+func TestConfigMapReporter_ConfigureJob_SecurityContext(t *testing.T) {
+	r, _ := New(Config{Type: TypeConfigMap})
+	model, job := testModelAndJob()
+	job.Spec.Template.Spec.Containers[0].SecurityContext = &corev1.SecurityContext{
+		RunAsNonRoot:           ptr.To(true),
+		ReadOnlyRootFilesystem: ptr.To(true),
+	}
+
+	r.ConfigureJob(model, job)
+
+	sc := job.Spec.Template.Spec.InitContainers[0].SecurityContext
+	if sc == nil || !ptr.Deref(sc.RunAsNonRoot, false) || !ptr.Deref(sc.ReadOnlyRootFilesystem, false) {
+		t.Errorf("Sidecar SecurityContext = %+v, want the downloader's", sc)
+	}
+}
+
+func TestPushgatewayReporter_ConfigureJob(t *testing.T) {
+	r, _ := New(Config{Type: TypePushgateway, PushgatewayURL: "http://pgw:9091/"})
+	model, job := testModelAndJob()
+
+	r.ConfigureJob(model, job)
+
+	sidecar := job.Spec.Template.Spec.InitContainers[0]
+	if !strings.Contains(sidecar.Args[0], "model_download_bytes") {
+		t.Errorf("Script should push model_download_bytes")
+	}
+	for _, e := range sidecar.Env {
+		if e.Name == "PUSHGATEWAY_URL" && e.Value != "http://pgw:9091" {
+			t.Errorf("PUSHGATEWAY_URL = %v, want trailing slash trimmed", e.Value)
+		}
+	}
+	if job.Spec.Template.Spec.ServiceAccountName != "" {
+		t.Errorf("Pushgateway reporter should not set a ServiceAccount")
+	}
+}
+
+func TestConfigMapReporter_Observe(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+
+	model, _ := testModelAndJob()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName(model.Name),
+			Namespace: model.Namespace,
+		},
+		Data: map[string]string{
+			KeyBytes:   "1048576",
+			KeyUpdated: "2026-01-02T03:04:05Z",
+			KeyActive:  "2026-01-02T03:00:00Z",
+		},
+	}
+
+	r, _ := New(Config{Type: TypeConfigMap})
+
+	// Missing ConfigMap is not an error
+	empty := fake.NewClientBuilder().WithScheme(scheme).Build()
+	p, err := r.Observe(context.Background(), empty, model)
+	if err != nil || p != nil {
+		t.Fatalf("Observe() = %v, %v, want nil, nil", p, err)
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+	p, err = r.Observe(context.Background(), c, model)
+	if err != nil {
+		t.Fatalf("Observe() error = %v", err)
+	}
+	if p.BytesDownloaded != 1048576 {
+		t.Errorf("BytesDownloaded = %v, want 1048576", p.BytesDownloaded)
+	}
+	if p.UpdatedAt.IsZero() {
+		t.Errorf("UpdatedAt should be parsed")
+	}
+	if want := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC); !p.LastActivity.Equal(want) {
+		t.Errorf("LastActivity = %v, want %v", p.LastActivity, want)
+	}
+}
+
+func TestParseProgressData_Invalid(t *testing.T) {
+	if _, err := parseProgressData(map[string]string{KeyBytes: "lots"}); err == nil {
+		t.Errorf("Expected error for non-numeric bytes")
+	}
+}
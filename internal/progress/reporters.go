@@ -0,0 +1,144 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package progress
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// ConfigMap data keys written by the configmap reporter
+const (
+	KeyBytes   = "bytes"
+	KeyUpdated = "updated"
+	// KeyActive is when the byte count last changed
+	KeyActive = "active"
+)
+
+// configMapReporter publishes progress to a ConfigMap that the controller and
+// users can poll. Suited for small installs without a metrics stack.
+type configMapReporter struct {
+	cfg Config
+}
+
+func (r *configMapReporter) Name() string { return TypeConfigMap }
+
+func (r *configMapReporter) ConfigureJob(model *modelsv1alpha1.Model, job *batchv1.Job) {
+	// Patch the ConfigMap, creating it (owned by the Model) on first publish
+	publish := fmt.Sprintf(`DATA="\"data\":{\"%[1]s\":\"$BYTES\",\"%[2]s\":\"$NOW\",\"%[5]s\":\"$ACTIVE\"}"
+%[3]s -X PATCH -H "Content-Type: application/merge-patch+json" \
+  "https://kubernetes.default.svc/api/v1/namespaces/$MODEL_NAMESPACE/configmaps/$PROGRESS_CONFIGMAP" \
+  -d "{$DATA}" >/dev/null || \
+%[3]s -X POST -H "Content-Type: application/json" \
+  "https://kubernetes.default.svc/api/v1/namespaces/$MODEL_NAMESPACE/configmaps" \
+  -d "{\"metadata\":{\"name\":\"$PROGRESS_CONFIGMAP\",\"ownerReferences\":[{\"apiVersion\":\"%[4]s\",\"kind\":\"Model\",\"name\":\"$MODEL_NAME\",\"uid\":\"$MODEL_UID\"}]},$DATA}" >/dev/null || true`,
+		KeyBytes, KeyUpdated, kubeAPICurl, modelsv1alpha1.GroupVersion.String(), KeyActive)
+
+	addSidecar(r.cfg, model, job, publish,
+		corev1.EnvVar{Name: "PROGRESS_CONFIGMAP", Value: ConfigMapName(model.Name)})
+}
+
+func (r *configMapReporter) Observe(ctx context.Context, c client.Reader, model *modelsv1alpha1.Model) (*Progress, error) {
+	cm := &corev1.ConfigMap{}
+	err := c.Get(ctx, types.NamespacedName{Name: ConfigMapName(model.Name), Namespace: model.Namespace}, cm)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return parseProgressData(cm.Data)
+}
+
+// parseProgressData converts ConfigMap data into a Progress observation
+func parseProgressData(data map[string]string) (*Progress, error) {
+	raw, ok := data[KeyBytes]
+	if !ok {
+		return nil, nil
+	}
+
+	bytes, err := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid progress bytes %q: %w", raw, err)
+	}
+
+	p := &Progress{BytesDownloaded: bytes}
+	if updated, ok := data[KeyUpdated]; ok {
+		if t, err := time.Parse(time.RFC3339, updated); err == nil {
+			p.UpdatedAt = t
+		}
+	}
+	if active, ok := data[KeyActive]; ok {
+		if t, err := time.Parse(time.RFC3339, active); err == nil {
+			p.LastActivity = t
+		}
+	}
+	return p, nil
+}
+
+// statusReporter patches the Model status subresource directly from the Job
+type statusReporter struct {
+	cfg Config
+}
+
+func (r *statusReporter) Name() string { return TypeStatus }
+
+func (r *statusReporter) ConfigureJob(model *modelsv1alpha1.Model, job *batchv1.Job) {
+	publish := fmt.Sprintf(`%s -X PATCH -H "Content-Type: application/merge-patch+json" \
+  "https://kubernetes.default.svc/apis/%s/namespaces/$MODEL_NAMESPACE/models/$MODEL_NAME/status" \
+  -d "{\"status\":{\"downloadedBytes\":$BYTES,\"lastActivityTime\":\"$ACTIVE\"}}" >/dev/null || true`,
+		kubeAPICurl, modelsv1alpha1.GroupVersion.String())
+
+	addSidecar(r.cfg, model, job, publish)
+}
+
+// Observe returns nil: the sidecar already writes to the Model status
+func (r *statusReporter) Observe(context.Context, client.Reader, *modelsv1alpha1.Model) (*Progress, error) {
+	return nil, nil
+}
+
+// pushgatewayReporter pushes progress metrics to a Prometheus pushgateway.
+// Suited for larger installs that alert on metrics.
+type pushgatewayReporter struct {
+	cfg Config
+}
+
+func (r *pushgatewayReporter) Name() string { return TypePushgateway }
+
+func (r *pushgatewayReporter) ConfigureJob(model *modelsv1alpha1.Model, job *batchv1.Job) {
+	publish := `printf 'model_download_bytes %s\nmodel_download_last_update_timestamp_seconds %s\n' "$BYTES" "$(date +%s)" | \
+curl -sf --data-binary @- "$PUSHGATEWAY_URL/metrics/job/model_download/namespace/$MODEL_NAMESPACE/model/$MODEL_NAME" >/dev/null || true`
+
+	addSidecar(r.cfg, model, job, publish,
+		corev1.EnvVar{Name: "PUSHGATEWAY_URL", Value: strings.TrimSuffix(r.cfg.PushgatewayURL, "/")})
+}
+
+// Observe returns nil: progress is only available in Prometheus
+func (r *pushgatewayReporter) Observe(context.Context, client.Reader, *modelsv1alpha1.Model) (*Progress, error) {
+	return nil, nil
+}
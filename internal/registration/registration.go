@@ -0,0 +1,191 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registration registers downloaded models with an external catalog,
+// such as an internal model registry, by POSTing their digest and location
+// to a webhook before the Model becomes Ready.
+package registration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// Request is the JSON body POSTed to the webhook
+type Request struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	UID       string `json:"uid"`
+	Version   string `json:"version,omitempty"`
+	// ContentDigest is the digest of the file manifest
+	ContentDigest string `json:"contentDigest"`
+	// Source names the source the files were downloaded from
+	Source         string `json:"source,omitempty"`
+	SourceRevision string `json:"sourceRevision,omitempty"`
+	// PVCName is the claim holding the files, in the pvc storage mode
+	PVCName string `json:"pvcName,omitempty"`
+	// Image is the pushed image pinned by digest, in the image storage mode
+	Image string `json:"image,omitempty"`
+	// EnginePath is the converted engine directory on the volume
+	EnginePath string `json:"enginePath,omitempty"`
+	SizeBytes  int64  `json:"sizeBytes,omitempty"`
+}
+
+// NewRequest describes the model as it is registered
+func NewRequest(model *modelsv1alpha1.Model) Request {
+	req := Request{
+		Namespace:      model.Namespace,
+		Name:           model.Name,
+		UID:            string(model.UID),
+		Version:        model.Spec.Version,
+		ContentDigest:  model.Status.ContentDigest,
+		Source:         model.Status.DownloadedFrom,
+		SourceRevision: model.Status.SourceRevision,
+		SizeBytes:      model.Status.DownloadedBytes,
+	}
+	if resources.UsesPVC(model) {
+		req.PVCName = resources.ClaimName(model)
+	}
+	if p := model.Status.Publication; p != nil && p.ContentDigest == model.Status.ContentDigest {
+		req.Image = p.Image
+	}
+	if c := model.Status.Conversion; c != nil {
+		req.EnginePath = c.ArtifactPath
+	}
+	return req
+}
+
+// Registrar registers models with a catalog
+type Registrar interface {
+	// Register POSTs req to url. The token is optional and sent as a bearer
+	// token. Any response other than 2xx is an error.
+	Register(ctx context.Context, url, token string, req Request) error
+}
+
+// NewRegistrar returns a Registrar that calls webhooks over HTTP. It only
+// calls URLs under one of the allowed prefixes, so without any it registers
+// nothing: the URL is picked by the Model's author, and the manager must not
+// become a way to reach endpoints they could not reach themselves. Redirects
+// are not followed, and the response body is never reported back.
+func NewRegistrar(httpClient *http.Client, allowedPrefixes []string) (Registrar, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	noRedirects := *httpClient
+	noRedirects.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	w := &webhookRegistrar{client: &noRedirects}
+	for _, prefix := range allowedPrefixes {
+		u, err := parsePrefix(prefix)
+		if err != nil {
+			return nil, err
+		}
+		w.allowed = append(w.allowed, u)
+	}
+	return w, nil
+}
+
+type webhookRegistrar struct {
+	client *http.Client
+	// allowed holds the URL prefixes webhooks may be called under
+	allowed []*url.URL
+}
+
+// parsePrefix parses an allowed URL prefix, which is an http or https URL
+// without credentials, query or fragment. The trailing slash is dropped so
+// the prefix matches whole path segments.
+func parsePrefix(prefix string) (*url.URL, error) {
+	u, err := url.Parse(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registration URL prefix %q: %w", prefix, err)
+	}
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return nil, fmt.Errorf("registration URL prefix %q must be an http or https URL", prefix)
+	case u.Host == "":
+		return nil, fmt.Errorf("registration URL prefix %q has no host", prefix)
+	case u.User != nil:
+		return nil, errors.New("registration URL prefix must not contain credentials")
+	case u.RawQuery != "" || u.ForceQuery || u.Fragment != "" || u.RawFragment != "":
+		return nil, fmt.Errorf("registration URL prefix %q must not have a query or fragment", prefix)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	return u, nil
+}
+
+// allows reports whether u is under one of the allowed prefixes: the same
+// scheme and host, and a path equal to or below the prefix's
+func (w *webhookRegistrar) allows(u *url.URL) bool {
+	if u.User != nil || slices.ContainsFunc(strings.Split(u.Path, "/"), func(segment string) bool {
+		return segment == "." || segment == ".."
+	}) {
+		return false
+	}
+	for _, prefix := range w.allowed {
+		if u.Scheme == prefix.Scheme && strings.EqualFold(u.Host, prefix.Host) &&
+			(u.Path == prefix.Path || strings.HasPrefix(u.Path, prefix.Path+"/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *webhookRegistrar) Register(ctx context.Context, rawURL, token string, req Request) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook URL: %w", err)
+	}
+	if !w.allows(u) {
+		return fmt.Errorf("webhook URL %s is not under an allowed registration URL prefix", u.Redacted())
+	}
+	body, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := w.client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// The body is left out: the webhook's answer is not the Model author's
+	// to read through the Model's status and Events
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("POST %s: unexpected status %s", u.Redacted(), resp.Status)
+	}
+	return nil
+}
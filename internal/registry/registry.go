@@ -0,0 +1,231 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package registry serves each namespace a JSON index of its Ready Models
+// and how pods mount them, at http://model-registry:<port>/, so sidecars and
+// routers discover models at runtime without Kubernetes API access.
+//
+// The model-registry Service in each namespace points at the manager, which
+// tells namespaces apart by the address of the pod a request comes from, so
+// a pod only ever sees the Models of its own namespace.
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// PodIPIndex is the field selector finding pods by their IP address
+const PodIPIndex = "status.podIP"
+
+// Timeouts bounding the reads behind one request and the server's shutdown
+const (
+	listTimeout     = 10 * time.Second
+	shutdownTimeout = 5 * time.Second
+)
+
+// Entry describes one Ready Model and the conventions pods mount it by
+type Entry struct {
+	Name          string `json:"name"`
+	Version       string `json:"version,omitempty"`
+	ContentDigest string `json:"contentDigest,omitempty"`
+	// SourceRevision is the source revision the files were downloaded at
+	SourceRevision string `json:"sourceRevision,omitempty"`
+	// MountPath is where injected pods mount the model by default
+	MountPath string `json:"mountPath"`
+	// EnvPrefix is the default prefix of the env vars injected for the model
+	EnvPrefix string `json:"envPrefix"`
+	// PVCName is the claim holding the files, for pods mounting it directly
+	PVCName string `json:"pvcName,omitempty"`
+	// FileServerURL serves the files over HTTP, if spec.fileServer is set
+	FileServerURL string `json:"fileServerURL,omitempty"`
+	SizeBytes     int64  `json:"sizeBytes,omitempty"`
+	// ReadySince is when the model last became Ready
+	ReadySince *metav1.Time `json:"readySince,omitempty"`
+	// Deprecated is set for a model being retired, with the Model to move to
+	Deprecated  bool   `json:"deprecated,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// Index lists the Ready Models of a namespace
+type Index struct {
+	Namespace string  `json:"namespace"`
+	Models    []Entry `json:"models"`
+}
+
+// Server serves the model registry
+type Server struct {
+	reader client.Reader
+	pods   client.Reader
+	addr   string
+}
+
+// New returns a Server listening on addr, reading Models from reader and
+// pods from pods. The manager only caches the operator's own pods, so pods
+// should be an uncached reader that can select them by PodIPIndex.
+func New(reader, pods client.Reader, addr string) *Server {
+	return &Server{reader: reader, pods: pods, addr: addr}
+}
+
+// SetupWithManager runs the server with mgr
+func (s *Server) SetupWithManager(mgr ctrl.Manager) error {
+	return mgr.Add(s)
+}
+
+// Start serves the registry until ctx is done
+func (s *Server) Start(ctx context.Context) error {
+	server := &http.Server{
+		Addr:              s.addr,
+		Handler:           s,
+		ReadHeaderTimeout: listTimeout,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		errs <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errs:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	}
+}
+
+// NeedLeaderElection is false: every replica serves the registry
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// List returns the Ready Models of namespace, sorted by name
+func (s *Server) List(ctx context.Context, namespace string) (*Index, error) {
+	models := &modelsv1alpha1.ModelList{}
+	if err := s.reader.List(ctx, models, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	index := &Index{Namespace: namespace, Models: []Entry{}}
+	for i := range models.Items {
+		model := &models.Items[i]
+		if model.Status.Phase != modelsv1alpha1.ModelPhaseReady {
+			continue
+		}
+		entry := Entry{
+			Name:           model.Name,
+			Version:        model.Spec.Version,
+			ContentDigest:  model.Status.ContentDigest,
+			SourceRevision: model.Status.SourceRevision,
+			MountPath:      resources.DefaultMountPath(model.Name),
+			EnvPrefix:      resources.EnvVarPrefix(model.Name),
+			PVCName:        model.Status.PVCName,
+			SizeBytes:      model.Status.DownloadedBytes,
+			Deprecated:     model.Spec.Deprecated,
+			Replacement:    model.Spec.Replacement,
+		}
+		if resources.FileServed(model) {
+			entry.FileServerURL = fmt.Sprintf("http://%s.%s.svc:%d/", resources.FileServerName(model.Name),
+				model.Namespace, resources.FileServerPort(model))
+		}
+		if cond := meta.FindStatusCondition(model.Status.Conditions, "Ready"); cond != nil && cond.Status == metav1.ConditionTrue {
+			entry.ReadySince = &cond.LastTransitionTime
+		}
+		index.Models = append(index.Models, entry)
+	}
+	sort.Slice(index.Models, func(a, b int) bool { return index.Models[a].Name < index.Models[b].Name })
+	return index, nil
+}
+
+// namespaceOf returns the namespace of the pod with the given address. It
+// fails if no running pod has the address, or pods in several namespaces do.
+// Pods on the host network share the node's address, so they are left out.
+func (s *Server) namespaceOf(ctx context.Context, address string) (string, error) {
+	pods := &corev1.PodList{}
+	if err := s.pods.List(ctx, pods, client.MatchingFields{PodIPIndex: address}); err != nil {
+		return "", err
+	}
+
+	namespace := ""
+	for _, pod := range pods.Items {
+		if pod.Spec.HostNetwork {
+			continue
+		}
+		if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			continue
+		}
+		if namespace != "" && pod.Namespace != namespace {
+			return "", fmt.Errorf("pods in several namespaces have address %s", address)
+		}
+		namespace = pod.Namespace
+	}
+	if namespace == "" {
+		return "", fmt.Errorf("no pod has address %s", address)
+	}
+	return namespace, nil
+}
+
+// ServeHTTP writes the index of the requesting pod's namespace as JSON
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if req.URL.Path != "/" {
+		http.NotFound(w, req)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), listTimeout)
+	defer cancel()
+	log := logf.FromContext(ctx).WithName("registry")
+
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		host = req.RemoteAddr
+	}
+	namespace, err := s.namespaceOf(ctx, host)
+	if err != nil {
+		log.V(1).Info("Refusing model registry request", "reason", err.Error())
+		http.Error(w, "requests must come from a pod", http.StatusForbidden)
+		return
+	}
+
+	index, err := s.List(ctx, namespace)
+	if err != nil {
+		log.Error(err, "Failed to list the model registry", "namespace", namespace)
+		http.Error(w, "failed to list models", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(index)
+}
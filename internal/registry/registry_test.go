@@ -0,0 +1,182 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package registry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var readySince = metav1.NewTime(time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC))
+
+func testPod(namespace, name, ip string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status: corev1.PodStatus{
+			Phase:  phase,
+			PodIP:  ip,
+			PodIPs: []corev1.PodIP{{IP: ip}},
+		},
+	}
+}
+
+func hostNetworkPod(namespace, name, ip string) *corev1.Pod {
+	pod := testPod(namespace, name, ip, corev1.PodRunning)
+	pod.Spec.HostNetwork = true
+	return pod
+}
+
+func testServer(t *testing.T, objs ...client.Object) *Server {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	ready := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Version:    "3.1",
+			Storage:    modelsv1alpha1.StorageSpec{Size: "20Gi"},
+			FileServer: &modelsv1alpha1.FileServerSpec{},
+		},
+		Status: modelsv1alpha1.ModelStatus{
+			Phase:           modelsv1alpha1.ModelPhaseReady,
+			PVCName:         "model-llama",
+			ContentDigest:   "sha256:abc",
+			DownloadedBytes: 1000,
+			Conditions: []metav1.Condition{{
+				Type: "Ready", Status: metav1.ConditionTrue, Reason: "DownloadComplete", LastTransitionTime: readySince,
+			}},
+		},
+	}
+	downloading := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "mistral", Namespace: "ml"},
+		Status:     modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseDownloading},
+	}
+	otherNamespace := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "bert", Namespace: "search"},
+		Status:     modelsv1alpha1.ModelStatus{Phase: modelsv1alpha1.ModelPhaseReady},
+	}
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(append([]client.Object{ready, downloading, otherNamespace}, objs...)...).
+		WithIndex(&corev1.Pod{}, PodIPIndex, func(obj client.Object) []string {
+			// The API server selects pods by their primary address
+			return []string{obj.(*corev1.Pod).Status.PodIP}
+		}).
+		Build()
+	return New(c, c, ":0")
+}
+
+func TestList(t *testing.T) {
+	index, err := testServer(t).List(t.Context(), "ml")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(index.Models) != 1 {
+		t.Fatalf("Expected only the Ready Model of the namespace, got %+v", index.Models)
+	}
+
+	got := index.Models[0]
+	want := Entry{
+		Name:          "llama",
+		Version:       "3.1",
+		ContentDigest: "sha256:abc",
+		MountPath:     "/models/llama",
+		EnvPrefix:     "MODEL_LLAMA",
+		PVCName:       "model-llama",
+		FileServerURL: "http://model-files-llama.ml.svc:80/",
+		SizeBytes:     1000,
+		ReadySince:    &readySince,
+	}
+	if got.ReadySince == nil || !got.ReadySince.Equal(want.ReadySince) {
+		t.Errorf("ReadySince = %v, want %v", got.ReadySince, want.ReadySince)
+	}
+	got.ReadySince, want.ReadySince = nil, nil
+	if got != want {
+		t.Errorf("Entry = %+v, want %+v", got, want)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	server := testServer(t,
+		testPod("ml", "router", "10.0.0.5", corev1.PodRunning),
+		testPod("search", "router", "10.0.0.6", corev1.PodRunning),
+		// A finished pod's address may be reused by a pod in another namespace
+		testPod("search", "old-job", "10.0.0.5", corev1.PodSucceeded),
+		// A host network pod shares its node's address with other pods
+		hostNetworkPod("search", "node-agent", "10.0.0.5"),
+		testPod("ml", "a", "10.0.0.7", corev1.PodRunning),
+		testPod("search", "b", "10.0.0.7", corev1.PodPending),
+	)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		wantStatus int
+		wantModels []string
+	}{
+		{name: "pod in ml", remoteAddr: "10.0.0.5:41234", wantStatus: http.StatusOK, wantModels: []string{"llama"}},
+		{name: "pod in search", remoteAddr: "10.0.0.6:41234", wantStatus: http.StatusOK, wantModels: []string{"bert"}},
+		{name: "unknown address", remoteAddr: "192.168.1.1:41234", wantStatus: http.StatusForbidden},
+		{name: "ambiguous address", remoteAddr: "10.0.0.7:41234", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			rec := httptest.NewRecorder()
+			server.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Status = %d, want %d: %s", rec.Code, tt.wantStatus, rec.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			var index Index
+			if err := json.NewDecoder(rec.Body).Decode(&index); err != nil {
+				t.Fatalf("Invalid JSON: %v", err)
+			}
+			var names []string
+			for _, entry := range index.Models {
+				names = append(names, entry.Name)
+			}
+			if len(names) != len(tt.wantModels) || (len(names) > 0 && names[0] != tt.wantModels[0]) {
+				t.Errorf("Models = %v, want %v", names, tt.wantModels)
+			}
+		})
+	}
+}
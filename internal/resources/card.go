@@ -0,0 +1,45 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// CardConfigMapKey is the ConfigMap key holding the full model card
+const CardConfigMapKey = "README.md"
+
+// BuildCardConfigMap creates the ConfigMap holding a Model's full model card
+func BuildCardConfigMap(model *modelsv1alpha1.Model, readme string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CardConfigMapName(model.Name),
+			Namespace: model.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "model-card",
+				"app.kubernetes.io/instance":   model.Name,
+				"app.kubernetes.io/managed-by": "model-operator",
+			},
+		},
+		Data: map[string]string{
+			CardConfigMapKey: readme,
+		},
+	}
+}
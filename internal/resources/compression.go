@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+const (
+	// CompressorContainerName is the name of the download pod container that
+	// compresses the downloaded files and writes the completion marker
+	CompressorContainerName = "compress"
+
+	compressImage = "alpine:3.20"
+
+	// decompressMountPath is where the decompress init container mounts the PVC
+	decompressMountPath = "/compressed"
+)
+
+// downloadedPath is where the downloader of a compressed model signals that
+// the download is complete, in place of the completion marker
+var downloadedPath = modelMountPath + "/" + marker.Dir + "/downloaded"
+
+// Compressed reports whether the model is compressed at rest
+func Compressed(model *modelsv1alpha1.Model) bool {
+	c := model.Spec.Storage.Compression
+	return c != "" && c != modelsv1alpha1.CompressionNone
+}
+
+// CompressionExtension returns the file extension the files of a compressed
+// model are stored with, or an empty string if the model is not compressed
+func CompressionExtension(model *modelsv1alpha1.Model) string {
+	switch model.Spec.Storage.Compression {
+	case modelsv1alpha1.CompressionGzip:
+		return ".gz"
+	case modelsv1alpha1.CompressionZstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
+// compressCommand returns the shell fragment that compresses every file under
+// dir in place. Hidden files, such as the completion marker and download
+// caches, are left as they are, as are files compressed by an earlier run.
+func compressCommand(model *modelsv1alpha1.Model, dir string) string {
+	find := fmt.Sprintf("find %s -type f ! -path '*/.*' ! -name '*%s'", dir, CompressionExtension(model))
+	if model.Spec.Storage.Compression == modelsv1alpha1.CompressionGzip {
+		return find + " -exec gzip -f {} +"
+	}
+	return "command -v zstd >/dev/null || apk add --no-cache zstd >/dev/null && \\\n" +
+		find + " -exec zstd -q -f --rm {} +"
+}
+
+// decompressCommand returns the shell fragment that decompresses the files
+// under src into dst, copying the others as they are
+func decompressCommand(model *modelsv1alpha1.Model, src, dst string) string {
+	ext := CompressionExtension(model)
+	decompress := "gzip -dc"
+	if model.Spec.Storage.Compression == modelsv1alpha1.CompressionZstd {
+		decompress = "zstd -qdc"
+	}
+	script := fmt.Sprintf(`cd %[1]s && \
+find . -type d | while read -r d; do mkdir -p "%[2]s/$d"; done && \
+find . -type f | while read -r f; do
+  case "$f" in
+    *%[3]s) %[4]s "$f" > "%[2]s/${f%%%[3]s}" ;;
+    *) cp -p "$f" "%[2]s/$f" ;;
+  esac || exit 1
+done`, src, dst, ext, decompress)
+	if model.Spec.Storage.Compression == modelsv1alpha1.CompressionZstd {
+		script = "command -v zstd >/dev/null || apk add --no-cache zstd >/dev/null && \\\n" + script
+	}
+	return script
+}
+
+// downloadedScript returns the shell fragment that signals the compress
+// container that the download is complete
+func downloadedScript() string {
+	return fmt.Sprintf("mkdir -p %s/%s && touch %s", modelMountPath, marker.Dir, downloadedPath)
+}
+
+// configureCompression adds the container that compresses a compressed
+// model's files once downloaded, and then writes the completion marker
+func configureCompression(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec, images Images) {
+	script := fmt.Sprintf("set -e\nuntil [ -f %s ]; do sleep 2; done\n", downloadedPath) +
+		compressCommand(model, modelMountPath) + " && \\\n"
+	if ownership := ownershipScript(model); ownership != "" {
+		script += ownership + " && \\\n"
+	}
+	script += marker.Script(modelMountPath, SourceRevision(model), model.Spec.Version) + " && \\\n" +
+		"rm -f " + downloadedPath
+
+	podSpec.Containers = append(podSpec.Containers, corev1.Container{
+		Name:    CompressorContainerName,
+		Image:   images.compress(),
+		Command: []string{"sh", "-c", script},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: modelVolumeName, MountPath: modelMountPath},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+				corev1.ResourceCPU:    resource.MustParse("2"),
+			},
+		},
+	})
+}
+
+// BuildDecompressContainer returns the init container that decompresses the
+// files of a compressed model from its PVC volume into the volume mounted in
+// place of it by consuming pods
+func BuildDecompressContainer(model *modelsv1alpha1.Model, images Images) corev1.Container {
+	return corev1.Container{
+		Name:    DecompressContainerName(model.Name),
+		Image:   images.compress(),
+		Command: []string{"sh", "-c", decompressCommand(model, decompressMountPath, modelMountPath)},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: CompressedVolumeName(model.Name), MountPath: decompressMountPath, ReadOnly: true},
+			{Name: VolumeName(model.Name), MountPath: modelMountPath},
+		},
+	}
+}
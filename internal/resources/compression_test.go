@@ -0,0 +1,120 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+func compressedModel(compression modelsv1alpha1.Compression) *modelsv1alpha1.Model {
+	model := inlineModel(modelsv1alpha1.StorageModePVC)
+	model.Spec.Storage.StorageClass = "standard"
+	model.Spec.Storage.Compression = compression
+	return model
+}
+
+func TestCompressed(t *testing.T) {
+	tests := []struct {
+		compression modelsv1alpha1.Compression
+		want        bool
+		wantExt     string
+	}{
+		{"", false, ""},
+		{modelsv1alpha1.CompressionNone, false, ""},
+		{modelsv1alpha1.CompressionGzip, true, ".gz"},
+		{modelsv1alpha1.CompressionZstd, true, ".zst"},
+	}
+	for _, tt := range tests {
+		model := compressedModel(tt.compression)
+		if got := Compressed(model); got != tt.want {
+			t.Errorf("Compressed(%q) = %v, want %v", tt.compression, got, tt.want)
+		}
+		if got := CompressionExtension(model); got != tt.wantExt {
+			t.Errorf("CompressionExtension(%q) = %q, want %q", tt.compression, got, tt.wantExt)
+		}
+	}
+}
+
+func TestBuildDownloadJob_Compression(t *testing.T) {
+	job, err := BuildDownloadJob(compressedModel(modelsv1alpha1.CompressionZstd), Config{
+		Images: Images{Compress: "registry.internal/zstd:1.5"},
+	})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	containers := job.Spec.Template.Spec.Containers
+	if len(containers) != 2 || containers[1].Name != CompressorContainerName {
+		t.Fatalf("Containers = %d, want the downloader and the compress container", len(containers))
+	}
+
+	// The downloader hands over to the compress container instead of
+	// writing the completion marker itself
+	download := containers[0].Args[0]
+	if strings.Contains(download, marker.FileName) || !strings.Contains(download, downloadedPath) {
+		t.Errorf("Downloader script should signal the compress container:\n%s", download)
+	}
+
+	compress := containers[1]
+	if compress.Image != "registry.internal/zstd:1.5" {
+		t.Errorf("Compress image = %q", compress.Image)
+	}
+	script := compress.Command[2]
+	for _, want := range []string{
+		"until [ -f " + downloadedPath + " ]",
+		"-exec zstd -q -f --rm {} +",
+		marker.FileName,
+		"rm -f " + downloadedPath,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Compress script missing %q:\n%s", want, script)
+		}
+	}
+	if strings.Index(script, "zstd -q") > strings.Index(script, marker.FileName) {
+		t.Errorf("Compress script should compress before writing the marker:\n%s", script)
+	}
+}
+
+func TestBuildDownloadJob_NoCompression(t *testing.T) {
+	job, err := BuildDownloadJob(compressedModel(modelsv1alpha1.CompressionNone), Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if len(job.Spec.Template.Spec.Containers) != 1 {
+		t.Errorf("Containers = %d, want only the downloader", len(job.Spec.Template.Spec.Containers))
+	}
+}
+
+func TestBuildDecompressContainer(t *testing.T) {
+	container := BuildDecompressContainer(compressedModel(modelsv1alpha1.CompressionGzip), Images{})
+
+	if container.Name != "model-decompress-tokenizer" || container.Image != compressImage {
+		t.Errorf("Container = %s (%s)", container.Name, container.Image)
+	}
+	if len(container.VolumeMounts) != 2 ||
+		container.VolumeMounts[0].Name != CompressedVolumeName("tokenizer") || !container.VolumeMounts[0].ReadOnly ||
+		container.VolumeMounts[1].Name != VolumeName("tokenizer") {
+		t.Errorf("VolumeMounts = %+v", container.VolumeMounts)
+	}
+	if script := container.Command[2]; !strings.Contains(script, `gzip -dc "$f" > "/models/${f%.gz}"`) {
+		t.Errorf("Decompress script:\n%s", script)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// Config holds the operator-level settings applied to the objects built for
+// every Model. Zero fields keep the built-in defaults, so the zero Config
+// builds the same objects as the operator always has.
+type Config struct {
+	// Images overrides the images of the operator's Jobs and DaemonSets
+	Images Images
+	// Jobs configures retries and cleanup of the operator's Jobs
+	Jobs JobConfig
+	// DownloadResources overrides the resources of the downloader container
+	DownloadResources *corev1.ResourceRequirements
+	// ImagePullSecrets are added to download pods, for downloader images
+	// mirrored into a private registry
+	ImagePullSecrets []corev1.LocalObjectReference
+	// HuggingFace configures the Hugging Face downloader
+	HuggingFace HuggingFaceOptions
+	// DownloaderServiceAccount is created in each namespace for download
+	// pods that name no ServiceAccount
+	DownloaderServiceAccount ServiceAccountConfig
+	// LocalStorageBaseDir is the directory on nodes that local storage is
+	// kept under (default /var/lib/model-operator)
+	LocalStorageBaseDir string
+}
+
+// Images overrides built-in images, e.g. with mirrors in an air-gapped
+// registry. The Hugging Face downloader image is set in HuggingFaceOptions.
+type Images struct {
+	// S3 downloads S3 sources (default amazon/aws-cli)
+	S3 string
+	// S5cmd downloads S3 sources with the s5cmd transfer tool (default
+	// peakcom/s5cmd)
+	S5cmd string
+	// Curl downloads URL sources and presigned S3 objects, and stores
+	// configmap-mode models (default curlimages/curl)
+	Curl string
+	// Git clones Git sources (default alpine/git)
+	Git string
+	// DVC downloads DVC sources, installing DVC unless the image has it
+	// (default python:3.11-slim)
+	DVC string
+	// Busybox cleans up local storage and rebuilds status (default busybox)
+	Busybox string
+	// Publish pushes OCI images of models (default crane:debug)
+	Publish string
+	// Pause keeps pre-pull pods alive (default registry.k8s.io/pause)
+	Pause string
+	// FileServer serves model files over HTTP, as a non-root nginx serving
+	// /usr/share/nginx/html on port 8080 (default nginxinc/nginx-unprivileged)
+	FileServer string
+	// Compress compresses models at rest and decompresses them for consuming
+	// pods, installing zstd unless the image has it (default alpine)
+	Compress string
+}
+
+// JobConfig configures retries and cleanup of the operator's Jobs
+type JobConfig struct {
+	// BackoffLimit is how often download, publish and cleanup Jobs are
+	// retried (default 3)
+	BackoffLimit *int32
+	// TTLSecondsAfterFinished is how long finished Jobs are kept (default 3600)
+	TTLSecondsAfterFinished *int32
+}
+
+func orDefault(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
+
+func (i Images) s3() string         { return orDefault(i.S3, s3Image) }
+func (i Images) s5cmd() string      { return orDefault(i.S5cmd, s5cmdImage) }
+func (i Images) curl() string       { return orDefault(i.Curl, urlImage) }
+func (i Images) git() string        { return orDefault(i.Git, gitImage) }
+func (i Images) dvc() string        { return orDefault(i.DVC, dvcImage) }
+func (i Images) busybox() string    { return orDefault(i.Busybox, cleanupImage) }
+func (i Images) publish() string    { return orDefault(i.Publish, publishImage) }
+func (i Images) pause() string      { return orDefault(i.Pause, pauseImage) }
+func (i Images) compress() string   { return orDefault(i.Compress, compressImage) }
+func (i Images) fileServer() string { return orDefault(i.FileServer, fileServerImage) }
+
+// backoffLimit returns the retries of download, publish and cleanup Jobs
+func (j JobConfig) backoffLimit() int32 {
+	if j.BackoffLimit != nil {
+		return *j.BackoffLimit
+	}
+	return defaultBackoffLimit
+}
+
+// ttlSecondsAfterFinished returns how long finished Jobs are kept
+func (j JobConfig) ttlSecondsAfterFinished() int32 {
+	if j.TTLSecondsAfterFinished != nil {
+		return *j.TTLSecondsAfterFinished
+	}
+	return defaultTTLSecondsAfterFinished
+}
+
+// ParseImagePullSecrets parses a comma-separated list of Secret names
+func ParseImagePullSecrets(value string) []corev1.LocalObjectReference {
+	var secrets []corev1.LocalObjectReference
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			secrets = append(secrets, corev1.LocalObjectReference{Name: name})
+		}
+	}
+	return secrets
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func configTestModel(source modelsv1alpha1.ModelSource) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source:  source,
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"},
+		},
+	}
+}
+
+func TestConfigDefaults(t *testing.T) {
+	job, err := BuildDownloadJob(configTestModel(modelsv1alpha1.ModelSource{
+		S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/"},
+	}), Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	if got := *job.Spec.BackoffLimit; got != defaultBackoffLimit {
+		t.Errorf("BackoffLimit = %v, want %v", got, defaultBackoffLimit)
+	}
+	if got := *job.Spec.TTLSecondsAfterFinished; got != defaultTTLSecondsAfterFinished {
+		t.Errorf("TTLSecondsAfterFinished = %v, want %v", got, defaultTTLSecondsAfterFinished)
+	}
+	if got := job.Spec.Template.Spec.Containers[0].Image; got != s3Image {
+		t.Errorf("Image = %v, want %v", got, s3Image)
+	}
+}
+
+func TestConfigOverrides(t *testing.T) {
+	res := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("4Gi")},
+	}
+	cfg := Config{
+		Images: Images{
+			S3:      "registry.internal/aws-cli:2",
+			Curl:    "registry.internal/curl:8",
+			Git:     "registry.internal/git:2",
+			Busybox: "registry.internal/busybox:1.36",
+			Pause:   "registry.internal/pause:3.10",
+		},
+		Jobs: JobConfig{
+			BackoffLimit:            ptr.To(int32(6)),
+			TTLSecondsAfterFinished: ptr.To(int32(0)),
+		},
+		DownloadResources: &res,
+	}
+
+	tests := []struct {
+		name      string
+		source    modelsv1alpha1.ModelSource
+		wantImage string
+	}{
+		{
+			name:      "s3",
+			source:    modelsv1alpha1.ModelSource{S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/"}},
+			wantImage: "registry.internal/aws-cli:2",
+		},
+		{
+			name: "presigned s3",
+			source: modelsv1alpha1.ModelSource{S3: &modelsv1alpha1.S3Source{
+				Bucket: "models", Key: "llama/", Presign: &modelsv1alpha1.S3Presign{},
+			}},
+			wantImage: "registry.internal/curl:8",
+		},
+		{
+			name:      "url",
+			source:    modelsv1alpha1.ModelSource{URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"}},
+			wantImage: "registry.internal/curl:8",
+		},
+		{
+			name:      "git",
+			source:    modelsv1alpha1.ModelSource{Git: &modelsv1alpha1.GitSource{URL: "https://example.com/llama.git"}},
+			wantImage: "registry.internal/git:2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := BuildDownloadJob(configTestModel(tt.source), cfg)
+			if err != nil {
+				t.Fatalf("BuildDownloadJob() error = %v", err)
+			}
+			container := job.Spec.Template.Spec.Containers[0]
+			if container.Image != tt.wantImage {
+				t.Errorf("Image = %v, want %v", container.Image, tt.wantImage)
+			}
+			if got := container.Resources.Limits[corev1.ResourceMemory]; got.String() != "4Gi" {
+				t.Errorf("memory limit = %v, want 4Gi", got.String())
+			}
+			if *job.Spec.BackoffLimit != 6 || *job.Spec.TTLSecondsAfterFinished != 0 {
+				t.Errorf("BackoffLimit, TTL = %v, %v, want 6, 0", *job.Spec.BackoffLimit, *job.Spec.TTLSecondsAfterFinished)
+			}
+			// Overridden images are not known to be multi-arch
+			if job.Spec.Template.Spec.Affinity != nil {
+				t.Errorf("Affinity = %v, want none for an overridden image", job.Spec.Template.Spec.Affinity)
+			}
+		})
+	}
+
+	model := configTestModel(modelsv1alpha1.ModelSource{})
+	if got := BuildRebuildJob(model, cfg).Spec.Template.Spec.Containers[0].Image; got != cfg.Images.Busybox {
+		t.Errorf("rebuild Image = %v, want %v", got, cfg.Images.Busybox)
+	}
+	model.Spec.Prewarm = &modelsv1alpha1.PrewarmSpec{Images: []string{"vllm/vllm-openai:latest"}}
+	if got := BuildPrewarmDaemonSet(model, cfg).Spec.Template.Spec.Containers[0].Image; got != cfg.Images.Pause {
+		t.Errorf("prewarm Image = %v, want %v", got, cfg.Images.Pause)
+	}
+}
+
+func TestConfigImagePullSecrets(t *testing.T) {
+	model := configTestModel(modelsv1alpha1.ModelSource{
+		S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/"},
+	})
+	cfg := Config{ImagePullSecrets: ParseImagePullSecrets(" mirror-pull, ,team-pull")}
+
+	job, err := BuildDownloadJob(model, cfg)
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	want := []corev1.LocalObjectReference{{Name: "mirror-pull"}, {Name: "team-pull"}}
+	if got := job.Spec.Template.Spec.ImagePullSecrets; !reflect.DeepEqual(got, want) {
+		t.Errorf("ImagePullSecrets = %v, want %v", got, want)
+	}
+
+	// The Model's secrets are added after the operator's, once each
+	model.Spec.Download = &modelsv1alpha1.DownloadSpec{
+		ImagePullSecrets: []corev1.LocalObjectReference{{Name: "team-pull"}, {Name: "private-pull"}},
+	}
+	job, err = BuildDownloadJob(model, cfg)
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	want = append(want, corev1.LocalObjectReference{Name: "private-pull"})
+	if got := job.Spec.Template.Spec.ImagePullSecrets; !reflect.DeepEqual(got, want) {
+		t.Errorf("ImagePullSecrets = %v, want %v", got, want)
+	}
+	if len(cfg.ImagePullSecrets) != 2 {
+		t.Errorf("Operator ImagePullSecrets modified: %v", cfg.ImagePullSecrets)
+	}
+}
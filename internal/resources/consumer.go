@@ -0,0 +1,53 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// MountsModel reports whether the pod mounts the model: injected by the
+// webhook, or a volume of the model's PVC or one of its replicas added by hand
+func MountsModel(pod *corev1.Pod, model *modelsv1alpha1.Model) (*corev1.Volume, bool) {
+	for i := range pod.Spec.Volumes {
+		volume := &pod.Spec.Volumes[i]
+		if volume.Name == VolumeName(model.Name) {
+			return volume, true
+		}
+		if pvc := volume.PersistentVolumeClaim; pvc != nil {
+			if pvc.ClaimName == ClaimName(model) ||
+				(model.Status.PVCName != "" && pvc.ClaimName == model.Status.PVCName) ||
+				strings.HasPrefix(pvc.ClaimName, ReplicaPrefix+model.Name+"-") {
+				return volume, true
+			}
+		}
+	}
+	return nil, false
+}
+
+// IsConsumerPod reports whether the pod can consume models: it has not
+// finished, and it is not one of the operator's own download or replica pods
+func IsConsumerPod(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+	return pod.Labels[LabelReplica] == "" && pod.Labels["app.kubernetes.io/managed-by"] != "model-operator"
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestMountsModel(t *testing.T) {
+	model := &modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "llama"}}
+	pvcVolume := func(name, claim string) corev1.Volume {
+		return corev1.Volume{Name: name, VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+		}}
+	}
+
+	tests := []struct {
+		name   string
+		volume corev1.Volume
+		want   bool
+	}{
+		{"injected", corev1.Volume{Name: VolumeName("llama")}, true},
+		{"claim added by hand", pvcVolume("weights", PVCName("llama")), true},
+		{"replica claim", pvcVolume("weights", ReplicaPVCName("llama", "zone-b")), true},
+		{"other model", pvcVolume("weights", PVCName("llama-2")), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{Spec: corev1.PodSpec{Volumes: []corev1.Volume{tt.volume}}}
+			if _, got := MountsModel(pod, model); got != tt.want {
+				t.Errorf("MountsModel() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsConsumerPod(t *testing.T) {
+	tests := []struct {
+		name   string
+		phase  corev1.PodPhase
+		labels map[string]string
+		want   bool
+	}{
+		{"running", corev1.PodRunning, nil, true},
+		{"finished", corev1.PodSucceeded, nil, false},
+		{"operator pod", corev1.PodRunning, map[string]string{"app.kubernetes.io/managed-by": "model-operator"}, false},
+		{"replica pod", corev1.PodRunning, map[string]string{LabelReplica: "zone-b"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: tt.labels}, Status: corev1.PodStatus{Phase: tt.phase}}
+			if got := IsConsumerPod(pod); got != tt.want {
+				t.Errorf("IsConsumerPod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// dvcImage runs dvc get, installing DVC unless the image already has it
+	dvcImage = "python:3.11-slim"
+
+	// dvcPackages are the DVC package and the remote types it can download from
+	dvcPackages = "dvc[s3,gs,azure,ssh]"
+
+	// Remote config volume
+	dvcRemoteConfigVolumeName = "dvc-remote-config"
+	dvcRemoteConfigMountPath  = "/etc/dvc-remote"
+
+	// dvcOutput is where dvc get writes, on the model volume so that moving
+	// a directory's contents into place is a rename
+	dvcOutput = modelMountPath + "/.dvc-get"
+)
+
+func buildDVCContainer(model *modelsv1alpha1.Model, images Images) corev1.Container {
+	dvc := model.Spec.Source.DVC
+
+	args := []string{"dvc", "get", fmt.Sprintf("'%s'", dvc.URL), fmt.Sprintf("'%s'", dvc.Path), "-o", dvcOutput}
+	if dvc.Rev != "" {
+		args = append(args, "--rev", fmt.Sprintf("'%s'", dvc.Rev))
+	}
+	if dvc.Remote != "" {
+		args = append(args, "--remote", fmt.Sprintf("'%s'", dvc.Remote))
+	}
+
+	// Pass every entry of the remote config Secret as a --remote-config option
+	remoteConfig := ""
+	if dvc.RemoteConfigSecret != "" {
+		remoteConfig = fmt.Sprintf(`set -- && \
+for f in %s/*; do set -- "$@" --remote-config "$(basename "$f")=$(cat "$f")"; done && \
+`, dvcRemoteConfigMountPath)
+		args = append(args, `"$@"`)
+	}
+
+	// A user install (PIP_USER, for non-root pods) puts dvc in ~/.local/bin
+	script := fmt.Sprintf(`export PATH="$PATH:$HOME/.local/bin" && \
+{ command -v dvc >/dev/null 2>&1 || pip install -q "%[1]s"; } && \
+%[2]srm -rf %[3]s && \
+%[4]s && \
+if [ -d %[3]s ]; then
+  find %[3]s -mindepth 1 -maxdepth 1 -exec mv {} /models/ \; && rmdir %[3]s
+else
+  mv %[3]s "/models/$(basename '%[5]s')"
+fi && \
+{ cat > /models/Modelfile << 'MODELFILE_EOF'
+%[6]s
+MODELFILE_EOF
+} && \
+%[7]s && \
+echo "Download complete" && \
+ls -la /models`, dvcPackages, remoteConfig, dvcOutput, strings.Join(args, " "), dvc.Path,
+		buildModelfileContent(model), completionMarkerScript(model))
+
+	container := corev1.Container{
+		Name:    "downloader",
+		Image:   images.dvc(),
+		Command: []string{"sh", "-c"},
+		Args:    []string{script},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      modelVolumeName,
+				MountPath: modelMountPath,
+			},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("2Gi"),
+				corev1.ResourceCPU:    resource.MustParse("2"),
+			},
+		},
+	}
+
+	if dvc.RemoteConfigSecret != "" {
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      dvcRemoteConfigVolumeName,
+			MountPath: dvcRemoteConfigMountPath,
+			ReadOnly:  true,
+		})
+	}
+
+	// The DVC project is cloned like a Git source
+	if model.Spec.CredentialsSecret != "" {
+		container.Env = append(container.Env, gitCredentialsEnv(model.Spec.CredentialsSecret)...)
+	}
+
+	return container
+}
+
+// configureDVCPod adds the volume holding the DVC remote config
+func configureDVCPod(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec) {
+	dvc := model.Spec.Source.DVC
+	if dvc == nil || dvc.RemoteConfigSecret == "" {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: dvcRemoteConfigVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: dvc.RemoteConfigSecret},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+func TestBuildDownloadJob_DVC(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "dvc-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				DVC: &modelsv1alpha1.DVCSource{
+					URL:                "https://github.com/example/research.git",
+					Path:               "models/classifier",
+					Rev:                "v2",
+					Remote:             "storage",
+					RemoteConfigSecret: "dvc-remote",
+				},
+			},
+			Storage:           modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"},
+			CredentialsSecret: "git-credentials",
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != dvcImage {
+		t.Errorf("Container image = %v, want %v", container.Image, dvcImage)
+	}
+
+	script := container.Args[0]
+	for _, want := range []string{
+		"dvc get 'https://github.com/example/research.git' 'models/classifier' -o /models/.dvc-get --rev 'v2' --remote 'storage' \"$@\"",
+		`--remote-config "$(basename "$f")=$(cat "$f")"`,
+		"# DVC_PATH models/classifier",
+		marker.FileName,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Script should contain %q", want)
+		}
+	}
+
+	var mounted bool
+	for _, m := range container.VolumeMounts {
+		mounted = mounted || (m.Name == dvcRemoteConfigVolumeName && m.ReadOnly)
+	}
+	if !mounted {
+		t.Errorf("Container should mount the remote config read-only")
+	}
+	var secret string
+	for _, v := range job.Spec.Template.Spec.Volumes {
+		if v.Name == dvcRemoteConfigVolumeName && v.Secret != nil {
+			secret = v.Secret.SecretName
+		}
+	}
+	if secret != "dvc-remote" {
+		t.Errorf("Remote config volume secret = %q, want dvc-remote", secret)
+	}
+
+	env := map[string]string{}
+	for _, e := range container.Env {
+		env[e.Name] = e.ValueFrom.SecretKeyRef.Name
+	}
+	if env["GIT_USERNAME"] != "git-credentials" || env["GIT_PASSWORD"] != "git-credentials" {
+		t.Errorf("Env = %v, want Git credentials from git-credentials", env)
+	}
+}
+
+func TestBuildDownloadJob_DVCDefaults(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "dvc-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				DVC: &modelsv1alpha1.DVCSource{URL: "https://github.com/example/research.git", Path: "model.onnx"},
+			},
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "1Gi"},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{Images: Images{DVC: "registry.internal/dvc:3"}})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != "registry.internal/dvc:3" {
+		t.Errorf("Container image = %v, want registry.internal/dvc:3", container.Image)
+	}
+	script := container.Args[0]
+	for _, unwanted := range []string{"--rev", "--remote", "$@"} {
+		if strings.Contains(script, unwanted) {
+			t.Errorf("Script should not contain %q", unwanted)
+		}
+	}
+	if len(job.Spec.Template.Spec.Volumes) != 1 || len(container.Env) != 0 {
+		t.Errorf("Job should only have the model volume and no env, got %v and %v",
+			job.Spec.Template.Spec.Volumes, container.Env)
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import "errors"
+
+// Errors in the Model spec that building its resources reports. They are
+// wrapped with the details, so match them with errors.Is.
+var (
+	// ErrNoSource is returned for a source that sets none of its fields
+	ErrNoSource = errors.New("no source specified")
+
+	// ErrMultipleSources is returned for a source that sets more than one
+	// of its fields
+	ErrMultipleSources = errors.New("multiple sources specified")
+
+	// ErrInvalidSize is returned for a storage size that is not a positive
+	// quantity
+	ErrInvalidSize = errors.New("invalid storage size")
+
+	// ErrVolumeConflict is returned for an extra download volume or mount
+	// whose name or path the download pod already uses
+	ErrVolumeConflict = errors.New("download volume conflict")
+)
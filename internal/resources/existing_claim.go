@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// ClaimName returns the PVC holding the model files: the existing claim the
+// Model names, or the one the operator creates for it
+func ClaimName(model *modelsv1alpha1.Model) string {
+	if claim := model.Spec.Storage.ExistingClaim; claim != nil {
+		return claim.Name
+	}
+	return PVCName(model.Name)
+}
+
+// UsesExistingClaim reports whether the model is kept on a pre-provisioned
+// PVC the operator neither creates nor owns
+func UsesExistingClaim(model *modelsv1alpha1.Model) bool {
+	return model.Spec.Storage.ExistingClaim != nil
+}
+
+// VerifyOnly reports whether the model files are already on an existing
+// claim and only need checking, rather than downloading
+func VerifyOnly(model *modelsv1alpha1.Model) bool {
+	claim := model.Spec.Storage.ExistingClaim
+	return claim != nil && claim.VerifyOnly
+}
+
+// buildSealContainer returns the downloader container of a verify-only model:
+// it checks the files on the existing claim against their completion marker,
+// or writes one for files that have none
+func buildSealContainer(model *modelsv1alpha1.Model, images Images) corev1.Container {
+	script := marker.SealScript(modelMountPath, SourceRevision(model), model.Spec.Version)
+	if ownership := ownershipScript(model); ownership != "" {
+		script = ownership + " && \\\n" + script
+	}
+
+	return corev1.Container{
+		Name:    "downloader",
+		Image:   images.busybox(),
+		Command: []string{"sh", "-c"},
+		Args:    []string{script},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      modelVolumeName,
+				MountPath: modelMountPath,
+			},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+				corev1.ResourceCPU:    resource.MustParse("50m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func existingClaimModel(verifyOnly bool) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "restored", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				Size:          "1Gi",
+				ExistingClaim: &modelsv1alpha1.ExistingClaimSpec{Name: "from-backup", VerifyOnly: verifyOnly},
+			},
+		},
+	}
+}
+
+func TestClaimName(t *testing.T) {
+	if got := ClaimName(existingClaimModel(false)); got != "from-backup" {
+		t.Errorf("ClaimName() = %q, want the existing claim", got)
+	}
+
+	model := existingClaimModel(false)
+	model.Spec.Storage.ExistingClaim = nil
+	if got := ClaimName(model); got != PVCName(model.Name) {
+		t.Errorf("ClaimName() = %q, want %q", got, PVCName(model.Name))
+	}
+}
+
+func TestBuildDownloadJob_ExistingClaim(t *testing.T) {
+	job, err := BuildDownloadJob(existingClaimModel(false), Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+
+	if claim := podSpec.Volumes[0].PersistentVolumeClaim; claim == nil || claim.ClaimName != "from-backup" {
+		t.Errorf("model volume = %+v, want the existing claim", podSpec.Volumes[0])
+	}
+	if podSpec.Containers[0].Image != huggingFaceImage {
+		t.Errorf("downloader image = %q, want the source's", podSpec.Containers[0].Image)
+	}
+}
+
+func TestBuildDownloadJob_VerifyOnly(t *testing.T) {
+	job, err := BuildDownloadJob(existingClaimModel(true), Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+
+	if len(podSpec.Containers) != 1 || len(podSpec.InitContainers) != 0 {
+		t.Fatalf("pod has %d containers and %d init containers, want only the seal container",
+			len(podSpec.Containers), len(podSpec.InitContainers))
+	}
+	seal := podSpec.Containers[0]
+	if seal.Image != cleanupImage {
+		t.Errorf("image = %q, want %q", seal.Image, cleanupImage)
+	}
+	if script := seal.Args[0]; strings.Contains(script, "org/model") || !strings.Contains(script, "completion marker") {
+		t.Errorf("script should verify the files without fetching the source: %s", script)
+	}
+	if len(podSpec.Volumes) != 1 || podSpec.Volumes[0].PersistentVolumeClaim.ClaimName != "from-backup" {
+		t.Errorf("volumes = %+v, want only the existing claim", podSpec.Volumes)
+	}
+}
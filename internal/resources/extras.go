@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// configureExtras adds spec.download.env, envFrom, extraVolumes and
+// extraVolumeMounts to the downloader container of podSpec. Env replaces
+// variables the operator sets; volumes and mounts must not clash with the
+// pod's own.
+func configureExtras(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec) error {
+	download := model.Spec.Download
+	if download == nil {
+		return nil
+	}
+	downloader := &podSpec.Containers[0]
+
+	for _, env := range download.Env {
+		i := slices.IndexFunc(downloader.Env, func(e corev1.EnvVar) bool { return e.Name == env.Name })
+		if i >= 0 {
+			downloader.Env[i] = *env.DeepCopy()
+		} else {
+			downloader.Env = append(downloader.Env, *env.DeepCopy())
+		}
+	}
+	for _, envFrom := range download.EnvFrom {
+		downloader.EnvFrom = append(downloader.EnvFrom, *envFrom.DeepCopy())
+	}
+
+	for _, volume := range download.ExtraVolumes {
+		if volume.Name == tmpVolumeName ||
+			slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == volume.Name }) {
+			return fmt.Errorf("%w in model %s: volume name %q is used by the download pod",
+				ErrVolumeConflict, model.Name, volume.Name)
+		}
+		podSpec.Volumes = append(podSpec.Volumes, *volume.DeepCopy())
+	}
+	for _, mount := range download.ExtraVolumeMounts {
+		if slices.ContainsFunc(downloader.VolumeMounts, func(m corev1.VolumeMount) bool { return m.MountPath == mount.MountPath }) {
+			return fmt.Errorf("%w in model %s: mount path %s is used by the downloader",
+				ErrVolumeConflict, model.Name, mount.MountPath)
+		}
+		downloader.VolumeMounts = append(downloader.VolumeMounts, *mount.DeepCopy())
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func extrasModel(download *modelsv1alpha1.DownloadSpec) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "extras-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+					RepoID:   "org/model",
+					Endpoint: "https://hf.example.com",
+				},
+			},
+			Download: download,
+		},
+	}
+}
+
+func TestBuildDownloadJob_Extras(t *testing.T) {
+	cache := corev1.Volume{
+		Name:         "hf-cache",
+		VolumeSource: corev1.VolumeSource{HostPath: &corev1.HostPathVolumeSource{Path: "/var/cache/hf"}},
+	}
+	job, err := BuildDownloadJob(extrasModel(&modelsv1alpha1.DownloadSpec{
+		Env: []corev1.EnvVar{
+			{Name: "HTTPS_PROXY", Value: "http://proxy.example.com:3128"},
+			{Name: "HF_ENDPOINT", Value: "https://mirror.example.com"},
+		},
+		EnvFrom: []corev1.EnvFromSource{{
+			ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "site-settings"}},
+		}},
+		ExtraVolumes:      []corev1.Volume{cache},
+		ExtraVolumeMounts: []corev1.VolumeMount{{Name: "hf-cache", MountPath: "/cache", ReadOnly: true}},
+	}), Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+	downloader := podSpec.Containers[0]
+
+	env := map[string]string{}
+	for _, e := range downloader.Env {
+		if _, ok := env[e.Name]; ok {
+			t.Errorf("Env %s is set twice", e.Name)
+		}
+		env[e.Name] = e.Value
+	}
+	if env["HTTPS_PROXY"] != "http://proxy.example.com:3128" {
+		t.Errorf("HTTPS_PROXY = %q, want the Model's", env["HTTPS_PROXY"])
+	}
+	if env["HF_ENDPOINT"] != "https://mirror.example.com" {
+		t.Errorf("HF_ENDPOINT = %q, want the Model's env to replace the operator's", env["HF_ENDPOINT"])
+	}
+	if len(downloader.EnvFrom) != 1 || downloader.EnvFrom[0].ConfigMapRef.Name != "site-settings" {
+		t.Errorf("EnvFrom = %+v, want the site-settings ConfigMap", downloader.EnvFrom)
+	}
+	if !slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == cache.Name && v.HostPath != nil }) {
+		t.Errorf("Volumes = %+v, want the hf-cache volume", podSpec.Volumes)
+	}
+	if !slices.ContainsFunc(downloader.VolumeMounts, func(m corev1.VolumeMount) bool { return m.Name == cache.Name && m.MountPath == "/cache" }) {
+		t.Errorf("VolumeMounts = %+v, want hf-cache at /cache", downloader.VolumeMounts)
+	}
+}
+
+func TestBuildDownloadJob_ExtrasConflict(t *testing.T) {
+	tests := []struct {
+		name     string
+		download *modelsv1alpha1.DownloadSpec
+	}{
+		{"model volume name", &modelsv1alpha1.DownloadSpec{
+			ExtraVolumes: []corev1.Volume{{Name: modelVolumeName}},
+		}},
+		{"tmp volume name", &modelsv1alpha1.DownloadSpec{
+			ExtraVolumes: []corev1.Volume{{Name: tmpVolumeName}},
+		}},
+		{"model mount path", &modelsv1alpha1.DownloadSpec{
+			ExtraVolumes:      []corev1.Volume{{Name: "other"}},
+			ExtraVolumeMounts: []corev1.VolumeMount{{Name: "other", MountPath: modelMountPath}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := BuildDownloadJob(extrasModel(tt.download), Config{})
+			if !errors.Is(err, ErrVolumeConflict) {
+				t.Errorf("BuildDownloadJob() error = %v, want ErrVolumeConflict", err)
+			}
+		})
+	}
+}
+
+func TestBuildDownloadJob_ExtrasReplaceTmp(t *testing.T) {
+	model := restrictedModel()
+	model.Spec.Download.ExtraVolumes = []corev1.Volume{{
+		Name:         "scratch",
+		VolumeSource: corev1.VolumeSource{Ephemeral: &corev1.EphemeralVolumeSource{}},
+	}}
+	model.Spec.Download.ExtraVolumeMounts = []corev1.VolumeMount{{Name: "scratch", MountPath: tmpMountPath}}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	for _, m := range job.Spec.Template.Spec.Containers[0].VolumeMounts {
+		if m.MountPath == tmpMountPath && m.Name != "scratch" {
+			t.Errorf("/tmp is mounted from %s, want the Model's scratch volume", m.Name)
+		}
+	}
+}
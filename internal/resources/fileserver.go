@@ -0,0 +1,151 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// fileServerImage serves the model files as a non-root nginx
+	fileServerImage = "nginxinc/nginx-unprivileged:1.27-alpine"
+	// fileServerRoot is where nginx serves files from
+	fileServerRoot = "/usr/share/nginx/html"
+	// fileServerContainerPort is the port the unprivileged nginx listens on
+	fileServerContainerPort = 8080
+	// defaultFileServerPort is the Service port of the file server
+	defaultFileServerPort = 80
+)
+
+// FileServerPort returns the Service port the model files are served on
+func FileServerPort(model *modelsv1alpha1.Model) int32 {
+	if fs := model.Spec.FileServer; fs != nil && fs.Port != nil {
+		return *fs.Port
+	}
+	return defaultFileServerPort
+}
+
+// FileServed reports whether a Model's files can be served over HTTP: they
+// must be on a PVC, compressed files would be served compressed, and the
+// Service would let any pod read a Model restricted by spec.access
+func FileServed(model *modelsv1alpha1.Model) bool {
+	return model.Spec.FileServer != nil && UsesPVC(model) && !Compressed(model) && model.Spec.Access == nil
+}
+
+// BuildFileServer creates a Deployment serving the model volume read-only
+// over HTTP, and the Service in front of it
+func BuildFileServer(model *modelsv1alpha1.Model, cfg Config) (*appsv1.Deployment, *corev1.Service) {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-files",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+
+	resources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("16Mi"),
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("128Mi"),
+		},
+	}
+	if fs := model.Spec.FileServer; fs != nil && fs.Resources != nil {
+		resources = *fs.Resources.DeepCopy()
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FileServerName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](1),
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			// A ReadWriteOnce volume cannot attach to a second pod on
+			// another node, so never run two file servers at once
+			Strategy: appsv1.DeploymentStrategy{Type: appsv1.RecreateDeploymentStrategyType},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "file-server",
+							Image: cfg.Images.fileServer(),
+							Ports: []corev1.ContainerPort{
+								{Name: "http", ContainerPort: fileServerContainerPort},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromString("http")},
+								},
+							},
+							Resources: resources,
+							SecurityContext: &corev1.SecurityContext{
+								RunAsNonRoot:             ptr.To(true),
+								AllowPrivilegeEscalation: ptr.To(false),
+								Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: modelVolumeName, MountPath: fileServerRoot, ReadOnly: true},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: modelVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: ClaimName(model),
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      FileServerName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: labels,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "http",
+					Port:       FileServerPort(model),
+					TargetPort: intstr.FromString("http"),
+				},
+			},
+		},
+	}
+
+	return deployment, service
+}
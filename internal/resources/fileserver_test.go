@@ -0,0 +1,87 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestBuildFileServer(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-3-8b", Namespace: "inference"},
+		Spec: modelsv1alpha1.ModelSpec{
+			FileServer: &modelsv1alpha1.FileServerSpec{Port: ptr.To[int32](8000)},
+		},
+	}
+
+	deployment, service := BuildFileServer(model, Config{Images: Images{FileServer: "registry.internal/nginx:1"}})
+
+	if deployment.Name != "model-files-llama-3-8b" || service.Name != deployment.Name {
+		t.Errorf("Names = %v, %v, want model-files-llama-3-8b", deployment.Name, service.Name)
+	}
+	podSpec := deployment.Spec.Template.Spec
+	container := podSpec.Containers[0]
+	if container.Image != "registry.internal/nginx:1" {
+		t.Errorf("Container image = %v, want the configured image", container.Image)
+	}
+	if len(container.VolumeMounts) != 1 || !container.VolumeMounts[0].ReadOnly ||
+		container.VolumeMounts[0].MountPath != fileServerRoot {
+		t.Errorf("Expected the model volume mounted read-only at %s, got %+v", fileServerRoot, container.VolumeMounts)
+	}
+	claim := podSpec.Volumes[0].PersistentVolumeClaim
+	if claim == nil || claim.ClaimName != "model-llama-3-8b" || !claim.ReadOnly {
+		t.Errorf("Expected the model PVC claimed read-only, got %+v", podSpec.Volumes[0])
+	}
+
+	if len(service.Spec.Ports) != 1 || service.Spec.Ports[0].Port != 8000 {
+		t.Errorf("Service ports = %+v, want port 8000", service.Spec.Ports)
+	}
+	for k, v := range service.Spec.Selector {
+		if deployment.Spec.Template.Labels[k] != v {
+			t.Errorf("Service selector %s=%s does not match the pods", k, v)
+		}
+	}
+}
+
+func TestFileServed(t *testing.T) {
+	model := &modelsv1alpha1.Model{Spec: modelsv1alpha1.ModelSpec{FileServer: &modelsv1alpha1.FileServerSpec{}}}
+	if !FileServed(model) {
+		t.Error("Expected an uncompressed PVC model to be served")
+	}
+	model.Spec.Storage.Compression = modelsv1alpha1.CompressionZstd
+	if FileServed(model) {
+		t.Error("Expected a compressed model not to be served")
+	}
+	model.Spec.Storage.Compression = ""
+	model.Spec.Storage.Mode = modelsv1alpha1.StorageModeConfigMap
+	if FileServed(model) {
+		t.Error("Expected a configmap-mode model not to be served")
+	}
+	model.Spec.Storage.Mode = ""
+	model.Spec.Access = &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"inference"}}
+	if FileServed(model) {
+		t.Error("Expected a model restricted by spec.access not to be served")
+	}
+	if FileServed(&modelsv1alpha1.Model{}) {
+		t.Error("Expected a model without a file server not to be served")
+	}
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// Keys of the info ConfigMap, each a file when the ConfigMap is mounted
+const (
+	InfoKeyName       = "name"
+	InfoKeyNamespace  = "namespace"
+	InfoKeyVersion    = "version"
+	InfoKeyRevision   = "revision"
+	InfoKeyDigest     = "digest"
+	InfoKeyMountPath  = "mountPath"
+	InfoKeyParameters = "parameters.json"
+)
+
+// BuildInfoConfigMap creates the ConfigMap exporting a Model's identity,
+// content and inference parameters to consumers that read files rather than
+// the env vars the webhook injects. Keys the Model has no value for yet,
+// such as the digest before the first download, are left out.
+func BuildInfoConfigMap(model *modelsv1alpha1.Model) (*corev1.ConfigMap, error) {
+	data := map[string]string{
+		InfoKeyName:      model.Name,
+		InfoKeyNamespace: model.Namespace,
+		InfoKeyMountPath: DefaultMountPath(model.Name),
+	}
+	for key, value := range map[string]string{
+		InfoKeyVersion:  model.Spec.Version,
+		InfoKeyRevision: model.Status.SourceRevision,
+		InfoKeyDigest:   model.Status.ContentDigest,
+	} {
+		if value != "" {
+			data[key] = value
+		}
+	}
+	if modelfile := model.Spec.Modelfile; modelfile != nil && modelfile.Parameters != nil {
+		parameters, err := json.Marshal(modelfile.Parameters)
+		if err != nil {
+			return nil, err
+		}
+		data[InfoKeyParameters] = string(parameters)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      InfoConfigMapName(model.Name),
+			Namespace: model.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "model-info",
+				"app.kubernetes.io/instance":   model.Name,
+				"app.kubernetes.io/managed-by": "model-operator",
+			},
+		},
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestBuildInfoConfigMap(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Version: "3.1",
+			Modelfile: &modelsv1alpha1.ModelfileSpec{
+				Parameters: &modelsv1alpha1.ModelParameters{Temperature: ptr.To("0.7"), NumCtx: ptr.To(8192)},
+			},
+		},
+		Status: modelsv1alpha1.ModelStatus{SourceRevision: "abc123", ContentDigest: "sha256:4f2a"},
+	}
+
+	cm, err := BuildInfoConfigMap(model)
+	if err != nil {
+		t.Fatalf("BuildInfoConfigMap() error = %v", err)
+	}
+	if cm.Name != "model-llama-info" || cm.Namespace != "ml" {
+		t.Errorf("ConfigMap = %s/%s, want ml/model-llama-info", cm.Namespace, cm.Name)
+	}
+	want := map[string]string{
+		InfoKeyName:       "llama",
+		InfoKeyNamespace:  "ml",
+		InfoKeyVersion:    "3.1",
+		InfoKeyRevision:   "abc123",
+		InfoKeyDigest:     "sha256:4f2a",
+		InfoKeyMountPath:  "/models/llama",
+		InfoKeyParameters: `{"temperature":"0.7","numCtx":8192}`,
+	}
+	for key, value := range want {
+		if cm.Data[key] != value {
+			t.Errorf("Data[%s] = %q, want %q", key, cm.Data[key], value)
+		}
+	}
+	if len(cm.Data) != len(want) {
+		t.Errorf("Data = %v, want only %v", cm.Data, want)
+	}
+}
+
+func TestBuildInfoConfigMap_NotDownloaded(t *testing.T) {
+	model := &modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "bge", Namespace: "default"}}
+
+	cm, err := BuildInfoConfigMap(model)
+	if err != nil {
+		t.Fatalf("BuildInfoConfigMap() error = %v", err)
+	}
+	for _, key := range []string{InfoKeyVersion, InfoKeyRevision, InfoKeyDigest, InfoKeyParameters} {
+		if _, ok := cm.Data[key]; ok {
+			t.Errorf("Data[%s] = %q, want it left out", key, cm.Data[key])
+		}
+	}
+}
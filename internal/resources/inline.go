@@ -0,0 +1,191 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// StoreContainerName is the name of the download pod container that stores a
+// configmap-mode model in its ConfigMap
+const StoreContainerName = "store"
+
+// waitForMarker blocks until the downloader wrote the completion marker
+var waitForMarker = fmt.Sprintf("until [ -f %s ]; do sleep 2; done\n", marker.Path(modelMountPath))
+
+// storeConfigMapScript replaces the ConfigMap's binaryData with the top-level
+// files of the download. Hidden files, such as the completion marker and
+// download caches, are left out; other nested files fail the store.
+const storeConfigMapScript = `set -e
+cd ` + modelMountPath + `
+nested=$(find . -mindepth 2 -type f ! -path './.*' | head -n 1)
+if [ -n "$nested" ]; then
+  echo "configmap storage only holds top-level files, found $nested" | tee /dev/termination-log
+  exit 1
+fi
+{
+  printf '[{"op":"add","path":"/binaryData","value":{'
+  sep=''
+  for f in *; do
+    [ -f "$f" ] || continue
+    case "$f" in *[!-._a-zA-Z0-9]*)
+      echo "file name $f is not a valid ConfigMap key" | tee /dev/termination-log
+      exit 1;;
+    esac
+    printf '%s"%s":"' "$sep" "$f"
+    base64 "$f" | tr -d '\n'
+    printf '"'
+    sep=','
+  done
+  printf '}}]'
+} > /tmp/patch.json
+curl -sSf --cacert /var/run/secrets/kubernetes.io/serviceaccount/ca.crt \
+  -H "Authorization: Bearer $(cat /var/run/secrets/kubernetes.io/serviceaccount/token)" \
+  -X PATCH -H "Content-Type: application/json-patch+json" --data-binary @/tmp/patch.json \
+  "https://kubernetes.default.svc/api/v1/namespaces/$MODEL_NAMESPACE/configmaps/$CONTENT_CONFIGMAP" >/dev/null
+echo "Stored model in ConfigMap $CONTENT_CONFIGMAP"`
+
+// UsesPVC reports whether the model is kept on a PVC, rather than in a
+// ConfigMap or an OCI image
+func UsesPVC(model *modelsv1alpha1.Model) bool {
+	mode := model.Spec.Storage.Mode
+	return mode == "" || mode == modelsv1alpha1.StorageModePVC
+}
+
+// configureInlineStorage makes the download Job of a configmap or image mode
+// model download into a scratch volume and store the result once complete
+func configureInlineStorage(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec, images Images) {
+	scratch := &corev1.EmptyDirVolumeSource{}
+	if size, err := resource.ParseQuantity(model.Spec.Storage.Size); err == nil {
+		scratch.SizeLimit = &size
+	}
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == modelVolumeName {
+			podSpec.Volumes[i].VolumeSource = corev1.VolumeSource{EmptyDir: scratch}
+		}
+	}
+
+	if model.Spec.Storage.Mode == modelsv1alpha1.StorageModeImage {
+		container, volumes := buildPublisher(model, waitForMarker+publishScript, images)
+		podSpec.Containers = append(podSpec.Containers, container)
+		podSpec.Volumes = append(podSpec.Volumes, volumes...)
+		return
+	}
+
+	podSpec.ServiceAccountName = StoreName(model.Name)
+	podSpec.Containers = append(podSpec.Containers, corev1.Container{
+		Name:    StoreContainerName,
+		Image:   images.curl(),
+		Command: []string{"sh", "-c", waitForMarker + storeConfigMapScript},
+		Env: []corev1.EnvVar{
+			{Name: "MODEL_NAMESPACE", Value: model.Namespace},
+			{Name: "CONTENT_CONFIGMAP", Value: ContentConfigMapName(model.Name)},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: modelVolumeName, MountPath: modelMountPath, ReadOnly: true},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("16Mi"),
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("64Mi"),
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+			},
+		},
+	})
+}
+
+// inlineLabels returns the labels of the objects backing a configmap-mode model
+func inlineLabels(model *modelsv1alpha1.Model) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "model-content",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+}
+
+// BuildContentConfigMap creates the ConfigMap a configmap-mode model is
+// stored in. The download pod fills in its binaryData.
+func BuildContentConfigMap(model *modelsv1alpha1.Model) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ContentConfigMapName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    inlineLabels(model),
+		},
+	}
+}
+
+// BuildStoreServiceAccount creates the ServiceAccount of the download pod of
+// a configmap-mode model
+func BuildStoreServiceAccount(model *modelsv1alpha1.Model) *corev1.ServiceAccount {
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StoreName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    inlineLabels(model),
+		},
+	}
+}
+
+// BuildStoreRole creates a Role that may only update the model's own ConfigMap
+func BuildStoreRole(model *modelsv1alpha1.Model) *rbacv1.Role {
+	return &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StoreName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    inlineLabels(model),
+		},
+		Rules: []rbacv1.PolicyRule{{
+			APIGroups:     []string{""},
+			Resources:     []string{"configmaps"},
+			ResourceNames: []string{ContentConfigMapName(model.Name)},
+			Verbs:         []string{"get", "patch"},
+		}},
+	}
+}
+
+// BuildStoreRoleBinding binds the store Role to the store ServiceAccount
+func BuildStoreRoleBinding(model *modelsv1alpha1.Model) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      StoreName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    inlineLabels(model),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     StoreName(model.Name),
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      StoreName(model.Name),
+			Namespace: model.Namespace,
+		}},
+	}
+}
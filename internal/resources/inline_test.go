@@ -0,0 +1,141 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func inlineModel(mode modelsv1alpha1.StorageMode) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "tokenizer", Namespace: "ml"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "bert-base-uncased", Include: []string{"tokenizer*.json", "vocab.txt"}},
+			},
+			Storage: modelsv1alpha1.StorageSpec{Mode: mode, Size: "1Mi"},
+			Publish: &modelsv1alpha1.PublishSpec{Image: "registry.internal/models/tokenizer:v1"},
+		},
+	}
+}
+
+func TestUsesPVC(t *testing.T) {
+	tests := []struct {
+		mode modelsv1alpha1.StorageMode
+		want bool
+	}{
+		{"", true},
+		{modelsv1alpha1.StorageModePVC, true},
+		{modelsv1alpha1.StorageModeConfigMap, false},
+		{modelsv1alpha1.StorageModeImage, false},
+	}
+	for _, tt := range tests {
+		if got := UsesPVC(inlineModel(tt.mode)); got != tt.want {
+			t.Errorf("UsesPVC(%q) = %v, want %v", tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestBuildDownloadJob_ConfigMapStorage(t *testing.T) {
+	job, err := BuildDownloadJob(inlineModel(modelsv1alpha1.StorageModeConfigMap), Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	podSpec := job.Spec.Template.Spec
+
+	for _, v := range podSpec.Volumes {
+		if v.PersistentVolumeClaim != nil {
+			t.Errorf("Volume %s mounts a PVC, want none", v.Name)
+		}
+		if v.Name == modelVolumeName && (v.EmptyDir == nil || v.EmptyDir.SizeLimit == nil || v.EmptyDir.SizeLimit.String() != "1Mi") {
+			t.Errorf("Model volume = %+v, want a 1Mi scratch volume", v.VolumeSource)
+		}
+	}
+
+	if podSpec.ServiceAccountName != "model-store-tokenizer" {
+		t.Errorf("ServiceAccountName = %v, want model-store-tokenizer", podSpec.ServiceAccountName)
+	}
+	if len(podSpec.Containers) != 2 || podSpec.Containers[0].Name != "downloader" ||
+		podSpec.Containers[1].Name != StoreContainerName {
+		t.Fatalf("Containers = %v, want the downloader then the store container", podSpec.Containers)
+	}
+	store := podSpec.Containers[1]
+	script := store.Command[len(store.Command)-1]
+	if !strings.HasPrefix(script, "until [ -f /models/.model-operator/complete.json ]") {
+		t.Errorf("Store script should wait for the completion marker, got %q", script)
+	}
+	if !strings.Contains(script, "configmaps/$CONTENT_CONFIGMAP") {
+		t.Errorf("Store script should patch the content ConfigMap")
+	}
+	env := map[string]string{}
+	for _, e := range store.Env {
+		env[e.Name] = e.Value
+	}
+	if env["CONTENT_CONFIGMAP"] != "model-content-tokenizer" || env["MODEL_NAMESPACE"] != "ml" {
+		t.Errorf("Store env = %v", env)
+	}
+}
+
+func TestBuildDownloadJob_ImageStorage(t *testing.T) {
+	job, err := BuildDownloadJob(inlineModel(modelsv1alpha1.StorageModeImage), Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	podSpec := job.Spec.Template.Spec
+
+	if podSpec.ServiceAccountName != "" {
+		t.Errorf("ServiceAccountName = %v, want none", podSpec.ServiceAccountName)
+	}
+	if len(podSpec.Containers) != 2 || podSpec.Containers[1].Name != PublisherContainerName {
+		t.Fatalf("Containers = %v, want the downloader then the publisher", podSpec.Containers)
+	}
+	script := podSpec.Containers[1].Command[len(podSpec.Containers[1].Command)-1]
+	if !strings.HasPrefix(script, "until [ -f /models/.model-operator/complete.json ]") || !strings.Contains(script, "crane append") {
+		t.Errorf("Publisher should push once the download completes, got %q", script)
+	}
+
+	volumes := map[string]bool{}
+	for _, v := range podSpec.Volumes {
+		volumes[v.Name] = true
+		if v.PersistentVolumeClaim != nil {
+			t.Errorf("Volume %s mounts a PVC, want none", v.Name)
+		}
+	}
+	if !volumes[modelVolumeName] || !volumes[layersVolumeName] {
+		t.Errorf("Volumes = %v, want the scratch and layer volumes", volumes)
+	}
+}
+
+func TestBuildStoreRole(t *testing.T) {
+	model := inlineModel(modelsv1alpha1.StorageModeConfigMap)
+
+	role := BuildStoreRole(model)
+	if len(role.Rules) != 1 || len(role.Rules[0].ResourceNames) != 1 || role.Rules[0].ResourceNames[0] != "model-content-tokenizer" {
+		t.Errorf("Role rules = %+v, want access to the content ConfigMap only", role.Rules)
+	}
+
+	binding := BuildStoreRoleBinding(model)
+	if binding.RoleRef.Name != role.Name || len(binding.Subjects) != 1 ||
+		binding.Subjects[0].Name != BuildStoreServiceAccount(model).Name {
+		t.Errorf("RoleBinding = %+v, want the store Role bound to the store ServiceAccount", binding)
+	}
+}
@@ -18,6 +18,8 @@ package resources
 
 import (
 	"fmt"
+	"path"
+	"slices"
 	"strings"
 
 	batchv1 "k8s.io/api/batch/v1"
@@ -27,17 +29,19 @@ import (
 	"k8s.io/utils/ptr"
 
 	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
 )
 
 const (
-	// Job configuration
-	backoffLimit            = int32(3)
-	ttlSecondsAfterFinished = int32(3600)
+	// Job defaults, overridable with JobConfig
+	defaultBackoffLimit            = int32(3)
+	defaultTTLSecondsAfterFinished = int32(3600)
 
-	// Container images
+	// Default container images
 	huggingFaceImage = "python:3.11-slim"
 	s3Image          = "amazon/aws-cli:latest"
 	urlImage         = "curlimages/curl:latest"
+	s5cmdImage       = "peakcom/s5cmd:v2.3.0"
 	gitImage         = "alpine/git:latest"
 
 	// Volume and mount names
@@ -45,22 +49,52 @@ const (
 	modelMountPath  = "/models"
 )
 
+// imageArchitectures lists the CPU architectures each downloader image is
+// published for. All built-in images are multi-arch manifests, so the same
+// reference resolves to the right variant on each node.
+var imageArchitectures = map[string][]string{
+	huggingFaceImage: {"amd64", "arm64"},
+	s3Image:          {"amd64", "arm64"},
+	urlImage:         {"amd64", "arm64"},
+	s5cmdImage:       {"amd64", "arm64"},
+	gitImage:         {"amd64", "arm64"},
+}
+
 // BuildDownloadJob creates a Job to download the model based on the source type
-func BuildDownloadJob(model *modelsv1alpha1.Model) (*batchv1.Job, error) {
+func BuildDownloadJob(model *modelsv1alpha1.Model, cfg Config) (*batchv1.Job, error) {
 	source := model.Spec.Source
+	if n := sourceCount(source); n > 1 {
+		return nil, fmt.Errorf("%w in model %s: %d of huggingFace, s3, url, git and dvc are set",
+			ErrMultipleSources, model.Name, n)
+	}
 
 	var container corev1.Container
 	switch {
+	case VerifyOnly(model) && sourceCount(source) == 1:
+		container = buildSealContainer(model, cfg.Images)
 	case source.HuggingFace != nil:
-		container = buildHuggingFaceContainer(model)
+		container = buildHuggingFaceContainer(model, cfg.HuggingFace)
 	case source.S3 != nil:
-		container = buildS3Container(model)
+		container = buildS3Container(model, cfg.Images)
 	case source.URL != nil:
-		container = buildURLContainer(model)
+		container = buildURLContainer(model, cfg.Images)
 	case source.Git != nil:
-		container = buildGitContainer(model)
+		container = buildGitContainer(model, cfg.Images)
+	case source.DVC != nil:
+		container = buildDVCContainer(model, cfg.Images)
 	default:
-		return nil, fmt.Errorf("no source specified in model %s", model.Name)
+		return nil, fmt.Errorf("%w in model %s", ErrNoSource, model.Name)
+	}
+	if cfg.DownloadResources != nil {
+		container.Resources = *cfg.DownloadResources.DeepCopy()
+	}
+	if download := model.Spec.Download; download != nil {
+		if download.Image != "" {
+			container.Image = download.Image
+		}
+		if download.Resources != nil {
+			container.Resources = *download.Resources.DeepCopy()
+		}
 	}
 
 	job := &batchv1.Job{
@@ -74,8 +108,8 @@ func BuildDownloadJob(model *modelsv1alpha1.Model) (*batchv1.Job, error) {
 			},
 		},
 		Spec: batchv1.JobSpec{
-			BackoffLimit:            ptr.To(backoffLimit),
-			TTLSecondsAfterFinished: ptr.To(ttlSecondsAfterFinished),
+			BackoffLimit:            ptr.To(cfg.Jobs.backoffLimit()),
+			TTLSecondsAfterFinished: ptr.To(cfg.Jobs.ttlSecondsAfterFinished()),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: map[string]string{
@@ -92,7 +126,7 @@ func BuildDownloadJob(model *modelsv1alpha1.Model) (*batchv1.Job, error) {
 							Name: modelVolumeName,
 							VolumeSource: corev1.VolumeSource{
 								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-									ClaimName: PVCName(model.Name),
+									ClaimName: ClaimName(model),
 								},
 							},
 						},
@@ -102,65 +136,237 @@ func BuildDownloadJob(model *modelsv1alpha1.Model) (*batchv1.Job, error) {
 		},
 	}
 
+	// Retry, keep and time out the Job as the Model asks
+	if download := model.Spec.Download; download != nil {
+		if download.BackoffLimit != nil {
+			job.Spec.BackoffLimit = ptr.To(*download.BackoffLimit)
+		}
+		if download.TTLSecondsAfterFinished != nil {
+			job.Spec.TTLSecondsAfterFinished = ptr.To(*download.TTLSecondsAfterFinished)
+		}
+		if download.Timeout != nil {
+			job.Spec.ActiveDeadlineSeconds = ptr.To(int64(download.Timeout.Seconds()))
+		}
+	}
+
+	// Run the download pod with the Model's pod security context; the
+	// ownership GID still wins as its fsGroup
+	if download := model.Spec.Download; download != nil && download.PodSecurityContext != nil {
+		job.Spec.Template.Spec.SecurityContext = download.PodSecurityContext.DeepCopy()
+	}
+
+	// A verify-only model fetches nothing, so it needs none of the source's
+	// settings nor a copy of the previous revision
+	if !VerifyOnly(model) {
+		if source.HuggingFace != nil {
+			cfg.HuggingFace.configurePod(&job.Spec.Template.Spec)
+		}
+		configureDVCPod(model, &job.Spec.Template.Spec)
+		configurePatterns(model, &job.Spec.Template.Spec)
+	}
+
+	configureOwnership(model, &job.Spec.Template.Spec)
+
+	// Keep the previous revision before downloading a new one
+	if UsesPVC(model) && !VerifyOnly(model) {
+		configureRevisionHistory(model, &job.Spec.Template.Spec, cfg.Images)
+	}
+
+	// Compress the files once downloaded, before the marker is written
+	if Compressed(model) {
+		configureCompression(model, &job.Spec.Template.Spec, cfg.Images)
+	}
+
+	// Download tiny models into a scratch volume and store them elsewhere
+	if !UsesPVC(model) {
+		configureInlineStorage(model, &job.Spec.Template.Spec, cfg.Images)
+	}
+
+	// Pull the downloader images with the operator's and the Model's secrets
+	configureImagePullSecrets(model, &job.Spec.Template.Spec, cfg.ImagePullSecrets)
+
 	// Apply node selector if specified
 	if len(model.Spec.NodeSelector) > 0 {
 		job.Spec.Template.Spec.NodeSelector = model.Spec.NodeSelector
 	}
 
+	// Apply tolerations, affinity, spread constraints, priority, DNS settings
+	// and the ServiceAccount if specified
+	if download := model.Spec.Download; download != nil {
+		if len(download.Tolerations) > 0 {
+			job.Spec.Template.Spec.Tolerations = download.Tolerations
+		}
+		if download.PriorityClassName != "" {
+			job.Spec.Template.Spec.PriorityClassName = download.PriorityClassName
+		}
+		if download.Affinity != nil {
+			job.Spec.Template.Spec.Affinity = download.Affinity.DeepCopy()
+		}
+		if len(download.TopologySpreadConstraints) > 0 {
+			job.Spec.Template.Spec.TopologySpreadConstraints = download.TopologySpreadConstraints
+		}
+		if len(download.HostAliases) > 0 {
+			job.Spec.Template.Spec.HostAliases = download.HostAliases
+		}
+		if download.DNSPolicy != "" {
+			job.Spec.Template.Spec.DNSPolicy = download.DNSPolicy
+		}
+		if download.DNSConfig != nil {
+			job.Spec.Template.Spec.DNSConfig = download.DNSConfig.DeepCopy()
+		}
+		if download.ServiceAccountName != "" {
+			job.Spec.Template.Spec.ServiceAccountName = download.ServiceAccountName
+		}
+	}
+
+	// Add the Model's own env and volumes to the downloader; mounts at /tmp
+	// replace the scratch volume of the security context
+	if err := configureExtras(model, &job.Spec.Template.Spec); err != nil {
+		return nil, err
+	}
+
+	// Run every container with the Model's security context, as non-root
+	// and with a read-only root filesystem if asked
+	configureSecurityContext(model, &job.Spec.Template.Spec)
+
+	// Keep the end of a failed container's logs as its termination message,
+	// for the Model status to show
+	for _, containers := range [][]corev1.Container{job.Spec.Template.Spec.InitContainers, job.Spec.Template.Spec.Containers} {
+		for i := range containers {
+			if containers[i].TerminationMessagePolicy == "" {
+				containers[i].TerminationMessagePolicy = corev1.TerminationMessageFallbackToLogsOnError
+			}
+		}
+	}
+
+	// Keep the pod off nodes the downloader image cannot run on
+	if arches := downloadArchitectures(model, container.Image); len(arches) > 0 {
+		requireNodeLabel(&job.Spec.Template.Spec, corev1.LabelArchStable, arches)
+	}
+
 	return job, nil
 }
 
-func buildHuggingFaceContainer(model *modelsv1alpha1.Model) corev1.Container {
+// configureImagePullSecrets adds the operator's image pull secrets and then
+// the Model's to the download pod, skipping duplicates
+func configureImagePullSecrets(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec, operator []corev1.LocalObjectReference) {
+	secrets := operator
+	if model.Spec.Download != nil {
+		secrets = append(slices.Clone(secrets), model.Spec.Download.ImagePullSecrets...)
+	}
+	for _, secret := range secrets {
+		if secret.Name != "" && !slices.Contains(podSpec.ImagePullSecrets, secret) {
+			podSpec.ImagePullSecrets = append(podSpec.ImagePullSecrets, secret)
+		}
+	}
+}
+
+// downloadArchitectures returns the architectures the download pod may run on:
+// the requested architecture if set, otherwise those the image supports
+func downloadArchitectures(model *modelsv1alpha1.Model, image string) []string {
+	if model.Spec.Download != nil && model.Spec.Download.Architecture != "" {
+		return []string{model.Spec.Download.Architecture}
+	}
+	return imageArchitectures[image]
+}
+
+// requireNodeLabel adds a required node affinity on a node label (e.g.
+// kubernetes.io/arch), preserving any affinity already set. Node selector
+// terms are ORed, so the requirement is added to every existing term.
+func requireNodeLabel(podSpec *corev1.PodSpec, key string, values []string) {
+	requirement := corev1.NodeSelectorRequirement{
+		Key:      key,
+		Operator: corev1.NodeSelectorOpIn,
+		Values:   values,
+	}
+
+	if podSpec.Affinity == nil {
+		podSpec.Affinity = &corev1.Affinity{}
+	}
+	if podSpec.Affinity.NodeAffinity == nil {
+		podSpec.Affinity.NodeAffinity = &corev1.NodeAffinity{}
+	}
+	nodeAffinity := podSpec.Affinity.NodeAffinity
+	if nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution = &corev1.NodeSelector{}
+	}
+	required := nodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	if len(required.NodeSelectorTerms) == 0 {
+		required.NodeSelectorTerms = []corev1.NodeSelectorTerm{{}}
+	}
+	for i := range required.NodeSelectorTerms {
+		required.NodeSelectorTerms[i].MatchExpressions = append(required.NodeSelectorTerms[i].MatchExpressions, requirement)
+	}
+}
+
+func buildHuggingFaceContainer(model *modelsv1alpha1.Model, opts HuggingFaceOptions) corev1.Container {
 	hf := model.Spec.Source.HuggingFace
 	revision := hf.Revision
 	if revision == "" {
 		revision = "main"
 	}
 
-	// Build snapshot_download kwargs
-	kwargs := []string{
-		fmt.Sprintf("'%s'", hf.RepoID),
-		fmt.Sprintf("revision='%s'", revision),
-		"local_dir='/models'",
+	// Add include and exclude patterns, read from the mounted files when
+	// they are too many or not plain globs
+	mounted := PatternsMounted(model)
+	patternList := func(list string, patterns []string) string {
+		if mounted {
+			return fmt.Sprintf("open('%s').read().splitlines()", patternsFile(list))
+		}
+		quoted := make([]string, len(patterns))
+		for i, p := range patterns {
+			quoted[i] = fmt.Sprintf("'%s'", p)
+		}
+		return "[" + strings.Join(quoted, ", ") + "]"
 	}
-
-	// Add include patterns
+	var patternKwargs []string
 	if len(hf.Include) > 0 {
-		patterns := make([]string, len(hf.Include))
-		for i, p := range hf.Include {
-			patterns[i] = fmt.Sprintf("'%s'", p)
-		}
-		kwargs = append(kwargs, fmt.Sprintf("allow_patterns=[%s]", strings.Join(patterns, ", ")))
+		patternKwargs = append(patternKwargs, "allow_patterns="+patternList("include", hf.Include))
 	}
-
-	// Add exclude patterns
 	if len(hf.Exclude) > 0 {
-		patterns := make([]string, len(hf.Exclude))
-		for i, p := range hf.Exclude {
-			patterns[i] = fmt.Sprintf("'%s'", p)
-		}
-		kwargs = append(kwargs, fmt.Sprintf("ignore_patterns=[%s]", strings.Join(patterns, ", ")))
+		patternKwargs = append(patternKwargs, "ignore_patterns="+patternList("exclude", hf.Exclude))
 	}
 
+	// Build snapshot_download kwargs. Downloading into local_dir keeps the
+	// ETag of every file under /models/.cache/huggingface, so a re-sync to a
+	// new revision only fetches the files that changed.
+	kwargs := []string{
+		fmt.Sprintf("'%s'", hf.RepoID),
+		fmt.Sprintf("revision='%s'", revision),
+		"local_dir='/models'",
+	}
+	kwargs = append(kwargs, patternKwargs...)
+
 	// Build the Python download command
 	downloadCmd := fmt.Sprintf("from huggingface_hub import snapshot_download; snapshot_download(%s)",
 		strings.Join(kwargs, ", "))
+	pruneCmd := huggingFacePruneCommand(hf.RepoID, revision, patternKwargs)
 
 	// Build the Modelfile content
 	modelfileContent := buildModelfileContent(model)
 
-	script := fmt.Sprintf(`pip install -q huggingface_hub hf_transfer && \
+	install := ""
+	if cmd := opts.installCommand(); cmd != "" {
+		install = cmd + " && \\\n"
+	}
+
+	// The Xet chunk cache is on a scratch volume now, so drop any copy an
+	// earlier download left on the model volume
+	script := fmt.Sprintf(`%srm -rf %s && \
 export HF_HUB_ENABLE_HF_TRANSFER=1 && \
 python -c "%s" && \
-cat > /models/Modelfile << 'MODELFILE_EOF'
+python -c "%s" && \
+{ cat > /models/Modelfile << 'MODELFILE_EOF'
 %s
 MODELFILE_EOF
+} && \
+%s && \
 echo "Download complete" && \
-ls -la /models`, downloadCmd, modelfileContent)
+ls -la /models`, install, path.Join(modelMountPath, legacyXetCacheDir), downloadCmd, pruneCmd, modelfileContent, completionMarkerScript(model))
 
 	container := corev1.Container{
 		Name:    "downloader",
-		Image:   huggingFaceImage,
+		Image:   opts.image(),
 		Command: []string{"sh", "-c"},
 		Args:    []string{script},
 		VolumeMounts: []corev1.VolumeMount{
@@ -197,9 +403,80 @@ ls -la /models`, downloadCmd, modelfileContent)
 		})
 	}
 
+	// Download from a Hub mirror if specified
+	if hf.Endpoint != "" {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "HF_ENDPOINT", Value: hf.Endpoint})
+	}
+
+	opts.configureContainer(&container)
+
 	return container
 }
 
+// huggingFacePruneCommand returns Python that removes the files an earlier
+// download of another revision left in /models and the revision no longer
+// has. Only files the hub downloaded, which have metadata under
+// .cache/huggingface/download, are touched.
+func huggingFacePruneCommand(repoID, revision string, patternKwargs []string) string {
+	filter := "files"
+	if len(patternKwargs) > 0 {
+		filter += ", " + strings.Join(patternKwargs, ", ")
+	}
+	return fmt.Sprintf(`import os
+from huggingface_hub import HfApi
+from huggingface_hub.utils import filter_repo_objects
+files = [s.rfilename for s in HfApi().model_info('%[1]s', revision='%[2]s').siblings]
+wanted = set(filter_repo_objects(%[3]s))
+meta = '/models/.cache/huggingface/download'
+for root, _, names in os.walk(meta):
+    for name in names:
+        if not name.endswith('.metadata'):
+            continue
+        path = os.path.relpath(os.path.join(root, name[:-len('.metadata')]), meta)
+        if path in wanted:
+            continue
+        print('Removing', path, 'dropped from revision %[2]s')
+        for stale in (os.path.join('/models', path), os.path.join(root, name)):
+            if os.path.exists(stale):
+                os.remove(stale)`, repoID, revision, filter)
+}
+
+// completionMarkerScript returns the shell fragment that writes the completion
+// marker, after applying spec.storage.ownership to the downloaded files. For a
+// compressed model it signals the compress container instead.
+func completionMarkerScript(model *modelsv1alpha1.Model) string {
+	// The compress container applies the ownership and writes the marker
+	if Compressed(model) {
+		return downloadedScript()
+	}
+	script := marker.Script(modelMountPath, SourceRevision(model), model.Spec.Version)
+	if ownership := ownershipScript(model); ownership != "" {
+		return ownership + " && \\\n" + script
+	}
+	return script
+}
+
+// SourceRevision returns the revision of the source being downloaded, if the source has one
+func SourceRevision(model *modelsv1alpha1.Model) string {
+	source := model.Spec.Source
+	switch {
+	case source.HuggingFace != nil:
+		if source.HuggingFace.Revision != "" {
+			return source.HuggingFace.Revision
+		}
+		return "main"
+	case source.Git != nil:
+		if source.Git.Ref != "" {
+			return source.Git.Ref
+		}
+		return "main"
+	case source.DVC != nil:
+		return source.DVC.Rev
+	default:
+		return ""
+	}
+}
+
 // buildModelfileContent generates Ollama-style Modelfile content
 func buildModelfileContent(model *modelsv1alpha1.Model) string {
 	var lines []string
@@ -226,6 +503,12 @@ func buildModelfileContent(model *modelsv1alpha1.Model) string {
 		if model.Spec.Source.Git.Ref != "" {
 			lines = append(lines, fmt.Sprintf("# GIT_REF %s", model.Spec.Source.Git.Ref))
 		}
+	} else if model.Spec.Source.DVC != nil {
+		lines = append(lines, fmt.Sprintf("# DVC_URL %s", model.Spec.Source.DVC.URL))
+		lines = append(lines, fmt.Sprintf("# DVC_PATH %s", model.Spec.Source.DVC.Path))
+		if model.Spec.Source.DVC.Rev != "" {
+			lines = append(lines, fmt.Sprintf("# DVC_REV %s", model.Spec.Source.DVC.Rev))
+		}
 	} else if model.Spec.Source.URL != nil {
 		lines = append(lines, fmt.Sprintf("# SOURCE_URL %s", model.Spec.Source.URL.URL))
 	} else if model.Spec.Source.S3 != nil {
@@ -283,25 +566,25 @@ func buildModelfileContent(model *modelsv1alpha1.Model) string {
 	return strings.Join(lines, "\n")
 }
 
-func buildS3Container(model *modelsv1alpha1.Model) corev1.Container {
+func buildS3Container(model *modelsv1alpha1.Model, images Images) corev1.Container {
 	s3 := model.Spec.Source.S3
-
-	// Build the aws s3 cp command with optional endpoint and region
-	var endpointArg, regionArg string
-	if s3.Endpoint != "" {
-		endpointArg = fmt.Sprintf("--endpoint-url %s", s3.Endpoint)
+	if s3.Presign != nil {
+		return buildPresignedS3Container(model, images)
 	}
-	if s3.Region != "" {
-		regionArg = fmt.Sprintf("--region %s", s3.Region)
+
+	download, image := awsS3Command(s3), images.s3()
+	if s3.TransferTool == modelsv1alpha1.S3TransferToolS5cmd {
+		download, image = s5cmdCommand(s3), images.s5cmd()
 	}
 
-	script := fmt.Sprintf(`aws s3 cp %s %s s3://%s/%s /models/ --recursive && \
+	script := fmt.Sprintf(`%s && \
+%s && \
 echo "Download complete" && \
-ls -la /models`, endpointArg, regionArg, s3.Bucket, s3.Key)
+ls -la /models`, download, completionMarkerScript(model))
 
 	container := corev1.Container{
 		Name:    "downloader",
-		Image:   s3Image,
+		Image:   image,
 		Command: []string{"sh", "-c"},
 		Args:    []string{script},
 		VolumeMounts: []corev1.VolumeMount{
@@ -355,16 +638,61 @@ ls -la /models`, endpointArg, regionArg, s3.Bucket, s3.Key)
 	return container
 }
 
-func buildURLContainer(model *modelsv1alpha1.Model) corev1.Container {
+// awsS3Command returns the aws s3 cp command downloading the S3 source
+func awsS3Command(s3 *modelsv1alpha1.S3Source) string {
+	var endpointArg, regionArg string
+	if s3.Endpoint != "" {
+		endpointArg = fmt.Sprintf("--endpoint-url %s", s3.Endpoint)
+	}
+	if s3.Region != "" {
+		regionArg = fmt.Sprintf("--region %s", s3.Region)
+	}
+
+	command := fmt.Sprintf(`aws s3 cp %s %s s3://%s/%s /models/ --recursive`, endpointArg, regionArg, s3.Bucket, s3.Key)
+	if s3.Concurrency != nil {
+		command = fmt.Sprintf(`aws configure set default.s3.max_concurrent_requests %d && \
+%s`, *s3.Concurrency, command)
+	}
+	return command
+}
+
+// s5cmdCommand returns the s5cmd command downloading the S3 source. The key
+// is matched as a prefix, so a key ending in "/" puts the objects under it at
+// the root of the model volume.
+func s5cmdCommand(s3 *modelsv1alpha1.S3Source) string {
+	args := []string{`"$S5CMD"`}
+	if s3.Endpoint != "" {
+		args = append(args, "--endpoint-url", s3.Endpoint)
+	}
+	if s3.Concurrency != nil {
+		args = append(args, "--numworkers", fmt.Sprint(*s3.Concurrency))
+	}
+	args = append(args, "cp", fmt.Sprintf(`'s3://%s/%s*'`, s3.Bucket, s3.Key), "/models/")
+
+	region := ""
+	if s3.Region != "" {
+		region = fmt.Sprintf("AWS_REGION=%s ", s3.Region)
+	}
+	return fmt.Sprintf(`S5CMD=$(command -v s5cmd || echo /s5cmd) && \
+%s%s`, region, strings.Join(args, " "))
+}
+
+func buildURLContainer(model *modelsv1alpha1.Model, images Images) corev1.Container {
 	url := model.Spec.Source.URL
 
-	script := fmt.Sprintf(`curl -L -o /models/model "%s" && \
+	download := fmt.Sprintf(`curl -L -o /models/model "%s"`, url.URL)
+	if parallelism := downloadParallelism(model); parallelism > 1 {
+		download = rangedDownloadScript(url.URL, parallelism)
+	}
+
+	script := fmt.Sprintf(`%s && \
+%s && \
 echo "Download complete" && \
-ls -la /models`, url.URL)
+ls -la /models`, download, completionMarkerScript(model))
 
 	return corev1.Container{
 		Name:    "downloader",
-		Image:   urlImage,
+		Image:   images.curl(),
 		Command: []string{"sh", "-c"},
 		Args:    []string{script},
 		VolumeMounts: []corev1.VolumeMount{
@@ -386,7 +714,62 @@ ls -la /models`, url.URL)
 	}
 }
 
-func buildGitContainer(model *modelsv1alpha1.Model) corev1.Container {
+// downloadParallelism returns the number of concurrent range requests a
+// single-file download may use
+func downloadParallelism(model *modelsv1alpha1.Model) int32 {
+	if download := model.Spec.Download; download != nil && download.Parallelism != nil {
+		return *download.Parallelism
+	}
+	return 1
+}
+
+// rangedAttempts is how many times each range of a parallel download is
+// fetched before the download fails
+const rangedAttempts = 3
+
+// rangedDownloadScript returns a shell fragment that downloads url to
+// /models/model with parallel range requests, each written in place at its
+// offset so the download needs no extra space for the parts. A failed range
+// is fetched again over the same bytes rather than restarting the whole
+// file. Servers that do not advertise byte ranges or a length get a single
+// stream instead.
+func rangedDownloadScript(url string, parallelism int32) string {
+	return fmt.Sprintf(`URL="%[1]s" && \
+SIZE=$(curl -fsSIL "$URL" | tr -d '\r' | awk 'tolower($1) ~ /^http\// {size = ""; ranges = 0} tolower($1) == "content-length:" {size = $2} tolower($1) == "accept-ranges:" && tolower($2) == "bytes" {ranges = 1} END {if (ranges && size > 0) print size}') ; \
+if [ -z "$SIZE" ]; then
+  echo "Server does not support range requests, downloading in a single stream" && \
+  curl -L -o /models/model "$URL"
+else
+  PART=$(( (SIZE + %[2]d - 1) / %[2]d )) && \
+  PART=$(( (PART + 1048575) / 1048576 * 1048576 )) && \
+  rm -f /tmp/ranged-failed* && : > /models/model && \
+  PIDS="" && START=0 && \
+  while [ "$START" -lt "$SIZE" ]; do
+    END=$((START + PART - 1))
+    [ "$END" -ge "$SIZE" ] && END=$((SIZE - 1))
+    (
+      ATTEMPT=1
+      while :; do
+        rm -f "/tmp/ranged-failed-$START"
+        { curl -fsSL -r "$START-$END" "$URL" || touch "/tmp/ranged-failed-$START"; } | dd of=/models/model bs=1048576 seek=$((START / 1048576)) conv=notrunc 2>/dev/null
+        [ -e "/tmp/ranged-failed-$START" ] || break
+        if [ "$ATTEMPT" -ge %[3]d ]; then
+          echo "Bytes $START-$END failed after $ATTEMPT attempts" && touch /tmp/ranged-failed && break
+        fi
+        echo "Retrying bytes $START-$END" && sleep $((ATTEMPT * 5))
+        ATTEMPT=$((ATTEMPT + 1))
+      done
+    ) &
+    PIDS="$PIDS $!"
+    START=$((END + 1))
+  done
+  for PID in $PIDS; do wait "$PID"; done
+  [ ! -e /tmp/ranged-failed ] && [ "$(stat -c %%s /models/model)" = "$SIZE" ] || { echo "Ranged download failed"; exit 1; }
+  echo "Downloaded $SIZE bytes with %[2]d range requests"
+fi`, url, parallelism, rangedAttempts)
+}
+
+func buildGitContainer(model *modelsv1alpha1.Model, images Images) corev1.Container {
 	git := model.Spec.Source.Git
 	ref := git.Ref
 	if ref == "" {
@@ -427,8 +810,12 @@ git lfs install && \
 	if len(git.Include) > 0 {
 		// Build sparse checkout patterns
 		var patterns string
-		for _, p := range git.Include {
-			patterns += fmt.Sprintf("echo '%s' >> .git/info/sparse-checkout && \\\n", p)
+		if PatternsMounted(model) {
+			patterns = fmt.Sprintf("cat %s >> .git/info/sparse-checkout && \\\n", patternsFile("include"))
+		} else {
+			for _, p := range git.Include {
+				patterns += fmt.Sprintf("echo '%s' >> .git/info/sparse-checkout && \\\n", p)
+			}
 		}
 
 		script = fmt.Sprintf(`%sgit clone --no-checkout %s --branch %s %s /tmp/repo && \
@@ -459,21 +846,29 @@ rm -rf /tmp/repo && \
 	// Add exclude patterns (delete files after clone)
 	if len(git.Exclude) > 0 {
 		script += "cd /models && \\\n"
-		for _, p := range git.Exclude {
-			script += fmt.Sprintf("rm -rf %s 2>/dev/null || true && \\\n", p)
+		if PatternsMounted(model) {
+			// Expand each pattern as a glob without splitting it on spaces
+			script += fmt.Sprintf("(IFS=; while read -r p; do rm -rf $p 2>/dev/null || true; done < %s) && \\\n",
+				patternsFile("exclude"))
+		} else {
+			for _, p := range git.Exclude {
+				script += fmt.Sprintf("rm -rf %s 2>/dev/null || true && \\\n", p)
+			}
 		}
 	}
 
 	// Write Modelfile and finish
-	script += fmt.Sprintf(`cat > /models/Modelfile << 'MODELFILE_EOF'
+	script += fmt.Sprintf(`{ cat > /models/Modelfile << 'MODELFILE_EOF'
 %s
 MODELFILE_EOF
+} && \
+%s && \
 echo "Clone complete" && \
-ls -la /models`, modelfileContent)
+ls -la /models`, modelfileContent, completionMarkerScript(model))
 
 	container := corev1.Container{
 		Name:    "downloader",
-		Image:   gitImage,
+		Image:   images.git(),
 		Command: []string{"sh", "-c"},
 		Args:    []string{script},
 		VolumeMounts: []corev1.VolumeMount{
@@ -496,33 +891,34 @@ ls -la /models`, modelfileContent)
 
 	// Add Git credentials from secret if specified (username/password or token)
 	if model.Spec.CredentialsSecret != "" {
-		container.Env = append(container.Env,
-			corev1.EnvVar{
-				Name: "GIT_USERNAME",
-				ValueFrom: &corev1.EnvVarSource{
-					SecretKeyRef: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: model.Spec.CredentialsSecret,
-						},
-						Key:      "GIT_USERNAME",
-						Optional: ptr.To(true),
-					},
+		container.Env = append(container.Env, gitCredentialsEnv(model.Spec.CredentialsSecret)...)
+	}
+
+	return container
+}
+
+// gitCredentialsEnv returns the env vars holding Git credentials from the secret
+func gitCredentialsEnv(secret string) []corev1.EnvVar {
+	return []corev1.EnvVar{
+		{
+			Name: "GIT_USERNAME",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+					Key:                  "GIT_USERNAME",
+					Optional:             ptr.To(true),
 				},
 			},
-			corev1.EnvVar{
-				Name: "GIT_PASSWORD",
-				ValueFrom: &corev1.EnvVarSource{
-					SecretKeyRef: &corev1.SecretKeySelector{
-						LocalObjectReference: corev1.LocalObjectReference{
-							Name: model.Spec.CredentialsSecret,
-						},
-						Key:      "GIT_PASSWORD",
-						Optional: ptr.To(true),
-					},
+		},
+		{
+			Name: "GIT_PASSWORD",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secret},
+					Key:                  "GIT_PASSWORD",
+					Optional:             ptr.To(true),
 				},
 			},
-		)
+		},
 	}
-
-	return container
 }
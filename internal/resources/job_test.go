@@ -17,12 +17,21 @@ limitations under the License.
 package resources
 
 import (
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
 )
 
 func TestBuildDownloadJob_HuggingFace(t *testing.T) {
@@ -45,7 +54,7 @@ func TestBuildDownloadJob_HuggingFace(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -70,6 +79,14 @@ func TestBuildDownloadJob_HuggingFace(t *testing.T) {
 		t.Errorf("Script should contain repo ID")
 	}
 
+	// Check that script writes the completion marker with the revision
+	if !strings.Contains(script, ".model-operator/complete.json") {
+		t.Errorf("Script should write the completion marker")
+	}
+	if !strings.Contains(script, `"revision":"main"`) {
+		t.Errorf("Completion marker should record the revision")
+	}
+
 	// Check volume mount
 	if len(container.VolumeMounts) == 0 {
 		t.Errorf("Expected volume mount")
@@ -101,7 +118,7 @@ func TestBuildDownloadJob_HuggingFace_WithFilters(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -122,6 +139,82 @@ func TestBuildDownloadJob_HuggingFace_WithFilters(t *testing.T) {
 	}
 }
 
+func TestBuildDownloadJob_HuggingFace_PrunesDroppedFiles(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama-sync",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+					RepoID:   "meta-llama/Llama-3.1-8B-Instruct",
+					Revision: "v2",
+					Exclude:  []string{"*.bin"},
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				StorageClass: "longhorn",
+				Size:         "20Gi",
+			},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	script := job.Spec.Template.Spec.Containers[0].Args[0]
+	download := strings.Index(script, "snapshot_download(")
+	prune := strings.Index(script, "model_info('meta-llama/Llama-3.1-8B-Instruct', revision='v2')")
+	if download < 0 || prune < download {
+		t.Errorf("Script should list the revision's files after the download")
+	}
+	if !strings.Contains(script, "filter_repo_objects(files, ignore_patterns=['*.bin'])") {
+		t.Errorf("Script should filter the revision's files like the download")
+	}
+	if !strings.Contains(script, "'/models/.cache/huggingface/download'") {
+		t.Errorf("Script should only prune files with hub download metadata")
+	}
+}
+
+func TestBuildDownloadJob_HuggingFace_Endpoint(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama-mirror",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+					RepoID:   "meta-llama/Llama-3.1-8B-Instruct",
+					Endpoint: "https://hf-mirror.internal",
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				StorageClass: "longhorn",
+				Size:         "20Gi",
+			},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	var endpoint string
+	for _, env := range job.Spec.Template.Spec.Containers[0].Env {
+		if env.Name == "HF_ENDPOINT" {
+			endpoint = env.Value
+		}
+	}
+	if endpoint != "https://hf-mirror.internal" {
+		t.Errorf("HF_ENDPOINT = %v, want https://hf-mirror.internal", endpoint)
+	}
+}
+
 func TestBuildDownloadJob_S3(t *testing.T) {
 	model := &modelsv1alpha1.Model{
 		ObjectMeta: metav1.ObjectMeta{
@@ -144,7 +237,7 @@ func TestBuildDownloadJob_S3(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -163,6 +256,60 @@ func TestBuildDownloadJob_S3(t *testing.T) {
 	}
 }
 
+func TestBuildDownloadJob_S3TransferTool(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				S3: &modelsv1alpha1.S3Source{
+					Bucket:       "my-bucket",
+					Key:          "models/llama/",
+					Region:       "eu-west-1",
+					Endpoint:     "https://minio.internal",
+					TransferTool: modelsv1alpha1.S3TransferToolS5cmd,
+					Concurrency:  ptr.To(int32(64)),
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "gp3", Size: "500Gi"},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != s5cmdImage {
+		t.Errorf("Container image = %v, want %v", container.Image, s5cmdImage)
+	}
+	script := container.Args[0]
+	for _, want := range []string{
+		`AWS_REGION=eu-west-1 "$S5CMD" --endpoint-url https://minio.internal --numworkers 64 cp 's3://my-bucket/models/llama/*' /models/`,
+		marker.FileName,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Script should contain %q:\n%s", want, script)
+		}
+	}
+	if strings.Contains(script, "aws s3 cp") {
+		t.Errorf("Script should not use the aws-cli:\n%s", script)
+	}
+
+	// The aws-cli takes the concurrency as its max_concurrent_requests
+	model.Spec.Source.S3.TransferTool = modelsv1alpha1.S3TransferToolAWSCLI
+	job, err = BuildDownloadJob(model, Config{Images: Images{S3: "registry.internal/aws-cli:2"}})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	container = job.Spec.Template.Spec.Containers[0]
+	if container.Image != "registry.internal/aws-cli:2" {
+		t.Errorf("Container image = %v, want the configured aws-cli image", container.Image)
+	}
+	if !strings.Contains(container.Args[0], "aws configure set default.s3.max_concurrent_requests 64") {
+		t.Errorf("Script should set the aws-cli concurrency:\n%s", container.Args[0])
+	}
+}
+
 func TestBuildDownloadJob_URL(t *testing.T) {
 	model := &modelsv1alpha1.Model{
 		ObjectMeta: metav1.ObjectMeta{
@@ -182,7 +329,7 @@ func TestBuildDownloadJob_URL(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -199,6 +346,44 @@ func TestBuildDownloadJob_URL(t *testing.T) {
 	if !strings.Contains(script, "curl") {
 		t.Errorf("Script should use curl")
 	}
+	if strings.Contains(script, "-r \"$START-$END\"") {
+		t.Errorf("Script should download in a single stream by default")
+	}
+}
+
+func TestBuildDownloadJob_URLParallelism(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "gguf", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source:   modelsv1alpha1.ModelSource{URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"}},
+			Storage:  modelsv1alpha1.StorageSpec{StorageClass: "local-path", Size: "50Gi"},
+			Download: &modelsv1alpha1.DownloadSpec{Parallelism: ptr.To(int32(8))},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	script := job.Spec.Template.Spec.Containers[0].Args[0]
+	for _, want := range []string{
+		`URL="https://example.com/model.gguf"`,
+		"accept-ranges:",
+		`curl -fsSL -r "$START-$END" "$URL"`,
+		"conv=notrunc",
+		`Retrying bytes $START-$END`,
+		`[ "$ATTEMPT" -ge 3 ]`,
+		"with 8 range requests",
+		`curl -L -o /models/model "$URL"`,
+	} {
+		if !strings.Contains(script, want) {
+			t.Errorf("Script should contain %q:\n%s", want, script)
+		}
+	}
+	if !strings.Contains(script, marker.FileName) {
+		t.Errorf("Script should still write the completion marker")
+	}
 }
 
 func TestBuildDownloadJob_Git(t *testing.T) {
@@ -226,7 +411,7 @@ func TestBuildDownloadJob_Git(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -266,9 +451,26 @@ func TestBuildDownloadJob_NoSource(t *testing.T) {
 		},
 	}
 
-	_, err := BuildDownloadJob(model)
-	if err == nil {
-		t.Errorf("Expected error for model with no source")
+	_, err := BuildDownloadJob(model, Config{})
+	if !errors.Is(err, ErrNoSource) {
+		t.Errorf("BuildDownloadJob() error = %v, want ErrNoSource", err)
+	}
+}
+
+func TestBuildDownloadJob_MultipleSources(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "two-sources", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"},
+				URL:         &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+			},
+		},
+	}
+
+	_, err := BuildDownloadJob(model, Config{})
+	if !errors.Is(err, ErrMultipleSources) {
+		t.Errorf("BuildDownloadJob() error = %v, want ErrMultipleSources", err)
 	}
 }
 
@@ -292,7 +494,7 @@ func TestBuildDownloadJob_WithCredentials(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -336,7 +538,7 @@ func TestBuildDownloadJob_WithNodeSelector(t *testing.T) {
 		},
 	}
 
-	job, err := BuildDownloadJob(model)
+	job, err := BuildDownloadJob(model, Config{})
 	if err != nil {
 		t.Fatalf("BuildDownloadJob() error = %v", err)
 	}
@@ -425,3 +627,492 @@ func TestBuildModelfileContent_CustomPaths(t *testing.T) {
 		t.Errorf("Content should contain custom FROM path")
 	}
 }
+
+func TestBuildDownloadJob_WithTolerationsAndAffinity(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gpu-local-model",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+					RepoID: "meta-llama/Llama-3.1-8B-Instruct",
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				StorageClass: "local-path",
+				Size:         "20Gi",
+			},
+			Download: &modelsv1alpha1.DownloadSpec{
+				PriorityClassName: "model-downloads-low",
+				Tolerations: []corev1.Toleration{
+					{
+						Key:      "nvidia.com/gpu",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					},
+				},
+				Affinity: &corev1.Affinity{
+					PodAntiAffinity: &corev1.PodAntiAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+							{
+								Weight: 100,
+								PodAffinityTerm: corev1.PodAffinityTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"app": "inference"},
+									},
+									TopologyKey: "kubernetes.io/hostname",
+								},
+							},
+						},
+					},
+				},
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"app.kubernetes.io/name": "model-downloader"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if len(podSpec.TopologySpreadConstraints) != 1 ||
+		podSpec.TopologySpreadConstraints[0].TopologyKey != "topology.kubernetes.io/zone" {
+		t.Errorf("Topology spread constraints not applied correctly: %v", podSpec.TopologySpreadConstraints)
+	}
+	if len(podSpec.Tolerations) != 1 || podSpec.Tolerations[0].Key != "nvidia.com/gpu" {
+		t.Errorf("Tolerations not applied correctly: %v", podSpec.Tolerations)
+	}
+	if podSpec.Affinity == nil || podSpec.Affinity.PodAntiAffinity == nil {
+		t.Fatalf("Expected pod anti-affinity to be applied")
+	}
+	if podSpec.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].Weight != 100 {
+		t.Errorf("Anti-affinity weight not preserved")
+	}
+	if podSpec.PriorityClassName != "model-downloads-low" {
+		t.Errorf("PriorityClassName = %q, want model-downloads-low", podSpec.PriorityClassName)
+	}
+}
+
+func TestBuildDownloadJob_WithDNS(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mirrored-model",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+					RepoID:   "meta-llama/Llama-3.1-8B-Instruct",
+					Endpoint: "https://hf-mirror.internal",
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				StorageClass: "standard",
+				Size:         "20Gi",
+			},
+			Download: &modelsv1alpha1.DownloadSpec{
+				HostAliases: []corev1.HostAlias{
+					{IP: "10.0.0.12", Hostnames: []string{"hf-mirror.internal"}},
+				},
+				DNSPolicy: corev1.DNSNone,
+				DNSConfig: &corev1.PodDNSConfig{
+					Nameservers: []string{"10.0.0.53"},
+					Searches:    []string{"mirrors.internal"},
+				},
+			},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if len(podSpec.HostAliases) != 1 || podSpec.HostAliases[0].Hostnames[0] != "hf-mirror.internal" {
+		t.Errorf("HostAliases not applied correctly: %v", podSpec.HostAliases)
+	}
+	if podSpec.DNSPolicy != corev1.DNSNone {
+		t.Errorf("DNSPolicy = %q, want %q", podSpec.DNSPolicy, corev1.DNSNone)
+	}
+	if podSpec.DNSConfig == nil || podSpec.DNSConfig.Nameservers[0] != "10.0.0.53" {
+		t.Errorf("DNSConfig not applied correctly: %v", podSpec.DNSConfig)
+	}
+}
+
+func TestBuildDownloadJob_DownloadImage(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "mirror-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/"},
+			},
+			Download: &modelsv1alpha1.DownloadSpec{Image: "registry.internal/aws-cli:2"},
+		},
+	}
+
+	// The Model's image takes precedence over the operator's
+	job, err := BuildDownloadJob(model, Config{Images: Images{S3: "mirror.internal/aws-cli:2"}})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if image := job.Spec.Template.Spec.Containers[0].Image; image != "registry.internal/aws-cli:2" {
+		t.Errorf("Container image = %v, want the Model's image", image)
+	}
+
+	model.Spec.Download.Image = ""
+	job, err = BuildDownloadJob(model, Config{Images: Images{S3: "mirror.internal/aws-cli:2"}})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if image := job.Spec.Template.Spec.Containers[0].Image; image != "mirror.internal/aws-cli:2" {
+		t.Errorf("Container image = %v, want the operator's image", image)
+	}
+}
+
+func TestBuildDownloadJob_DownloadResources(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "big-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+			},
+		},
+	}
+	operator := &corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+	}
+
+	job, err := BuildDownloadJob(model, Config{DownloadResources: operator})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if limit := job.Spec.Template.Spec.Containers[0].Resources.Limits.Memory(); limit.String() != "2Gi" {
+		t.Errorf("Memory limit = %v, want the operator's 2Gi", limit)
+	}
+
+	// The Model's resources replace the operator's as a whole
+	model.Spec.Download = &modelsv1alpha1.DownloadSpec{Resources: &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("4")},
+		Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("16Gi")},
+	}}
+	job, err = BuildDownloadJob(model, Config{DownloadResources: operator})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	res := job.Spec.Template.Spec.Containers[0].Resources
+	if limit := res.Limits.Memory(); limit.String() != "16Gi" {
+		t.Errorf("Memory limit = %v, want the Model's 16Gi", limit)
+	}
+	if request := res.Requests.Cpu(); request.String() != "4" {
+		t.Errorf("CPU request = %v, want the Model's 4", request)
+	}
+	if _, ok := res.Requests[corev1.ResourceMemory]; ok {
+		t.Errorf("Expected no memory request, got %v", res.Requests.Memory())
+	}
+}
+
+func TestBuildDownloadJob_Architecture(t *testing.T) {
+	archValues := func(podSpec corev1.PodSpec) [][]string {
+		var values [][]string
+		if podSpec.Affinity == nil || podSpec.Affinity.NodeAffinity == nil ||
+			podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+			return nil
+		}
+		for _, term := range podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			for _, expr := range term.MatchExpressions {
+				if expr.Key == corev1.LabelArchStable {
+					values = append(values, expr.Values)
+				}
+			}
+		}
+		return values
+	}
+
+	tests := []struct {
+		name      string
+		download  *modelsv1alpha1.DownloadSpec
+		wantTerms int
+		wantArch  []string
+	}{
+		{
+			name:      "defaults to image architectures",
+			wantTerms: 1,
+			wantArch:  []string{"amd64", "arm64"},
+		},
+		{
+			name:      "explicit architecture",
+			download:  &modelsv1alpha1.DownloadSpec{Architecture: "arm64"},
+			wantTerms: 1,
+			wantArch:  []string{"arm64"},
+		},
+		{
+			name: "added to every existing node selector term",
+			download: &modelsv1alpha1.DownloadSpec{
+				Architecture: "amd64",
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{
+								{MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"a"}},
+								}},
+								{MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: "zone", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}},
+								}},
+							},
+						},
+					},
+				},
+			},
+			wantTerms: 2,
+			wantArch:  []string{"amd64"},
+		},
+		{
+			name:      "custom image leaves architecture open",
+			download:  &modelsv1alpha1.DownloadSpec{Image: "registry.internal/curl:8"},
+			wantTerms: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &modelsv1alpha1.Model{
+				ObjectMeta: metav1.ObjectMeta{Name: "arch-model", Namespace: "default"},
+				Spec: modelsv1alpha1.ModelSpec{
+					Source: modelsv1alpha1.ModelSource{
+						URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+					},
+					Download: tt.download,
+				},
+			}
+
+			job, err := BuildDownloadJob(model, Config{})
+			if err != nil {
+				t.Fatalf("BuildDownloadJob() error = %v", err)
+			}
+
+			values := archValues(job.Spec.Template.Spec)
+			if len(values) != tt.wantTerms {
+				t.Fatalf("Expected arch requirement in %d terms, got %d", tt.wantTerms, len(values))
+			}
+			for _, v := range values {
+				if strings.Join(v, ",") != strings.Join(tt.wantArch, ",") {
+					t.Errorf("Arch values = %v, want %v", v, tt.wantArch)
+				}
+			}
+		})
+	}
+
+	// The Model's own affinity must not be mutated
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "arch-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source:   modelsv1alpha1.ModelSource{URL: &modelsv1alpha1.URLSource{URL: "https://example.com/m"}},
+			Download: &modelsv1alpha1.DownloadSpec{Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{}}},
+		},
+	}
+	if _, err := BuildDownloadJob(model, Config{}); err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if model.Spec.Download.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		t.Errorf("BuildDownloadJob should not mutate the Model spec")
+	}
+}
+
+func TestBuildDownloadJob_PresignedS3(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "s3-model",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				S3: &modelsv1alpha1.S3Source{
+					Bucket:  "my-bucket",
+					Key:     "models/llama/",
+					Presign: &modelsv1alpha1.S3Presign{ExpirySeconds: 3600},
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				StorageClass: "gp3",
+				Size:         "50Gi",
+			},
+			CredentialsSecret: "aws-credentials",
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	if container.Image != urlImage {
+		t.Errorf("Container image = %v, want %v", container.Image, urlImage)
+	}
+	if !strings.Contains(container.Args[0], `curl -fsSL --retry 3 -o "/models/$path" "$url"`) {
+		t.Errorf("Script should download each presigned URL")
+	}
+
+	// Only the presigned URLs reach the pod, never the credentials
+	if len(container.Env) != 1 {
+		t.Fatalf("Container env = %v, want only the presigned URLs", container.Env)
+	}
+	ref := container.Env[0].ValueFrom.SecretKeyRef
+	if ref.Name != PresignedSecretName("s3-model") || ref.Key != PresignedURLsKey {
+		t.Errorf("PRESIGNED_URLS from %s/%s, want %s/%s", ref.Name, ref.Key, PresignedSecretName("s3-model"), PresignedURLsKey)
+	}
+}
+
+func TestBuildDownloadJob_ServiceAccountName(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "irsa-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/", Region: "us-east-1"},
+			},
+			Download: &modelsv1alpha1.DownloadSpec{ServiceAccountName: "model-reader"},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+	if podSpec.ServiceAccountName != "model-reader" {
+		t.Errorf("ServiceAccountName = %q, want model-reader", podSpec.ServiceAccountName)
+	}
+	// Without a credentialsSecret the aws-cli falls back to the web identity token
+	for _, env := range podSpec.Containers[0].Env {
+		if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+			t.Errorf("Expected no credentials from a Secret, got %s", env.Name)
+		}
+	}
+}
+
+func TestBuildDownloadJob_RetriesAndTimeout(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "huge-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+			},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if job.Spec.ActiveDeadlineSeconds != nil {
+		t.Errorf("ActiveDeadlineSeconds = %v, want none by default", *job.Spec.ActiveDeadlineSeconds)
+	}
+
+	model.Spec.Download = &modelsv1alpha1.DownloadSpec{
+		BackoffLimit:            ptr.To(int32(10)),
+		TTLSecondsAfterFinished: ptr.To(int32(0)),
+		Timeout:                 &metav1.Duration{Duration: 12 * time.Hour},
+	}
+	job, err = BuildDownloadJob(model, Config{Jobs: JobConfig{BackoffLimit: ptr.To(int32(6))}})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if got := *job.Spec.BackoffLimit; got != 10 {
+		t.Errorf("BackoffLimit = %v, want the Model's 10", got)
+	}
+	if got := *job.Spec.TTLSecondsAfterFinished; got != 0 {
+		t.Errorf("TTLSecondsAfterFinished = %v, want the Model's 0", got)
+	}
+	if got := job.Spec.ActiveDeadlineSeconds; got == nil || *got != 43200 {
+		t.Errorf("ActiveDeadlineSeconds = %v, want 43200", got)
+	}
+}
+
+func TestBuildDownloadJob_TerminationMessage(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "failing-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"},
+			},
+			RevisionHistoryLimit: ptr.To(int32(1)),
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+	if len(podSpec.InitContainers) == 0 {
+		t.Fatalf("Expected an init container keeping the previous revision")
+	}
+	for _, c := range append(podSpec.InitContainers, podSpec.Containers...) {
+		if c.TerminationMessagePolicy != corev1.TerminationMessageFallbackToLogsOnError {
+			t.Errorf("Container %s TerminationMessagePolicy = %q, want the logs on error", c.Name, c.TerminationMessagePolicy)
+		}
+	}
+}
+
+func TestBuildDownloadJob_FailedDownloadLeavesNoMarker(t *testing.T) {
+	tests := []struct {
+		name   string
+		source modelsv1alpha1.ModelSource
+	}{
+		{name: "huggingface", source: modelsv1alpha1.ModelSource{
+			HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"},
+		}},
+		{name: "git", source: modelsv1alpha1.ModelSource{
+			Git: &modelsv1alpha1.GitSource{URL: "https://github.com/example/model.git"},
+		}},
+		{name: "dvc", source: modelsv1alpha1.ModelSource{
+			DVC: &modelsv1alpha1.DVCSource{URL: "https://github.com/example/research.git", Path: "model"},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &modelsv1alpha1.Model{
+				ObjectMeta: metav1.ObjectMeta{Name: "model", Namespace: "default"},
+				Spec:       modelsv1alpha1.ModelSpec{Source: tt.source},
+			}
+			job, err := BuildDownloadJob(model, Config{})
+			if err != nil {
+				t.Fatalf("BuildDownloadJob() error = %v", err)
+			}
+
+			// Every download tool fails, and the script writes under a
+			// scratch directory instead of the model volume
+			bin, root := t.TempDir(), t.TempDir()
+			for _, tool := range []string{"python", "pip", "git", "git-lfs", "dvc"} {
+				if err := os.WriteFile(filepath.Join(bin, tool), []byte("#!/bin/sh\nexit 1\n"), 0o755); err != nil {
+					t.Fatal(err)
+				}
+			}
+			script := strings.ReplaceAll(job.Spec.Template.Spec.Containers[0].Args[0], modelMountPath, root)
+			cmd := exec.Command("sh", "-c", script)
+			cmd.Env = append(os.Environ(), "PATH="+bin+string(os.PathListSeparator)+os.Getenv("PATH"))
+			if out, err := cmd.CombinedOutput(); err == nil {
+				t.Errorf("Script succeeded after the download failed:\n%s", out)
+			}
+			if _, err := os.Stat(filepath.Join(root, marker.Dir, marker.FileName)); !os.IsNotExist(err) {
+				t.Errorf("Completion marker written after the download failed: %v", err)
+			}
+		})
+	}
+}
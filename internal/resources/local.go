@@ -0,0 +1,290 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+const (
+	// DefaultLocalStorageBaseDir is the default directory on nodes that local
+	// storage is kept under
+	DefaultLocalStorageBaseDir = "/var/lib/model-operator"
+
+	// Labels identifying the Model a cluster-scoped local PV belongs to
+	LabelModelNamespace = "models.main-currents.news/model-namespace"
+	LabelModelName      = "models.main-currents.news/model-name"
+
+	// cleanupImage removes the model files from the node
+	cleanupImage = "busybox:1.36"
+
+	// ReverifyContainerName is the name of the re-verification Job's container
+	ReverifyContainerName = "reverify"
+	// reverifyMountPath is where the re-verification Job mounts the model files
+	reverifyMountPath = "/model"
+	// cleanupMountPath is where the cleanup Job mounts the namespace's directory
+	cleanupMountPath = "/data"
+)
+
+// cleanupScript removes the files in the Model's directory, named by $1, under
+// the namespace's directory. It refuses to follow a symlink out of it, and
+// find does not cross into other filesystems mounted below it.
+const cleanupScript = `dir="` + cleanupMountPath + `/$1"
+if [ -L "$dir" ]; then
+  echo "$dir is a symlink, not removing what it points to" >&2
+  exit 1
+fi
+[ -d "$dir" ] || exit 0
+find "$dir" -xdev -mindepth 1 -delete`
+
+func (c Config) localStorageBaseDir() string {
+	return orDefault(c.LocalStorageBaseDir, DefaultLocalStorageBaseDir)
+}
+
+// ValidateLocalStorageBaseDir checks the directory local storage is kept
+// under, which must be an absolute, clean path other than the root
+func ValidateLocalStorageBaseDir(dir string) error {
+	if !path.IsAbs(dir) || path.Clean(dir) != dir || dir == "/" {
+		return fmt.Errorf("local storage base directory %q must be a clean absolute path below /", dir)
+	}
+	return nil
+}
+
+// localSubdir returns the name of the directory the Model's path names in
+// the Model's directory, if any. It must be a single path component, so the
+// files stay in the Model's directory.
+func localSubdir(model *modelsv1alpha1.Model) (string, error) {
+	local := model.Spec.Storage.Local
+	if local == nil || local.Path == "" {
+		return "", nil
+	}
+	name := strings.ReplaceAll(local.Path, "{namespace}", model.Namespace)
+	name = strings.ReplaceAll(name, "{name}", model.Name)
+	if name == "." || name == ".." || strings.Contains(name, "/") {
+		return "", fmt.Errorf("local storage path %q must name a single directory", name)
+	}
+	return name, nil
+}
+
+// localNamespaceDir returns the directory on the node holding the local
+// storage of the Model's namespace
+func localNamespaceDir(model *modelsv1alpha1.Model, cfg Config) string {
+	return path.Join(cfg.localStorageBaseDir(), model.Namespace)
+}
+
+// LocalPath returns the directory on the node that holds the model files.
+// Each Model has a directory named after it in its namespace's directory
+// under the local storage base directory, so no two Models share files; the
+// Model's path names a directory in it.
+func LocalPath(model *modelsv1alpha1.Model, cfg Config) (string, error) {
+	subdir, err := localSubdir(model)
+	if err != nil {
+		return "", err
+	}
+	return path.Join(localNamespaceDir(model, cfg), model.Name, subdir), nil
+}
+
+// BuildLocalPV creates a hostPath PersistentVolume pinned to the local storage
+// node and pre-bound to the Model's PVC. Its node affinity makes the scheduler
+// place every pod using the PVC on that node.
+func BuildLocalPV(model *modelsv1alpha1.Model, cfg Config) (*corev1.PersistentVolume, error) {
+	storage := model.Spec.Storage
+	size, err := ParseStorageSize(storage.Size)
+	if err != nil {
+		return nil, err
+	}
+	hostPath, err := LocalPath(model, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	accessModes := storage.AccessModes
+	if len(accessModes) == 0 {
+		accessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+	}
+
+	return &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: LocalPVName(model.Namespace, model.Name),
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "model",
+				"app.kubernetes.io/instance":   model.Name,
+				"app.kubernetes.io/managed-by": "model-operator",
+				LabelModelNamespace:            model.Namespace,
+				LabelModelName:                 model.Name,
+			},
+		},
+		Spec: corev1.PersistentVolumeSpec{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceStorage: size,
+			},
+			AccessModes: accessModes,
+			// The operator removes the files itself when the Model is deleted
+			PersistentVolumeReclaimPolicy: corev1.PersistentVolumeReclaimRetain,
+			StorageClassName:              storage.StorageClass,
+			ClaimRef: &corev1.ObjectReference{
+				Kind:       "PersistentVolumeClaim",
+				APIVersion: "v1",
+				Namespace:  model.Namespace,
+				Name:       PVCName(model.Name),
+			},
+			PersistentVolumeSource: corev1.PersistentVolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: hostPath,
+					Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+				},
+			},
+			NodeAffinity: &corev1.VolumeNodeAffinity{
+				Required: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{
+							Key:      corev1.LabelHostname,
+							Operator: corev1.NodeSelectorOpIn,
+							Values:   []string{storage.Local.NodeName},
+						}},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+// BuildLocalCleanupJob creates a Job that removes the files in the Model's
+// directory from the local storage node once the Model is deleted. It mounts
+// the namespace's directory rather than the Model's, so it can see whether
+// the Model's directory was replaced with a symlink.
+func BuildLocalCleanupJob(model *modelsv1alpha1.Model, cfg Config) *batchv1.Job {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-cleanup",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CleanupJobName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(cfg.Jobs.backoffLimit()),
+			TTLSecondsAfterFinished: ptr.To(cfg.Jobs.ttlSecondsAfterFinished()),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					NodeName:      model.Spec.Storage.Local.NodeName,
+					// Run on the storage node regardless of its taints
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{
+						{
+							Name:    "cleanup",
+							Image:   cfg.Images.busybox(),
+							Command: []string{"sh", "-c", cleanupScript, "sh", model.Name},
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "data", MountPath: cleanupMountPath},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "data",
+							VolumeSource: corev1.VolumeSource{
+								HostPath: &corev1.HostPathVolumeSource{
+									Path: localNamespaceDir(model, cfg),
+									Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// BuildLocalReverifyJob creates a Job that checks the model files on the local
+// storage node against their manifest, reporting the content digest in its
+// termination message. It fails without retrying when a file is missing or
+// changed.
+func BuildLocalReverifyJob(model *modelsv1alpha1.Model, cfg Config) (*batchv1.Job, error) {
+	hostPath, err := LocalPath(model, cfg)
+	if err != nil {
+		return nil, err
+	}
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-reverify",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ReverifyJobName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(0)),
+			TTLSecondsAfterFinished: ptr.To(cfg.Jobs.ttlSecondsAfterFinished()),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					NodeName:      model.Spec.Storage.Local.NodeName,
+					// Run on the storage node regardless of its taints
+					Tolerations: []corev1.Toleration{{Operator: corev1.TolerationOpExists}},
+					Containers: []corev1.Container{
+						{
+							Name:    ReverifyContainerName,
+							Image:   cfg.Images.busybox(),
+							Command: marker.VerifyCommand(reverifyMountPath),
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "data", MountPath: reverifyMountPath, ReadOnly: true},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "data",
+							VolumeSource: corev1.VolumeSource{
+								HostPath: &corev1.HostPathVolumeSource{
+									Path: hostPath,
+									Type: ptr.To(corev1.HostPathDirectoryOrCreate),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func testLocalModel(path string) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama",
+			Namespace: "ml",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Storage: modelsv1alpha1.StorageSpec{
+				Size: "20Gi",
+				Local: &modelsv1alpha1.LocalStorageSpec{
+					NodeName: "homelab-1",
+					Path:     path,
+				},
+			},
+		},
+	}
+}
+
+func TestLocalPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		baseDir string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", want: "/var/lib/model-operator/ml/llama"},
+		{name: "template", path: "{namespace}-{name}-q4", want: "/var/lib/model-operator/ml/llama/ml-llama-q4"},
+		// Another Model with the same path still gets a directory of its own
+		{name: "fixed", path: "shared", want: "/var/lib/model-operator/ml/llama/shared"},
+		{name: "base directory", baseDir: "/mnt/ssd/models", want: "/mnt/ssd/models/ml/llama"},
+		{name: "absolute", path: "/etc", wantErr: true},
+		{name: "nested", path: "shared/{name}", wantErr: true},
+		{name: "parent", path: "..", wantErr: true},
+		{name: "parent in path", path: "../../etc", wantErr: true},
+		{name: "current", path: ".", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LocalPath(testLocalModel(tt.path), Config{LocalStorageBaseDir: tt.baseDir})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LocalPath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("LocalPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateLocalStorageBaseDir(t *testing.T) {
+	for dir, valid := range map[string]bool{
+		"/var/lib/model-operator": true,
+		"/":                       false,
+		"var/lib/model-operator":  false,
+		"/var/lib/../../etc":      false,
+		"/var/lib/models/":        false,
+	} {
+		if err := ValidateLocalStorageBaseDir(dir); (err == nil) != valid {
+			t.Errorf("ValidateLocalStorageBaseDir(%q) error = %v, want valid %v", dir, err, valid)
+		}
+	}
+}
+
+func TestBuildLocalPV(t *testing.T) {
+	pv, err := BuildLocalPV(testLocalModel(""), Config{})
+	if err != nil {
+		t.Fatalf("BuildLocalPV() error = %v", err)
+	}
+
+	if pv.Name != LocalPVName("ml", "llama") {
+		t.Errorf("PV name = %v, want %v", pv.Name, LocalPVName("ml", "llama"))
+	}
+	if pv.Spec.HostPath == nil || pv.Spec.HostPath.Path != "/var/lib/model-operator/ml/llama" {
+		t.Errorf("HostPath = %+v, want /var/lib/model-operator/ml/llama", pv.Spec.HostPath)
+	}
+	if pv.Spec.ClaimRef == nil || pv.Spec.ClaimRef.Name != "model-llama" || pv.Spec.ClaimRef.Namespace != "ml" {
+		t.Errorf("ClaimRef = %+v, want ml/model-llama", pv.Spec.ClaimRef)
+	}
+	if pv.Spec.StorageClassName != "" {
+		t.Errorf("StorageClassName = %v, want empty", pv.Spec.StorageClassName)
+	}
+	if pv.Spec.PersistentVolumeReclaimPolicy != corev1.PersistentVolumeReclaimRetain {
+		t.Errorf("ReclaimPolicy = %v, want Retain", pv.Spec.PersistentVolumeReclaimPolicy)
+	}
+	if pv.Labels[LabelModelNamespace] != "ml" || pv.Labels[LabelModelName] != "llama" {
+		t.Errorf("Labels = %v, should identify the Model", pv.Labels)
+	}
+
+	terms := pv.Spec.NodeAffinity.Required.NodeSelectorTerms
+	if len(terms) != 1 || terms[0].MatchExpressions[0].Key != corev1.LabelHostname ||
+		terms[0].MatchExpressions[0].Values[0] != "homelab-1" {
+		t.Errorf("NodeAffinity = %+v, want hostname homelab-1", terms)
+	}
+
+	capacity := pv.Spec.Capacity[corev1.ResourceStorage]
+	if capacity.String() != "20Gi" {
+		t.Errorf("Capacity = %v, want 20Gi", capacity.String())
+	}
+}
+
+func TestBuildPVC_Local(t *testing.T) {
+	pvc, err := BuildPVC(testLocalModel(""))
+	if err != nil {
+		t.Fatalf("BuildPVC() error = %v", err)
+	}
+
+	if pvc.Spec.VolumeName != LocalPVName("ml", "llama") {
+		t.Errorf("VolumeName = %v, want %v", pvc.Spec.VolumeName, LocalPVName("ml", "llama"))
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != "" {
+		t.Errorf("StorageClassName should be empty to disable dynamic provisioning")
+	}
+}
+
+func TestBuildLocalPV_InvalidPath(t *testing.T) {
+	if _, err := BuildLocalPV(testLocalModel("../../etc"), Config{}); err == nil {
+		t.Error("BuildLocalPV() should reject a path outside the namespace's directory")
+	}
+}
+
+func TestBuildLocalCleanupJob(t *testing.T) {
+	job := BuildLocalCleanupJob(testLocalModel("q4"), Config{})
+	if job.Name != "model-cleanup-llama" {
+		t.Errorf("Job name = %v, want model-cleanup-llama", job.Name)
+	}
+	podSpec := job.Spec.Template.Spec
+	if podSpec.NodeName != "homelab-1" {
+		t.Errorf("NodeName = %v, want homelab-1", podSpec.NodeName)
+	}
+	// The namespace's directory is mounted so a symlinked model directory
+	// can be told apart from a real one
+	if hp := podSpec.Volumes[0].HostPath; hp == nil || hp.Path != "/var/lib/model-operator/ml" {
+		t.Errorf("HostPath = %+v, want the namespace's directory", hp)
+	}
+	command := podSpec.Containers[0].Command
+	if command[len(command)-1] != "llama" || !strings.Contains(command[2], "-L") {
+		t.Errorf("Command = %v, should empty the Model's llama directory unless it is a symlink", command)
+	}
+}
+
+func TestBuildLocalReverifyJob(t *testing.T) {
+	job, err := BuildLocalReverifyJob(testLocalModel(""), Config{})
+	if err != nil {
+		t.Fatalf("BuildLocalReverifyJob() error = %v", err)
+	}
+
+	if job.Name != "model-reverify-llama" {
+		t.Errorf("Job name = %v, want model-reverify-llama", job.Name)
+	}
+	if *job.Spec.BackoffLimit != 0 {
+		t.Errorf("BackoffLimit = %v, want 0 so a failed check is final", *job.Spec.BackoffLimit)
+	}
+	podSpec := job.Spec.Template.Spec
+	if podSpec.NodeName != "homelab-1" {
+		t.Errorf("NodeName = %v, want homelab-1", podSpec.NodeName)
+	}
+	if hp := podSpec.Volumes[0].HostPath; hp == nil || hp.Path != "/var/lib/model-operator/ml/llama" {
+		t.Errorf("HostPath = %+v, want the model directory", hp)
+	}
+	container := podSpec.Containers[0]
+	if container.Name != ReverifyContainerName || !container.VolumeMounts[0].ReadOnly {
+		t.Errorf("Container = %+v, want a read-only %s container", container, ReverifyContainerName)
+	}
+}
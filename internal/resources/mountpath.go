@@ -0,0 +1,105 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// mountPathPlaceholder matches the placeholders of a mount path template,
+// such as {name} or {digest:8}
+var mountPathPlaceholder = regexp.MustCompile(`\{([a-z]+)(?::([0-9]+))?\}`)
+
+// ResolveMountPath returns where a model is mounted given an optional base
+// path. An empty base uses the default /models/<name>. A base with
+// placeholders is a template: {name}, {namespace} and {version} of the model
+// are replaced, as is {digest}, the hex content digest, or {digest:n} for its
+// first n characters. Any other base has the model name appended, unless its
+// last element already is the model name. It fails for a relative base, for
+// unknown placeholders and for a version or digest the model does not have.
+func ResolveMountPath(model *modelsv1alpha1.Model, base string) (string, error) {
+	if base == "" {
+		return DefaultMountPath(model.Name), nil
+	}
+	if !path.IsAbs(base) {
+		return "", fmt.Errorf("mount path %q must be absolute", base)
+	}
+
+	if !mountPathPlaceholder.MatchString(base) {
+		base = path.Clean(base)
+		if path.Base(base) == model.Name {
+			return base, nil
+		}
+		return path.Join(base, model.Name), nil
+	}
+
+	var errs []string
+	expanded := mountPathPlaceholder.ReplaceAllStringFunc(base, func(placeholder string) string {
+		match := mountPathPlaceholder.FindStringSubmatch(placeholder)
+		value, err := placeholderValue(model, match[1], match[2])
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+		return value
+	})
+	if len(errs) > 0 {
+		return "", fmt.Errorf("mount path %q: %s", base, strings.Join(errs, ", "))
+	}
+	return path.Clean(expanded), nil
+}
+
+// placeholderValue returns the value of a mount path placeholder, truncated
+// to length if one is given
+func placeholderValue(model *modelsv1alpha1.Model, key, length string) (string, error) {
+	var value string
+	switch key {
+	case "name":
+		value = model.Name
+	case "namespace":
+		value = model.Namespace
+	case "version":
+		if model.Spec.Version == "" {
+			return "", fmt.Errorf("model %q has no version", model.Name)
+		}
+		value = model.Spec.Version
+	case "digest":
+		if model.Status.ContentDigest == "" {
+			return "", fmt.Errorf("model %q has no content digest yet", model.Name)
+		}
+		value = strings.TrimPrefix(model.Status.ContentDigest, "sha256:")
+	default:
+		return "", fmt.Errorf("unknown placeholder {%s}", key)
+	}
+
+	if length == "" {
+		return value, nil
+	}
+	if key != "digest" {
+		return "", fmt.Errorf("{%s} takes no length", key)
+	}
+	n, err := strconv.Atoi(length)
+	if err != nil || n < 1 {
+		return "", fmt.Errorf("invalid length in {%s:%s}", key, length)
+	}
+	return value[:min(n, len(value))], nil
+}
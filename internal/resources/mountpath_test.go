@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestResolveMountPath(t *testing.T) {
+	digest := "0a1b2c3d4e5f" + strings.Repeat("0", 52)
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+		Spec:       modelsv1alpha1.ModelSpec{Version: "3.1"},
+		Status:     modelsv1alpha1.ModelStatus{ContentDigest: "sha256:" + digest},
+	}
+
+	tests := []struct {
+		name    string
+		base    string
+		want    string
+		wantErr string
+	}{
+		{name: "default", base: "", want: "/models/llama"},
+		{name: "base path", base: "/data", want: "/data/llama"},
+		{name: "trailing slash", base: "/data/", want: "/data/llama"},
+		{name: "root", base: "/", want: "/llama"},
+		{name: "unclean base", base: "/data//models/./", want: "/data/models/llama"},
+		{name: "already suffixed", base: "/data/llama", want: "/data/llama"},
+		{name: "already suffixed with trailing slash", base: "/data/llama/", want: "/data/llama"},
+		{name: "name as suffix of another element", base: "/opt/my-llama", want: "/opt/my-llama/llama"},
+		{name: "name as prefix of the last element", base: "/opt/llama-2", want: "/opt/llama-2/llama"},
+		{name: "name in a parent element", base: "/llama/weights", want: "/llama/weights/llama"},
+		{name: "name placeholder", base: "/data/{name}/weights", want: "/data/llama/weights"},
+		{name: "placeholder with trailing slash", base: "/data/{name}/", want: "/data/llama"},
+		{name: "namespace and version", base: "/data/{namespace}/{name}-{version}", want: "/data/ml/llama-3.1"},
+		{name: "short digest", base: "/data/{name}@{digest:8}", want: "/data/llama@0a1b2c3d"},
+		{name: "digest longer than available", base: "/data/{digest:100}", want: "/data/" + digest},
+		{name: "full digest", base: "/data/{digest}", want: "/data/" + digest},
+		{name: "relative", base: "data/{name}", wantErr: `mount path "data/{name}" must be absolute`},
+		{name: "unknown placeholder", base: "/data/{tag}", wantErr: "unknown placeholder {tag}"},
+		{name: "length of name", base: "/data/{name:3}", wantErr: "{name} takes no length"},
+		{name: "zero length", base: "/data/{digest:0}", wantErr: "invalid length in {digest:0}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveMountPath(model, tt.base)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("ResolveMountPath(%q) error = %v, want %q", tt.base, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveMountPath(%q) error = %v", tt.base, err)
+			}
+			if got != tt.want {
+				t.Errorf("ResolveMountPath(%q) = %q, want %q", tt.base, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveMountPath_MissingValues(t *testing.T) {
+	model := &modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"}}
+
+	_, err := ResolveMountPath(model, "/data/{version}/{digest:8}")
+	want := `mount path "/data/{version}/{digest:8}": model "llama" has no version, model "llama" has no content digest yet`
+	if err == nil || err.Error() != want {
+		t.Errorf("ResolveMountPath() error = %v, want %q", err, want)
+	}
+}
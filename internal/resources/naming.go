@@ -17,6 +17,8 @@ limitations under the License.
 package resources
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"strings"
 )
 
@@ -27,6 +29,49 @@ const (
 	JobPrefix = "model-download-"
 	// VolumePrefix is the prefix for volume names in pods
 	VolumePrefix = "model-"
+	// PrewarmPrefix is the prefix for image pre-pull DaemonSet names
+	PrewarmPrefix = "model-prewarm-"
+	// FileServerPrefix is the prefix for file server Deployment and Service names
+	FileServerPrefix = "model-files-"
+	// ConversionPrefix is the prefix for conversion Job names
+	ConversionPrefix = "model-convert-"
+	// CleanupPrefix is the prefix for local storage cleanup Job names
+	CleanupPrefix = "model-cleanup-"
+	// PublishPrefix is the prefix for OCI image publish Job names
+	PublishPrefix = "model-publish-"
+	// CardPrefix is the prefix for model card ConfigMap names
+	CardPrefix = "model-card-"
+	// TokenVolumePrefix is the prefix for injected credential volume names in pods
+	TokenVolumePrefix = "model-token-"
+	// ReplicaPrefix is the prefix for storage replica PVC names
+	ReplicaPrefix = "model-replica-"
+	// ReplicaJobPrefix is the prefix for storage replica download Job names
+	ReplicaJobPrefix = "model-replicate-"
+	// RebuildJobPrefix is the prefix for status rebuild Job names
+	RebuildJobPrefix = "model-rebuild-"
+	// ContentPrefix is the prefix for the ConfigMaps holding configmap-mode models
+	ContentPrefix = "model-content-"
+	// StorePrefix is the prefix for the ServiceAccount, Role and RoleBinding
+	// the download pod stores a configmap-mode model with
+	StorePrefix = "model-store-"
+	// PatternsPrefix is the prefix for the ConfigMaps holding long include
+	// and exclude pattern lists
+	PatternsPrefix = "model-patterns-"
+	// PresignedPrefix is the prefix for the Secrets holding presigned download URLs
+	PresignedPrefix = "model-presigned-"
+	// VerifyPrefix is the prefix for signature verification Job names
+	VerifyPrefix = "model-verify-"
+	// ReverifyPrefix is the prefix for local storage re-verification Job names
+	ReverifyPrefix = "model-reverify-"
+	// CompressedVolumePrefix is the prefix for the PVC volume names of
+	// compressed models in pods, which mount the decompressed files instead
+	CompressedVolumePrefix = "model-compressed-"
+	// DecompressPrefix is the prefix for the init containers that decompress
+	// compressed models in pods
+	DecompressPrefix = "model-decompress-"
+	// RegistryName is the name of the model registry Service and
+	// EndpointSlice in each namespace with Models
+	RegistryName = "model-registry"
 )
 
 // PVCName returns the PVC name for a given model name
@@ -39,17 +84,126 @@ func JobName(modelName string) string {
 	return JobPrefix + modelName
 }
 
+// PrewarmName returns the image pre-pull DaemonSet name for a given model name
+func PrewarmName(modelName string) string {
+	return PrewarmPrefix + modelName
+}
+
+// FileServerName returns the file server Deployment and Service name for a
+// given model name
+func FileServerName(modelName string) string {
+	return FileServerPrefix + modelName
+}
+
+// ConversionJobName returns the conversion Job name for a given model name
+func ConversionJobName(modelName string) string {
+	return ConversionPrefix + modelName
+}
+
+// VerifyJobName returns the signature verification Job name for a given model name
+func VerifyJobName(modelName string) string {
+	return VerifyPrefix + modelName
+}
+
+// LocalPVName returns the cluster-scoped local PersistentVolume name for a
+// given model. PVs are not namespaced, and joining the namespace and name
+// would let "a-b/c" and "a/b-c" collide, so the name is a hash of both.
+func LocalPVName(namespace, modelName string) string {
+	sum := sha256.Sum256([]byte(namespace + "/" + modelName))
+	return PVCPrefix + "local-" + hex.EncodeToString(sum[:10])
+}
+
+// CleanupJobName returns the local storage cleanup Job name for a given model name
+func CleanupJobName(modelName string) string {
+	return CleanupPrefix + modelName
+}
+
+// ReverifyJobName returns the local storage re-verification Job name for a given model name
+func ReverifyJobName(modelName string) string {
+	return ReverifyPrefix + modelName
+}
+
+// PublishJobName returns the OCI image publish Job name for a given model name
+func PublishJobName(modelName string) string {
+	return PublishPrefix + modelName
+}
+
+// CardConfigMapName returns the model card ConfigMap name for a given model name
+func CardConfigMapName(modelName string) string {
+	return CardPrefix + modelName
+}
+
+// InfoConfigMapName returns the name of the ConfigMap exporting a given
+// model's info to consumers
+func InfoConfigMapName(modelName string) string {
+	return "model-" + modelName + "-info"
+}
+
+// ReplicaPVCName returns the PVC name of a storage replica of a given model
+func ReplicaPVCName(modelName, replica string) string {
+	return ReplicaPrefix + modelName + "-" + replica
+}
+
+// ReplicaJobName returns the download Job name of a storage replica of a given model
+func ReplicaJobName(modelName, replica string) string {
+	return ReplicaJobPrefix + modelName + "-" + replica
+}
+
+// RebuildJobName returns the status rebuild Job name for a model
+func RebuildJobName(modelName string) string {
+	return RebuildJobPrefix + modelName
+}
+
+// ContentConfigMapName returns the ConfigMap name holding a configmap-mode model
+func ContentConfigMapName(modelName string) string {
+	return ContentPrefix + modelName
+}
+
+// StoreName returns the name of the ServiceAccount, Role and RoleBinding used
+// to store a configmap-mode model
+func StoreName(modelName string) string {
+	return StorePrefix + modelName
+}
+
+// PatternsConfigMapName returns the name of the ConfigMap holding the
+// include and exclude patterns of a given model
+func PatternsConfigMapName(modelName string) string {
+	return PatternsPrefix + modelName
+}
+
+// PresignedSecretName returns the name of the Secret holding the presigned
+// download URLs of a given model
+func PresignedSecretName(modelName string) string {
+	return PresignedPrefix + modelName
+}
+
+// TokenVolumeName returns the injected token volume name for a given model name
+func TokenVolumeName(modelName string) string {
+	return TokenVolumePrefix + modelName
+}
+
 // VolumeName returns the volume name for a given model name
 func VolumeName(modelName string) string {
 	return VolumePrefix + modelName
 }
 
+// CompressedVolumeName returns the PVC volume name of a compressed model
+func CompressedVolumeName(modelName string) string {
+	return CompressedVolumePrefix + modelName
+}
+
+// DecompressContainerName returns the decompress init container name for a given model name
+func DecompressContainerName(modelName string) string {
+	return DecompressPrefix + modelName
+}
+
 // EnvVarPrefix returns the environment variable prefix for a given model name.
-// Converts the model name to uppercase and replaces hyphens with underscores.
+// Converts the model name to uppercase and replaces hyphens and dots with
+// underscores, so different names may share a prefix.
 // Example: "llama-3-8b" -> "MODEL_LLAMA_3_8B"
 func EnvVarPrefix(modelName string) string {
 	name := strings.ToUpper(modelName)
-	name = strings.ReplaceAll(name, "-", "_")
+	name = strings.NewReplacer("-", "_", ".", "_").Replace(name)
 	return "MODEL_" + name
 }
 
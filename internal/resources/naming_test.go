@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -59,6 +60,71 @@ func TestJobName(t *testing.T) {
 	}
 }
 
+func TestPrewarmName(t *testing.T) {
+	if got := PrewarmName("llama-3-8b"); got != "model-prewarm-llama-3-8b" {
+		t.Errorf("PrewarmName() = %v, want model-prewarm-llama-3-8b", got)
+	}
+}
+
+func TestConversionJobName(t *testing.T) {
+	if got := ConversionJobName("llama-3-8b"); got != "model-convert-llama-3-8b" {
+		t.Errorf("ConversionJobName() = %v, want model-convert-llama-3-8b", got)
+	}
+}
+
+func TestVerifyJobName(t *testing.T) {
+	if got := VerifyJobName("llama-3-8b"); got != "model-verify-llama-3-8b" {
+		t.Errorf("VerifyJobName() = %v, want model-verify-llama-3-8b", got)
+	}
+}
+
+func TestLocalPVName(t *testing.T) {
+	got := LocalPVName("ml", "llama-3-8b")
+	if !strings.HasPrefix(got, "model-local-") || len(got) != len("model-local-")+20 {
+		t.Errorf("LocalPVName() = %v, want model-local- and a hash", got)
+	}
+	if got != LocalPVName("ml", "llama-3-8b") {
+		t.Error("LocalPVName() should be stable")
+	}
+	// Joining namespace and name with a dash would make these the same
+	if LocalPVName("a-b", "c") == LocalPVName("a", "b-c") {
+		t.Error("LocalPVName() should differ across namespaces")
+	}
+}
+
+func TestReverifyJobName(t *testing.T) {
+	if got := ReverifyJobName("llama-3-8b"); got != "model-reverify-llama-3-8b" {
+		t.Errorf("ReverifyJobName() = %v, want model-reverify-llama-3-8b", got)
+	}
+}
+
+func TestCleanupJobName(t *testing.T) {
+	if got := CleanupJobName("llama-3-8b"); got != "model-cleanup-llama-3-8b" {
+		t.Errorf("CleanupJobName() = %v, want model-cleanup-llama-3-8b", got)
+	}
+}
+
+func TestPublishJobName(t *testing.T) {
+	if got := PublishJobName("llama-3-8b"); got != "model-publish-llama-3-8b" {
+		t.Errorf("PublishJobName() = %v, want model-publish-llama-3-8b", got)
+	}
+}
+
+func TestRebuildJobName(t *testing.T) {
+	if got := RebuildJobName("llama-3-8b"); got != "model-rebuild-llama-3-8b" {
+		t.Errorf("RebuildJobName() = %v, want model-rebuild-llama-3-8b", got)
+	}
+}
+
+func TestInlineStorageNames(t *testing.T) {
+	if got := ContentConfigMapName("tokenizer"); got != "model-content-tokenizer" {
+		t.Errorf("ContentConfigMapName() = %v, want model-content-tokenizer", got)
+	}
+	if got := StoreName("tokenizer"); got != "model-store-tokenizer" {
+		t.Errorf("StoreName() = %v, want model-store-tokenizer", got)
+	}
+}
+
 func TestVolumeName(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -88,6 +154,7 @@ func TestEnvVarPrefix(t *testing.T) {
 		{"with hyphens", "llama-3-8b", "MODEL_LLAMA_3_8B"},
 		{"lowercase", "gpt-4-turbo", "MODEL_GPT_4_TURBO"},
 		{"mixed case", "Mistral-7B", "MODEL_MISTRAL_7B"},
+		{"with dots", "qwen2.5-7b", "MODEL_QWEN2_5_7B"},
 	}
 
 	for _, tt := range tests {
@@ -117,3 +184,30 @@ func TestDefaultMountPath(t *testing.T) {
 		})
 	}
 }
+
+func TestCardConfigMapName(t *testing.T) {
+	if got := CardConfigMapName("llama-3-8b"); got != "model-card-llama-3-8b" {
+		t.Errorf("CardConfigMapName() = %v, want model-card-llama-3-8b", got)
+	}
+}
+
+func TestTokenVolumeName(t *testing.T) {
+	if got := TokenVolumeName("llama-3-8b"); got != "model-token-llama-3-8b" {
+		t.Errorf("TokenVolumeName() = %v, want model-token-llama-3-8b", got)
+	}
+}
+
+func TestReplicaNames(t *testing.T) {
+	if got := ReplicaPVCName("llama-3-8b", "nfs"); got != "model-replica-llama-3-8b-nfs" {
+		t.Errorf("ReplicaPVCName() = %v, want model-replica-llama-3-8b-nfs", got)
+	}
+	if got := ReplicaJobName("llama-3-8b", "nfs"); got != "model-replicate-llama-3-8b-nfs" {
+		t.Errorf("ReplicaJobName() = %v, want model-replicate-llama-3-8b-nfs", got)
+	}
+}
+
+func TestPresignedSecretName(t *testing.T) {
+	if got := PresignedSecretName("llama"); got != "model-presigned-llama" {
+		t.Errorf("PresignedSecretName() = %v, want model-presigned-llama", got)
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// Pip sources for the Hugging Face downloader's Python dependencies
+const (
+	// PipSourcePyPI installs from the public PyPI at runtime
+	PipSourcePyPI = "pypi"
+	// PipSourceIndex installs from a private index (PIP_INDEX_URL)
+	PipSourceIndex = "index"
+	// PipSourceWheels installs offline from a mounted directory of wheels
+	PipSourceWheels = "wheels"
+	// PipSourceNone skips installation; the image must already contain the packages
+	PipSourceNone = "none"
+)
+
+const (
+	// hfPackages are the Python packages the Hugging Face downloader needs
+	hfPackages = "huggingface_hub hf_transfer"
+
+	// xetPackage enables chunked, deduplicated downloads from Xet-backed repos.
+	// It is installed on a best-effort basis, as wheels are not published for
+	// every platform.
+	xetPackage = "hf_xet"
+
+	// DefaultXetCacheDir is where the downloader mounts the scratch volume
+	// holding the Xet chunk cache
+	DefaultXetCacheDir = "/xet-cache"
+	// xetCacheVolumeName is the scratch volume holding the Xet chunk cache.
+	// It is kept off the model volume, whose files are served, published
+	// and verified as the model.
+	xetCacheVolumeName = "xet-cache"
+	// legacyXetCacheDir is where the Xet chunk cache used to be kept on the
+	// model volume, removed by downloads into it
+	legacyXetCacheDir = marker.Dir + "/xet-cache"
+
+	// Wheels volume
+	wheelsVolumeName = "pip-wheels"
+	wheelsMountPath  = "/wheels"
+)
+
+// HuggingFaceOptions configures how the Hugging Face downloader gets its
+// Python dependencies, for clusters without access to the public PyPI
+type HuggingFaceOptions struct {
+	// Image overrides the downloader image (e.g. one with huggingface_hub pre-baked)
+	Image string
+	// PipSource is one of pypi (default), index, wheels or none
+	PipSource string
+	// PipIndexURL is the private package index (index only)
+	PipIndexURL string
+	// WheelsConfigMap is a ConfigMap whose binaryData holds the wheels (wheels only)
+	WheelsConfigMap string
+	// WheelsClaimName is a PVC holding the wheels, for wheels too large for a ConfigMap (wheels only)
+	WheelsClaimName string
+	// DisableXet turns off Xet transfers, downloading whole files over HTTP
+	DisableXet bool
+	// XetCacheDir is where the downloader mounts the scratch volume holding
+	// the Xet chunk cache (default DefaultXetCacheDir)
+	XetCacheDir string
+}
+
+// Validate checks the options are consistent with the selected pip source
+func (o HuggingFaceOptions) Validate() error {
+	if dir := o.XetCacheDir; dir != "" {
+		if !path.IsAbs(dir) || path.Clean(dir) != dir || dir == "/" {
+			return fmt.Errorf("xet cache dir %q must be a clean absolute path below /", dir)
+		}
+		for _, reserved := range []string{modelMountPath, tmpMountPath, wheelsMountPath} {
+			if dir == reserved || strings.HasPrefix(dir, reserved+"/") || strings.HasPrefix(reserved, dir+"/") {
+				return fmt.Errorf("xet cache dir %q must not overlap %s", dir, reserved)
+			}
+		}
+	}
+
+	switch o.PipSource {
+	case "", PipSourcePyPI, PipSourceNone:
+		return nil
+	case PipSourceIndex:
+		if o.PipIndexURL == "" {
+			return fmt.Errorf("pip source %q requires a pip index URL", PipSourceIndex)
+		}
+		return nil
+	case PipSourceWheels:
+		if (o.WheelsConfigMap == "") == (o.WheelsClaimName == "") {
+			return fmt.Errorf("pip source %q requires exactly one of a wheels ConfigMap or PVC", PipSourceWheels)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown pip source %q", o.PipSource)
+	}
+}
+
+// image returns the downloader image to use
+func (o HuggingFaceOptions) image() string {
+	if o.Image != "" {
+		return o.Image
+	}
+	return huggingFaceImage
+}
+
+// xetCacheDir returns the Xet chunk cache path inside the container
+func (o HuggingFaceOptions) xetCacheDir() string {
+	return orDefault(o.XetCacheDir, DefaultXetCacheDir)
+}
+
+// installCommand returns the shell command installing the downloader's
+// dependencies, or an empty string if they are pre-installed
+func (o HuggingFaceOptions) installCommand() string {
+	var pip string
+	switch o.PipSource {
+	case PipSourceNone:
+		return ""
+	case PipSourceWheels:
+		pip = fmt.Sprintf("pip install -q --no-index --find-links %s", wheelsMountPath)
+	default:
+		// PIP_INDEX_URL is picked up from the environment for the index source
+		pip = "pip install -q"
+	}
+
+	cmd := pip + " " + hfPackages
+	if !o.DisableXet {
+		// Fall back to plain HTTP downloads if hf_xet cannot be installed
+		cmd += fmt.Sprintf(" && \\\n{ %s %s || echo \"%s not available, downloading over HTTP\"; }",
+			pip, xetPackage, xetPackage)
+	}
+	return cmd
+}
+
+// configureContainer adds the env and mounts the pip source and Xet need
+func (o HuggingFaceOptions) configureContainer(container *corev1.Container) {
+	if o.DisableXet {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "HF_HUB_DISABLE_XET", Value: "1"})
+	} else {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "HF_XET_CACHE", Value: o.xetCacheDir()})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      xetCacheVolumeName,
+			MountPath: o.xetCacheDir(),
+		})
+	}
+
+	switch o.PipSource {
+	case PipSourceIndex:
+		container.Env = append(container.Env, corev1.EnvVar{Name: "PIP_INDEX_URL", Value: o.PipIndexURL})
+		// Let pip reach a plain-HTTP mirror without extra configuration
+		if host := insecureIndexHost(o.PipIndexURL); host != "" {
+			container.Env = append(container.Env, corev1.EnvVar{Name: "PIP_TRUSTED_HOST", Value: host})
+		}
+	case PipSourceWheels:
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      wheelsVolumeName,
+			MountPath: wheelsMountPath,
+			ReadOnly:  true,
+		})
+	}
+}
+
+// configurePod adds the volumes the pip source and Xet need
+func (o HuggingFaceOptions) configurePod(podSpec *corev1.PodSpec) {
+	if !o.DisableXet {
+		podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+			Name:         xetCacheVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+	}
+	if o.PipSource != PipSourceWheels {
+		return
+	}
+
+	volume := corev1.Volume{Name: wheelsVolumeName}
+	if o.WheelsConfigMap != "" {
+		volume.ConfigMap = &corev1.ConfigMapVolumeSource{
+			LocalObjectReference: corev1.LocalObjectReference{Name: o.WheelsConfigMap},
+		}
+	} else {
+		volume.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: o.WheelsClaimName,
+			ReadOnly:  true,
+		}
+	}
+	podSpec.Volumes = append(podSpec.Volumes, volume)
+}
+
+// insecureIndexHost returns the host of a plain-HTTP index URL, without the
+// port or any credentials
+func insecureIndexHost(indexURL string) string {
+	u, err := url.Parse(indexURL)
+	if err != nil || u.Scheme != "http" {
+		return ""
+	}
+	return u.Hostname()
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// ownershipScript returns the shell fragment that applies spec.storage.ownership
+// to the downloaded files, or an empty string if none is set
+func ownershipScript(model *modelsv1alpha1.Model) string {
+	o := model.Spec.Storage.Ownership
+	if o == nil {
+		return ""
+	}
+
+	var steps []string
+	switch {
+	case o.UID != nil && o.GID != nil:
+		steps = append(steps, fmt.Sprintf("chown -R %d:%d %s", *o.UID, *o.GID, modelMountPath))
+	case o.UID != nil:
+		steps = append(steps, fmt.Sprintf("chown -R %d %s", *o.UID, modelMountPath))
+	case o.GID != nil:
+		steps = append(steps, fmt.Sprintf("chgrp -R %d %s", *o.GID, modelMountPath))
+	}
+	if mode, err := strconv.ParseUint(o.Mode, 8, 32); err == nil {
+		// Directories need the execute bit to be listed and traversed
+		dirMode := mode | (mode&0o444)>>2
+		steps = append(steps,
+			fmt.Sprintf("find %s -type f -exec chmod %04o {} +", modelMountPath, mode),
+			fmt.Sprintf("find %s -type d -exec chmod %04o {} +", modelMountPath, dirMode))
+	}
+
+	return strings.Join(steps, " && \\\n")
+}
+
+// configureOwnership makes the download pod's volumes group-owned by the
+// ownership GID, so the files are accessible even before the final chown
+func configureOwnership(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec) {
+	o := model.Spec.Storage.Ownership
+	if o == nil || o.GID == nil {
+		return
+	}
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	podSpec.SecurityContext.FSGroup = ptr.To(*o.GID)
+	podSpec.SecurityContext.FSGroupChangePolicy = ptr.To(corev1.FSGroupChangeOnRootMismatch)
+}
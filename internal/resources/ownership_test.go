@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestOwnershipScript(t *testing.T) {
+	tests := []struct {
+		name      string
+		ownership *modelsv1alpha1.StorageOwnership
+		want      string
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:      "uid and gid",
+			ownership: &modelsv1alpha1.StorageOwnership{UID: ptr.To[int64](1000), GID: ptr.To[int64](1000)},
+			want:      "chown -R 1000:1000 /models",
+		},
+		{
+			name:      "uid only",
+			ownership: &modelsv1alpha1.StorageOwnership{UID: ptr.To[int64](1000)},
+			want:      "chown -R 1000 /models",
+		},
+		{
+			name:      "gid only",
+			ownership: &modelsv1alpha1.StorageOwnership{GID: ptr.To[int64](0)},
+			want:      "chgrp -R 0 /models",
+		},
+		{
+			name:      "mode",
+			ownership: &modelsv1alpha1.StorageOwnership{Mode: "0640"},
+			want: "find /models -type f -exec chmod 0640 {} + && \\\n" +
+				"find /models -type d -exec chmod 0750 {} +",
+		},
+		{
+			name:      "mode without leading zero",
+			ownership: &modelsv1alpha1.StorageOwnership{GID: ptr.To[int64](2000), Mode: "444"},
+			want: "chgrp -R 2000 /models && \\\n" +
+				"find /models -type f -exec chmod 0444 {} + && \\\n" +
+				"find /models -type d -exec chmod 0555 {} +",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &modelsv1alpha1.Model{}
+			model.Spec.Storage.Ownership = tt.ownership
+			if got := ownershipScript(model); got != tt.want {
+				t.Errorf("ownershipScript() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildDownloadJob_Ownership(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "ollama-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"},
+			},
+			Storage: modelsv1alpha1.StorageSpec{
+				StorageClass: "standard",
+				Size:         "10Gi",
+				Ownership: &modelsv1alpha1.StorageOwnership{
+					UID: ptr.To[int64](1000), GID: ptr.To[int64](1000), Mode: "0640",
+				},
+			},
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+
+	podSpec := job.Spec.Template.Spec
+	sc := podSpec.SecurityContext
+	if sc == nil || sc.FSGroup == nil || *sc.FSGroup != 1000 {
+		t.Errorf("SecurityContext = %+v, want fsGroup 1000", sc)
+	} else if sc.FSGroupChangePolicy == nil || *sc.FSGroupChangePolicy != corev1.FSGroupChangeOnRootMismatch {
+		t.Errorf("FSGroupChangePolicy = %v, want OnRootMismatch", sc.FSGroupChangePolicy)
+	}
+
+	// Ownership is applied before the completion marker is written
+	script := podSpec.Containers[0].Args[0]
+	chown := strings.Index(script, "chown -R 1000:1000 /models")
+	markerDir := strings.Index(script, "mkdir -p .model-operator")
+	if chown < 0 || markerDir < 0 || chown > markerDir {
+		t.Errorf("Script should chown the files before writing the completion marker:\n%s", script)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"regexp"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// patternsVolumeName is the volume of the patterns ConfigMap in download pods
+	patternsVolumeName = "model-patterns"
+	// patternsMountPath is where the patterns ConfigMap is mounted
+	patternsMountPath = "/etc/model-patterns"
+	// maxInlinePatterns is how many patterns are embedded in the download
+	// script; longer lists are mounted from the patterns ConfigMap
+	maxInlinePatterns = 16
+)
+
+// inlinePattern matches the globs that are safe to embed in the download
+// script unquoted and in Python string literals
+var inlinePattern = regexp.MustCompile(`^[A-Za-z0-9._*?/+=@,:~\[\]-]+$`)
+
+// sourcePatterns returns the include and exclude patterns of the source
+func sourcePatterns(model *modelsv1alpha1.Model) (include, exclude []string) {
+	source := model.Spec.Source
+	switch {
+	case source.HuggingFace != nil:
+		return source.HuggingFace.Include, source.HuggingFace.Exclude
+	case source.Git != nil:
+		return source.Git.Include, source.Git.Exclude
+	}
+	return nil, nil
+}
+
+// PatternsMounted reports whether the include and exclude patterns of a
+// Model are mounted from its patterns ConfigMap instead of embedded in the
+// download script, because there are many or some are not plain globs
+func PatternsMounted(model *modelsv1alpha1.Model) bool {
+	include, exclude := sourcePatterns(model)
+	if len(include)+len(exclude) > maxInlinePatterns {
+		return true
+	}
+	for _, p := range append(include, exclude...) {
+		if !inlinePattern.MatchString(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// patternsFile returns the path of a mounted pattern list ("include" or "exclude")
+func patternsFile(list string) string {
+	return patternsMountPath + "/" + list
+}
+
+// BuildPatternsConfigMap creates the ConfigMap holding the include and
+// exclude patterns of a Model, one per line
+func BuildPatternsConfigMap(model *modelsv1alpha1.Model) *corev1.ConfigMap {
+	include, exclude := sourcePatterns(model)
+	lines := func(patterns []string) string {
+		if len(patterns) == 0 {
+			return ""
+		}
+		return strings.Join(patterns, "\n") + "\n"
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PatternsConfigMapName(model.Name),
+			Namespace: model.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "model-patterns",
+				"app.kubernetes.io/instance":   model.Name,
+				"app.kubernetes.io/managed-by": "model-operator",
+			},
+		},
+		Data: map[string]string{
+			"include": lines(include),
+			"exclude": lines(exclude),
+		},
+	}
+}
+
+// configurePatterns mounts the patterns ConfigMap into the downloader of a
+// Model whose patterns are not embedded in the script
+func configurePatterns(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec) {
+	if !PatternsMounted(model) {
+		return
+	}
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name: patternsVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: PatternsConfigMapName(model.Name)},
+			},
+		},
+	})
+	podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, corev1.VolumeMount{
+		Name:      patternsVolumeName,
+		MountPath: patternsMountPath,
+		ReadOnly:  true,
+	})
+}
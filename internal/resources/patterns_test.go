@@ -0,0 +1,184 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// manyPatterns returns n distinct file patterns
+func manyPatterns(n int) []string {
+	patterns := make([]string, n)
+	for i := range patterns {
+		patterns[i] = fmt.Sprintf("shards/model-%05d-of-%05d.safetensors", i+1, n)
+	}
+	return patterns
+}
+
+func TestPatternsMounted(t *testing.T) {
+	tests := []struct {
+		name   string
+		source modelsv1alpha1.ModelSource
+		want   bool
+	}{
+		{
+			name:   "no patterns",
+			source: modelsv1alpha1.ModelSource{HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"}},
+		},
+		{
+			name: "few plain globs",
+			source: modelsv1alpha1.ModelSource{HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+				RepoID: "org/model", Include: []string{"*.safetensors", "config.json"}, Exclude: []string{"original/*"},
+			}},
+		},
+		{
+			name: "hundreds of patterns",
+			source: modelsv1alpha1.ModelSource{HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+				RepoID: "org/model", Include: manyPatterns(500),
+			}},
+			want: true,
+		},
+		{
+			name: "quote in a pattern",
+			source: modelsv1alpha1.ModelSource{Git: &modelsv1alpha1.GitSource{
+				URL: "https://example.com/repo.git", Exclude: []string{"it's *.bin"},
+			}},
+			want: true,
+		},
+		{
+			name: "shell expansion in a pattern",
+			source: modelsv1alpha1.ModelSource{HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+				RepoID: "org/model", Include: []string{"$(reboot)"},
+			}},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &modelsv1alpha1.Model{Spec: modelsv1alpha1.ModelSpec{Source: tt.source}}
+			if got := PatternsMounted(model); got != tt.want {
+				t.Errorf("PatternsMounted() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildDownloadJob_ManyPatterns(t *testing.T) {
+	include := manyPatterns(400)
+	exclude := []string{"it's.bin", "*.h5"}
+
+	tests := []struct {
+		name   string
+		source modelsv1alpha1.ModelSource
+	}{
+		{
+			name: "huggingface",
+			source: modelsv1alpha1.ModelSource{HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+				RepoID: "org/sharded", Include: include, Exclude: exclude,
+			}},
+		},
+		{
+			name: "git",
+			source: modelsv1alpha1.ModelSource{Git: &modelsv1alpha1.GitSource{
+				URL: "https://example.com/sharded.git", Include: include, Exclude: exclude,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := &modelsv1alpha1.Model{
+				ObjectMeta: metav1.ObjectMeta{Name: "sharded", Namespace: "default"},
+				Spec:       modelsv1alpha1.ModelSpec{Source: tt.source},
+			}
+			job, err := BuildDownloadJob(model, Config{})
+			if err != nil {
+				t.Fatalf("BuildDownloadJob() error = %v", err)
+			}
+
+			script := job.Spec.Template.Spec.Containers[0].Args[0]
+			if len(script) > 4096 {
+				t.Errorf("Script is %d bytes, want the patterns kept out of it", len(script))
+			}
+			for _, p := range append(include[:1], exclude...) {
+				if strings.Contains(script, p) {
+					t.Errorf("Script embeds pattern %q", p)
+				}
+			}
+			for _, list := range []string{"include", "exclude"} {
+				if !strings.Contains(script, patternsFile(list)) {
+					t.Errorf("Script does not read %s", patternsFile(list))
+				}
+			}
+			if out, err := exec.Command("sh", "-n", "-c", script).CombinedOutput(); err != nil {
+				t.Errorf("Script is not valid shell: %v: %s", err, out)
+			}
+
+			var mounted bool
+			for _, m := range job.Spec.Template.Spec.Containers[0].VolumeMounts {
+				mounted = mounted || (m.Name == patternsVolumeName && m.MountPath == patternsMountPath && m.ReadOnly)
+			}
+			if !mounted {
+				t.Errorf("Patterns volume not mounted at %s", patternsMountPath)
+			}
+			var volume bool
+			for _, v := range job.Spec.Template.Spec.Volumes {
+				volume = volume || (v.ConfigMap != nil && v.ConfigMap.Name == "model-patterns-sharded")
+			}
+			if !volume {
+				t.Error("Patterns ConfigMap volume missing")
+			}
+
+			cm := BuildPatternsConfigMap(model)
+			if got := strings.Split(strings.TrimSuffix(cm.Data["include"], "\n"), "\n"); len(got) != len(include) {
+				t.Errorf("ConfigMap has %d include patterns, want %d", len(got), len(include))
+			}
+			if cm.Data["exclude"] != "it's.bin\n*.h5\n" {
+				t.Errorf("ConfigMap exclude = %q", cm.Data["exclude"])
+			}
+		})
+	}
+}
+
+func TestBuildDownloadJob_InlinePatterns(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "small", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{Source: modelsv1alpha1.ModelSource{HuggingFace: &modelsv1alpha1.HuggingFaceSource{
+			RepoID: "org/small", Include: []string{"*.safetensors"},
+		}}},
+	}
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if script := job.Spec.Template.Spec.Containers[0].Args[0]; !strings.Contains(script, "allow_patterns=['*.safetensors']") {
+		t.Errorf("Expected the pattern embedded in the script:\n%s", script)
+	}
+	for _, v := range job.Spec.Template.Spec.Volumes {
+		if v.Name == patternsVolumeName {
+			t.Error("Short pattern lists should not mount the patterns ConfigMap")
+		}
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/presign"
+)
+
+// PresignedURLsKey is the key of the presigned URL list in the Secret, one
+// "<url> <path>" line per file
+const PresignedURLsKey = "urls"
+
+// BuildPresignedSecret creates the Secret handing the presigned download URLs
+// to the download pod. The URLs are short-lived, unlike the credentials they
+// were signed with, which stay with the operator.
+func BuildPresignedSecret(model *modelsv1alpha1.Model, files []presign.File) *corev1.Secret {
+	var urls strings.Builder
+	for _, file := range files {
+		fmt.Fprintf(&urls, "%s %s\n", file.URL, file.Path)
+	}
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PresignedSecretName(model.Name),
+			Namespace: model.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "model-downloader",
+				"app.kubernetes.io/instance":   model.Name,
+				"app.kubernetes.io/managed-by": "model-operator",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{PresignedURLsKey: []byte(urls.String())},
+	}
+}
+
+// buildPresignedS3Container downloads an S3 source through the URLs the
+// operator presigned, without any cloud credentials
+func buildPresignedS3Container(model *modelsv1alpha1.Model, images Images) corev1.Container {
+	script := fmt.Sprintf(`printf '%%s' "$PRESIGNED_URLS" | while read -r url path; do
+  [ -n "$url" ] || continue
+  mkdir -p "$(dirname "/models/$path")" && \
+  curl -fsSL --retry 3 -o "/models/$path" "$url" || exit 1
+done && \
+%s && \
+echo "Download complete" && \
+ls -la /models`, completionMarkerScript(model))
+
+	return corev1.Container{
+		Name:    "downloader",
+		Image:   images.curl(),
+		Command: []string{"sh", "-c"},
+		Args:    []string{script},
+		Env: []corev1.EnvVar{{
+			Name: "PRESIGNED_URLS",
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: PresignedSecretName(model.Name)},
+					Key:                  PresignedURLsKey,
+				},
+			},
+		}},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      modelVolumeName,
+				MountPath: modelMountPath,
+			},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("128Mi"),
+				corev1.ResourceCPU:    resource.MustParse("100m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("512Mi"),
+				corev1.ResourceCPU:    resource.MustParse("500m"),
+			},
+		},
+	}
+}
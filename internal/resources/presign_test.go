@@ -0,0 +1,45 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/presign"
+)
+
+func TestBuildPresignedSecret(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "s3-model", Namespace: "default"},
+	}
+	secret := BuildPresignedSecret(model, []presign.File{
+		{Path: "config.json", URL: "https://my-bucket.s3.amazonaws.com/models/config.json?X-Amz-Signature=a"},
+		{Path: "weights/model 1.safetensors", URL: "https://my-bucket.s3.amazonaws.com/models/weights/model%201.safetensors?X-Amz-Signature=b"},
+	})
+
+	if secret.Name != "model-presigned-s3-model" || secret.Namespace != "default" {
+		t.Errorf("Secret = %s/%s, want default/model-presigned-s3-model", secret.Namespace, secret.Name)
+	}
+	want := "https://my-bucket.s3.amazonaws.com/models/config.json?X-Amz-Signature=a config.json\n" +
+		"https://my-bucket.s3.amazonaws.com/models/weights/model%201.safetensors?X-Amz-Signature=b weights/model 1.safetensors\n"
+	if got := string(secret.Data[PresignedURLsKey]); got != want {
+		t.Errorf("Secret URLs = %q, want %q", got, want)
+	}
+}
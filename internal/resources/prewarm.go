@@ -0,0 +1,94 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// pauseImage keeps the pre-pull pod alive after the images are pulled
+	pauseImage = "registry.k8s.io/pause:3.10"
+)
+
+// BuildPrewarmDaemonSet creates a DaemonSet that pre-pulls the Model's serving
+// runtime images. Each image runs as an init container that exits immediately,
+// which is enough for the kubelet to pull it; the pod then idles on pause.
+func BuildPrewarmDaemonSet(model *modelsv1alpha1.Model, cfg Config) *appsv1.DaemonSet {
+	prewarm := model.Spec.Prewarm
+
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-prewarm",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+
+	minimal := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("8Mi"),
+			corev1.ResourceCPU:    resource.MustParse("5m"),
+		},
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("32Mi"),
+			corev1.ResourceCPU:    resource.MustParse("50m"),
+		},
+	}
+
+	initContainers := make([]corev1.Container, 0, len(prewarm.Images))
+	for i, image := range prewarm.Images {
+		initContainers = append(initContainers, corev1.Container{
+			Name:            fmt.Sprintf("prepull-%d", i),
+			Image:           image,
+			ImagePullPolicy: corev1.PullIfNotPresent,
+			Command:         []string{"/bin/sh", "-c", "exit 0"},
+			Resources:       minimal,
+		})
+	}
+
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PrewarmName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					InitContainers: initContainers,
+					Containers: []corev1.Container{
+						{
+							Name:      "pause",
+							Image:     cfg.Images.pause(),
+							Resources: minimal,
+						},
+					},
+					NodeSelector: prewarm.NodeSelector,
+					Tolerations:  prewarm.Tolerations,
+				},
+			},
+		},
+	}
+}
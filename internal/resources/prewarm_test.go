@@ -0,0 +1,79 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestBuildPrewarmDaemonSet(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama-3-8b",
+			Namespace: "inference",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Prewarm: &modelsv1alpha1.PrewarmSpec{
+				Images:       []string{"ollama/ollama:0.5.7", "vllm/vllm-openai:v0.6.6"},
+				NodeSelector: map[string]string{"node-type": "gpu"},
+				Tolerations: []corev1.Toleration{
+					{Key: "nvidia.com/gpu", Operator: corev1.TolerationOpExists},
+				},
+			},
+		},
+	}
+
+	ds := BuildPrewarmDaemonSet(model, Config{})
+
+	if ds.Name != "model-prewarm-llama-3-8b" {
+		t.Errorf("DaemonSet name = %v, want model-prewarm-llama-3-8b", ds.Name)
+	}
+	if ds.Namespace != "inference" {
+		t.Errorf("DaemonSet namespace = %v, want inference", ds.Namespace)
+	}
+
+	podSpec := ds.Spec.Template.Spec
+	if len(podSpec.InitContainers) != 2 {
+		t.Fatalf("Expected 2 pre-pull init containers, got %d", len(podSpec.InitContainers))
+	}
+	if podSpec.InitContainers[0].Image != "ollama/ollama:0.5.7" {
+		t.Errorf("Init container image = %v, want ollama/ollama:0.5.7", podSpec.InitContainers[0].Image)
+	}
+	if podSpec.InitContainers[1].ImagePullPolicy != corev1.PullIfNotPresent {
+		t.Errorf("Init containers should use IfNotPresent pull policy")
+	}
+	if len(podSpec.Containers) != 1 || podSpec.Containers[0].Image != pauseImage {
+		t.Errorf("Expected a single pause container")
+	}
+	if podSpec.NodeSelector["node-type"] != "gpu" {
+		t.Errorf("NodeSelector not applied")
+	}
+	if len(podSpec.Tolerations) != 1 {
+		t.Errorf("Tolerations not applied")
+	}
+
+	for k, v := range ds.Spec.Selector.MatchLabels {
+		if ds.Spec.Template.Labels[k] != v {
+			t.Errorf("Selector label %s=%s does not match template", k, v)
+		}
+	}
+}
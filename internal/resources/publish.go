@@ -0,0 +1,205 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// publishImage provides crane and a busybox shell
+	publishImage = "gcr.io/go-containerregistry/crane:debug"
+
+	// PublisherContainerName is the name of the publish Job's container,
+	// which reports the pushed image digest in its termination message
+	PublisherContainerName = "publisher"
+
+	// defaultLayerSize is used when spec.publish.layerSize is unset
+	defaultLayerSize = int64(1 << 30)
+
+	// Layer staging volume
+	layersVolumeName = "layers"
+	layersMountPath  = "/layers"
+
+	dockerConfigMountPath = "/docker-config"
+)
+
+// publishScript groups the model files into layers of about LAYER_SIZE bytes,
+// appends them to an empty image and pushes it, then reports the digest.
+const publishScript = `set -e
+cd /models
+find . -type f -exec stat -c '%s %n' {} + | LC_ALL=C sort -k2 | \
+awk -v max="$LAYER_SIZE" -v dir="` + layersMountPath + `" -v n=0 '
+{
+  size = $1; sub(/^[0-9]+ /, "")
+  if (total > 0 && total + size > max) { close(file); n++; total = 0 }
+  total += size
+  file = dir "/" n ".list"
+  print > file
+}'
+set --
+for list in ` + layersMountPath + `/*.list; do
+  tar -cf "${list%.list}.tar" -T "$list"
+  set -- "$@" -f "${list%.list}.tar"
+done
+crane append $CRANE_FLAGS -t "$IMAGE" "$@"
+crane digest $CRANE_FLAGS "$IMAGE" | tr -d '\n' > /dev/termination-log
+echo "Published $IMAGE"`
+
+// BuildPublishJob creates a Job that packages the model files into an OCI
+// image and pushes it to spec.publish.image. The image contains the files at
+// its root, so it can be mounted directly with the image volume source.
+func BuildPublishJob(model *modelsv1alpha1.Model, cfg Config) *batchv1.Job {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-publisher",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+
+	container, volumes := buildPublisher(model, publishScript, cfg.Images)
+	volumes = append([]corev1.Volume{{
+		Name: modelVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: ClaimName(model),
+				ReadOnly:  true,
+			},
+		},
+	}}, volumes...)
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PublishJobName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(cfg.Jobs.backoffLimit()),
+			TTLSecondsAfterFinished: ptr.To(cfg.Jobs.ttlSecondsAfterFinished()),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers:    []corev1.Container{container},
+					Volumes:       volumes,
+				},
+			},
+		},
+	}
+
+	// Follow the download onto nodes that can reach the volume
+	if len(model.Spec.NodeSelector) > 0 {
+		job.Spec.Template.Spec.NodeSelector = model.Spec.NodeSelector
+	}
+
+	return job
+}
+
+// buildPublisher returns the publisher container running script against the
+// model volume, and the volumes it needs besides the model volume
+func buildPublisher(model *modelsv1alpha1.Model, script string, images Images) (corev1.Container, []corev1.Volume) {
+	publish := model.Spec.Publish
+
+	layerSize := defaultLayerSize
+	if publish.LayerSize != nil && publish.LayerSize.Value() > 0 {
+		layerSize = publish.LayerSize.Value()
+	}
+
+	var craneFlags []string
+	if publish.Insecure {
+		craneFlags = append(craneFlags, "--insecure")
+	}
+
+	container := corev1.Container{
+		Name:    PublisherContainerName,
+		Image:   images.publish(),
+		Command: []string{"/busybox/sh", "-c", script},
+		Env: []corev1.EnvVar{
+			{Name: "IMAGE", Value: publish.Image},
+			{Name: "LAYER_SIZE", Value: strconv.FormatInt(layerSize, 10)},
+			{Name: "CRANE_FLAGS", Value: strings.Join(craneFlags, " ")},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: modelVolumeName, MountPath: modelMountPath, ReadOnly: true},
+			{Name: layersVolumeName, MountPath: layersMountPath},
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+				corev1.ResourceCPU:    resource.MustParse("250m"),
+			},
+			Limits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+				corev1.ResourceCPU:    resource.MustParse("2"),
+			},
+		},
+	}
+
+	volumes := []corev1.Volume{
+		{
+			// Layer tarballs are staged here before upload
+			Name:         layersVolumeName,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	}
+
+	if publish.PushSecret != "" {
+		container.Env = append(container.Env, corev1.EnvVar{Name: "DOCKER_CONFIG", Value: dockerConfigMountPath})
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      "docker-config",
+			MountPath: dockerConfigMountPath,
+			ReadOnly:  true,
+		})
+		volumes = append(volumes, corev1.Volume{
+			Name: "docker-config",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: publish.PushSecret,
+					Items: []corev1.KeyToPath{
+						{Key: corev1.DockerConfigJsonKey, Path: "config.json"},
+					},
+				},
+			},
+		})
+	}
+
+	return container, volumes
+}
+
+// PublishedImage returns the image reference pinned to digest
+func PublishedImage(image, digest string) string {
+	repo := image
+	if i := strings.LastIndex(repo, "@"); i >= 0 {
+		repo = repo[:i]
+	}
+	// Strip a tag, but not a registry port
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo = repo[:i]
+	}
+	return repo + "@" + digest
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestBuildPublishJob(t *testing.T) {
+	layerSize := resource.MustParse("512Mi")
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Publish: &modelsv1alpha1.PublishSpec{
+				Image:      "registry.internal:5000/models/llama:3.1",
+				PushSecret: "registry-push",
+				Insecure:   true,
+				LayerSize:  &layerSize,
+			},
+		},
+	}
+
+	job := BuildPublishJob(model, Config{})
+
+	if job.Name != "model-publish-llama" {
+		t.Errorf("Job name = %v, want model-publish-llama", job.Name)
+	}
+
+	container := job.Spec.Template.Spec.Containers[0]
+	env := map[string]string{}
+	for _, e := range container.Env {
+		env[e.Name] = e.Value
+	}
+	if env["IMAGE"] != "registry.internal:5000/models/llama:3.1" {
+		t.Errorf("IMAGE = %v", env["IMAGE"])
+	}
+	if env["LAYER_SIZE"] != "536870912" {
+		t.Errorf("LAYER_SIZE = %v, want 536870912", env["LAYER_SIZE"])
+	}
+	if env["CRANE_FLAGS"] != "--insecure" {
+		t.Errorf("CRANE_FLAGS = %v, want --insecure", env["CRANE_FLAGS"])
+	}
+	if env["DOCKER_CONFIG"] != "/docker-config" {
+		t.Errorf("DOCKER_CONFIG = %v, want /docker-config", env["DOCKER_CONFIG"])
+	}
+
+	var hasSecret, hasModel bool
+	for _, v := range job.Spec.Template.Spec.Volumes {
+		if v.Secret != nil && v.Secret.SecretName == "registry-push" {
+			hasSecret = true
+		}
+		if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == "model-llama" && v.PersistentVolumeClaim.ReadOnly {
+			hasModel = true
+		}
+	}
+	if !hasSecret {
+		t.Error("Expected the push secret to be mounted")
+	}
+	if !hasModel {
+		t.Error("Expected the model PVC to be mounted read-only")
+	}
+}
+
+func TestBuildPublishJob_Defaults(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Publish: &modelsv1alpha1.PublishSpec{Image: "registry.internal/models/llama"},
+		},
+	}
+
+	job := BuildPublishJob(model, Config{})
+	container := job.Spec.Template.Spec.Containers[0]
+	for _, e := range container.Env {
+		switch e.Name {
+		case "LAYER_SIZE":
+			if e.Value != "1073741824" {
+				t.Errorf("LAYER_SIZE = %v, want 1073741824", e.Value)
+			}
+		case "CRANE_FLAGS":
+			if e.Value != "" {
+				t.Errorf("CRANE_FLAGS = %v, want empty", e.Value)
+			}
+		case "DOCKER_CONFIG":
+			t.Error("DOCKER_CONFIG should not be set without a push secret")
+		}
+	}
+}
+
+func TestPublishedImage(t *testing.T) {
+	digest := "sha256:abc"
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{"registry.internal/models/llama:3.1", "registry.internal/models/llama@sha256:abc"},
+		{"registry.internal:5000/models/llama", "registry.internal:5000/models/llama@sha256:abc"},
+		{"registry.internal:5000/models/llama:latest", "registry.internal:5000/models/llama@sha256:abc"},
+		{"registry.internal/models/llama@sha256:old", "registry.internal/models/llama@sha256:abc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := PublishedImage(tt.image, digest); got != tt.want {
+				t.Errorf("PublishedImage() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package resources
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,8 +26,26 @@ import (
 	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
 )
 
+// ParseStorageSize parses a storage size such as "20Gi" or "20G". The CRD
+// pattern also admits sizes that are not quantities, such as "20K", so every
+// size is parsed here rather than with resource.MustParse.
+func ParseStorageSize(size string) (resource.Quantity, error) {
+	q, err := resource.ParseQuantity(size)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("%w %q: %w", ErrInvalidSize, size, err)
+	}
+	if q.Sign() <= 0 {
+		return resource.Quantity{}, fmt.Errorf("%w %q: must be positive", ErrInvalidSize, size)
+	}
+	return q, nil
+}
+
 // BuildPVC creates a PersistentVolumeClaim for the given Model
-func BuildPVC(model *modelsv1alpha1.Model) *corev1.PersistentVolumeClaim {
+func BuildPVC(model *modelsv1alpha1.Model) (*corev1.PersistentVolumeClaim, error) {
+	size, err := ParseStorageSize(model.Spec.Storage.Size)
+	if err != nil {
+		return nil, err
+	}
 	storageClass := model.Spec.Storage.StorageClass
 
 	accessModes := model.Spec.Storage.AccessModes
@@ -48,11 +68,16 @@ func BuildPVC(model *modelsv1alpha1.Model) *corev1.PersistentVolumeClaim {
 			StorageClassName: &storageClass,
 			Resources: corev1.VolumeResourceRequirements{
 				Requests: corev1.ResourceList{
-					corev1.ResourceStorage: resource.MustParse(model.Spec.Storage.Size),
+					corev1.ResourceStorage: size,
 				},
 			},
 		},
 	}
 
-	return pvc
+	// Bind directly to the operator-provisioned local PV
+	if model.Spec.Storage.Local != nil {
+		pvc.Spec.VolumeName = LocalPVName(model.Namespace, model.Name)
+	}
+
+	return pvc, nil
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package resources
 
 import (
+	"errors"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -77,7 +78,10 @@ func TestBuildPVC(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pvc := BuildPVC(tt.model)
+			pvc, err := BuildPVC(tt.model)
+			if err != nil {
+				t.Fatalf("BuildPVC() error = %v", err)
+			}
 
 			if pvc.Name != tt.wantName {
 				t.Errorf("PVC name = %v, want %v", pvc.Name, tt.wantName)
@@ -113,3 +117,35 @@ func TestBuildPVC(t *testing.T) {
 		})
 	}
 }
+
+func FuzzBuildPVC(f *testing.F) {
+	for _, size := range []string{"20Gi", "20G", "20K", "20gi", "20g", "0Gi", "-1Gi", "1e3", "", "20Gi "} {
+		f.Add(size)
+	}
+	f.Fuzz(func(t *testing.T, size string) {
+		model := &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+			Spec: modelsv1alpha1.ModelSpec{
+				Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: size},
+			},
+		}
+		want, parseErr := ParseStorageSize(size)
+		if parseErr == nil && want.Sign() <= 0 {
+			t.Fatalf("ParseStorageSize(%q) = %v, want a positive size", size, want.String())
+		}
+
+		pvc, err := BuildPVC(model)
+		if (err != nil) != (parseErr != nil) {
+			t.Fatalf("BuildPVC() error = %v, ParseStorageSize() error = %v", err, parseErr)
+		}
+		if err != nil {
+			if !errors.Is(err, ErrInvalidSize) {
+				t.Errorf("BuildPVC() error = %v, want ErrInvalidSize", err)
+			}
+			return
+		}
+		if got := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; got.Cmp(want) != 0 {
+			t.Errorf("PVC size = %v, want %v", got.String(), want.String())
+		}
+	})
+}
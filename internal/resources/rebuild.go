@@ -0,0 +1,86 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+const (
+	// RebuildContainerName is the name of the status rebuild Job's container
+	RebuildContainerName = "rebuild"
+	// rebuildMountPath is where the rebuild Job mounts the model volume
+	rebuildMountPath = "/model"
+)
+
+// BuildRebuildJob creates a Job that reads the completion marker on a Model's
+// PVC and reports the content digest in its termination message. It fails
+// without retrying when the volume holds no complete download.
+func BuildRebuildJob(model *modelsv1alpha1.Model, cfg Config) *batchv1.Job {
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-rebuild",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RebuildJobName(model.Name),
+			Namespace: model.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(int32(0)),
+			TTLSecondsAfterFinished: ptr.To(cfg.Jobs.ttlSecondsAfterFinished()),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:    RebuildContainerName,
+							Image:   cfg.Images.busybox(),
+							Command: marker.ReportCommand(rebuildMountPath),
+							VolumeMounts: []corev1.VolumeMount{
+								{Name: "model", MountPath: rebuildMountPath, ReadOnly: true},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "model",
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: ClaimName(model),
+									ReadOnly:  true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestBuildRebuildJob(t *testing.T) {
+	job := BuildRebuildJob(&modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"}}, Config{})
+
+	if job.Name != "model-rebuild-llama" || job.Namespace != "ml" {
+		t.Errorf("Job = %v/%v, want ml/model-rebuild-llama", job.Namespace, job.Name)
+	}
+	if *job.Spec.BackoffLimit != 0 {
+		t.Errorf("BackoffLimit = %v, want 0 so a missing marker fails at once", *job.Spec.BackoffLimit)
+	}
+	podSpec := job.Spec.Template.Spec
+	if pvc := podSpec.Volumes[0].PersistentVolumeClaim; pvc == nil || pvc.ClaimName != "model-llama" || !pvc.ReadOnly {
+		t.Errorf("Volume = %+v, want the model PVC read-only", podSpec.Volumes[0])
+	}
+	if podSpec.Containers[0].Name != RebuildContainerName {
+		t.Errorf("Container name = %v, want %v", podSpec.Containers[0].Name, RebuildContainerName)
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"net"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// DefaultRegistryPort is the port the model registry is served on, both by
+// the manager and through each namespace's Service
+const DefaultRegistryPort = 8082
+
+// registryLabels returns the labels of the model registry objects
+func registryLabels() map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       RegistryName,
+		"app.kubernetes.io/managed-by": "model-operator",
+	}
+}
+
+// BuildRegistryService creates the headless Service giving the model
+// registry a stable DNS name in namespace. It has no selector: the manager
+// serving the registry runs in another namespace, so its EndpointSlice is
+// kept by the operator.
+func BuildRegistryService(namespace string, port int32) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RegistryName,
+			Namespace: namespace,
+			Labels:    registryLabels(),
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Ports: []corev1.ServicePort{
+				{Name: "http", Port: port},
+			},
+		},
+	}
+}
+
+// BuildRegistryEndpointSlice points the model registry Service of namespace
+// at the manager's pod address
+func BuildRegistryEndpointSlice(namespace, address string, port int32) *discoveryv1.EndpointSlice {
+	labels := registryLabels()
+	labels[discoveryv1.LabelServiceName] = RegistryName
+	labels[discoveryv1.LabelManagedBy] = "model-operator"
+
+	addressType := discoveryv1.AddressTypeIPv4
+	if ip := net.ParseIP(address); ip != nil && ip.To4() == nil {
+		addressType = discoveryv1.AddressTypeIPv6
+	}
+
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RegistryName,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		AddressType: addressType,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses:  []string{address},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+			},
+		},
+		Ports: []discoveryv1.EndpointPort{
+			{Name: ptr.To("http"), Port: ptr.To(port), Protocol: ptr.To(corev1.ProtocolTCP)},
+		},
+	}
+}
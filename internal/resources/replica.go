@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// LabelReplica marks the PVCs and Jobs of a storage replica with its name
+const LabelReplica = "models.main-currents.news/replica"
+
+// replicaAppName is the app.kubernetes.io/name of replica download pods, kept
+// apart from the primary download so its pod health checks ignore them
+const replicaAppName = "model-replica"
+
+// BuildReplicaPVC creates the PersistentVolumeClaim of a storage replica
+func BuildReplicaPVC(model *modelsv1alpha1.Model, replica modelsv1alpha1.StorageReplica) (*corev1.PersistentVolumeClaim, error) {
+	pvc, err := BuildPVC(model)
+	if err != nil {
+		return nil, err
+	}
+	pvc.Name = ReplicaPVCName(model.Name, replica.Name)
+	pvc.Labels[LabelReplica] = replica.Name
+	pvc.Spec.StorageClassName = &replica.StorageClass
+	pvc.Spec.VolumeName = ""
+	if replica.Size != "" {
+		size, err := ParseStorageSize(replica.Size)
+		if err != nil {
+			return nil, err
+		}
+		pvc.Spec.Resources.Requests[corev1.ResourceStorage] = size
+	}
+	return pvc, nil
+}
+
+// BuildReplicaJob creates a Job that downloads the model from its source into
+// a storage replica. Replicas are downloaded independently rather than copied
+// from the primary PVC, which may not be mountable from the replica's zone.
+func BuildReplicaJob(model *modelsv1alpha1.Model, replica modelsv1alpha1.StorageReplica, cfg Config) (*batchv1.Job, error) {
+	job, err := BuildDownloadJob(model, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	job.Name = ReplicaJobName(model.Name, replica.Name)
+	for _, labels := range []map[string]string{job.Labels, job.Spec.Template.Labels} {
+		labels["app.kubernetes.io/name"] = replicaAppName
+		labels[LabelReplica] = replica.Name
+	}
+
+	podSpec := &job.Spec.Template.Spec
+	for i := range podSpec.Volumes {
+		if podSpec.Volumes[i].Name == modelVolumeName {
+			podSpec.Volumes[i].PersistentVolumeClaim.ClaimName = ReplicaPVCName(model.Name, replica.Name)
+		}
+	}
+
+	// Provision and fill the replica in its zone
+	if replica.Zone != "" {
+		requireNodeLabel(podSpec, corev1.LabelTopologyZone, []string{replica.Zone})
+	}
+
+	return job, nil
+}
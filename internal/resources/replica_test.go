@@ -0,0 +1,112 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func replicaModel() *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "meta-llama/Llama-3.1-8B-Instruct"},
+			},
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "nfs", Size: "20Gi"},
+		},
+	}
+}
+
+func TestBuildReplicaPVC(t *testing.T) {
+	model := replicaModel()
+
+	pvc, err := BuildReplicaPVC(model, modelsv1alpha1.StorageReplica{Name: "nvme", StorageClass: "local-nvme", Size: "30Gi"})
+	if err != nil {
+		t.Fatalf("BuildReplicaPVC() error = %v", err)
+	}
+	if pvc.Name != "model-replica-llama-nvme" {
+		t.Errorf("Name = %v, want model-replica-llama-nvme", pvc.Name)
+	}
+	if *pvc.Spec.StorageClassName != "local-nvme" {
+		t.Errorf("StorageClassName = %v, want local-nvme", *pvc.Spec.StorageClassName)
+	}
+	if got := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; got.String() != "30Gi" {
+		t.Errorf("Size = %v, want 30Gi", got.String())
+	}
+	if pvc.Labels[LabelReplica] != "nvme" {
+		t.Errorf("Replica label = %v, want nvme", pvc.Labels[LabelReplica])
+	}
+
+	pvc, err = BuildReplicaPVC(model, modelsv1alpha1.StorageReplica{Name: "nfs-b", StorageClass: "nfs"})
+	if err != nil {
+		t.Fatalf("BuildReplicaPVC() error = %v", err)
+	}
+	if got := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; got.String() != "20Gi" {
+		t.Errorf("Size = %v, want spec.storage.size 20Gi", got.String())
+	}
+
+	if _, err := BuildReplicaPVC(model, modelsv1alpha1.StorageReplica{Name: "bad", StorageClass: "nfs", Size: "30K"}); err == nil {
+		t.Error("BuildReplicaPVC() should reject the replica size 30K")
+	}
+}
+
+func TestBuildReplicaJob(t *testing.T) {
+	model := replicaModel()
+
+	job, err := BuildReplicaJob(model, modelsv1alpha1.StorageReplica{Name: "nvme", StorageClass: "local-nvme", Zone: "zone-a"}, Config{})
+	if err != nil {
+		t.Fatalf("BuildReplicaJob() error = %v", err)
+	}
+
+	if job.Name != "model-replicate-llama-nvme" {
+		t.Errorf("Name = %v, want model-replicate-llama-nvme", job.Name)
+	}
+	if job.Spec.Template.Labels["app.kubernetes.io/name"] != "model-replica" {
+		t.Errorf("Pod app label = %v, want model-replica", job.Spec.Template.Labels["app.kubernetes.io/name"])
+	}
+
+	podSpec := job.Spec.Template.Spec
+	if claim := podSpec.Volumes[0].PersistentVolumeClaim.ClaimName; claim != "model-replica-llama-nvme" {
+		t.Errorf("ClaimName = %v, want model-replica-llama-nvme", claim)
+	}
+
+	var zones []string
+	for _, term := range podSpec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, expr := range term.MatchExpressions {
+			if expr.Key == corev1.LabelTopologyZone {
+				zones = append(zones, expr.Values...)
+			}
+		}
+	}
+	if len(zones) != 1 || zones[0] != "zone-a" {
+		t.Errorf("Zone requirement = %v, want [zone-a]", zones)
+	}
+
+	primary, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if primary.Spec.Template.Spec.Volumes[0].PersistentVolumeClaim.ClaimName != PVCName(model.Name) {
+		t.Errorf("BuildReplicaJob() should not affect the primary download Job")
+	}
+}
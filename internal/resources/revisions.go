@@ -0,0 +1,113 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// RevisionsContainerName is the name of the download pod init container that
+// keeps the previous revision and restores a kept one
+const RevisionsContainerName = "revisions"
+
+// revisionsDir holds the kept revisions, relative to the volume root. It is
+// inside the metadata directory so the manifest leaves it out.
+const revisionsDir = marker.Dir + "/revisions"
+
+// revisionsScript keeps the files of the revision on the volume in
+// revisionsDir before $TARGET is downloaded, as hard links since the
+// HuggingFace downloader replaces changed files rather than rewriting them.
+// A kept $TARGET is moved back in place, so the download finds its files
+// unchanged. Only the $LIMIT most recently replaced revisions are kept.
+const revisionsScript = `set -e
+cd ` + modelMountPath + `
+history=` + revisionsDir + `
+dirname() { printf '%s' "$1" | tr -c 'A-Za-z0-9._-' '_'; }
+current=$(sed -n 's/.*"revision":"\([^"]*\)".*/\1/p' ` + marker.Dir + `/` + marker.FileName + ` 2>/dev/null || true)
+if [ "$LIMIT" -gt 0 ] && [ -n "$current" ] && [ "$current" != "$TARGET" ]; then
+  keep="$history/$(dirname "$current")"
+  rm -rf "$keep"
+  mkdir -p "$keep/` + marker.Dir + `"
+  for f in * .[!.]* ..?*; do
+    if [ -e "$f" ] && [ "$f" != ` + marker.Dir + ` ]; then cp -al "$f" "$keep/"; fi
+  done
+  cp -p ` + marker.Dir + `/` + marker.FileName + ` ` + marker.Dir + `/` + marker.ManifestFileName + ` "$keep/` + marker.Dir + `/"
+  echo "Kept revision $current in $keep"
+fi
+restore="$history/$(dirname "$TARGET")"
+if [ -n "$current" ] && [ "$current" != "$TARGET" ] && [ -d "$restore" ]; then
+  for f in * .[!.]* ..?*; do
+    if [ -e "$f" ] && [ "$f" != ` + marker.Dir + ` ]; then rm -rf "$f"; fi
+  done
+  for f in "$restore"/* "$restore"/.[!.]* "$restore"/..?*; do
+    if [ -e "$f" ] && [ "${f##*/}" != ` + marker.Dir + ` ]; then cp -al "$f" .; fi
+  done
+  cp -p "$restore/` + marker.Dir + `/"* ` + marker.Dir + `/
+  rm -rf "$restore"
+  echo "Restored revision $TARGET from $restore"
+fi
+if [ -d "$history" ]; then
+  ls -1t "$history" | tail -n +$((LIMIT + 1)) | while read -r d; do rm -rf "${history:?}/$d"; done
+fi`
+
+// RevisionHistoryLimit returns how many previous revisions of the model are
+// kept on its volume
+func RevisionHistoryLimit(model *modelsv1alpha1.Model) int32 {
+	if model.Spec.RevisionHistoryLimit == nil || model.Spec.Source.HuggingFace == nil {
+		return 0
+	}
+	return *model.Spec.RevisionHistoryLimit
+}
+
+// RevisionPath returns the directory a previous revision is kept in, relative
+// to the volume root
+func RevisionPath(revision string) string {
+	dir := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '.' || r == '_' || r == '-' {
+			return r
+		}
+		return '_'
+	}, revision)
+	return revisionsDir + "/" + dir
+}
+
+// configureRevisionHistory adds the init container that keeps the previous
+// revision before the download, and removes kept revisions once the limit
+// is lowered
+func configureRevisionHistory(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec, images Images) {
+	if RevisionHistoryLimit(model) == 0 && len(model.Status.Revisions) == 0 {
+		return
+	}
+	podSpec.InitContainers = append(podSpec.InitContainers, corev1.Container{
+		Name:    RevisionsContainerName,
+		Image:   images.busybox(),
+		Command: []string{"sh", "-c", revisionsScript},
+		Env: []corev1.EnvVar{
+			{Name: "TARGET", Value: SourceRevision(model)},
+			{Name: "LIMIT", Value: strconv.Itoa(int(RevisionHistoryLimit(model)))},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: modelVolumeName, MountPath: modelMountPath},
+		},
+	})
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestRevisionPath(t *testing.T) {
+	tests := map[string]string{
+		"main":                 ".model-operator/revisions/main",
+		"v1.2-rc_1":            ".model-operator/revisions/v1.2-rc_1",
+		"refs/pr/12":           ".model-operator/revisions/refs_pr_12",
+		"a1b2c3d4e5f6a7b8c9d0": ".model-operator/revisions/a1b2c3d4e5f6a7b8c9d0",
+	}
+	for revision, want := range tests {
+		if got := RevisionPath(revision); got != want {
+			t.Errorf("RevisionPath(%q) = %q, want %q", revision, got, want)
+		}
+	}
+}
+
+func TestBuildDownloadJob_RevisionHistory(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "ml"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/llama", Revision: "v2"},
+			},
+			Storage:              modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"},
+			RevisionHistoryLimit: ptr.To(int32(3)),
+		},
+	}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	init := job.Spec.Template.Spec.InitContainers
+	if len(init) != 1 || init[0].Name != RevisionsContainerName {
+		t.Fatalf("InitContainers = %+v, want the revisions container", init)
+	}
+	env := map[string]string{}
+	for _, e := range init[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env["TARGET"] != "v2" || env["LIMIT"] != "3" {
+		t.Errorf("Env = %v, want TARGET=v2 and LIMIT=3", env)
+	}
+
+	// Kept revisions are removed once the history is turned off
+	model.Spec.RevisionHistoryLimit = nil
+	model.Status.Revisions = []modelsv1alpha1.ModelRevision{{Revision: "v1", Path: RevisionPath("v1")}}
+	job, err = BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if init := job.Spec.Template.Spec.InitContainers; len(init) != 1 || init[0].Env[1].Value != "0" {
+		t.Errorf("InitContainers = %+v, want the revisions container with LIMIT=0", init)
+	}
+
+	model.Status.Revisions = nil
+	job, err = BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if init := job.Spec.Template.Spec.InitContainers; len(init) != 0 {
+		t.Errorf("InitContainers = %+v, want none without revision history", init)
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// nonRootID is the user and group a non-root download pod runs as when
+	// the Model does not choose one, the "nonroot" user of distroless images
+	nonRootID = int64(65532)
+
+	tmpVolumeName = "tmp"
+	tmpMountPath  = "/tmp"
+)
+
+// configureSecurityContext applies spec.download.securityContext to every
+// container of the download pod and lets the built-in scripts run as a
+// non-root user with a read-only root filesystem
+func configureSecurityContext(model *modelsv1alpha1.Model, podSpec *corev1.PodSpec) {
+	download := model.Spec.Download
+	if download == nil || (download.SecurityContext == nil && download.PodSecurityContext == nil) {
+		return
+	}
+	if podSpec.SecurityContext == nil {
+		podSpec.SecurityContext = &corev1.PodSecurityContext{}
+	}
+	pod := podSpec.SecurityContext
+	container := download.SecurityContext
+	if container == nil {
+		container = &corev1.SecurityContext{}
+	}
+
+	// Images like python and alpine default to root, which runAsNonRoot rejects
+	nonRoot := ptr.Deref(container.RunAsNonRoot, ptr.Deref(pod.RunAsNonRoot, false))
+	if nonRoot && container.RunAsUser == nil && pod.RunAsUser == nil {
+		pod.RunAsUser = ptr.To(nonRootID)
+		if container.RunAsGroup == nil && pod.RunAsGroup == nil {
+			pod.RunAsGroup = ptr.To(nonRootID)
+		}
+	}
+
+	// Make the model volume writable by whoever the downloader runs as
+	if pod.FSGroup == nil {
+		for _, id := range []*int64{container.RunAsGroup, pod.RunAsGroup, container.RunAsUser, pod.RunAsUser} {
+			if id != nil {
+				if *id != 0 {
+					pod.FSGroup = ptr.To(*id)
+					pod.FSGroupChangePolicy = ptr.To(corev1.FSGroupChangeOnRootMismatch)
+				}
+				break
+			}
+		}
+	}
+
+	// The scripts write to /tmp and pip installs into the home directory, so
+	// give them a scratch volume instead of the root filesystem
+	podSpec.Volumes = append(podSpec.Volumes, corev1.Volume{
+		Name:         tmpVolumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+	})
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			c := &containers[i]
+			if c.SecurityContext == nil && download.SecurityContext != nil {
+				c.SecurityContext = download.SecurityContext.DeepCopy()
+			}
+			if !slices.ContainsFunc(c.VolumeMounts, func(m corev1.VolumeMount) bool { return m.MountPath == tmpMountPath }) {
+				c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{Name: tmpVolumeName, MountPath: tmpMountPath})
+			}
+			setDefaultEnv(c, "HOME", tmpMountPath)
+			setDefaultEnv(c, "PIP_USER", "1")
+		}
+	}
+}
+
+// setDefaultEnv sets an environment variable unless the container already has it
+func setDefaultEnv(c *corev1.Container, name, value string) {
+	if !slices.ContainsFunc(c.Env, func(e corev1.EnvVar) bool { return e.Name == name }) {
+		c.Env = append(c.Env, corev1.EnvVar{Name: name, Value: value})
+	}
+}
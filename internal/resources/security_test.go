@@ -0,0 +1,123 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func restrictedModel() *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "restricted-model", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/model"},
+			},
+			Download: &modelsv1alpha1.DownloadSpec{
+				SecurityContext: &corev1.SecurityContext{
+					RunAsNonRoot:             ptr.To(true),
+					ReadOnlyRootFilesystem:   ptr.To(true),
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+				},
+				PodSecurityContext: &corev1.PodSecurityContext{
+					SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+				},
+			},
+		},
+	}
+}
+
+func TestBuildDownloadJob_SecurityContext(t *testing.T) {
+	job, err := BuildDownloadJob(restrictedModel(), Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+
+	pod := podSpec.SecurityContext
+	if pod == nil || pod.SeccompProfile == nil || pod.SeccompProfile.Type != corev1.SeccompProfileTypeRuntimeDefault {
+		t.Fatalf("SecurityContext = %+v, want the Model's seccomp profile", pod)
+	}
+	if ptr.Deref(pod.RunAsUser, 0) != nonRootID || ptr.Deref(pod.RunAsGroup, 0) != nonRootID {
+		t.Errorf("RunAsUser/RunAsGroup = %v/%v, want %d", pod.RunAsUser, pod.RunAsGroup, nonRootID)
+	}
+	if ptr.Deref(pod.FSGroup, 0) != nonRootID {
+		t.Errorf("FSGroup = %v, want %d so the model volume is writable", pod.FSGroup, nonRootID)
+	}
+	if !slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == tmpVolumeName && v.EmptyDir != nil }) {
+		t.Errorf("Expected an emptyDir volume for /tmp")
+	}
+
+	for _, c := range append(podSpec.InitContainers, podSpec.Containers...) {
+		if c.SecurityContext == nil || !ptr.Deref(c.SecurityContext.ReadOnlyRootFilesystem, false) {
+			t.Errorf("Container %s SecurityContext = %+v, want the Model's", c.Name, c.SecurityContext)
+		}
+		if !slices.ContainsFunc(c.VolumeMounts, func(m corev1.VolumeMount) bool { return m.MountPath == tmpMountPath }) {
+			t.Errorf("Container %s should mount a writable /tmp", c.Name)
+		}
+		env := map[string]string{}
+		for _, e := range c.Env {
+			env[e.Name] = e.Value
+		}
+		if env["HOME"] != tmpMountPath || env["PIP_USER"] != "1" {
+			t.Errorf("Container %s HOME/PIP_USER = %q/%q, want pip to install under /tmp", c.Name, env["HOME"], env["PIP_USER"])
+		}
+	}
+}
+
+func TestBuildDownloadJob_SecurityContextKeepsChoices(t *testing.T) {
+	model := restrictedModel()
+	model.Spec.Download.SecurityContext.RunAsUser = ptr.To(int64(1000))
+	model.Spec.Storage.Ownership = &modelsv1alpha1.StorageOwnership{GID: ptr.To(int64(2000))}
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	pod := job.Spec.Template.Spec.SecurityContext
+	if pod.RunAsUser != nil || pod.RunAsGroup != nil {
+		t.Errorf("RunAsUser/RunAsGroup = %v/%v, want the container's user alone", pod.RunAsUser, pod.RunAsGroup)
+	}
+	if ptr.Deref(pod.FSGroup, 0) != 2000 {
+		t.Errorf("FSGroup = %v, want the ownership GID 2000", pod.FSGroup)
+	}
+}
+
+func TestBuildDownloadJob_NoSecurityContext(t *testing.T) {
+	model := restrictedModel()
+	model.Spec.Download = nil
+
+	job, err := BuildDownloadJob(model, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+	if podSpec.SecurityContext != nil {
+		t.Errorf("SecurityContext = %+v, want none by default", podSpec.SecurityContext)
+	}
+	if slices.ContainsFunc(podSpec.Volumes, func(v corev1.Volume) bool { return v.Name == tmpVolumeName }) {
+		t.Errorf("Expected no /tmp volume by default")
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// DefaultDownloaderServiceAccount is the ServiceAccount download pods run as
+// unless the Model or the operator configures another
+const DefaultDownloaderServiceAccount = "model-downloader"
+
+// ServiceAccountConfig configures the ServiceAccount the operator creates in
+// each namespace for download pods that name none
+type ServiceAccountConfig struct {
+	// Name of the ServiceAccount. Empty runs download pods as the
+	// namespace's default ServiceAccount.
+	Name string
+	// Annotations of the ServiceAccount, e.g. to bind it to a cloud
+	// identity. Values are Go templates of .Namespace.
+	Annotations map[string]string
+}
+
+// serviceAccountTemplateData is what annotation templates are executed with
+type serviceAccountTemplateData struct {
+	Namespace string
+}
+
+// ParseServiceAccountAnnotation parses a key=value annotation whose value is
+// a Go template of .Namespace
+func ParseServiceAccountAnnotation(value string) (string, string, error) {
+	key, tmpl, ok := strings.Cut(value, "=")
+	if !ok || strings.TrimSpace(key) == "" {
+		return "", "", fmt.Errorf("annotation %q is not key=value", value)
+	}
+	if _, err := template.New(key).Option("missingkey=error").Parse(tmpl); err != nil {
+		return "", "", fmt.Errorf("annotation %s: %w", key, err)
+	}
+	return strings.TrimSpace(key), tmpl, nil
+}
+
+// BuildDownloaderServiceAccount creates the ServiceAccount download pods in
+// namespace run as. It has no permissions and does not mount an API token.
+func BuildDownloaderServiceAccount(namespace string, cfg ServiceAccountConfig) (*corev1.ServiceAccount, error) {
+	var annotations map[string]string
+	for key, tmpl := range cfg.Annotations {
+		t, err := template.New(key).Option("missingkey=error").Parse(tmpl)
+		if err != nil {
+			return nil, fmt.Errorf("annotation %s: %w", key, err)
+		}
+		var value strings.Builder
+		if err := t.Execute(&value, serviceAccountTemplateData{Namespace: namespace}); err != nil {
+			return nil, fmt.Errorf("annotation %s: %w", key, err)
+		}
+		if annotations == nil {
+			annotations = make(map[string]string, len(cfg.Annotations))
+		}
+		annotations[key] = value.String()
+	}
+
+	return &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      cfg.Name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "model-downloader",
+				"app.kubernetes.io/managed-by": "model-operator",
+			},
+			Annotations: annotations,
+		},
+		AutomountServiceAccountToken: ptr.To(false),
+	}, nil
+}
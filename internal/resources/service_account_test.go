@@ -0,0 +1,78 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestBuildDownloaderServiceAccount(t *testing.T) {
+	sa, err := BuildDownloaderServiceAccount("team-a", ServiceAccountConfig{
+		Name: DefaultDownloaderServiceAccount,
+		Annotations: map[string]string{
+			"iam.gke.io/gcp-service-account": "models-{{.Namespace}}@project.iam.gserviceaccount.com",
+			"eks.amazonaws.com/role-arn":     "arn:aws:iam::123456789012:role/model-downloader",
+		},
+	})
+	if err != nil {
+		t.Fatalf("BuildDownloaderServiceAccount() error = %v", err)
+	}
+	if sa.Name != DefaultDownloaderServiceAccount || sa.Namespace != "team-a" {
+		t.Errorf("ServiceAccount = %s/%s, want team-a/%s", sa.Namespace, sa.Name, DefaultDownloaderServiceAccount)
+	}
+	if got := sa.Annotations["iam.gke.io/gcp-service-account"]; got != "models-team-a@project.iam.gserviceaccount.com" {
+		t.Errorf("GCP annotation = %q, want the namespace filled in", got)
+	}
+	if got := sa.Annotations["eks.amazonaws.com/role-arn"]; got != "arn:aws:iam::123456789012:role/model-downloader" {
+		t.Errorf("EKS annotation = %q, want it unchanged", got)
+	}
+	if ptr.Deref(sa.AutomountServiceAccountToken, true) {
+		t.Errorf("AutomountServiceAccountToken = %v, want false", sa.AutomountServiceAccountToken)
+	}
+	if len(sa.OwnerReferences) != 0 {
+		t.Errorf("OwnerReferences = %v, want none for a ServiceAccount shared by the namespace", sa.OwnerReferences)
+	}
+}
+
+func TestParseServiceAccountAnnotation(t *testing.T) {
+	tests := []struct {
+		value   string
+		key     string
+		tmpl    string
+		wantErr bool
+	}{
+		{value: "iam.gke.io/gcp-service-account=dl-{{.Namespace}}@p.iam.gserviceaccount.com",
+			key: "iam.gke.io/gcp-service-account", tmpl: "dl-{{.Namespace}}@p.iam.gserviceaccount.com"},
+		{value: "azure.workload.identity/client-id=0000-1111", key: "azure.workload.identity/client-id", tmpl: "0000-1111"},
+		{value: "no-value", wantErr: true},
+		{value: "=value", wantErr: true},
+		{value: "bad={{.Namespace", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			key, tmpl, err := ParseServiceAccountAnnotation(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseServiceAccountAnnotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if key != tt.key || tmpl != tt.tmpl {
+				t.Errorf("ParseServiceAccountAnnotation() = %q, %q, want %q, %q", key, tmpl, tt.key, tt.tmpl)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"fmt"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// SourceName names the source at index: "primary" or "fallbacks[n]"
+func SourceName(index int32) string {
+	if index <= 0 {
+		return "primary"
+	}
+	return fmt.Sprintf("fallbacks[%d]", index-1)
+}
+
+// ForSource returns the Model with spec.source replaced by the source at
+// index, for building its download Job. Out of range indexes select the
+// primary source.
+func ForSource(model *modelsv1alpha1.Model, index int32) *modelsv1alpha1.Model {
+	if index <= 0 || int(index) > len(model.Spec.Source.Fallbacks) {
+		return model
+	}
+
+	m := model.DeepCopy()
+	fallback := m.Spec.Source.Fallbacks[index-1]
+	m.Spec.Source = modelsv1alpha1.ModelSource{
+		HuggingFace: fallback.HuggingFace,
+		URL:         fallback.URL,
+		S3:          fallback.S3,
+		Git:         fallback.Git,
+		DVC:         fallback.DVC,
+	}
+	return m
+}
+
+// sourceCount returns how many of the source's fields are set, which the API
+// requires to be exactly one
+func sourceCount(source modelsv1alpha1.ModelSource) int {
+	n := 0
+	for _, set := range []bool{
+		source.HuggingFace != nil, source.S3 != nil, source.URL != nil, source.Git != nil, source.DVC != nil,
+	} {
+		if set {
+			n++
+		}
+	}
+	return n
+}
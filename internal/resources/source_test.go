@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resources
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestSourceName(t *testing.T) {
+	tests := []struct {
+		index int32
+		want  string
+	}{
+		{0, "primary"},
+		{1, "fallbacks[0]"},
+		{3, "fallbacks[2]"},
+	}
+
+	for _, tt := range tests {
+		if got := SourceName(tt.index); got != tt.want {
+			t.Errorf("SourceName(%d) = %v, want %v", tt.index, got, tt.want)
+		}
+	}
+}
+
+func TestForSource(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "meta-llama/Llama-3.1-8B-Instruct"},
+				Fallbacks: []modelsv1alpha1.FallbackSource{
+					{S3: &modelsv1alpha1.S3Source{Bucket: "model-mirror", Key: "llama/"}},
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "20Gi"},
+		},
+	}
+
+	if got := ForSource(model, 0); got != model {
+		t.Errorf("ForSource(0) should return the Model unchanged")
+	}
+	if got := ForSource(model, 2); got != model {
+		t.Errorf("ForSource() out of range should return the Model unchanged")
+	}
+
+	got := ForSource(model, 1)
+	if got.Spec.Source.HuggingFace != nil || got.Spec.Source.S3 == nil || got.Spec.Source.S3.Bucket != "model-mirror" {
+		t.Errorf("ForSource(1).Spec.Source = %+v, want the S3 fallback", got.Spec.Source)
+	}
+	if model.Spec.Source.HuggingFace == nil {
+		t.Errorf("ForSource() should not modify the Model")
+	}
+
+	job, err := BuildDownloadJob(got, Config{})
+	if err != nil {
+		t.Fatalf("BuildDownloadJob() error = %v", err)
+	}
+	if job.Name != JobName(model.Name) {
+		t.Errorf("Job name = %v, want %v", job.Name, JobName(model.Name))
+	}
+}
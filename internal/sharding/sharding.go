@@ -0,0 +1,85 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sharding splits reconciliation across operator replicas by
+// namespace, so large installs are not limited to a single active leader.
+//
+// Every namespace hashes to exactly one of Count shards. A replica only
+// reconciles objects in the namespaces of its shard and elects a leader among
+// the replicas of that shard alone. Webhooks are stateless and served by every
+// replica regardless of its shard.
+package sharding
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// Shard is the part of the namespaces a replica reconciles. The zero value
+// and a Count of 1 reconcile everything.
+type Shard struct {
+	// Index is this replica's shard, from 0 to Count-1
+	Index int
+	// Count is the total number of shards
+	Count int
+}
+
+// Validate checks the shard index is within the shard count
+func (s Shard) Validate() error {
+	if s.Count < 0 {
+		return fmt.Errorf("shard count %d must not be negative", s.Count)
+	}
+	if s.Count > 1 && (s.Index < 0 || s.Index >= s.Count) {
+		return fmt.Errorf("shard index %d must be between 0 and %d", s.Index, s.Count-1)
+	}
+	return nil
+}
+
+// Enabled reports whether reconciliation is split across shards
+func (s Shard) Enabled() bool {
+	return s.Count > 1
+}
+
+// Owns reports whether the namespace belongs to this shard
+func (s Shard) Owns(namespace string) bool {
+	if !s.Enabled() {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(namespace))
+	return int(h.Sum32()%uint32(s.Count)) == s.Index
+}
+
+// Predicate filters events to the objects in this shard's namespaces.
+// Cluster-scoped objects pass, and their map functions pick the objects of
+// this shard they relate to.
+func (s Shard) Predicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return obj.GetNamespace() == "" || s.Owns(obj.GetNamespace())
+	})
+}
+
+// LeaderElectionID returns the leader election ID for this shard, so each
+// shard elects its own leader
+func (s Shard) LeaderElectionID(id string) string {
+	if !s.Enabled() {
+		return id
+	}
+	return fmt.Sprintf("shard-%d-of-%d.%s", s.Index, s.Count, id)
+}
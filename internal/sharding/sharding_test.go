@@ -0,0 +1,99 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sharding
+
+import (
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestShard_Validate(t *testing.T) {
+	tests := []struct {
+		shard   Shard
+		wantErr bool
+	}{
+		{Shard{}, false},
+		{Shard{Index: 0, Count: 1}, false},
+		{Shard{Index: 2, Count: 3}, false},
+		{Shard{Index: 3, Count: 3}, true},
+		{Shard{Index: -1, Count: 3}, true},
+		{Shard{Count: -1}, true},
+	}
+	for _, tt := range tests {
+		if err := tt.shard.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%+v.Validate() error = %v, wantErr %v", tt.shard, err, tt.wantErr)
+		}
+	}
+}
+
+// TestShard_Owns checks every namespace belongs to exactly one shard and the
+// shards share the namespaces out
+func TestShard_Owns(t *testing.T) {
+	const count = 4
+	perShard := make([]int, count)
+	for i := range 1000 {
+		namespace := fmt.Sprintf("team-%d", i)
+		owners := 0
+		for index := range count {
+			if (Shard{Index: index, Count: count}).Owns(namespace) {
+				owners++
+				perShard[index]++
+			}
+		}
+		if owners != 1 {
+			t.Fatalf("Namespace %s is owned by %d shards, want 1", namespace, owners)
+		}
+	}
+	for index, n := range perShard {
+		if n < 150 {
+			t.Errorf("Shard %d owns %d of 1000 namespaces, want a fair share", index, n)
+		}
+	}
+
+	if !(Shard{}).Owns("anything") {
+		t.Errorf("An unsharded replica should own every namespace")
+	}
+}
+
+func TestShard_Predicate(t *testing.T) {
+	shard := Shard{Index: 1, Count: 2}
+	pred := shard.Predicate()
+	for _, namespace := range []string{"default", "ml", "team-a", "team-b"} {
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "p", Namespace: namespace}}
+		if got := pred.Create(event.CreateEvent{Object: pod}); got != shard.Owns(namespace) {
+			t.Errorf("Predicate for namespace %s = %v, want %v", namespace, got, shard.Owns(namespace))
+		}
+	}
+
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "n"}}
+	if !pred.Create(event.CreateEvent{Object: node}) {
+		t.Errorf("Predicate should pass cluster-scoped objects to their map functions")
+	}
+}
+
+func TestShard_LeaderElectionID(t *testing.T) {
+	if got := (Shard{}).LeaderElectionID("ddfcb75e.main-currents.news"); got != "ddfcb75e.main-currents.news" {
+		t.Errorf("LeaderElectionID() = %v, want the base ID when not sharded", got)
+	}
+	if got := (Shard{Index: 1, Count: 3}).LeaderElectionID("ddfcb75e.main-currents.news"); got != "shard-1-of-3.ddfcb75e.main-currents.news" {
+		t.Errorf("LeaderElectionID() = %v, want shard-1-of-3.ddfcb75e.main-currents.news", got)
+	}
+}
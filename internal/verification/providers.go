@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// Verifier images
+	cosignImage = "ghcr.io/sigstore/cosign/cosign:latest"
+	gpgImage    = "debian:bookworm-slim"
+)
+
+func init() {
+	Register(cosignProvider{})
+	Register(gpgProvider{})
+}
+
+// cosignProvider verifies a cosign blob signature against a PEM public key.
+// The key pins the signer, so the transparency log is not consulted and the
+// Job needs no access to Rekor.
+type cosignProvider struct{}
+
+func (cosignProvider) Name() modelsv1alpha1.SignatureProvider {
+	return modelsv1alpha1.SignatureProviderCosign
+}
+
+func (cosignProvider) DefaultImage() string { return cosignImage }
+
+func (cosignProvider) Command(key, signature, file string) []string {
+	return []string{
+		"cosign", "verify-blob",
+		"--key", key,
+		"--signature", signature,
+		"--insecure-ignore-tlog=true",
+		file,
+	}
+}
+
+// gpgProvider verifies an OpenPGP detached signature with gpgv, which trusts
+// exactly the keys in the given keyring
+type gpgProvider struct{}
+
+func (gpgProvider) Name() modelsv1alpha1.SignatureProvider {
+	return modelsv1alpha1.SignatureProviderGPG
+}
+
+func (gpgProvider) DefaultImage() string { return gpgImage }
+
+func (gpgProvider) Command(key, signature, file string) []string {
+	return []string{"gpgv", "--keyring", key, signature, file}
+}
@@ -0,0 +1,278 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package verification builds post-download Jobs that verify a detached
+// signature over the downloaded model (cosign, GPG) before it becomes Ready.
+//
+// Each signature provider is implemented by a Provider registered under its
+// name. The Job fetches the signature into a scratch volume, mounts the model
+// volume read-only at /models and the public key at /keys, and fails unless
+// the provider accepts the signature.
+package verification
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"sync"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+const (
+	// Job configuration
+	backoffLimit            = int32(1)
+	ttlSecondsAfterFinished = int32(3600)
+
+	// Volume names and mount paths
+	modelVolumeName     = "model-storage"
+	modelMountPath      = "/models"
+	keyVolumeName       = "public-key"
+	keyMountPath        = "/keys"
+	keyFile             = "key"
+	signatureVolumeName = "signature"
+	signatureMountPath  = "/signature"
+	signatureFile       = "signature"
+
+	// Images fetching the signature and checking checksums
+	curlImage     = "curlimages/curl:latest"
+	s3Image       = "amazon/aws-cli:latest"
+	checksumImage = "busybox:latest"
+
+	// LabelProvider records the signature provider on the Job
+	LabelProvider = "models.main-currents.news/signature-provider"
+	// AnnotationContentDigest records the content digest the Job verifies,
+	// so a Job left over from a previous download is not trusted
+	AnnotationContentDigest = "models.main-currents.news/content-digest"
+)
+
+// Provider produces the container that verifies a detached signature
+type Provider interface {
+	// Name returns the signature provider this implements
+	Name() modelsv1alpha1.SignatureProvider
+	// DefaultImage returns the verifier image used unless spec.verification.signature.image is set
+	DefaultImage() string
+	// Command returns the command verifying signature over file with the public key
+	Command(key, signature, file string) []string
+}
+
+var (
+	mu        sync.RWMutex
+	providers = map[modelsv1alpha1.SignatureProvider]Provider{}
+)
+
+// Register makes a Provider available under its name, replacing any existing one
+func Register(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[p.Name()] = p
+}
+
+// Lookup returns the Provider registered under name
+func Lookup(name modelsv1alpha1.SignatureProvider) (Provider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("no signature provider registered as %q", name)
+	}
+	return p, nil
+}
+
+// Providers returns the registered signature providers, sorted
+func Providers() []modelsv1alpha1.SignatureProvider {
+	mu.RLock()
+	defer mu.RUnlock()
+	names := make([]modelsv1alpha1.SignatureProvider, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
+
+// containerResources are the resources of every verification container
+var containerResources = corev1.ResourceRequirements{
+	Requests: corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("100m"),
+		corev1.ResourceMemory: resource.MustParse("128Mi"),
+	},
+	Limits: corev1.ResourceList{
+		corev1.ResourceMemory: resource.MustParse("512Mi"),
+	},
+}
+
+// BuildJob creates the verification Job for the Model's spec.verification.signature
+func BuildJob(model *modelsv1alpha1.Model) (*batchv1.Job, error) {
+	if model.Spec.Verification == nil || model.Spec.Verification.Signature == nil {
+		return nil, fmt.Errorf("no signature verification specified in model %s", model.Name)
+	}
+	spec := model.Spec.Verification.Signature
+
+	provider, err := Lookup(spec.Provider)
+	if err != nil {
+		return nil, err
+	}
+	fetch, err := fetchContainer(model)
+	if err != nil {
+		return nil, err
+	}
+
+	image := spec.Image
+	if image == "" {
+		image = provider.DefaultImage()
+	}
+	signature := path.Join(signatureMountPath, signatureFile)
+	verifier := corev1.Container{
+		Name:      "verifier",
+		Image:     image,
+		Command:   provider.Command(path.Join(keyMountPath, keyFile), signature, path.Join(modelMountPath, spec.File)),
+		Resources: containerResources,
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: modelVolumeName, MountPath: modelMountPath, ReadOnly: true},
+			{Name: keyVolumeName, MountPath: keyMountPath, ReadOnly: true},
+			{Name: signatureVolumeName, MountPath: signatureMountPath, ReadOnly: true},
+		},
+	}
+
+	// Checksums are only trusted once the manifest's signature is verified
+	initContainers := []corev1.Container{fetch}
+	containers := []corev1.Container{verifier}
+	if spec.Checksums {
+		initContainers = append(initContainers, verifier)
+		containers = []corev1.Container{{
+			Name:       "checksums",
+			Image:      checksumImage,
+			Command:    []string{"sha256sum", "-c", spec.File},
+			WorkingDir: modelMountPath,
+			Resources:  containerResources,
+			VolumeMounts: []corev1.VolumeMount{
+				{Name: modelVolumeName, MountPath: modelMountPath, ReadOnly: true},
+			},
+		}}
+	}
+
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "model-verifier",
+		"app.kubernetes.io/instance":   model.Name,
+		"app.kubernetes.io/managed-by": "model-operator",
+		LabelProvider:                  string(spec.Provider),
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        resources.VerifyJobName(model.Name),
+			Namespace:   model.Namespace,
+			Labels:      labels,
+			Annotations: map[string]string{AnnotationContentDigest: model.Status.ContentDigest},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            ptr.To(backoffLimit),
+			TTLSecondsAfterFinished: ptr.To(ttlSecondsAfterFinished),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:  corev1.RestartPolicyOnFailure,
+					InitContainers: initContainers,
+					Containers:     containers,
+					Volumes: []corev1.Volume{
+						{
+							Name: modelVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+									ClaimName: resources.ClaimName(model),
+									ReadOnly:  true,
+								},
+							},
+						},
+						{
+							Name: keyVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: spec.PublicKey.Name,
+									Items:      []corev1.KeyToPath{{Key: spec.PublicKey.Key, Path: keyFile}},
+								},
+							},
+						},
+						{
+							Name:         signatureVolumeName,
+							VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// fetchContainer downloads the detached signature into the scratch volume
+func fetchContainer(model *modelsv1alpha1.Model) (corev1.Container, error) {
+	spec := model.Spec.Verification.Signature
+	signature := path.Join(signatureMountPath, signatureFile)
+	container := corev1.Container{
+		Name:      "fetch-signature",
+		Resources: containerResources,
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: signatureVolumeName, MountPath: signatureMountPath},
+		},
+	}
+
+	switch {
+	case spec.URL != "":
+		container.Image = curlImage
+		container.Command = []string{"curl", "-fsSL", "-o", signature, spec.URL}
+	case spec.S3Key != "":
+		s3 := model.Spec.Source.S3
+		if s3 == nil {
+			return container, fmt.Errorf("signature s3Key requires an S3 source in model %s", model.Name)
+		}
+		command := []string{"aws", "s3", "cp"}
+		if s3.Endpoint != "" {
+			command = append(command, "--endpoint-url", s3.Endpoint)
+		}
+		if s3.Region != "" {
+			command = append(command, "--region", s3.Region)
+		}
+		container.Image = s3Image
+		container.Command = append(command, fmt.Sprintf("s3://%s/%s", s3.Bucket, spec.S3Key), signature)
+		if model.Spec.CredentialsSecret != "" {
+			for _, key := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"} {
+				container.Env = append(container.Env, corev1.EnvVar{
+					Name: key,
+					ValueFrom: &corev1.EnvVarSource{
+						SecretKeyRef: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: model.Spec.CredentialsSecret},
+							Key:                  key,
+							Optional:             ptr.To(true),
+						},
+					},
+				})
+			}
+		}
+	default:
+		return container, fmt.Errorf("no signature location specified in model %s", model.Name)
+	}
+	return container, nil
+}
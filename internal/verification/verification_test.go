@@ -0,0 +1,219 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func testModel(sig *modelsv1alpha1.SignatureVerification) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "llama",
+			Namespace: "default",
+		},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/", Endpoint: "http://minio:9000"},
+			},
+			CredentialsSecret: "s3-creds",
+			Verification:      &modelsv1alpha1.VerificationSpec{Signature: sig},
+		},
+		Status: modelsv1alpha1.ModelStatus{ContentDigest: "sha256:abc"},
+	}
+}
+
+func signature(provider modelsv1alpha1.SignatureProvider) *modelsv1alpha1.SignatureVerification {
+	return &modelsv1alpha1.SignatureVerification{
+		Provider: provider,
+		PublicKey: corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "signing-key"},
+			Key:                  "cosign.pub",
+		},
+		File: "model.safetensors",
+		URL:  "https://example.com/model.safetensors.sig",
+	}
+}
+
+func TestProviders(t *testing.T) {
+	names := Providers()
+	if len(names) != 2 {
+		t.Fatalf("Providers() = %v, want 2 builtin providers", names)
+	}
+	if names[0] != modelsv1alpha1.SignatureProviderCosign || names[1] != modelsv1alpha1.SignatureProviderGPG {
+		t.Errorf("Providers() = %v, want [cosign gpg]", names)
+	}
+}
+
+func TestLookupUnknown(t *testing.T) {
+	if _, err := Lookup("notation"); err == nil {
+		t.Error("Lookup() expected error for unregistered provider")
+	}
+}
+
+func TestBuildJob(t *testing.T) {
+	tests := []struct {
+		name      string
+		sig       *modelsv1alpha1.SignatureVerification
+		wantImage string
+		wantCmd   string
+		wantFetch string
+	}{
+		{
+			name:      "cosign from URL",
+			sig:       signature(modelsv1alpha1.SignatureProviderCosign),
+			wantImage: cosignImage,
+			wantCmd:   "cosign verify-blob --key /keys/key --signature /signature/signature --insecure-ignore-tlog=true /models/model.safetensors",
+			wantFetch: "curl -fsSL -o /signature/signature https://example.com/model.safetensors.sig",
+		},
+		{
+			name: "gpg from S3",
+			sig: func() *modelsv1alpha1.SignatureVerification {
+				sig := signature(modelsv1alpha1.SignatureProviderGPG)
+				sig.URL = ""
+				sig.S3Key = "llama/model.safetensors.sig"
+				return sig
+			}(),
+			wantImage: gpgImage,
+			wantCmd:   "gpgv --keyring /keys/key /signature/signature /models/model.safetensors",
+			wantFetch: "aws s3 cp --endpoint-url http://minio:9000 s3://models/llama/model.safetensors.sig /signature/signature",
+		},
+		{
+			name: "image override",
+			sig: func() *modelsv1alpha1.SignatureVerification {
+				sig := signature(modelsv1alpha1.SignatureProviderCosign)
+				sig.Image = "registry.internal/cosign:v2"
+				return sig
+			}(),
+			wantImage: "registry.internal/cosign:v2",
+			wantCmd:   "cosign verify-blob",
+			wantFetch: "curl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job, err := BuildJob(testModel(tt.sig))
+			if err != nil {
+				t.Fatalf("BuildJob() error = %v", err)
+			}
+			if job.Name != "model-verify-llama" {
+				t.Errorf("Name = %v, want model-verify-llama", job.Name)
+			}
+			if job.Annotations[AnnotationContentDigest] != "sha256:abc" {
+				t.Errorf("content digest annotation = %v, want sha256:abc", job.Annotations[AnnotationContentDigest])
+			}
+
+			podSpec := job.Spec.Template.Spec
+			if len(podSpec.InitContainers) != 1 || len(podSpec.Containers) != 1 {
+				t.Fatalf("containers = %d init, %d main, want 1 and 1", len(podSpec.InitContainers), len(podSpec.Containers))
+			}
+			verifier := podSpec.Containers[0]
+			if verifier.Image != tt.wantImage {
+				t.Errorf("Image = %v, want %v", verifier.Image, tt.wantImage)
+			}
+			if cmd := strings.Join(verifier.Command, " "); !strings.Contains(cmd, tt.wantCmd) {
+				t.Errorf("Command = %v, want to contain %v", cmd, tt.wantCmd)
+			}
+			if fetch := strings.Join(podSpec.InitContainers[0].Command, " "); !strings.Contains(fetch, tt.wantFetch) {
+				t.Errorf("fetch Command = %v, want to contain %v", fetch, tt.wantFetch)
+			}
+
+			for _, mount := range verifier.VolumeMounts {
+				if !mount.ReadOnly {
+					t.Errorf("mount %s is writable, want read-only", mount.Name)
+				}
+			}
+			keyVolume := podSpec.Volumes[1]
+			if keyVolume.Secret == nil || keyVolume.Secret.SecretName != "signing-key" ||
+				keyVolume.Secret.Items[0].Key != "cosign.pub" {
+				t.Errorf("key volume = %+v, want signing-key/cosign.pub", keyVolume)
+			}
+		})
+	}
+}
+
+func TestBuildJobS3Credentials(t *testing.T) {
+	sig := signature(modelsv1alpha1.SignatureProviderCosign)
+	sig.URL = ""
+	sig.S3Key = "llama/model.sig"
+
+	job, err := BuildJob(testModel(sig))
+	if err != nil {
+		t.Fatalf("BuildJob() error = %v", err)
+	}
+	var names []string
+	for _, env := range job.Spec.Template.Spec.InitContainers[0].Env {
+		names = append(names, env.Name)
+		if env.ValueFrom.SecretKeyRef.Name != "s3-creds" {
+			t.Errorf("%s from %v, want s3-creds", env.Name, env.ValueFrom.SecretKeyRef.Name)
+		}
+	}
+	if !slices.Equal(names, []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY"}) {
+		t.Errorf("env = %v, want AWS credentials", names)
+	}
+}
+
+func TestBuildJobChecksums(t *testing.T) {
+	sig := signature(modelsv1alpha1.SignatureProviderGPG)
+	sig.File = "SHA256SUMS"
+	sig.Checksums = true
+
+	job, err := BuildJob(testModel(sig))
+	if err != nil {
+		t.Fatalf("BuildJob() error = %v", err)
+	}
+	podSpec := job.Spec.Template.Spec
+	if len(podSpec.InitContainers) != 2 || podSpec.InitContainers[1].Name != "verifier" {
+		t.Fatalf("init containers = %v, want fetch-signature and verifier", podSpec.InitContainers)
+	}
+	checksums := podSpec.Containers[0]
+	if got := strings.Join(checksums.Command, " "); got != "sha256sum -c SHA256SUMS" {
+		t.Errorf("checksums Command = %v, want sha256sum -c SHA256SUMS", got)
+	}
+	if checksums.WorkingDir != "/models" {
+		t.Errorf("WorkingDir = %v, want /models", checksums.WorkingDir)
+	}
+}
+
+func TestBuildJobErrors(t *testing.T) {
+	unknown := signature("notation")
+	noLocation := signature(modelsv1alpha1.SignatureProviderCosign)
+	noLocation.URL = ""
+	noS3Source := testModel(signature(modelsv1alpha1.SignatureProviderCosign))
+	noS3Source.Spec.Verification.Signature.URL = ""
+	noS3Source.Spec.Verification.Signature.S3Key = "model.sig"
+	noS3Source.Spec.Source.S3 = nil
+
+	for name, model := range map[string]*modelsv1alpha1.Model{
+		"no verification":  {ObjectMeta: metav1.ObjectMeta{Name: "llama"}},
+		"unknown provider": testModel(unknown),
+		"no location":      testModel(noLocation),
+		"s3Key without S3": noS3Source,
+	} {
+		if _, err := BuildJob(model); err == nil {
+			t.Errorf("%s: BuildJob() expected error", name)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// testScheme returns a scheme with the core and Model types
+func testScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return scheme
+}
+
+// fixtureModel returns a Model in the given phase
+func fixtureModel(name string, phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "org/" + name},
+			},
+			Storage:                  modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "10Gi"},
+			CredentialsSecret:        "hf-credentials",
+			AllowCredentialInjection: true,
+		},
+		Status: modelsv1alpha1.ModelStatus{Phase: phase},
+	}
+}
+
+// fixturePod returns a pod with the given annotations and labels
+func fixturePod(annotations, labels map[string]string, containers ...string) *corev1.Pod {
+	if len(containers) == 0 {
+		containers = []string{"main"}
+	}
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "inference",
+			Namespace:   "default",
+			Annotations: annotations,
+			Labels:      labels,
+		},
+	}
+	for _, name := range containers {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: name, Image: "vllm/vllm-openai:latest"})
+	}
+	return pod
+}
+
+// admitPod sends the pod through the injector and returns the response and
+// the pod with the response's patches applied
+func admitPod(t *testing.T, injector *ModelInjector, pod *corev1.Pod) (admission.Response, *corev1.Pod) {
+	t.Helper()
+
+	raw, err := json.Marshal(pod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp := injector.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Name:      pod.Name,
+		Namespace: pod.Namespace,
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}})
+	if len(resp.Patches) == 0 {
+		return resp, pod
+	}
+
+	ops, err := json.Marshal(resp.Patches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	patch, err := jsonpatch.DecodePatch(ops)
+	if err != nil {
+		t.Fatalf("Response patch is invalid: %v", err)
+	}
+	patched, err := patch.Apply(raw)
+	if err != nil {
+		t.Fatalf("Response patch does not apply: %v", err)
+	}
+	mutated := &corev1.Pod{}
+	if err := json.Unmarshal(patched, mutated); err != nil {
+		t.Fatal(err)
+	}
+	return resp, mutated
+}
+
+func TestModelInjector_Handle(t *testing.T) {
+	scheme := testScheme(t)
+	published := fixtureModel("published", modelsv1alpha1.ModelPhaseReady)
+	published.Status.Publication = &modelsv1alpha1.PublicationStatus{Image: "registry.internal/models/published@sha256:abc"}
+	restricted := fixtureModel("restricted", modelsv1alpha1.ModelPhaseReady)
+	restricted.Spec.Access = &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"inference"}}
+	servingPod := fixturePod(map[string]string{AnnotationInject: "restricted"}, nil)
+	servingPod.Spec.ServiceAccountName = "inference"
+	tokenizer := fixtureModel("tokenizer", modelsv1alpha1.ModelPhaseReady)
+	tokenizer.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeConfigMap, Size: "1Mi"}
+	classifier := fixtureModel("classifier", modelsv1alpha1.ModelPhaseReady)
+	classifier.Spec.Storage = modelsv1alpha1.StorageSpec{Mode: modelsv1alpha1.StorageModeImage, Size: "1Gi"}
+	classifier.Status.Publication = &modelsv1alpha1.PublicationStatus{Image: "registry.internal/models/classifier@sha256:def"}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		fixtureModel("llama", modelsv1alpha1.ModelPhaseReady),
+		fixtureModel("mistral", modelsv1alpha1.ModelPhaseReady),
+		fixtureModel("pending", modelsv1alpha1.ModelPhaseDownloading),
+		published,
+		restricted,
+		tokenizer,
+		classifier,
+		fixtureModel("my-model", modelsv1alpha1.ModelPhaseReady),
+		fixtureModel("my.model", modelsv1alpha1.ModelPhaseReady),
+	).Build()
+
+	tests := []struct {
+		name        string
+		pod         *corev1.Pod
+		wantAllowed bool
+		wantDenied  string
+		// check inspects the pod after the response's patches are applied
+		check func(t *testing.T, pod *corev1.Pod)
+	}{
+		{
+			name:        "no annotations",
+			pod:         fixturePod(nil, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 0 || pod.Labels[LabelInjected] != "" {
+					t.Errorf("Pod without annotations should not be mutated")
+				}
+			},
+		},
+		{
+			name:        "already injected",
+			pod:         fixturePod(map[string]string{AnnotationInject: "llama"}, map[string]string{LabelInjected: "true"}),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 0 {
+					t.Errorf("Already injected pod should not be mutated again")
+				}
+			},
+		},
+		{
+			name:        "single model",
+			pod:         fixturePod(map[string]string{AnnotationInject: "llama"}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].PersistentVolumeClaim == nil ||
+					pod.Spec.Volumes[0].PersistentVolumeClaim.ClaimName != resources.PVCName("llama") {
+					t.Errorf("Volumes = %v, want the llama PVC", pod.Spec.Volumes)
+				}
+				mounts := pod.Spec.Containers[0].VolumeMounts
+				if len(mounts) != 1 || mounts[0].MountPath != "/models/llama" || !mounts[0].ReadOnly {
+					t.Errorf("VolumeMounts = %v, want read-only /models/llama", mounts)
+				}
+				if pod.Labels[LabelInjected] != "true" {
+					t.Errorf("Pod should be labelled as injected")
+				}
+				if !hasEnv(pod.Spec.Containers[0], resources.EnvVarPrefix("llama")+"_MOUNT_PATH") {
+					t.Errorf("Env vars should be injected by default")
+				}
+			},
+		},
+		{
+			name:        "multiple models",
+			pod:         fixturePod(map[string]string{AnnotationInject: "llama, mistral"}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 2 || len(pod.Spec.Containers[0].VolumeMounts) != 2 {
+					t.Errorf("Both models should be mounted, got volumes %v", pod.Spec.Volumes)
+				}
+			},
+		},
+		{
+			name: "sub-path per model",
+			pod: fixturePod(map[string]string{
+				AnnotationInject:  "llama,mistral",
+				AnnotationSubPath: `{"llama": "gguf/q4_K_M/"}`,
+			}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				subPaths := map[string]string{}
+				for _, m := range pod.Spec.Containers[0].VolumeMounts {
+					subPaths[m.MountPath] = m.SubPath
+				}
+				if subPaths["/models/llama"] != "gguf/q4_K_M" || subPaths["/models/mistral"] != "" {
+					t.Errorf("VolumeMount sub-paths = %v, want gguf/q4_K_M for llama only", subPaths)
+				}
+			},
+		},
+		{
+			name:       "sub-path outside the model volume",
+			pod:        fixturePod(map[string]string{AnnotationInject: "llama", AnnotationSubPath: "../secrets"}, nil),
+			wantDenied: "invalid " + AnnotationSubPath,
+		},
+		{
+			name:        "models with the same env var prefix",
+			pod:         fixturePod(map[string]string{AnnotationInject: "my-model,my.model"}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				names := map[string]string{}
+				for _, e := range pod.Spec.Containers[0].Env {
+					if strings.HasSuffix(e.Name, "_NAME") {
+						names[e.Value] = e.Name
+					}
+				}
+				if len(names) != 2 || names["my-model"] == names["my.model"] {
+					t.Errorf("Env vars = %v, want distinct _NAME vars for both models", pod.Spec.Containers[0].Env)
+				}
+			},
+		},
+		{
+			name: "custom env var prefixes",
+			pod: fixturePod(map[string]string{
+				AnnotationInject:    "llama,mistral",
+				AnnotationEnvPrefix: `{"llama": "LLM"}`,
+			}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				container := pod.Spec.Containers[0]
+				if !hasEnv(container, "LLM_MOUNT_PATH") || hasEnv(container, resources.EnvVarPrefix("llama")+"_MOUNT_PATH") {
+					t.Errorf("Env = %v, want LLM_MOUNT_PATH instead of the derived name", container.Env)
+				}
+				if !hasEnv(container, resources.EnvVarPrefix("mistral")+"_MOUNT_PATH") {
+					t.Errorf("Env = %v, want the derived prefix for mistral", container.Env)
+				}
+			},
+		},
+		{
+			name: "plain env var prefix for several models",
+			pod: fixturePod(map[string]string{
+				AnnotationInject:    "llama,mistral",
+				AnnotationEnvPrefix: "LLM",
+			}, nil),
+			wantDenied: "a plain prefix applies to a single model",
+		},
+		{
+			name: "options",
+			pod: fixturePod(map[string]string{
+				AnnotationInject:    "llama",
+				AnnotationMountPath: "/data",
+				AnnotationReadOnly:  "false",
+				AnnotationContainer: "server",
+				AnnotationInjectEnv: "false",
+			}, nil, "proxy", "server"),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Containers[0].VolumeMounts) != 0 {
+					t.Errorf("Only the target container should be mounted")
+				}
+				server := pod.Spec.Containers[1]
+				if len(server.VolumeMounts) != 1 || server.VolumeMounts[0].MountPath != "/data/llama" ||
+					server.VolumeMounts[0].ReadOnly {
+					t.Errorf("VolumeMounts = %v, want read-write /data/llama", server.VolumeMounts)
+				}
+				if len(server.Env) != 0 {
+					t.Errorf("Env = %v, want none", server.Env)
+				}
+			},
+		},
+		{
+			name:        "image volume",
+			pod:         fixturePod(map[string]string{AnnotationInject: "published", AnnotationVolumeSource: VolumeSourceImage}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].Image == nil ||
+					pod.Spec.Volumes[0].Image.Reference != published.Status.Publication.Image {
+					t.Errorf("Volumes = %v, want the published image", pod.Spec.Volumes)
+				}
+			},
+		},
+		{
+			name:        "configmap storage",
+			pod:         fixturePod(map[string]string{AnnotationInject: "tokenizer"}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].ConfigMap == nil ||
+					pod.Spec.Volumes[0].ConfigMap.Name != resources.ContentConfigMapName("tokenizer") {
+					t.Errorf("Volumes = %v, want the tokenizer ConfigMap", pod.Spec.Volumes)
+				}
+				if mounts := pod.Spec.Containers[0].VolumeMounts; len(mounts) != 1 || mounts[0].MountPath != "/models/tokenizer" {
+					t.Errorf("VolumeMounts = %v, want /models/tokenizer", mounts)
+				}
+			},
+		},
+		{
+			name:        "image storage",
+			pod:         fixturePod(map[string]string{AnnotationInject: "classifier"}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].Image == nil ||
+					pod.Spec.Volumes[0].Image.Reference != classifier.Status.Publication.Image {
+					t.Errorf("Volumes = %v, want the pushed image without asking for it", pod.Spec.Volumes)
+				}
+			},
+		},
+		{
+			name:        "credentials",
+			pod:         fixturePod(map[string]string{AnnotationInject: "llama", AnnotationInjectCredentials: CredentialsModeEnv}, nil),
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if !hasEnv(pod.Spec.Containers[0], "HF_TOKEN") {
+					t.Errorf("HF_TOKEN should be injected")
+				}
+			},
+		},
+		{
+			name:        "allowed by access policy",
+			pod:         servingPod,
+			wantAllowed: true,
+			check: func(t *testing.T, pod *corev1.Pod) {
+				if len(pod.Spec.Volumes) != 1 {
+					t.Errorf("Volumes = %v, want the restricted model's PVC", pod.Spec.Volumes)
+				}
+			},
+		},
+		{
+			name:       "denied by access policy",
+			pod:        fixturePod(map[string]string{AnnotationInject: "llama,restricted"}, nil),
+			wantDenied: `pod may not mount model "restricted": service account "default"`,
+		},
+		{
+			name:       "model not found",
+			pod:        fixturePod(map[string]string{AnnotationInject: "missing"}, nil),
+			wantDenied: `model "missing" not found`,
+		},
+		{
+			name:       "model not ready",
+			pod:        fixturePod(map[string]string{AnnotationInject: "llama,pending"}, nil),
+			wantDenied: `model "pending" is not ready (phase: Downloading)`,
+		},
+		{
+			name:       "unpublished image volume",
+			pod:        fixturePod(map[string]string{AnnotationInject: "llama", AnnotationVolumeSource: VolumeSourceImage}, nil),
+			wantDenied: "has not been published",
+		},
+		{
+			name:       "container not found",
+			pod:        fixturePod(map[string]string{AnnotationInject: "llama", AnnotationContainer: "missing"}, nil),
+			wantDenied: `container "missing" not found`,
+		},
+	}
+
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, pod := admitPod(t, injector, tt.pod)
+			if tt.wantDenied != "" {
+				if resp.Allowed {
+					t.Fatalf("Handle() allowed, want denied with %q", tt.wantDenied)
+				}
+				if !strings.Contains(resp.Result.Message, tt.wantDenied) {
+					t.Errorf("Handle() message = %q, want it to contain %q", resp.Result.Message, tt.wantDenied)
+				}
+				return
+			}
+			if resp.Allowed != tt.wantAllowed {
+				t.Fatalf("Handle() allowed = %v, want %v: %v", resp.Allowed, tt.wantAllowed, resp.Result)
+			}
+			if tt.check != nil {
+				tt.check(t, pod)
+			}
+		})
+	}
+}
+
+// controllerPod returns a pod as a controller creates it from its template:
+// with a generateName instead of a name and a controller owner reference
+func controllerPod(kind, owner string, annotations map[string]string) *corev1.Pod {
+	pod := fixturePod(annotations, nil)
+	pod.Name = ""
+	pod.GenerateName = owner + "-"
+	pod.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "apps/v1",
+		Kind:       kind,
+		Name:       owner,
+		UID:        "6a1f8e2c",
+		Controller: ptr.To(true),
+	}}
+	return pod
+}
+
+func TestModelInjector_ControllerPods(t *testing.T) {
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		fixtureModel("llama", modelsv1alpha1.ModelPhaseReady),
+	).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	for _, kind := range []string{"ReplicaSet", "Job", "DaemonSet"} {
+		t.Run(kind, func(t *testing.T) {
+			pod := controllerPod(kind, "inference-7d9f", map[string]string{AnnotationInject: "llama", AnnotationInjectEnv: "true"})
+
+			// The request carries no name, as the API server has yet to generate it
+			resp, mutated := admitPod(t, injector, pod)
+			if !resp.Allowed || len(resp.Patches) == 0 {
+				t.Fatalf("Handle() allowed = %v with %d patches, want an injected pod: %v", resp.Allowed, len(resp.Patches), resp.Result)
+			}
+			if mutated.Labels[LabelInjected] != "true" {
+				t.Errorf("Pod labels = %v, want %s", mutated.Labels, LabelInjected)
+			}
+			if len(mutated.Spec.Volumes) != 1 || mutated.Spec.Volumes[0].PersistentVolumeClaim.ClaimName != resources.PVCName("llama") {
+				t.Errorf("Pod volumes = %v, want the llama PVC", mutated.Spec.Volumes)
+			}
+			if !hasEnv(mutated.Spec.Containers[0], "MODEL_LLAMA_MOUNT_PATH") {
+				t.Errorf("Pod env = %v, want MODEL_LLAMA_MOUNT_PATH", mutated.Spec.Containers[0].Env)
+			}
+		})
+	}
+}
+
+func hasEnv(container corev1.Container, name string) bool {
+	for _, e := range container.Env {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// TestWebhooks_Envtest serves the webhooks to a real API server, so requests
+// go through the generated webhook configuration and admission is end to end.
+// It needs the envtest binaries, as set up by make test.
+func TestWebhooks_Envtest(t *testing.T) {
+	if os.Getenv("KUBEBUILDER_ASSETS") == "" {
+		t.Skip("KUBEBUILDER_ASSETS not set, run with make test")
+	}
+
+	scheme := testScheme(t)
+	env := &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths: []string{filepath.Join("..", "..", "config", "webhook")},
+		},
+	}
+	cfg, err := env.Start()
+	if err != nil {
+		t.Fatalf("Failed to start envtest: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := env.Stop(); err != nil {
+			t.Errorf("Failed to stop envtest: %v", err)
+		}
+	})
+
+	opts := env.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:  scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Host:    opts.LocalServingHost,
+			Port:    opts.LocalServingPort,
+			CertDir: opts.LocalServingCertDir,
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create manager: %v", err)
+	}
+
+	// Read Models uncached so status updates are seen by the next admission
+	c, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	decoder := admission.NewDecoder(scheme)
+	server := mgr.GetWebhookServer()
+	server.Register(PathModelInjector, &webhook.Admission{Handler: &ModelInjector{Client: c, Decoder: decoder}})
+	server.Register(PathModelOverlay, &webhook.Admission{
+		Handler: &ModelOverlayDefaulter{Environment: "prod", Decoder: decoder},
+	})
+	server.Register(PathModelAudit, &webhook.Admission{
+		Handler: &ModelAuditor{Client: c, Decoder: decoder, ConfigMap: "model-audit"},
+	})
+	server.Register(PathModelRetention, &webhook.Admission{Handler: &ModelRetention{Client: c, Decoder: decoder}})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			t.Errorf("Failed to run manager: %v", err)
+		}
+	}()
+
+	// Wait for the webhook server to serve TLS
+	addr := net.JoinHostPort(opts.LocalServingHost, fmt.Sprint(opts.LocalServingPort))
+	err = wait.PollUntilContextTimeout(ctx, 100*time.Millisecond, 30*time.Second, true, func(context.Context) (bool, error) {
+		conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr,
+			&tls.Config{InsecureSkipVerify: true}) // #nosec G402 -- test certificate
+		if err != nil {
+			return false, nil
+		}
+		return true, conn.Close()
+	})
+	if err != nil {
+		t.Fatalf("Webhook server did not start: %v", err)
+	}
+
+	// Models are admitted through the overlay and audit webhooks
+	llama := fixtureModel("llama", "")
+	llama.Spec.Overlays = map[string]modelsv1alpha1.ModelOverlay{"prod": {StorageClass: "longhorn"}}
+	if err := c.Create(ctx, llama); err != nil {
+		t.Fatalf("Failed to create Model: %v", err)
+	}
+	if llama.Spec.Storage.StorageClass != "longhorn" || llama.Annotations[AnnotationOverlay] != "prod" {
+		t.Errorf("Overlay not applied on admission: storageClass %q, annotations %v",
+			llama.Spec.Storage.StorageClass, llama.Annotations)
+	}
+	audit := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "model-audit", Namespace: "default"}, audit); err != nil ||
+		audit.Data["llama"] == "" {
+		t.Errorf("Model creation not audited: %v", err)
+	}
+
+	pending := fixtureModel("pending", "")
+	if err := c.Create(ctx, pending); err != nil {
+		t.Fatalf("Failed to create Model: %v", err)
+	}
+
+	llama.Status.Phase = modelsv1alpha1.ModelPhaseReady
+	if err := c.Status().Update(ctx, llama); err != nil {
+		t.Fatalf("Failed to update Model status: %v", err)
+	}
+
+	t.Run("injects a ready model", func(t *testing.T) {
+		pod := fixturePod(map[string]string{AnnotationInject: "llama", AnnotationInjectCredentials: CredentialsModeFile}, nil)
+		pod.Name = "ready"
+		if err := c.Create(ctx, pod); err != nil {
+			t.Fatalf("Failed to create pod: %v", err)
+		}
+
+		if pod.Labels[LabelInjected] != "true" {
+			t.Errorf("Pod should be labelled as injected, got %v", pod.Labels)
+		}
+		volumes := map[string]bool{}
+		for _, v := range pod.Spec.Volumes {
+			volumes[v.Name] = true
+		}
+		if !volumes[resources.VolumeName("llama")] || !volumes[resources.TokenVolumeName("llama")] {
+			t.Errorf("Volumes = %v, want the model and token volumes", pod.Spec.Volumes)
+		}
+		if !hasEnv(pod.Spec.Containers[0], "HF_TOKEN_PATH") {
+			t.Errorf("HF_TOKEN_PATH should be injected")
+		}
+	})
+
+	t.Run("denies a model that is not ready", func(t *testing.T) {
+		pod := fixturePod(map[string]string{AnnotationInject: "llama,pending"}, nil)
+		pod.Name = "not-ready"
+		err := c.Create(ctx, pod)
+		if err == nil || !strings.Contains(err.Error(), `model "pending" is not ready`) {
+			t.Errorf("Create() error = %v, want a not ready denial", err)
+		}
+	})
+
+	t.Run("leaves other pods alone", func(t *testing.T) {
+		pod := fixturePod(nil, nil)
+		pod.Name = "plain"
+		if err := c.Create(ctx, pod); err != nil {
+			t.Fatalf("Failed to create pod: %v", err)
+		}
+		if len(pod.Spec.Volumes) != 0 || pod.Labels[LabelInjected] != "" {
+			t.Errorf("Pod without annotations should not be mutated")
+		}
+	})
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// podsDenied counts pods the injector denied because a model was not Ready
+var podsDenied = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "model_operator_pods_denied_total",
+	Help: "Pods denied by the model injector because a requested Model was not Ready.",
+}, []string{"namespace", "model", "phase"})
+
+// deprecatedPods counts pods requesting a deprecated model, by whether the
+// injector warned about or denied them
+var deprecatedPods = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Name: "model_operator_deprecated_model_pods_total",
+	Help: "Pods requesting a deprecated Model, by action: warned or denied.",
+}, []string{"namespace", "model", "action"})
+
+func init() {
+	metrics.Registry.MustRegister(podsDenied, deprecatedPods)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// defaultServiceAccount is the service account of pods that do not name one
+const defaultServiceAccount = "default"
+
+// jobControllerUser is the user the Job controller creates pods as
+const jobControllerUser = "system:serviceaccount:kube-system:job-controller"
+
+// checkAccess returns an error unless the Model's access policy allows the
+// pod to mount it: by its service account or by matching a selector. Pod
+// authors set their pod's labels themselves, so a selector only keeps out
+// pods that do not try to match it; the service account is the stronger
+// check where RBAC limits who may use it.
+func checkAccess(pod *corev1.Pod, model *modelsv1alpha1.Model) error {
+	access := model.Spec.Access
+	if access == nil {
+		return nil
+	}
+
+	serviceAccount := podServiceAccount(pod)
+	if slices.Contains(access.AllowedServiceAccounts, serviceAccount) {
+		return nil
+	}
+
+	for i := range access.AllowedSelectors {
+		selector, err := metav1.LabelSelectorAsSelector(&access.AllowedSelectors[i])
+		if err != nil {
+			return fmt.Errorf("spec.access.allowedSelectors[%d] is invalid: %w", i, err)
+		}
+		if !selector.Empty() && selector.Matches(labels.Set(pod.Labels)) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("service account %q and pod labels are not allowed by spec.access", serviceAccount)
+}
+
+// modelClaims returns the PVCs holding the Model's files: its own and those
+// of its replicas
+func modelClaims(model *modelsv1alpha1.Model) map[string]bool {
+	claims := map[string]bool{resources.ClaimName(model): true}
+	if model.Status.PVCName != "" {
+		claims[model.Status.PVCName] = true
+	}
+	for _, replica := range model.Spec.Replicas {
+		claims[resources.ReplicaPVCName(model.Name, replica.Name)] = true
+	}
+	for _, status := range model.Status.Replicas {
+		if status.PVCName != "" {
+			claims[status.PVCName] = true
+		}
+	}
+	return claims
+}
+
+// checkClaimAccess applies the access policies of the Models whose PVCs, or
+// replica PVCs, the pod mounts as volumes of its own rather than through
+// injection. It returns why the pod is denied, or an empty string if it is
+// allowed.
+func (m *ModelInjector) checkClaimAccess(ctx context.Context, req admission.Request, pod *corev1.Pod) (string, error) {
+	var claims []string
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	if len(claims) == 0 {
+		return "", nil
+	}
+
+	models := &modelsv1alpha1.ModelList{}
+	if err := m.Client.List(ctx, models, client.InNamespace(req.Namespace)); err != nil {
+		return "", err
+	}
+	for i := range models.Items {
+		model := &models.Items[i]
+		if model.Spec.Access == nil {
+			continue
+		}
+		owned := modelClaims(model)
+		if !slices.ContainsFunc(claims, func(claim string) bool { return owned[claim] }) {
+			continue
+		}
+		if m.createdForModel(ctx, req, pod, model) {
+			continue
+		}
+		if err := checkAccess(pod, model); err != nil {
+			return fmt.Sprintf("pod may not mount the PVC of model %q: %v", model.Name, err), nil
+		}
+	}
+	return "", nil
+}
+
+// createdForModel reports whether the pod belongs to a Job the Model
+// controls, as the operator's download, replica and conversion pods do.
+// These mount the Model's PVC whatever its access policy. Only pods the Job
+// controller creates qualify, since anyone can put an owner reference on a
+// pod. A Model with an access policy has no file server, so no Deployment
+// qualifies.
+func (m *ModelInjector) createdForModel(ctx context.Context, req admission.Request, pod *corev1.Pod, model *modelsv1alpha1.Model) bool {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil || owner.Kind != "Job" || req.UserInfo.Username != jobControllerUser {
+		return false
+	}
+	job := &batchv1.Job{}
+	if err := m.Client.Get(ctx, types.NamespacedName{Name: owner.Name, Namespace: req.Namespace}, job); err != nil {
+		return false
+	}
+	return job.UID == owner.UID && metav1.IsControlledBy(job, model)
+}
+
+// podServiceAccount returns the service account the pod runs as
+func podServiceAccount(pod *corev1.Pod) string {
+	switch {
+	case pod.Spec.ServiceAccountName != "":
+		return pod.Spec.ServiceAccountName
+	case pod.Spec.DeprecatedServiceAccount != "":
+		return pod.Spec.DeprecatedServiceAccount
+	default:
+		return defaultServiceAccount
+	}
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+func TestCheckAccess(t *testing.T) {
+	tests := []struct {
+		name           string
+		access         *modelsv1alpha1.ModelAccess
+		serviceAccount string
+		labels         map[string]string
+		wantErr        bool
+	}{
+		{name: "no policy", access: nil},
+		{
+			name:           "allowed service account",
+			access:         &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"inference"}},
+			serviceAccount: "inference",
+		},
+		{
+			name:           "other service account",
+			access:         &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"inference"}},
+			serviceAccount: "notebook",
+			wantErr:        true,
+		},
+		{
+			name:   "default service account",
+			access: &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"default"}},
+		},
+		{
+			name: "matching selector",
+			access: &modelsv1alpha1.ModelAccess{AllowedSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"app": "vllm"}},
+			}},
+			labels: map[string]string{"app": "vllm", "tier": "serving"},
+		},
+		{
+			name: "no matching selector",
+			access: &modelsv1alpha1.ModelAccess{AllowedSelectors: []metav1.LabelSelector{
+				{MatchLabels: map[string]string{"app": "vllm"}},
+			}},
+			labels:  map[string]string{"app": "notebook"},
+			wantErr: true,
+		},
+		{
+			name: "empty selector matches nothing",
+			access: &modelsv1alpha1.ModelAccess{AllowedSelectors: []metav1.LabelSelector{
+				{},
+			}},
+			labels:  map[string]string{"app": "vllm"},
+			wantErr: true,
+		},
+		{
+			name: "service account or selector",
+			access: &modelsv1alpha1.ModelAccess{
+				AllowedServiceAccounts: []string{"inference"},
+				AllowedSelectors:       []metav1.LabelSelector{{MatchLabels: map[string]string{"app": "vllm"}}},
+			},
+			serviceAccount: "notebook",
+			labels:         map[string]string{"app": "vllm"},
+		},
+		{
+			name: "invalid selector",
+			access: &modelsv1alpha1.ModelAccess{AllowedSelectors: []metav1.LabelSelector{{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "app", Operator: "Near"}},
+			}}},
+			labels:  map[string]string{"app": "vllm"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := fixtureModel("llama", modelsv1alpha1.ModelPhaseReady)
+			model.Spec.Access = tt.access
+			pod := fixturePod(nil, tt.labels)
+			pod.Spec.ServiceAccountName = tt.serviceAccount
+
+			err := checkAccess(pod, model)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("checkAccess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckClaimAccess(t *testing.T) {
+	restricted := fixtureModel("llama", modelsv1alpha1.ModelPhaseReady)
+	restricted.UID = "llama-uid"
+	restricted.Spec.Access = &modelsv1alpha1.ModelAccess{AllowedServiceAccounts: []string{"inference"}}
+	restricted.Spec.Replicas = []modelsv1alpha1.StorageReplica{{Name: "zone-b"}}
+	open := fixtureModel("mistral", modelsv1alpha1.ModelPhaseReady)
+
+	controlledBy := func(owner metav1.Object, kind string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: owner.GetName(), UID: owner.GetUID(), Controller: ptr.To(true)}}
+	}
+	download := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+		Name: resources.JobName("llama"), Namespace: "default", UID: "job-uid",
+		OwnerReferences: controlledBy(restricted, "Model"),
+	}}
+	userJob := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "evaluate", Namespace: "default", UID: "user-job-uid"}}
+	// A ReplicaSet named like the file server's, which a restricted Model
+	// does not have
+	replicas := &appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+		Name: resources.FileServerName("llama") + "-5c8d", Namespace: "default", UID: "rs-uid",
+	}}
+
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(restricted, open, download, userJob, replicas).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	pod := func(claim, serviceAccount string, owners []metav1.OwnerReference) *corev1.Pod {
+		p := fixturePod(nil, nil)
+		p.Spec.ServiceAccountName = serviceAccount
+		p.OwnerReferences = owners
+		p.Spec.Volumes = []corev1.Volume{{Name: "weights", VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim},
+		}}}
+		return p
+	}
+
+	tests := []struct {
+		name       string
+		pod        *corev1.Pod
+		user       string
+		wantDenied bool
+	}{
+		{name: "model PVC", pod: pod(resources.PVCName("llama"), "notebook", nil), wantDenied: true},
+		{name: "replica PVC", pod: pod(resources.ReplicaPVCName("llama", "zone-b"), "notebook", nil), wantDenied: true},
+		{name: "allowed service account", pod: pod(resources.PVCName("llama"), "inference", nil)},
+		{name: "unrestricted model", pod: pod(resources.PVCName("mistral"), "notebook", nil)},
+		{name: "other PVC", pod: pod("scratch", "notebook", nil)},
+		{name: "operator download pod", pod: pod(resources.PVCName("llama"), "", controlledBy(download, "Job")), user: jobControllerUser},
+		{
+			name:       "ReplicaSet named like a file server",
+			pod:        pod(resources.PVCName("llama"), "notebook", controlledBy(replicas, "ReplicaSet")),
+			user:       "system:serviceaccount:kube-system:replicaset-controller",
+			wantDenied: true,
+		},
+		{
+			name:       "forged owner reference",
+			pod:        pod(resources.PVCName("llama"), "notebook", controlledBy(download, "Job")),
+			user:       "alice@example.com",
+			wantDenied: true,
+		},
+		{
+			name:       "Job the Model does not control",
+			pod:        pod(resources.PVCName("llama"), "notebook", controlledBy(userJob, "Job")),
+			user:       jobControllerUser,
+			wantDenied: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Namespace: "default",
+				UserInfo:  authenticationv1.UserInfo{Username: tt.user},
+			}}
+			denied, err := injector.checkClaimAccess(context.Background(), req, tt.pod)
+			if err != nil {
+				t.Fatalf("checkClaimAccess() error = %v", err)
+			}
+			if (denied != "") != tt.wantDenied {
+				t.Errorf("checkClaimAccess() denied = %q, want denied %v", denied, tt.wantDenied)
+			}
+			if tt.wantDenied && !strings.Contains(denied, `model "llama"`) {
+				t.Errorf("Denial %q should name the model", denied)
+			}
+		})
+	}
+
+	// Handle applies the check to pods that request no injection
+	resp, _ := admitPod(t, injector, pod(resources.PVCName("llama"), "notebook", nil))
+	if resp.Allowed {
+		t.Error("Handle() allowed a pod mounting a restricted model's PVC by hand")
+	}
+}
@@ -0,0 +1,296 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// MaxAuditEntries is how many audit entries are kept per Model in the audit ConfigMap
+const MaxAuditEntries = 50
+
+// maxEventMessage bounds audit event messages; the full diff is in the ConfigMap
+const maxEventMessage = 1024
+
+// Event reasons recorded on audited Models
+const (
+	ReasonModelCreated = "Created"
+	ReasonSpecChanged  = "SpecChanged"
+)
+
+// FieldChange is a single changed field of the Model source
+type FieldChange struct {
+	// Path is the dotted JSON path within spec.source (e.g. "huggingFace.revision")
+	Path string `json:"path"`
+	Old  string `json:"old,omitempty"`
+	New  string `json:"new,omitempty"`
+}
+
+// AuditEntry records one create or spec change of a Model
+type AuditEntry struct {
+	Time      time.Time `json:"time"`
+	User      string    `json:"user"`
+	Operation string    `json:"operation"`
+	// Fields are the top-level spec fields that changed
+	Fields []string `json:"fields"`
+	// Source is the structured diff of spec.source
+	Source []FieldChange `json:"source,omitempty"`
+}
+
+// ModelAuditor records who created or changed a Model, and how its source
+// changed, as events and optionally in a ConfigMap. It never denies a request.
+// +kubebuilder:webhook:path=/validate-models-v1alpha1-model-audit,mutating=false,failurePolicy=ignore,sideEffects=NoneOnDryRun,groups=models.main-currents.news,resources=models,verbs=create;update,versions=v1alpha1,name=model-audit.models.main-currents.news,admissionReviewVersions=v1
+
+type ModelAuditor struct {
+	Client   client.Client
+	Decoder  admission.Decoder
+	Recorder record.EventRecorder
+
+	// ConfigMap names the ConfigMap in each Model namespace that keeps the
+	// audit log, keyed by Model name. Empty records events only.
+	ConfigMap string
+}
+
+// Handle processes admission requests for Models
+func (a *ModelAuditor) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx).WithName("model-audit")
+
+	if req.DryRun != nil && *req.DryRun {
+		return admission.Allowed("dry run")
+	}
+
+	model := &modelsv1alpha1.Model{}
+	if err := a.Decoder.Decode(req, model); err != nil {
+		log.Error(err, "Failed to decode model")
+		return admission.Allowed("undecodable model not audited")
+	}
+
+	old := &modelsv1alpha1.Model{}
+	if req.Operation == admissionv1.Update {
+		if err := a.Decoder.DecodeRaw(req.OldObject, old); err != nil {
+			log.Error(err, "Failed to decode old model")
+			return admission.Allowed("undecodable model not audited")
+		}
+		if equality.Semantic.DeepEqual(old.Spec, model.Spec) {
+			return admission.Allowed("spec unchanged")
+		}
+	}
+
+	entry, err := newAuditEntry(req.UserInfo.Username, string(req.Operation), &old.Spec, &model.Spec)
+	if err != nil {
+		log.Error(err, "Failed to diff model spec")
+		return admission.Allowed("spec not diffable")
+	}
+
+	// Events on a created Model carry no UID yet, but still list under its name
+	if model.Name == "" {
+		model.Name = req.Name
+	}
+	reason := ReasonSpecChanged
+	if req.Operation == admissionv1.Create {
+		reason = ReasonModelCreated
+	}
+	if a.Recorder != nil {
+		message := entry.String()
+		if len(message) > maxEventMessage {
+			message = message[:maxEventMessage-3] + "..."
+		}
+		a.Recorder.Event(model, corev1.EventTypeNormal, reason, message)
+	}
+
+	if a.ConfigMap != "" {
+		if err := a.appendToConfigMap(ctx, req.Namespace, model.Name, entry); err != nil {
+			log.Error(err, "Failed to append to audit log", "configMap", a.ConfigMap)
+		}
+	}
+
+	log.Info("Audited model change", "model", model.Name, "namespace", req.Namespace,
+		"user", entry.User, "fields", entry.Fields)
+	return admission.Allowed("audited")
+}
+
+// newAuditEntry diffs two Model specs
+func newAuditEntry(user, operation string, old, updated *modelsv1alpha1.ModelSpec) (AuditEntry, error) {
+	entry := AuditEntry{Time: time.Now().UTC(), User: user, Operation: operation}
+
+	oldFields, err := flatten(old)
+	if err != nil {
+		return entry, err
+	}
+	newFields, err := flatten(updated)
+	if err != nil {
+		return entry, err
+	}
+
+	for _, change := range diffFields(oldFields, newFields) {
+		field, rest, _ := strings.Cut(change.Path, ".")
+		if !slices.Contains(entry.Fields, field) {
+			entry.Fields = append(entry.Fields, field)
+		}
+		if field == "source" {
+			change.Path = rest
+			entry.Source = append(entry.Source, change)
+		}
+	}
+	return entry, nil
+}
+
+// String formats the entry as an event message
+func (e AuditEntry) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s changed spec (%s)", e.User, strings.Join(e.Fields, ", "))
+	for i, change := range e.Source {
+		if i == 0 {
+			b.WriteString(": source ")
+		} else {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s %q -> %q", change.Path, change.Old, change.New)
+	}
+	return b.String()
+}
+
+// flatten converts an object into a map of dotted JSON paths to scalar values
+func flatten(obj any) (map[string]string, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	// Keep numbers as written rather than as floats
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	fields := map[string]string{}
+	var walk func(prefix string, v any)
+	walk = func(prefix string, v any) {
+		join := func(key string) string {
+			if prefix == "" {
+				return key
+			}
+			return prefix + "." + key
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			for key, child := range v {
+				walk(join(key), child)
+			}
+		case []any:
+			for i, child := range v {
+				walk(join(fmt.Sprint(i)), child)
+			}
+		case nil:
+		default:
+			fields[prefix] = fmt.Sprint(v)
+		}
+	}
+	walk("", value)
+	return fields, nil
+}
+
+// diffFields returns the changed paths, sorted
+func diffFields(old, updated map[string]string) []FieldChange {
+	var changes []FieldChange
+	for path, value := range updated {
+		if old[path] != value {
+			changes = append(changes, FieldChange{Path: path, Old: old[path], New: value})
+		}
+	}
+	for path, value := range old {
+		if _, ok := updated[path]; !ok {
+			changes = append(changes, FieldChange{Path: path, Old: value})
+		}
+	}
+	slices.SortFunc(changes, func(a, b FieldChange) int { return strings.Compare(a.Path, b.Path) })
+	return changes
+}
+
+// appendToConfigMap adds the entry to the Model's audit log, keeping the last
+// MaxAuditEntries entries as JSON lines
+func (a *ModelAuditor) appendToConfigMap(ctx context.Context, namespace, modelName string, entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	// A concurrent change may create the ConfigMap or update it first
+	retriable := func(err error) bool { return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err) }
+	return retry.OnError(retry.DefaultRetry, retriable, func() error {
+		cm := &corev1.ConfigMap{}
+		err := a.Client.Get(ctx, types.NamespacedName{Name: a.ConfigMap, Namespace: namespace}, cm)
+		if apierrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      a.ConfigMap,
+					Namespace: namespace,
+					Labels: map[string]string{
+						"app.kubernetes.io/name":       "model-audit",
+						"app.kubernetes.io/managed-by": "model-operator",
+					},
+				},
+				Data: map[string]string{modelName: string(line)},
+			}
+			return a.Client.Create(ctx, cm)
+		}
+		if err != nil {
+			return err
+		}
+
+		if cm.Data == nil {
+			cm.Data = map[string]string{}
+		}
+		cm.Data[modelName] = appendAuditLine(cm.Data[modelName], string(line))
+		return a.Client.Update(ctx, cm)
+	})
+}
+
+// appendAuditLine appends a line to a JSON lines log, dropping the oldest
+// lines beyond MaxAuditEntries
+func appendAuditLine(log, line string) string {
+	var lines []string
+	if log != "" {
+		lines = strings.Split(log, "\n")
+	}
+	lines = append(lines, line)
+	if len(lines) > MaxAuditEntries {
+		lines = lines[len(lines)-MaxAuditEntries:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestNewAuditEntry(t *testing.T) {
+	old := overlayModel().Spec
+	updated := overlayModel().Spec
+	updated.Source.HuggingFace.Revision = "a1b2c3"
+	updated.Storage.Size = "40Gi"
+
+	entry, err := newAuditEntry("alice@example.com", "UPDATE", &old, &updated)
+	if err != nil {
+		t.Fatalf("newAuditEntry() error = %v", err)
+	}
+
+	if got := strings.Join(entry.Fields, ","); got != "source,storage" {
+		t.Errorf("Fields = %v, want source,storage", got)
+	}
+	want := []FieldChange{{Path: "huggingFace.revision", New: "a1b2c3"}}
+	if len(entry.Source) != 1 || entry.Source[0] != want[0] {
+		t.Errorf("Source = %v, want %v", entry.Source, want)
+	}
+	if msg := entry.String(); !strings.Contains(msg, `alice@example.com changed spec (source, storage): source huggingFace.revision "" -> "a1b2c3"`) {
+		t.Errorf("String() = %q", msg)
+	}
+}
+
+func TestAppendAuditLine(t *testing.T) {
+	log := ""
+	for i := range MaxAuditEntries + 5 {
+		log = appendAuditLine(log, fmt.Sprint(i))
+	}
+
+	lines := strings.Split(log, "\n")
+	if len(lines) != MaxAuditEntries {
+		t.Fatalf("len(lines) = %d, want %d", len(lines), MaxAuditEntries)
+	}
+	if lines[0] != "5" || lines[len(lines)-1] != fmt.Sprint(MaxAuditEntries+4) {
+		t.Errorf("Log should keep the newest entries, got %s ... %s", lines[0], lines[len(lines)-1])
+	}
+}
+
+func TestModelAuditor_Handle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	old := overlayModel()
+	updated := overlayModel()
+	updated.Spec.Source.HuggingFace.Revision = "a1b2c3"
+	oldRaw, err := json.Marshal(old)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newRaw, err := json.Marshal(updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	request := func(object []byte) admission.Request {
+		return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      "llama",
+			Namespace: "default",
+			Operation: admissionv1.Update,
+			UserInfo:  authenticationv1.UserInfo{Username: "alice@example.com"},
+			Object:    runtime.RawExtension{Raw: object},
+			OldObject: runtime.RawExtension{Raw: oldRaw},
+		}}
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	recorder := record.NewFakeRecorder(10)
+	a := &ModelAuditor{Client: c, Decoder: admission.NewDecoder(scheme), Recorder: recorder, ConfigMap: "model-audit"}
+
+	// An update that leaves the spec alone is not audited
+	if resp := a.Handle(context.Background(), request(oldRaw)); !resp.Allowed {
+		t.Fatalf("Handle() denied: %v", resp.Result)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("Unchanged spec should not record an event")
+	}
+
+	for range 2 {
+		if resp := a.Handle(context.Background(), request(newRaw)); !resp.Allowed {
+			t.Fatalf("Handle() denied: %v", resp.Result)
+		}
+	}
+
+	event := <-recorder.Events
+	if !strings.HasPrefix(event, "Normal SpecChanged alice@example.com changed spec (source)") {
+		t.Errorf("Event = %q", event)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(context.Background(), types.NamespacedName{Name: "model-audit", Namespace: "default"}, cm); err != nil {
+		t.Fatalf("Audit ConfigMap not created: %v", err)
+	}
+	lines := strings.Split(cm.Data["llama"], "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Audit log has %d entries, want 2", len(lines))
+	}
+	var entry AuditEntry
+	if err := json.Unmarshal([]byte(lines[1]), &entry); err != nil {
+		t.Fatalf("Audit entry is not JSON: %v", err)
+	}
+	if entry.User != "alice@example.com" || len(entry.Source) != 1 || entry.Source[0].New != "a1b2c3" {
+		t.Errorf("Audit entry = %+v", entry)
+	}
+}
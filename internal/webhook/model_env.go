@@ -0,0 +1,134 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// envPrefixPattern matches the prefixes accepted in AnnotationEnvPrefix
+var envPrefixPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// parseEnvPrefixes parses the AnnotationEnvPrefix value into the prefixes
+// chosen per model. A plain prefix applies to the pod's only model; a JSON
+// object maps model names to prefixes and leaves the others derived.
+func parseEnvPrefixes(value string, modelNames []string) (map[string]string, error) {
+	custom, err := parseModelValues(value, modelNames, "prefix")
+	if err != nil {
+		return nil, err
+	}
+	for name, prefix := range custom {
+		if !envPrefixPattern.MatchString(prefix) {
+			return nil, fmt.Errorf("prefix %q for model %q is not a valid env var name", prefix, name)
+		}
+	}
+	return custom, nil
+}
+
+// parseModelValues parses an annotation value set per injected model: a
+// plain value for the pod's only model, or a JSON object keyed by model name
+func parseModelValues(value string, modelNames []string, kind string) (map[string]string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	values := map[string]string{}
+	if strings.HasPrefix(value, "{") {
+		if err := json.Unmarshal([]byte(value), &values); err != nil {
+			return nil, fmt.Errorf("expected a %s or a JSON object keyed by model name: %w", kind, err)
+		}
+		for name := range values {
+			if !slices.Contains(modelNames, name) {
+				return nil, fmt.Errorf("model %q is not injected", name)
+			}
+		}
+		return values, nil
+	}
+
+	models := slices.Compact(slices.Sorted(slices.Values(modelNames)))
+	if len(models) != 1 {
+		return nil, fmt.Errorf("a plain %s applies to a single model, use a JSON object keyed by model name for %d models",
+			kind, len(models))
+	}
+	values[models[0]] = value
+	return values, nil
+}
+
+// envPrefixes assigns each injected model its env var prefix, taking the
+// custom prefixes as given. Models whose names normalize to the same prefix
+// (e.g. "my.model" and "my-model") get a suffix hashed from their name
+// instead, so the assignment does not depend on their order in the
+// annotation. It fails if prefixes still collide.
+func envPrefixes(modelNames []string, custom map[string]string) (map[string]string, error) {
+	prefixes := make(map[string]string, len(modelNames))
+	byPrefix := map[string][]string{}
+	for _, name := range modelNames {
+		if prefix, ok := custom[name]; ok {
+			prefixes[name] = prefix
+			continue
+		}
+		prefix := resources.EnvVarPrefix(name)
+		if !slices.Contains(byPrefix[prefix], name) {
+			byPrefix[prefix] = append(byPrefix[prefix], name)
+		}
+	}
+
+	for prefix, names := range byPrefix {
+		for _, name := range names {
+			if len(names) == 1 {
+				prefixes[name] = prefix
+				continue
+			}
+			sum := sha256.Sum256([]byte(name))
+			prefixes[name] = fmt.Sprintf("%s_%X", prefix, sum[:4])
+		}
+	}
+
+	// A disambiguated prefix could still match another model's prefix
+	owners := make(map[string]string, len(prefixes))
+	names := make([]string, 0, len(prefixes))
+	for name := range prefixes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if other, ok := owners[prefixes[name]]; ok {
+			return nil, fmt.Errorf("models %q and %q both map to the env var prefix %s; rename one, set %s or set %s to \"false\"",
+				other, name, prefixes[name], AnnotationEnvPrefix, AnnotationInjectEnv)
+		}
+		owners[prefixes[name]] = name
+	}
+	return prefixes, nil
+}
+
+// envPrefix returns the env var prefix assigned to a model, defaulting to
+// the one derived from its name
+func (o injectionOptions) envPrefix(modelName string) string {
+	if prefix, ok := o.EnvPrefixes[modelName]; ok {
+		return prefix
+	}
+	return resources.EnvVarPrefix(modelName)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestEnvPrefixes(t *testing.T) {
+	prefixes, err := envPrefixes([]string{"llama", "my-model", "my.model"}, nil)
+	if err != nil {
+		t.Fatalf("envPrefixes() error = %v", err)
+	}
+
+	if got := prefixes["llama"]; got != "MODEL_LLAMA" {
+		t.Errorf("envPrefixes()[llama] = %v, want MODEL_LLAMA", got)
+	}
+	dashed, dotted := prefixes["my-model"], prefixes["my.model"]
+	if dashed == dotted || !strings.HasPrefix(dashed, "MODEL_MY_MODEL_") || !strings.HasPrefix(dotted, "MODEL_MY_MODEL_") {
+		t.Errorf("envPrefixes() = %v and %v, want distinct MODEL_MY_MODEL_ prefixes", dashed, dotted)
+	}
+
+	// The assignment does not depend on the annotation order
+	reversed, err := envPrefixes([]string{"my.model", "my-model", "llama"}, nil)
+	if err != nil {
+		t.Fatalf("envPrefixes() error = %v", err)
+	}
+	if reversed["my-model"] != dashed || reversed["my.model"] != dotted {
+		t.Errorf("envPrefixes() reversed = %v, want %v", reversed, prefixes)
+	}
+
+	// A model listed twice keeps its plain prefix
+	if twice, _ := envPrefixes([]string{"llama", "llama"}, nil); twice["llama"] != "MODEL_LLAMA" {
+		t.Errorf("envPrefixes() = %v, want MODEL_LLAMA for a repeated model", twice)
+	}
+}
+
+func TestEnvPrefixes_Conflict(t *testing.T) {
+	prefixes, err := envPrefixes([]string{"my-model", "my.model"}, nil)
+	if err != nil {
+		t.Fatalf("envPrefixes() error = %v", err)
+	}
+
+	// A model named after a disambiguated prefix cannot be told apart
+	taken := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(prefixes["my.model"], "MODEL_"), "_", "-"))
+	_, err = envPrefixes([]string{"my-model", "my.model", taken}, nil)
+	if err == nil || !strings.Contains(err.Error(), AnnotationInjectEnv) {
+		t.Errorf("envPrefixes() error = %v, want a conflict naming %s", err, AnnotationInjectEnv)
+	}
+}
+
+func TestEnvPrefixes_Custom(t *testing.T) {
+	prefixes, err := envPrefixes([]string{"llama-3-8b", "bge"}, map[string]string{"llama-3-8b": "LLM"})
+	if err != nil {
+		t.Fatalf("envPrefixes() error = %v", err)
+	}
+	if prefixes["llama-3-8b"] != "LLM" || prefixes["bge"] != "MODEL_BGE" {
+		t.Errorf("envPrefixes() = %v, want LLM and MODEL_BGE", prefixes)
+	}
+
+	// A custom prefix may not take another model's prefix
+	_, err = envPrefixes([]string{"llama", "bge"}, map[string]string{"llama": "MODEL_BGE"})
+	if err == nil || !strings.Contains(err.Error(), AnnotationEnvPrefix) {
+		t.Errorf("envPrefixes() error = %v, want a conflict naming %s", err, AnnotationEnvPrefix)
+	}
+}
+
+func TestParseEnvPrefixes(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		models  []string
+		want    map[string]string
+		wantErr string
+	}{
+		{name: "unset", models: []string{"llama"}},
+		{name: "plain", value: "LLM", models: []string{"llama"}, want: map[string]string{"llama": "LLM"}},
+		{name: "plain repeated model", value: "LLM", models: []string{"llama", "llama"}, want: map[string]string{"llama": "LLM"}},
+		{
+			name:   "per model",
+			value:  `{"llama": "LLM", "bge": "EMBED"}`,
+			models: []string{"llama", "bge", "mistral"},
+			want:   map[string]string{"llama": "LLM", "bge": "EMBED"},
+		},
+		{name: "plain for several models", value: "LLM", models: []string{"llama", "bge"}, wantErr: "single model"},
+		{name: "malformed JSON", value: `{"llama": 1}`, models: []string{"llama"}, wantErr: "JSON object"},
+		{name: "model not injected", value: `{"bge": "EMBED"}`, models: []string{"llama"}, wantErr: `"bge" is not injected`},
+		{name: "invalid prefix", value: "LLM-1", models: []string{"llama"}, wantErr: "not a valid env var name"},
+		{name: "empty prefix", value: `{"llama": ""}`, models: []string{"llama"}, wantErr: "not a valid env var name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseEnvPrefixes(tt.value, tt.models)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("parseEnvPrefixes() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseEnvPrefixes() error = %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("parseEnvPrefixes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
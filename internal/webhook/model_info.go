@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+
+	corev1 "k8s.io/api/core/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// AnnotationModelInfo holds the ModelInfo of every injected Model, as a JSON
+// object keyed by Model name
+const AnnotationModelInfo = "models.main-currents.news/model-info"
+
+// ModelInfo describes an injected Model. It is injected as the JSON value of
+// the MODEL_<NAME>_INFO env var, so applications can parse a single document
+// instead of reading many env vars.
+type ModelInfo struct {
+	Name          string     `json:"name"`
+	Version       string     `json:"version,omitempty"`
+	Source        SourceInfo `json:"source"`
+	ContentDigest string     `json:"contentDigest,omitempty"`
+	MountPath     string     `json:"mountPath"`
+	// StorageSize is the requested size of the model's storage
+	StorageSize string `json:"storageSize,omitempty"`
+	// SizeBytes is the size of the download, or its estimate
+	SizeBytes int64 `json:"sizeBytes,omitempty"`
+	// Deprecated is set for a model being retired, with the Model to move to
+	Deprecated  bool   `json:"deprecated,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+// SourceInfo identifies the source the Model's content was downloaded from,
+// which is a fallback source if the primary source failed
+type SourceInfo struct {
+	// Type is huggingface, s3, url, git or dvc
+	Type     string `json:"type"`
+	RepoID   string `json:"repoId,omitempty"`
+	URL      string `json:"url,omitempty"`
+	Bucket   string `json:"bucket,omitempty"`
+	Key      string `json:"key,omitempty"`
+	Path     string `json:"path,omitempty"`
+	Revision string `json:"revision,omitempty"`
+}
+
+// modelInfo describes the Model as mounted at mountPath
+func modelInfo(model *modelsv1alpha1.Model, mountPath string) ModelInfo {
+	info := ModelInfo{
+		Name:          model.Name,
+		Version:       model.Spec.Version,
+		Source:        sourceInfo(resources.ForSource(model, model.Status.SourceIndex).Spec.Source),
+		ContentDigest: model.Status.ContentDigest,
+		MountPath:     mountPath,
+		StorageSize:   model.Spec.Storage.Size,
+		SizeBytes:     model.Status.DownloadedBytes,
+		Deprecated:    model.Spec.Deprecated,
+		Replacement:   model.Spec.Replacement,
+	}
+	if info.SizeBytes == 0 {
+		info.SizeBytes = model.Status.EstimatedSizeBytes
+	}
+	return info
+}
+
+// sourceInfo identifies the source set in source
+func sourceInfo(source modelsv1alpha1.ModelSource) SourceInfo {
+	switch {
+	case source.HuggingFace != nil:
+		return SourceInfo{Type: "huggingface", RepoID: source.HuggingFace.RepoID, Revision: source.HuggingFace.Revision}
+	case source.S3 != nil:
+		return SourceInfo{Type: "s3", Bucket: source.S3.Bucket, Key: source.S3.Key}
+	case source.URL != nil:
+		return SourceInfo{Type: "url", URL: source.URL.URL}
+	case source.Git != nil:
+		return SourceInfo{Type: "git", URL: source.Git.URL, Revision: source.Git.Ref}
+	case source.DVC != nil:
+		return SourceInfo{Type: "dvc", URL: source.DVC.URL, Path: source.DVC.Path, Revision: source.DVC.Rev}
+	default:
+		return SourceInfo{}
+	}
+}
+
+// annotateModelInfo records the ModelInfo of the injected Models on the pod
+func annotateModelInfo(pod *corev1.Pod, models []*modelsv1alpha1.Model, opts injectionOptions) error {
+	if len(models) == 0 {
+		return nil
+	}
+	infos := make(map[string]ModelInfo, len(models))
+	for _, model := range models {
+		mountPath, err := modelMountPath(model, opts)
+		if err != nil {
+			return err
+		}
+		infos[model.Name] = modelInfo(model, mountPath)
+	}
+	value, err := json.Marshal(infos)
+	if err != nil {
+		return err
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+	pod.Annotations[AnnotationModelInfo] = string(value)
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestModelInfo(t *testing.T) {
+	model := fixtureModel("llama", modelsv1alpha1.ModelPhaseReady)
+	model.Spec.Version = "3.1"
+	model.Spec.Source.HuggingFace.Revision = "main"
+	model.Spec.Source.Fallbacks = []modelsv1alpha1.FallbackSource{{
+		S3: &modelsv1alpha1.S3Source{Bucket: "model-mirror", Key: "llama/"},
+	}}
+	model.Status.ContentDigest = "sha256:abc"
+	model.Status.EstimatedSizeBytes = 1000
+
+	got := modelInfo(model, "/models/llama")
+	want := ModelInfo{
+		Name:          "llama",
+		Version:       "3.1",
+		Source:        SourceInfo{Type: "huggingface", RepoID: "org/llama", Revision: "main"},
+		ContentDigest: "sha256:abc",
+		MountPath:     "/models/llama",
+		StorageSize:   "10Gi",
+		SizeBytes:     1000,
+	}
+	if got != want {
+		t.Errorf("modelInfo() = %+v, want %+v", got, want)
+	}
+
+	// The content came from the fallback and its size is known
+	model.Status.SourceIndex = 1
+	model.Status.DownloadedBytes = 1200
+	got = modelInfo(model, "/models/llama")
+	if wantSource := (SourceInfo{Type: "s3", Bucket: "model-mirror", Key: "llama/"}); got.Source != wantSource {
+		t.Errorf("modelInfo() source = %+v, want %+v", got.Source, wantSource)
+	}
+	if got.SizeBytes != 1200 {
+		t.Errorf("modelInfo() size = %d, want 1200", got.SizeBytes)
+	}
+}
+
+func TestSourceInfo(t *testing.T) {
+	tests := []struct {
+		name   string
+		source modelsv1alpha1.ModelSource
+		want   SourceInfo
+	}{
+		{
+			name:   "url",
+			source: modelsv1alpha1.ModelSource{URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"}},
+			want:   SourceInfo{Type: "url", URL: "https://example.com/model.gguf"},
+		},
+		{
+			name:   "git",
+			source: modelsv1alpha1.ModelSource{Git: &modelsv1alpha1.GitSource{URL: "https://example.com/llama.git", Ref: "v1"}},
+			want:   SourceInfo{Type: "git", URL: "https://example.com/llama.git", Revision: "v1"},
+		},
+		{
+			name: "dvc",
+			source: modelsv1alpha1.ModelSource{DVC: &modelsv1alpha1.DVCSource{
+				URL: "https://example.com/research.git", Path: "models/classifier", Rev: "v2",
+			}},
+			want: SourceInfo{Type: "dvc", URL: "https://example.com/research.git", Path: "models/classifier", Revision: "v2"},
+		},
+		{
+			name: "none",
+			want: SourceInfo{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sourceInfo(tt.source); got != tt.want {
+				t.Errorf("sourceInfo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelInjector_ModelInfo(t *testing.T) {
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		fixtureModel("llama", modelsv1alpha1.ModelPhaseReady),
+		fixtureModel("mistral", modelsv1alpha1.ModelPhaseReady),
+	).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	_, pod := admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama,mistral"}, nil))
+
+	var env ModelInfo
+	if err := json.Unmarshal([]byte(envValue(pod.Spec.Containers[0], "MODEL_LLAMA_INFO")), &env); err != nil {
+		t.Fatalf("MODEL_LLAMA_INFO is not a JSON ModelInfo: %v", err)
+	}
+	if env.Name != "llama" || env.MountPath != "/models/llama" || env.Source.RepoID != "org/llama" {
+		t.Errorf("MODEL_LLAMA_INFO = %+v, want llama mounted at /models/llama", env)
+	}
+
+	var annotated map[string]ModelInfo
+	if err := json.Unmarshal([]byte(pod.Annotations[AnnotationModelInfo]), &annotated); err != nil {
+		t.Fatalf("%s is not a JSON object of ModelInfo: %v", AnnotationModelInfo, err)
+	}
+	if len(annotated) != 2 || annotated["llama"] != env || annotated["mistral"].Name != "mistral" {
+		t.Errorf("%s = %+v, want the info of llama and mistral", AnnotationModelInfo, annotated)
+	}
+}
+
+// envValue returns the value of the named env var in the container
+func envValue(container corev1.Container, name string) string {
+	for _, e := range container.Env {
+		if e.Name == name {
+			return e.Value
+		}
+	}
+	return ""
+}
@@ -21,10 +21,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"path"
+	"slices"
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -33,6 +37,12 @@ import (
 	"github.com/rsJames-ttrpg/model-operator/internal/resources"
 )
 
+// Volume sources selectable with AnnotationVolumeSource
+const (
+	VolumeSourcePVC   = "pvc"
+	VolumeSourceImage = "image"
+)
+
 // Annotation keys
 const (
 	AnnotationInject    = "models.main-currents.news/inject"
@@ -40,24 +50,86 @@ const (
 	AnnotationReadOnly  = "models.main-currents.news/read-only"
 	AnnotationContainer = "models.main-currents.news/container"
 	AnnotationInjectEnv = "models.main-currents.news/inject-env"
+	// AnnotationVolumeSource selects how models are mounted: "pvc" (default)
+	// or "image" to mount the published OCI image with an image volume
+	AnnotationVolumeSource = "models.main-currents.news/volume-source"
+	// AnnotationReplica mounts the named spec.replicas entry instead of
+	// choosing one from the pod's zone
+	AnnotationReplica = "models.main-currents.news/replica"
+	// AnnotationInjectCredentials injects the Model's HF_TOKEN as an env var
+	// ("env") or a token file ("file"), if the Model allows it
+	AnnotationInjectCredentials = "models.main-currents.news/inject-credentials"
+	// AnnotationEnvPrefix replaces the MODEL_<NAME> env var prefix, either
+	// with a plain prefix for a single model or a JSON object mapping model
+	// names to prefixes
+	AnnotationEnvPrefix = "models.main-currents.news/env-prefix"
+	// AnnotationWaitTimeout waits up to the given duration (e.g. "30s", at
+	// most 20s) for a Model in the last stretch of its download to become
+	// Ready, instead of denying the pod straight away
+	AnnotationWaitTimeout = "models.main-currents.news/wait-timeout"
+	// AnnotationSubPath mounts only a directory of the model volume, either
+	// a plain path for a single model or a JSON object mapping model names
+	// to paths
+	AnnotationSubPath = "models.main-currents.news/sub-path"
+	// AnnotationDecompress set to "false" mounts the files of compressed
+	// models as stored, for runtimes that read them compressed
+	AnnotationDecompress = "models.main-currents.news/decompress"
 
 	LabelInjected = "models.main-currents.news/injected"
 )
 
+// ReasonPodDenied is the event recorded on a Model that is not Ready when a pod
+// requesting it is denied
+const ReasonPodDenied = "PodDenied"
+
+// Credential injection modes selectable with AnnotationInjectCredentials
+const (
+	CredentialsModeEnv  = "env"
+	CredentialsModeFile = "file"
+)
+
+// tokenMountDir is where token files are mounted, one directory per Model
+const tokenMountDir = "/var/run/secrets/models.main-currents.news"
+
 // injectionOptions holds parsed annotation values
 type injectionOptions struct {
+	// MountPath is the mount path or base path, with the placeholders
+	// resources.ResolveMountPath replaces
 	MountPath     string
 	ReadOnly      bool
 	ContainerName string
 	InjectEnv     bool
+	VolumeSource  string
+	// InjectCredentials is the credential injection mode, empty for none
+	InjectCredentials string
+	// Replica is the storage replica requested with AnnotationReplica
+	Replica string
+	// EnvPrefixes are the env var prefixes assigned to the injected models
+	EnvPrefixes map[string]string
+	// SubPaths are the directories of the model volumes to mount, by model
+	SubPaths map[string]string
+	// KeepCompressed mounts compressed models without decompressing them
+	KeepCompressed bool
+	// Revisions are the kept revisions pinned with AnnotationRevision, by model
+	Revisions map[string]string
 }
 
 // ModelInjector handles pod mutation for model injection
-// +kubebuilder:webhook:path=/mutate-v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=model-injector.models.main-currents.news,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-v1-pod,mutating=true,failurePolicy=ignore,sideEffects=None,groups="",resources=pods,verbs=create,versions=v1,name=model-injector.models.main-currents.news,admissionReviewVersions=v1,timeoutSeconds=30
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 
 type ModelInjector struct {
 	Client  client.Client
 	Decoder admission.Decoder
+	// PodMetadata is stamped on every injected pod, under the metadata set
+	// by the pod's namespace
+	PodMetadata PodMetadata
+	// Recorder records an event on each Model that is not Ready when a pod
+	// requesting it is denied. Nil records none.
+	Recorder record.EventRecorder
+	// Images overrides the image of the init container that decompresses
+	// compressed models
+	Images resources.Images
 }
 
 // Handle processes admission requests for pods
@@ -70,6 +142,22 @@ func (m *ModelInjector) Handle(ctx context.Context, req admission.Request) admis
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
+	// Pods created by controllers have no name yet, so name them by their
+	// generateName and owner
+	log = log.WithValues("pod", podName(pod, req.Name), "namespace", req.Namespace)
+	if owner := podOwner(pod); owner != "" {
+		log = log.WithValues("owner", owner)
+	}
+
+	// Apply the access policies of Models whose PVCs the pod mounts by hand
+	if denied, err := m.checkClaimAccess(ctx, req, pod); err != nil {
+		log.Error(err, "Failed to check access to model PVCs")
+		return admission.Errored(http.StatusInternalServerError, err)
+	} else if denied != "" {
+		log.Info("Pod not allowed to mount model PVC", "reason", denied)
+		return admission.Denied(denied)
+	}
+
 	// Check if already injected
 	if pod.Labels != nil && pod.Labels[LabelInjected] == "true" {
 		return admission.Allowed("already injected")
@@ -89,20 +177,54 @@ func (m *ModelInjector) Handle(ctx context.Context, req admission.Request) admis
 	opts := parseOptions(pod.Annotations)
 
 	// Parse model names
-	modelNames := strings.Split(injectAnnotation, ",")
+	var modelNames []string
+	for _, name := range strings.Split(injectAnnotation, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			modelNames = append(modelNames, name)
+		}
+	}
 
-	log.Info("Processing pod for model injection",
-		"pod", req.Name,
-		"namespace", req.Namespace,
-		"models", modelNames)
+	waitTimeout, err := parseWaitTimeout(pod.Annotations[AnnotationWaitTimeout])
+	if err != nil {
+		log.Info("Invalid wait timeout", "reason", err.Error())
+		return admission.Denied(fmt.Sprintf("invalid %s annotation: %v", AnnotationWaitTimeout, err))
+	}
 
-	// Process each model
-	for _, name := range modelNames {
-		name = strings.TrimSpace(name)
-		if name == "" {
-			continue
+	subPaths, err := parseSubPaths(pod.Annotations[AnnotationSubPath], modelNames)
+	if err != nil {
+		log.Info("Invalid sub-path", "reason", err.Error())
+		return admission.Denied(fmt.Sprintf("invalid %s annotation: %v", AnnotationSubPath, err))
+	}
+	opts.SubPaths = subPaths
+
+	revisions, err := parseModelValues(pod.Annotations[AnnotationRevision], modelNames, "revision")
+	if err != nil {
+		log.Info("Invalid revision", "reason", err.Error())
+		return admission.Denied(fmt.Sprintf("invalid %s annotation: %v", AnnotationRevision, err))
+	}
+	opts.Revisions = revisions
+
+	// Give models whose names normalize alike distinct env var prefixes
+	if opts.InjectEnv {
+		custom, err := parseEnvPrefixes(pod.Annotations[AnnotationEnvPrefix], modelNames)
+		if err != nil {
+			log.Info("Invalid env var prefix", "reason", err.Error())
+			return admission.Denied(fmt.Sprintf("invalid %s annotation: %v", AnnotationEnvPrefix, err))
+		}
+		prefixes, err := envPrefixes(modelNames, custom)
+		if err != nil {
+			log.Info("Conflicting env var prefixes", "reason", err.Error())
+			return admission.Denied(fmt.Sprintf("cannot inject env vars: %v", err))
 		}
+		opts.EnvPrefixes = prefixes
+	}
 
+	log.Info("Processing pod for model injection", "models", modelNames)
+
+	// Process each model
+	var injected []*modelsv1alpha1.Model
+	var warnings []string
+	for _, name := range modelNames {
 		// Fetch Model CR
 		model := &modelsv1alpha1.Model{}
 		if err := m.Client.Get(ctx, types.NamespacedName{
@@ -113,14 +235,81 @@ func (m *ModelInjector) Handle(ctx context.Context, req admission.Request) admis
 			return admission.Denied(fmt.Sprintf("model %q not found: %v", name, err))
 		}
 
+		// Wait briefly for a download that is about to finish
+		if waitTimeout > 0 && nearlyReady(model) {
+			log.Info("Waiting for model to become ready", "model", name, "progress", model.Status.Progress, "timeout", waitTimeout)
+			if model, err = m.waitForReady(ctx, model, waitTimeout); err != nil {
+				log.Error(err, "Failed to wait for model", "model", name)
+				return admission.Denied(fmt.Sprintf("failed to wait for model %q: %v", name, err))
+			}
+		}
+
 		// Verify model is Ready
 		if model.Status.Phase != modelsv1alpha1.ModelPhaseReady {
 			log.Info("Model not ready", "model", name, "phase", model.Status.Phase)
+			m.recordDenied(req, pod, model)
 			return admission.Denied(fmt.Sprintf("model %q is not ready (phase: %s)", name, model.Status.Phase))
 		}
 
+		// Verify the pod is allowed to mount the model
+		if err := checkAccess(pod, model); err != nil {
+			log.Info("Pod not allowed to mount model", "model", name, "reason", err.Error())
+			return admission.Denied(fmt.Sprintf("pod may not mount model %q: %v", name, err))
+		}
+
+		// Warn new consumers of a deprecated model, or deny them
+		if model.Spec.Deprecated {
+			message := deprecationMessage(model)
+			if model.Spec.DeprecationPolicy == modelsv1alpha1.DeprecationPolicyDeny {
+				log.Info("Model deprecated", "model", name, "replacement", model.Spec.Replacement)
+				recordDeprecated(req, model, "denied")
+				return admission.Denied(message)
+			}
+			recordDeprecated(req, model, "warned")
+			warnings = append(warnings, message)
+		}
+
+		// Mount a kept revision if the pod pins one
+		model, revisionPath, err := pinRevision(model, opts.Revisions[name])
+		if err != nil {
+			log.Info("Cannot pin model revision", "model", name, "reason", err.Error())
+			return admission.Denied(fmt.Sprintf("cannot mount model %q: %v", name, err))
+		}
+
 		// Inject volume
-		injectVolume(pod, model)
+		switch {
+		case revisionPath != "":
+			if opts.VolumeSource == VolumeSourceImage || opts.Replica != "" {
+				return admission.Denied(fmt.Sprintf("cannot mount model %q: kept revisions are only on its PVC", name))
+			}
+			if resources.Compressed(model) && !opts.KeepCompressed {
+				injectDecompressedVolume(pod, model, resources.ClaimName(model), revisionPath, m.Images)
+				break
+			}
+			injectVolume(pod, model, resources.ClaimName(model))
+			if opts.SubPaths == nil {
+				opts.SubPaths = map[string]string{}
+			}
+			opts.SubPaths[name] = path.Join(revisionPath, opts.SubPaths[name])
+		case model.Spec.Storage.Mode == modelsv1alpha1.StorageModeConfigMap:
+			injectConfigMapVolume(pod, model)
+		case opts.VolumeSource == VolumeSourceImage || model.Spec.Storage.Mode == modelsv1alpha1.StorageModeImage:
+			if err := injectImageVolume(pod, model); err != nil {
+				log.Info("Cannot mount model as image", "model", name, "reason", err.Error())
+				return admission.Denied(fmt.Sprintf("cannot mount model %q as image: %v", name, err))
+			}
+		default:
+			claimName, err := selectClaim(pod, model, opts.Replica)
+			if err != nil {
+				log.Info("Cannot select model replica", "model", name, "reason", err.Error())
+				return admission.Denied(fmt.Sprintf("cannot mount model %q: %v", name, err))
+			}
+			if resources.Compressed(model) && !opts.KeepCompressed {
+				injectDecompressedVolume(pod, model, claimName, "", m.Images)
+			} else {
+				injectVolume(pod, model, claimName)
+			}
+		}
 
 		// Inject volume mount
 		if err := injectVolumeMount(pod, model, opts); err != nil {
@@ -135,12 +324,26 @@ func (m *ModelInjector) Handle(ctx context.Context, req admission.Request) admis
 				return admission.Denied(fmt.Sprintf("failed to inject env vars for model %q: %v", name, err))
 			}
 		}
+
+		// Inject Hub credentials if requested and allowed by the Model
+		if opts.InjectCredentials != "" {
+			if err := injectCredentials(pod, model, opts); err != nil {
+				log.Info("Cannot inject credentials", "model", name, "reason", err.Error())
+				return admission.Denied(fmt.Sprintf("cannot inject credentials for model %q: %v", name, err))
+			}
+		}
+
+		injected = append(injected, model)
 	}
 
-	// Add label to mark injection
-	if pod.Labels == nil {
-		pod.Labels = make(map[string]string)
+	// Stamp the configured metadata and the consumed models
+	stampPodMetadata(pod, m.podMetadata(ctx, req.Namespace), injected)
+	if err := annotateModelInfo(pod, injected, opts); err != nil {
+		log.Error(err, "Failed to annotate model info")
+		return admission.Errored(http.StatusInternalServerError, err)
 	}
+
+	// Add label to mark injection
 	pod.Labels[LabelInjected] = "true"
 
 	// Marshal the modified pod
@@ -150,8 +353,70 @@ func (m *ModelInjector) Handle(ctx context.Context, req admission.Request) admis
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 
-	log.Info("Successfully injected models into pod", "pod", req.Name)
-	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod)
+	log.Info("Successfully injected models into pod")
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledPod).WithWarnings(warnings...)
+}
+
+// deprecationMessage tells a new consumer of a deprecated model what to use instead
+func deprecationMessage(model *modelsv1alpha1.Model) string {
+	if model.Spec.Replacement == "" {
+		return fmt.Sprintf("model %q is deprecated", model.Name)
+	}
+	return fmt.Sprintf("model %q is deprecated, use %q instead", model.Name, model.Spec.Replacement)
+}
+
+// recordDeprecated counts a pod requesting a deprecated model. Dry runs are
+// not counted.
+func recordDeprecated(req admission.Request, model *modelsv1alpha1.Model, action string) {
+	if req.DryRun != nil && *req.DryRun {
+		return
+	}
+	deprecatedPods.WithLabelValues(req.Namespace, model.Name, action).Inc()
+}
+
+// recordDenied lets the owners of a Model that is not Ready know that a pod
+// requesting it was denied. Dry runs are not recorded.
+func (m *ModelInjector) recordDenied(req admission.Request, pod *corev1.Pod, model *modelsv1alpha1.Model) {
+	if req.DryRun != nil && *req.DryRun {
+		return
+	}
+	podsDenied.WithLabelValues(req.Namespace, model.Name, string(model.Status.Phase)).Inc()
+	if m.Recorder == nil {
+		return
+	}
+	// Name the owner rather than the pod, so retries of one controller
+	// aggregate into a single event
+	denied := "Denied pod " + podName(pod, req.Name)
+	if owner := podOwner(pod); owner != "" {
+		denied = "Denied a pod of " + owner
+	}
+	m.Recorder.Eventf(model, corev1.EventTypeWarning, ReasonPodDenied,
+		"%s: model is not ready (phase: %s)", denied, model.Status.Phase)
+}
+
+// podName returns the pod's name, or its generateName followed by "*" when
+// the API server has yet to generate the name
+func podName(pod *corev1.Pod, requestName string) string {
+	switch {
+	case requestName != "":
+		return requestName
+	case pod.Name != "":
+		return pod.Name
+	case pod.GenerateName != "":
+		return pod.GenerateName + "*"
+	default:
+		return ""
+	}
+}
+
+// podOwner returns the Kind/name of the controller that created the pod, or
+// an empty string if it has none
+func podOwner(pod *corev1.Pod) string {
+	owner := metav1.GetControllerOf(pod)
+	if owner == nil {
+		return ""
+	}
+	return owner.Kind + "/" + owner.Name
 }
 
 // parseOptions extracts injection options from pod annotations
@@ -177,13 +442,44 @@ func parseOptions(annotations map[string]string) injectionOptions {
 		opts.InjectEnv = v != "false"
 	}
 
+	if v, ok := annotations[AnnotationVolumeSource]; ok {
+		opts.VolumeSource = v
+	}
+
+	if v, ok := annotations[AnnotationInjectCredentials]; ok {
+		opts.InjectCredentials = v
+	}
+
+	if v, ok := annotations[AnnotationReplica]; ok {
+		opts.Replica = v
+	}
+
+	if v, ok := annotations[AnnotationDecompress]; ok {
+		opts.KeepCompressed = v == "false"
+	}
+
 	return opts
 }
 
+// parseSubPaths parses the AnnotationSubPath value into the directory to
+// mount per model. Paths must stay inside the model volume.
+func parseSubPaths(value string, modelNames []string) (map[string]string, error) {
+	subPaths, err := parseModelValues(value, modelNames, "path")
+	if err != nil {
+		return nil, err
+	}
+	for name, subPath := range subPaths {
+		if subPath == "" || path.IsAbs(subPath) || slices.Contains(strings.Split(subPath, "/"), "..") {
+			return nil, fmt.Errorf("path %q for model %q must be a relative path inside the model volume", subPath, name)
+		}
+		subPaths[name] = path.Clean(subPath)
+	}
+	return subPaths, nil
+}
+
 // injectVolume adds the model PVC volume to the pod
-func injectVolume(pod *corev1.Pod, model *modelsv1alpha1.Model) {
+func injectVolume(pod *corev1.Pod, model *modelsv1alpha1.Model, pvcName string) {
 	volumeName := resources.VolumeName(model.Name)
-	pvcName := resources.PVCName(model.Name)
 
 	// Check if volume already exists
 	for _, v := range pod.Spec.Volumes {
@@ -203,6 +499,140 @@ func injectVolume(pod *corev1.Pod, model *modelsv1alpha1.Model) {
 	})
 }
 
+// injectDecompressedVolume adds the PVC of a compressed model to the pod, with
+// an init container that decompresses its files, or those under subPath, into
+// the emptyDir volume the containers mount in place of the PVC
+func injectDecompressedVolume(pod *corev1.Pod, model *modelsv1alpha1.Model, pvcName, subPath string, images resources.Images) {
+	if hasVolume(pod, resources.VolumeName(model.Name)) {
+		return
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes,
+		corev1.Volume{
+			Name: resources.CompressedVolumeName(model.Name),
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvcName,
+					ReadOnly:  true,
+				},
+			},
+		},
+		corev1.Volume{
+			Name:         resources.VolumeName(model.Name),
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		},
+	)
+	container := resources.BuildDecompressContainer(model, images)
+	container.VolumeMounts[0].SubPath = subPath
+	pod.Spec.InitContainers = append(pod.Spec.InitContainers, container)
+}
+
+// injectConfigMapVolume adds the ConfigMap of a configmap-mode model to the pod
+func injectConfigMapVolume(pod *corev1.Pod, model *modelsv1alpha1.Model) {
+	if hasVolume(pod, resources.VolumeName(model.Name)) {
+		return
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: resources.VolumeName(model.Name),
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: resources.ContentConfigMapName(model.Name)},
+			},
+		},
+	})
+}
+
+// selectClaim returns the PVC to mount for the model: the requested replica,
+// else a ready replica pinned to the pod's zone, else the primary PVC
+func selectClaim(pod *corev1.Pod, model *modelsv1alpha1.Model, requested string) (string, error) {
+	ready := map[string]modelsv1alpha1.ReplicaStatus{}
+	for _, status := range model.Status.Replicas {
+		if status.Phase == modelsv1alpha1.ModelPhaseReady && status.ContentDigest == model.Status.ContentDigest {
+			ready[status.Name] = status
+		}
+	}
+
+	if requested != "" {
+		status, ok := ready[requested]
+		if !ok {
+			return "", fmt.Errorf("replica %q is not ready", requested)
+		}
+		return status.PVCName, nil
+	}
+
+	if zone := podZone(pod); zone != "" {
+		for _, replica := range model.Spec.Replicas {
+			if status, ok := ready[replica.Name]; ok && replica.Zone == zone {
+				return status.PVCName, nil
+			}
+		}
+	}
+	return resources.ClaimName(model), nil
+}
+
+// podZone returns the zone the pod is constrained to by its node selector or
+// required node affinity, or an empty string if it may run in several zones
+func podZone(pod *corev1.Pod) string {
+	if zone := pod.Spec.NodeSelector[corev1.LabelTopologyZone]; zone != "" {
+		return zone
+	}
+
+	affinity := pod.Spec.Affinity
+	if affinity == nil || affinity.NodeAffinity == nil ||
+		affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return ""
+	}
+
+	// Terms are ORed, so every term must pin the same single zone
+	zone := ""
+	terms := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for _, term := range terms {
+		termZone := ""
+		for _, expr := range term.MatchExpressions {
+			if expr.Key == corev1.LabelTopologyZone && expr.Operator == corev1.NodeSelectorOpIn && len(expr.Values) == 1 {
+				termZone = expr.Values[0]
+			}
+		}
+		if termZone == "" || (zone != "" && termZone != zone) {
+			return ""
+		}
+		zone = termZone
+	}
+	return zone
+}
+
+// injectImageVolume adds the model's published OCI image as an image volume
+func injectImageVolume(pod *corev1.Pod, model *modelsv1alpha1.Model) error {
+	if model.Status.Publication == nil {
+		return fmt.Errorf("model has not been published as an image")
+	}
+
+	volumeName := resources.VolumeName(model.Name)
+	for _, v := range pod.Spec.Volumes {
+		if v.Name == volumeName {
+			return nil
+		}
+	}
+
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Image: &corev1.ImageVolumeSource{
+				Reference:  model.Status.Publication.Image,
+				PullPolicy: corev1.PullIfNotPresent,
+			},
+		},
+	})
+	return nil
+}
+
+// modelMountPath returns where the model is mounted in the pod. The volume
+// mount, the env vars and the model info all take the path from here.
+func modelMountPath(model *modelsv1alpha1.Model, opts injectionOptions) (string, error) {
+	return resources.ResolveMountPath(model, opts.MountPath)
+}
+
 // injectVolumeMount adds the volume mount to the target container
 func injectVolumeMount(pod *corev1.Pod, model *modelsv1alpha1.Model, opts injectionOptions) error {
 	if len(pod.Spec.Containers) == 0 {
@@ -211,38 +641,21 @@ func injectVolumeMount(pod *corev1.Pod, model *modelsv1alpha1.Model, opts inject
 
 	volumeName := resources.VolumeName(model.Name)
 
-	// Determine mount path
-	mountPath := opts.MountPath
-	if mountPath == "" {
-		mountPath = resources.DefaultMountPath(model.Name)
-	} else if strings.Contains(opts.MountPath, "{name}") {
-		// Replace {name} placeholder
-		mountPath = strings.ReplaceAll(opts.MountPath, "{name}", model.Name)
-	} else if !strings.HasSuffix(mountPath, model.Name) {
-		// If custom base path specified, append model name
-		mountPath = strings.TrimSuffix(mountPath, "/") + "/" + model.Name
+	mountPath, err := modelMountPath(model, opts)
+	if err != nil {
+		return err
 	}
 
 	mount := corev1.VolumeMount{
 		Name:      volumeName,
 		MountPath: mountPath,
 		ReadOnly:  opts.ReadOnly,
+		SubPath:   opts.SubPaths[model.Name],
 	}
 
-	// Find target container
-	containerIdx := 0
-	if opts.ContainerName != "" {
-		found := false
-		for i, c := range pod.Spec.Containers {
-			if c.Name == opts.ContainerName {
-				containerIdx = i
-				found = true
-				break
-			}
-		}
-		if !found {
-			return fmt.Errorf("container %q not found", opts.ContainerName)
-		}
+	containerIdx, err := targetContainer(pod, opts.ContainerName)
+	if err != nil {
+		return err
 	}
 
 	// Check if mount already exists
@@ -266,16 +679,11 @@ func injectEnvVars(pod *corev1.Pod, model *modelsv1alpha1.Model, opts injectionO
 		return fmt.Errorf("pod has no containers")
 	}
 
-	prefix := resources.EnvVarPrefix(model.Name)
+	prefix := opts.envPrefix(model.Name)
 
-	// Determine mount path for env var
-	mountPath := opts.MountPath
-	if mountPath == "" {
-		mountPath = resources.DefaultMountPath(model.Name)
-	} else if strings.Contains(opts.MountPath, "{name}") {
-		mountPath = strings.ReplaceAll(opts.MountPath, "{name}", model.Name)
-	} else if !strings.HasSuffix(mountPath, model.Name) {
-		mountPath = strings.TrimSuffix(mountPath, "/") + "/" + model.Name
+	mountPath, err := modelMountPath(model, opts)
+	if err != nil {
+		return err
 	}
 
 	// Build env vars
@@ -292,6 +700,14 @@ func injectEnvVars(pod *corev1.Pod, model *modelsv1alpha1.Model, opts injectionO
 		})
 	}
 
+	// Tell consumers of a deprecated model what replaces it
+	if model.Spec.Deprecated {
+		envVars = append(envVars, corev1.EnvVar{Name: prefix + "_DEPRECATED", Value: "true"})
+		if model.Spec.Replacement != "" {
+			envVars = append(envVars, corev1.EnvVar{Name: prefix + "_REPLACEMENT", Value: model.Spec.Replacement})
+		}
+	}
+
 	// Add source-specific env vars
 	source := model.Spec.Source
 	switch {
@@ -312,6 +728,13 @@ func injectEnvVars(pod *corev1.Pod, model *modelsv1alpha1.Model, opts injectionO
 		)
 	}
 
+	// Describe the model in a single JSON document
+	info, err := json.Marshal(modelInfo(model, mountPath))
+	if err != nil {
+		return err
+	}
+	envVars = append(envVars, corev1.EnvVar{Name: prefix + "_INFO", Value: string(info)})
+
 	// Find target container
 	containerIdx := 0
 	if opts.ContainerName != "" {
@@ -340,3 +763,113 @@ func injectEnvVars(pod *corev1.Pod, model *modelsv1alpha1.Model, opts injectionO
 
 	return nil
 }
+
+// targetContainer returns the index of the named container, or of the first
+// container if no name is given
+func targetContainer(pod *corev1.Pod, name string) (int, error) {
+	if len(pod.Spec.Containers) == 0 {
+		return 0, fmt.Errorf("pod has no containers")
+	}
+	if name == "" {
+		return 0, nil
+	}
+	for i, c := range pod.Spec.Containers {
+		if c.Name == name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("container %q not found", name)
+}
+
+// injectCredentials exposes the Model's HF_TOKEN to the target container,
+// referencing the CredentialsSecret so the token itself never passes through
+// the webhook
+func injectCredentials(pod *corev1.Pod, model *modelsv1alpha1.Model, opts injectionOptions) error {
+	if opts.InjectCredentials != CredentialsModeEnv && opts.InjectCredentials != CredentialsModeFile {
+		return fmt.Errorf("unknown credentials mode %q, want %q or %q",
+			opts.InjectCredentials, CredentialsModeEnv, CredentialsModeFile)
+	}
+	if model.Spec.Source.HuggingFace == nil {
+		return fmt.Errorf("credentials are only injected for Hugging Face models")
+	}
+	if !model.Spec.AllowCredentialInjection {
+		return fmt.Errorf("model does not set spec.allowCredentialInjection")
+	}
+	if model.Spec.CredentialsSecret == "" {
+		return fmt.Errorf("model has no credentialsSecret")
+	}
+
+	containerIdx, err := targetContainer(pod, opts.ContainerName)
+	if err != nil {
+		return err
+	}
+	container := &pod.Spec.Containers[containerIdx]
+
+	env := corev1.EnvVar{
+		Name: "HF_TOKEN",
+		ValueFrom: &corev1.EnvVarSource{
+			SecretKeyRef: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: model.Spec.CredentialsSecret},
+				Key:                  "HF_TOKEN",
+			},
+		},
+	}
+
+	if opts.InjectCredentials == CredentialsModeFile {
+		volumeName := resources.TokenVolumeName(model.Name)
+		mountPath := tokenMountDir + "/" + model.Name
+		env = corev1.EnvVar{Name: "HF_TOKEN_PATH", Value: mountPath + "/token"}
+
+		if !hasVolume(pod, volumeName) {
+			pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					Projected: &corev1.ProjectedVolumeSource{
+						Sources: []corev1.VolumeProjection{{
+							Secret: &corev1.SecretProjection{
+								LocalObjectReference: corev1.LocalObjectReference{Name: model.Spec.CredentialsSecret},
+								Items:                []corev1.KeyToPath{{Key: "HF_TOKEN", Path: "token"}},
+							},
+						}},
+					},
+				},
+			})
+		}
+		if !hasVolumeMount(container, volumeName) {
+			container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+				Name:      volumeName,
+				MountPath: mountPath,
+				ReadOnly:  true,
+			})
+		}
+	}
+
+	// The first Model to provide a token wins
+	for _, e := range container.Env {
+		if e.Name == env.Name {
+			return nil
+		}
+	}
+	container.Env = append(container.Env, env)
+	return nil
+}
+
+// hasVolume reports whether the pod already has a volume with the given name
+func hasVolume(pod *corev1.Pod, name string) bool {
+	for _, v := range pod.Spec.Volumes {
+		if v.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// hasVolumeMount reports whether the container already mounts the named volume
+func hasVolumeMount(container *corev1.Container, name string) bool {
+	for _, m := range container.VolumeMounts {
+		if m.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -17,10 +17,18 @@ limitations under the License.
 package webhook
 
 import (
+	"maps"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
 	"github.com/rsJames-ttrpg/model-operator/internal/resources"
@@ -61,6 +69,17 @@ func TestParseOptions(t *testing.T) {
 				InjectEnv: true,
 			},
 		},
+		{
+			name: "keep compressed",
+			annotations: map[string]string{
+				AnnotationDecompress: "false",
+			},
+			wantOpts: injectionOptions{
+				ReadOnly:       true,
+				InjectEnv:      true,
+				KeepCompressed: true,
+			},
+		},
 		{
 			name: "disable env injection",
 			annotations: map[string]string{
@@ -82,6 +101,17 @@ func TestParseOptions(t *testing.T) {
 				InjectEnv:     true,
 			},
 		},
+		{
+			name: "inject credentials",
+			annotations: map[string]string{
+				AnnotationInjectCredentials: "file",
+			},
+			wantOpts: injectionOptions{
+				ReadOnly:          true,
+				InjectEnv:         true,
+				InjectCredentials: CredentialsModeFile,
+			},
+		},
 		{
 			name: "all options",
 			annotations: map[string]string{
@@ -115,6 +145,9 @@ func TestParseOptions(t *testing.T) {
 			if opts.InjectEnv != tt.wantOpts.InjectEnv {
 				t.Errorf("InjectEnv = %v, want %v", opts.InjectEnv, tt.wantOpts.InjectEnv)
 			}
+			if opts.InjectCredentials != tt.wantOpts.InjectCredentials {
+				t.Errorf("InjectCredentials = %v, want %v", opts.InjectCredentials, tt.wantOpts.InjectCredentials)
+			}
 		})
 	}
 }
@@ -133,7 +166,7 @@ func TestInjectVolume(t *testing.T) {
 		},
 	}
 
-	injectVolume(pod, model)
+	injectVolume(pod, model, resources.PVCName(model.Name))
 
 	if len(pod.Spec.Volumes) != 1 {
 		t.Fatalf("Expected 1 volume, got %d", len(pod.Spec.Volumes))
@@ -178,13 +211,47 @@ func TestInjectVolume_NoDuplicate(t *testing.T) {
 		},
 	}
 
-	injectVolume(pod, model)
+	injectVolume(pod, model, resources.PVCName(model.Name))
 
 	if len(pod.Spec.Volumes) != 1 {
 		t.Errorf("Expected 1 volume (no duplicate), got %d", len(pod.Spec.Volumes))
 	}
 }
 
+func TestInjectImageVolume(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-model",
+			Namespace: "default",
+		},
+	}
+	pod := &corev1.Pod{}
+
+	if err := injectImageVolume(pod, model); err == nil {
+		t.Fatal("Expected error for unpublished model")
+	}
+
+	image := "registry.internal/models/test@sha256:" + strings.Repeat("a", 64)
+	model.Status.Publication = &modelsv1alpha1.PublicationStatus{Image: image}
+	if err := injectImageVolume(pod, model); err != nil {
+		t.Fatalf("injectImageVolume() error = %v", err)
+	}
+	if err := injectImageVolume(pod, model); err != nil {
+		t.Fatalf("injectImageVolume() error = %v", err)
+	}
+
+	if len(pod.Spec.Volumes) != 1 {
+		t.Fatalf("Expected 1 volume, got %d", len(pod.Spec.Volumes))
+	}
+	vol := pod.Spec.Volumes[0]
+	if vol.Image == nil || vol.Image.Reference != image {
+		t.Errorf("Volume = %+v, want image volume of %v", vol, image)
+	}
+	if vol.Name != resources.VolumeName(model.Name) {
+		t.Errorf("Volume name = %v, want %v", vol.Name, resources.VolumeName(model.Name))
+	}
+}
+
 func TestInjectVolumeMount(t *testing.T) {
 	model := &modelsv1alpha1.Model{
 		ObjectMeta: metav1.ObjectMeta{
@@ -490,3 +557,431 @@ func TestInjectEnvVars_S3Source(t *testing.T) {
 		t.Errorf("BUCKET = %v, want my-bucket", envMap[prefix+"_BUCKET"])
 	}
 }
+
+func TestInjectCredentials(t *testing.T) {
+	newModel := func() *modelsv1alpha1.Model {
+		return &modelsv1alpha1.Model{
+			ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+			Spec: modelsv1alpha1.ModelSpec{
+				Source: modelsv1alpha1.ModelSource{
+					HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "meta-llama/Llama-3.1-8B-Instruct"},
+				},
+				CredentialsSecret:        "hf-credentials",
+				AllowCredentialInjection: true,
+			},
+		}
+	}
+	newPod := func() *corev1.Pod {
+		return &corev1.Pod{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}}}
+	}
+
+	t.Run("env", func(t *testing.T) {
+		pod := newPod()
+		if err := injectCredentials(pod, newModel(), injectionOptions{InjectCredentials: CredentialsModeEnv}); err != nil {
+			t.Fatalf("injectCredentials() error = %v", err)
+		}
+
+		env := pod.Spec.Containers[0].Env
+		if len(env) != 1 || env[0].Name != "HF_TOKEN" {
+			t.Fatalf("Env = %v, want HF_TOKEN", env)
+		}
+		ref := env[0].ValueFrom.SecretKeyRef
+		if ref == nil || ref.Name != "hf-credentials" || ref.Key != "HF_TOKEN" {
+			t.Errorf("HF_TOKEN should reference hf-credentials/HF_TOKEN, got %v", env[0].ValueFrom)
+		}
+		if len(pod.Spec.Volumes) != 0 {
+			t.Errorf("Env mode should not add volumes, got %v", pod.Spec.Volumes)
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		pod := newPod()
+		opts := injectionOptions{InjectCredentials: CredentialsModeFile}
+		for range 2 {
+			if err := injectCredentials(pod, newModel(), opts); err != nil {
+				t.Fatalf("injectCredentials() error = %v", err)
+			}
+		}
+
+		if len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].Name != resources.TokenVolumeName("llama") {
+			t.Fatalf("Volumes = %v, want one token volume", pod.Spec.Volumes)
+		}
+		secret := pod.Spec.Volumes[0].Projected.Sources[0].Secret
+		if secret.Name != "hf-credentials" || secret.Items[0].Key != "HF_TOKEN" {
+			t.Errorf("Token volume should project hf-credentials/HF_TOKEN, got %v", secret)
+		}
+
+		container := pod.Spec.Containers[0]
+		if len(container.VolumeMounts) != 1 || !container.VolumeMounts[0].ReadOnly {
+			t.Errorf("VolumeMounts = %v, want one read-only mount", container.VolumeMounts)
+		}
+		want := "/var/run/secrets/models.main-currents.news/llama/token"
+		if len(container.Env) != 1 || container.Env[0].Name != "HF_TOKEN_PATH" || container.Env[0].Value != want {
+			t.Errorf("Env = %v, want HF_TOKEN_PATH=%s", container.Env, want)
+		}
+	})
+
+	tests := []struct {
+		name   string
+		mutate func(*modelsv1alpha1.Model, *injectionOptions)
+		want   string
+	}{
+		{
+			name:   "not allowed",
+			mutate: func(m *modelsv1alpha1.Model, _ *injectionOptions) { m.Spec.AllowCredentialInjection = false },
+			want:   "allowCredentialInjection",
+		},
+		{
+			name:   "no secret",
+			mutate: func(m *modelsv1alpha1.Model, _ *injectionOptions) { m.Spec.CredentialsSecret = "" },
+			want:   "credentialsSecret",
+		},
+		{
+			name: "not hugging face",
+			mutate: func(m *modelsv1alpha1.Model, _ *injectionOptions) {
+				m.Spec.Source = modelsv1alpha1.ModelSource{URL: &modelsv1alpha1.URLSource{URL: "https://example.com/model.gguf"}}
+			},
+			want: "Hugging Face",
+		},
+		{
+			name:   "unknown mode",
+			mutate: func(_ *modelsv1alpha1.Model, o *injectionOptions) { o.InjectCredentials = "projected" },
+			want:   "unknown credentials mode",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := newModel()
+			opts := injectionOptions{InjectCredentials: CredentialsModeEnv}
+			tt.mutate(model, &opts)
+
+			pod := newPod()
+			err := injectCredentials(pod, model, opts)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("injectCredentials() error = %v, want it to mention %q", err, tt.want)
+			}
+			if len(pod.Spec.Containers[0].Env) != 0 {
+				t.Errorf("Env should be untouched on error, got %v", pod.Spec.Containers[0].Env)
+			}
+		})
+	}
+}
+
+func TestSelectClaim(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Replicas: []modelsv1alpha1.StorageReplica{
+				{Name: "nvme-a", StorageClass: "local-nvme", Zone: "zone-a"},
+				{Name: "nvme-b", StorageClass: "local-nvme", Zone: "zone-b"},
+				{Name: "nfs", StorageClass: "nfs"},
+			},
+		},
+		Status: modelsv1alpha1.ModelStatus{
+			ContentDigest: "sha256:abc",
+			Replicas: []modelsv1alpha1.ReplicaStatus{
+				{Name: "nvme-a", PVCName: "model-replica-llama-nvme-a", Phase: modelsv1alpha1.ModelPhaseReady, ContentDigest: "sha256:abc"},
+				{Name: "nvme-b", PVCName: "model-replica-llama-nvme-b", Phase: modelsv1alpha1.ModelPhaseDownloading},
+				{Name: "nfs", PVCName: "model-replica-llama-nfs", Phase: modelsv1alpha1.ModelPhaseReady, ContentDigest: "sha256:abc"},
+			},
+		},
+	}
+
+	zoneAffinity := func(zones ...string) *corev1.Affinity {
+		return &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+					MatchExpressions: []corev1.NodeSelectorRequirement{{
+						Key: corev1.LabelTopologyZone, Operator: corev1.NodeSelectorOpIn, Values: zones,
+					}},
+				}},
+			},
+		}}
+	}
+
+	tests := []struct {
+		name      string
+		podSpec   corev1.PodSpec
+		requested string
+		want      string
+		wantErr   bool
+	}{
+		{name: "no zone", want: "model-llama"},
+		{name: "zone node selector", podSpec: corev1.PodSpec{NodeSelector: map[string]string{corev1.LabelTopologyZone: "zone-a"}}, want: "model-replica-llama-nvme-a"},
+		{name: "zone affinity", podSpec: corev1.PodSpec{Affinity: zoneAffinity("zone-a")}, want: "model-replica-llama-nvme-a"},
+		{name: "several zones", podSpec: corev1.PodSpec{Affinity: zoneAffinity("zone-a", "zone-b")}, want: "model-llama"},
+		{name: "replica not ready", podSpec: corev1.PodSpec{NodeSelector: map[string]string{corev1.LabelTopologyZone: "zone-b"}}, want: "model-llama"},
+		{name: "requested", requested: "nfs", want: "model-replica-llama-nfs"},
+		{name: "requested not ready", requested: "nvme-b", wantErr: true},
+		{name: "requested unknown", requested: "ssd", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := selectClaim(&corev1.Pod{Spec: tt.podSpec}, model, tt.requested)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("selectClaim() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("selectClaim() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// A replica downloaded for older content is not used
+	stale := model.DeepCopy()
+	stale.Status.ContentDigest = "sha256:def"
+	pod := &corev1.Pod{Spec: corev1.PodSpec{NodeSelector: map[string]string{corev1.LabelTopologyZone: "zone-a"}}}
+	if got, _ := selectClaim(pod, stale, ""); got != "model-llama" {
+		t.Errorf("selectClaim() with stale replica = %v, want model-llama", got)
+	}
+}
+
+func TestPodName(t *testing.T) {
+	tests := []struct {
+		name        string
+		pod         *corev1.Pod
+		requestName string
+		want        string
+	}{
+		{
+			name:        "request name",
+			pod:         &corev1.Pod{},
+			requestName: "inference",
+			want:        "inference",
+		},
+		{
+			name: "pod name",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "inference"}},
+			want: "inference",
+		},
+		{
+			name: "generate name",
+			pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{GenerateName: "inference-7d9f-"}},
+			want: "inference-7d9f-*",
+		},
+		{
+			name: "no name",
+			pod:  &corev1.Pod{},
+			want: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := podName(tt.pod, tt.requestName); got != tt.want {
+				t.Errorf("podName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPodOwner(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{
+		{Kind: "ConfigMap", Name: "settings"},
+		{Kind: "ReplicaSet", Name: "inference-7d9f", Controller: ptr.To(true)},
+	}}}
+	if got := podOwner(pod); got != "ReplicaSet/inference-7d9f" {
+		t.Errorf("podOwner() = %q, want ReplicaSet/inference-7d9f", got)
+	}
+	if got := podOwner(&corev1.Pod{}); got != "" {
+		t.Errorf("podOwner() = %q, want empty for a pod without a controller", got)
+	}
+}
+
+func TestParseSubPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		models  []string
+		want    map[string]string
+		wantErr string
+	}{
+		{name: "unset", models: []string{"llama"}},
+		{name: "plain", value: "gguf/q4_K_M", models: []string{"llama"}, want: map[string]string{"llama": "gguf/q4_K_M"}},
+		{name: "cleaned", value: "./gguf//q4_K_M/", models: []string{"llama"}, want: map[string]string{"llama": "gguf/q4_K_M"}},
+		{
+			name:   "per model",
+			value:  `{"llama": "gguf/q4_K_M"}`,
+			models: []string{"llama", "bge"},
+			want:   map[string]string{"llama": "gguf/q4_K_M"},
+		},
+		{name: "plain for several models", value: "gguf", models: []string{"llama", "bge"}, wantErr: "single model"},
+		{name: "absolute", value: "/etc", models: []string{"llama"}, wantErr: "relative path"},
+		{name: "parent", value: "gguf/../../etc", models: []string{"llama"}, wantErr: "relative path"},
+		{name: "empty", value: `{"llama": ""}`, models: []string{"llama"}, wantErr: "relative path"},
+		{name: "model not injected", value: `{"bge": "onnx"}`, models: []string{"llama"}, wantErr: `"bge" is not injected`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseSubPaths(tt.value, tt.models)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("parseSubPaths() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSubPaths() error = %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("parseSubPaths() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelInjector_RecordsDenials(t *testing.T) {
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		fixtureModel("pending", modelsv1alpha1.ModelPhasePending),
+		fixtureModel("ready", modelsv1alpha1.ModelPhaseReady),
+	).Build()
+	recorder := record.NewFakeRecorder(10)
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme), Recorder: recorder}
+	denied := podsDenied.WithLabelValues("default", "pending", string(modelsv1alpha1.ModelPhasePending))
+	before := testutil.ToFloat64(denied)
+
+	pod := fixturePod(map[string]string{AnnotationInject: "ready,pending"}, nil)
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "vllm-7d9f", Controller: ptr.To(true)}}
+	if resp, _ := admitPod(t, injector, pod); resp.Allowed {
+		t.Fatal("Handle() allowed a pod requesting a Pending model")
+	}
+
+	if got := testutil.ToFloat64(denied) - before; got != 1 {
+		t.Errorf("model_operator_pods_denied_total increased by %v, want 1", got)
+	}
+	if len(recorder.Events) != 1 {
+		t.Fatalf("Recorded %d events, want 1", len(recorder.Events))
+	}
+	want := "Warning PodDenied Denied a pod of ReplicaSet/vllm-7d9f: model is not ready (phase: Pending)"
+	if event := <-recorder.Events; event != want {
+		t.Errorf("Event = %q, want %q", event, want)
+	}
+}
+
+func TestModelInjector_DeprecatedModel(t *testing.T) {
+	warned := fixtureModel("llama-2", modelsv1alpha1.ModelPhaseReady)
+	warned.Spec.Deprecated = true
+	warned.Spec.Replacement = "llama-3"
+	denied := fixtureModel("llama-1", modelsv1alpha1.ModelPhaseReady)
+	denied.Spec.Deprecated = true
+	denied.Spec.DeprecationPolicy = modelsv1alpha1.DeprecationPolicyDeny
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(warned, denied).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+	warnedPods := deprecatedPods.WithLabelValues("default", "llama-2", "warned")
+	deniedPods := deprecatedPods.WithLabelValues("default", "llama-1", "denied")
+	warnedBefore, deniedBefore := testutil.ToFloat64(warnedPods), testutil.ToFloat64(deniedPods)
+
+	resp, pod := admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama-2"}, nil))
+	if !resp.Allowed {
+		t.Fatalf("Handle() denied: %v", resp.Result)
+	}
+	if want := []string{`model "llama-2" is deprecated, use "llama-3" instead`}; !slices.Equal(resp.Warnings, want) {
+		t.Errorf("Warnings = %q, want %q", resp.Warnings, want)
+	}
+	env := map[string]string{}
+	for _, e := range pod.Spec.Containers[0].Env {
+		env[e.Name] = e.Value
+	}
+	if env["MODEL_LLAMA_2_DEPRECATED"] != "true" || env["MODEL_LLAMA_2_REPLACEMENT"] != "llama-3" {
+		t.Errorf("Env = %v, want the deprecation and replacement", env)
+	}
+
+	resp, _ = admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama-1"}, nil))
+	if resp.Allowed {
+		t.Fatal("Handle() allowed a pod requesting a model deprecated with the Deny policy")
+	}
+	if msg := resp.Result.Message; msg != `model "llama-1" is deprecated` {
+		t.Errorf("Denial = %q", msg)
+	}
+
+	if got := testutil.ToFloat64(warnedPods) - warnedBefore; got != 1 {
+		t.Errorf("Warned pods increased by %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(deniedPods) - deniedBefore; got != 1 {
+		t.Errorf("Denied pods increased by %v, want 1", got)
+	}
+}
+
+func TestModelInjector_CompressedModel(t *testing.T) {
+	compressed := fixtureModel("archived", modelsv1alpha1.ModelPhaseReady)
+	compressed.Spec.Storage.Compression = modelsv1alpha1.CompressionZstd
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(compressed).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	resp, pod := admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "archived"}, nil))
+	if !resp.Allowed {
+		t.Fatalf("Handle() denied: %v", resp.Result)
+	}
+	if len(pod.Spec.InitContainers) != 1 || pod.Spec.InitContainers[0].Name != resources.DecompressContainerName("archived") {
+		t.Fatalf("InitContainers = %+v, want the decompress container", pod.Spec.InitContainers)
+	}
+	volumes := map[string]corev1.Volume{}
+	for _, v := range pod.Spec.Volumes {
+		volumes[v.Name] = v
+	}
+	if v := volumes[resources.CompressedVolumeName("archived")]; v.PersistentVolumeClaim == nil || !v.PersistentVolumeClaim.ReadOnly {
+		t.Errorf("Compressed volume = %+v, want the PVC read-only", v)
+	}
+	if v := volumes[resources.VolumeName("archived")]; v.EmptyDir == nil {
+		t.Errorf("Model volume = %+v, want an emptyDir", v)
+	}
+
+	// Runtimes that read the files compressed opt out of the init container
+	resp, pod = admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "archived", AnnotationDecompress: "false"}, nil))
+	if !resp.Allowed {
+		t.Fatalf("Handle() denied: %v", resp.Result)
+	}
+	if len(pod.Spec.InitContainers) != 0 || len(pod.Spec.Volumes) != 1 || pod.Spec.Volumes[0].PersistentVolumeClaim == nil {
+		t.Errorf("Pod = %+v, want the PVC mounted as stored", pod.Spec)
+	}
+}
+
+func TestModelMountPath_Consistent(t *testing.T) {
+	model := fixtureModel("llama", modelsv1alpha1.ModelPhaseReady)
+	model.Spec.Version = "3.1"
+	model.Status.ContentDigest = "sha256:" + strings.Repeat("ab", 32)
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(model).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	for _, base := range []string{"", "/data", "/data/{name}", "/srv/{namespace}/{name}/{version}", "/cache/{name}-{digest:8}"} {
+		t.Run(base, func(t *testing.T) {
+			resp, pod := admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama", AnnotationMountPath: base}, nil))
+			if !resp.Allowed {
+				t.Fatalf("Handle() denied: %v", resp.Result)
+			}
+			container := pod.Spec.Containers[0]
+			mounted := container.VolumeMounts[0].MountPath
+
+			var env, info string
+			for _, e := range container.Env {
+				switch e.Name {
+				case "MODEL_LLAMA_MOUNT_PATH":
+					env = e.Value
+				case "MODEL_LLAMA_INFO":
+					info = e.Value
+				}
+			}
+			if env != mounted {
+				t.Errorf("MODEL_LLAMA_MOUNT_PATH = %q, mounted at %q", env, mounted)
+			}
+			if !strings.Contains(info, `"mountPath":"`+mounted+`"`) {
+				t.Errorf("MODEL_LLAMA_INFO = %s, mounted at %q", info, mounted)
+			}
+			if !strings.Contains(pod.Annotations[AnnotationModelInfo], `"mountPath":"`+mounted+`"`) {
+				t.Errorf("Model info annotation = %s, mounted at %q", pod.Annotations[AnnotationModelInfo], mounted)
+			}
+		})
+	}
+
+	resp, _ := admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama", AnnotationMountPath: "/data/{tag}"}, nil))
+	if resp.Allowed || !strings.Contains(resp.Result.Message, "unknown placeholder {tag}") {
+		t.Errorf("Handle() allowed = %v, message %q, want an unknown placeholder denied", resp.Allowed, resp.Result.Message)
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// AnnotationOverlay records the environment whose overlay was applied to a Model
+const AnnotationOverlay = "models.main-currents.news/overlay"
+
+// ModelOverlayDefaulter applies the spec.overlays entry for the operator's
+// environment to Models as they are admitted
+// +kubebuilder:webhook:path=/mutate-models-v1alpha1-model,mutating=true,failurePolicy=fail,sideEffects=None,groups=models.main-currents.news,resources=models,verbs=create;update,versions=v1alpha1,name=model-overlay.models.main-currents.news,admissionReviewVersions=v1
+
+type ModelOverlayDefaulter struct {
+	// Environment selects the spec.overlays entry to apply
+	Environment string
+	Decoder     admission.Decoder
+}
+
+// Handle processes admission requests for Models
+func (d *ModelOverlayDefaulter) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx).WithName("model-overlay")
+
+	model := &modelsv1alpha1.Model{}
+	if err := d.Decoder.Decode(req, model); err != nil {
+		log.Error(err, "Failed to decode model")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Deny sizes the CRD pattern lets through but that are not quantities,
+	// without blocking Models admitted with one before
+	var old *modelsv1alpha1.Model
+	if len(req.OldObject.Raw) > 0 {
+		old = &modelsv1alpha1.Model{}
+		if err := d.Decoder.DecodeRaw(req.OldObject, old); err != nil {
+			log.Error(err, "Failed to decode old model")
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+	}
+	if err := validateStorageSizes(model, old); err != nil {
+		return admission.Denied(err.Error())
+	}
+
+	if !applyOverlay(model, d.Environment) {
+		return admission.Allowed("no overlay for environment")
+	}
+
+	log.Info("Applied overlay", "model", req.Name, "namespace", req.Namespace, "environment", d.Environment)
+
+	marshaled, err := json.Marshal(model)
+	if err != nil {
+		log.Error(err, "Failed to marshal model")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
+
+// validateStorageSizes checks the storage sizes of the Model parse, skipping
+// the sizes unchanged from old and Models being deleted
+func validateStorageSizes(model, old *modelsv1alpha1.Model) error {
+	if model.DeletionTimestamp != nil {
+		return nil
+	}
+	sizes := storageSizes(model)
+	var oldSizes map[string]string
+	if old != nil {
+		oldSizes = storageSizes(old)
+	}
+	for _, field := range slices.Sorted(maps.Keys(sizes)) {
+		size := sizes[field]
+		if size == "" || (old != nil && oldSizes[field] == size) {
+			continue
+		}
+		if _, err := resources.ParseStorageSize(size); err != nil {
+			return fmt.Errorf("%s: %w", field, err)
+		}
+	}
+	return nil
+}
+
+// storageSizes returns the storage sizes of the Model by field path
+func storageSizes(model *modelsv1alpha1.Model) map[string]string {
+	sizes := map[string]string{"spec.storage.size": model.Spec.Storage.Size}
+	for env, overlay := range model.Spec.Overlays {
+		sizes[fmt.Sprintf("spec.overlays[%s].size", env)] = overlay.Size
+	}
+	for _, replica := range model.Spec.Replicas {
+		sizes[fmt.Sprintf("spec.replicas[%s].size", replica.Name)] = replica.Size
+	}
+	return sizes
+}
+
+// applyOverlay applies the overlay for environment to the Model spec and
+// records it in an annotation. It returns false if there is no such overlay.
+func applyOverlay(model *modelsv1alpha1.Model, environment string) bool {
+	if environment == "" {
+		return false
+	}
+	overlay, ok := model.Spec.Overlays[environment]
+	if !ok {
+		return false
+	}
+
+	spec := &model.Spec
+	if overlay.StorageClass != "" {
+		spec.Storage.StorageClass = overlay.StorageClass
+	}
+	if overlay.Size != "" {
+		spec.Storage.Size = overlay.Size
+	}
+	if len(overlay.NodeSelector) > 0 {
+		spec.NodeSelector = overlay.NodeSelector
+	}
+	if overlay.Endpoint != "" {
+		switch {
+		case spec.Source.S3 != nil:
+			spec.Source.S3.Endpoint = overlay.Endpoint
+		case spec.Source.HuggingFace != nil:
+			spec.Source.HuggingFace.Endpoint = overlay.Endpoint
+		}
+	}
+
+	if model.Annotations == nil {
+		model.Annotations = map[string]string{}
+	}
+	model.Annotations[AnnotationOverlay] = environment
+	return true
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func overlayModel() *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama", Namespace: "default"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "meta-llama/Llama-3.1-8B-Instruct"},
+			},
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "standard", Size: "20Gi"},
+			Overlays: map[string]modelsv1alpha1.ModelOverlay{
+				"dev": {StorageClass: "local-path", Size: "10Gi"},
+				"prod": {
+					StorageClass: "longhorn",
+					NodeSelector: map[string]string{"node-pool": "storage"},
+					Endpoint:     "https://hf-mirror.internal",
+				},
+			},
+		},
+	}
+}
+
+func TestApplyOverlay(t *testing.T) {
+	tests := []struct {
+		name             string
+		environment      string
+		wantApplied      bool
+		wantStorageClass string
+		wantSize         string
+		wantEndpoint     string
+		wantNodeSelector string
+	}{
+		{
+			name:             "no environment",
+			wantStorageClass: "standard",
+			wantSize:         "20Gi",
+		},
+		{
+			name:             "unknown environment",
+			environment:      "staging",
+			wantStorageClass: "standard",
+			wantSize:         "20Gi",
+		},
+		{
+			name:             "partial overlay",
+			environment:      "dev",
+			wantApplied:      true,
+			wantStorageClass: "local-path",
+			wantSize:         "10Gi",
+		},
+		{
+			name:             "full overlay",
+			environment:      "prod",
+			wantApplied:      true,
+			wantStorageClass: "longhorn",
+			wantSize:         "20Gi",
+			wantEndpoint:     "https://hf-mirror.internal",
+			wantNodeSelector: "storage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := overlayModel()
+			if got := applyOverlay(model, tt.environment); got != tt.wantApplied {
+				t.Errorf("applyOverlay() = %v, want %v", got, tt.wantApplied)
+			}
+			if model.Spec.Storage.StorageClass != tt.wantStorageClass {
+				t.Errorf("StorageClass = %v, want %v", model.Spec.Storage.StorageClass, tt.wantStorageClass)
+			}
+			if model.Spec.Storage.Size != tt.wantSize {
+				t.Errorf("Size = %v, want %v", model.Spec.Storage.Size, tt.wantSize)
+			}
+			if model.Spec.Source.HuggingFace.Endpoint != tt.wantEndpoint {
+				t.Errorf("Endpoint = %v, want %v", model.Spec.Source.HuggingFace.Endpoint, tt.wantEndpoint)
+			}
+			if model.Spec.NodeSelector["node-pool"] != tt.wantNodeSelector {
+				t.Errorf("NodeSelector = %v, want node-pool=%v", model.Spec.NodeSelector, tt.wantNodeSelector)
+			}
+			if tt.wantApplied && model.Annotations[AnnotationOverlay] != tt.environment {
+				t.Errorf("Annotation %s = %v, want %v", AnnotationOverlay, model.Annotations[AnnotationOverlay], tt.environment)
+			}
+		})
+	}
+}
+
+func TestApplyOverlay_S3Endpoint(t *testing.T) {
+	model := &modelsv1alpha1.Model{
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				S3: &modelsv1alpha1.S3Source{Bucket: "models", Key: "llama/", Endpoint: "https://s3.amazonaws.com"},
+			},
+			Overlays: map[string]modelsv1alpha1.ModelOverlay{
+				"dev": {Endpoint: "http://minio.dev:9000"},
+			},
+		},
+	}
+
+	applyOverlay(model, "dev")
+	if model.Spec.Source.S3.Endpoint != "http://minio.dev:9000" {
+		t.Errorf("S3 Endpoint = %v, want http://minio.dev:9000", model.Spec.Source.S3.Endpoint)
+	}
+}
+
+func TestModelOverlayDefaulter_Handle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := json.Marshal(overlayModel())
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Operation: admissionv1.Create,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+
+	tests := []struct {
+		environment string
+		wantPatches bool
+	}{
+		{"", false},
+		{"staging", false},
+		{"prod", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.environment, func(t *testing.T) {
+			d := &ModelOverlayDefaulter{Environment: tt.environment, Decoder: admission.NewDecoder(scheme)}
+			resp := d.Handle(context.Background(), req)
+			if !resp.Allowed {
+				t.Fatalf("Handle() denied: %v", resp.Result)
+			}
+			if got := len(resp.Patches) > 0; got != tt.wantPatches {
+				t.Errorf("Handle() patches = %v, want patches %v", resp.Patches, tt.wantPatches)
+			}
+		})
+	}
+}
+
+func TestModelOverlayDefaulter_StorageSizes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	d := &ModelOverlayDefaulter{Environment: "dev", Decoder: admission.NewDecoder(scheme)}
+
+	withSize := func(size, devSize string) runtime.RawExtension {
+		model := overlayModel()
+		model.Spec.Storage.Size = size
+		model.Spec.Overlays["dev"] = modelsv1alpha1.ModelOverlay{Size: devSize}
+		raw, err := json.Marshal(model)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return runtime.RawExtension{Raw: raw}
+	}
+
+	tests := []struct {
+		name      string
+		object    runtime.RawExtension
+		oldObject runtime.RawExtension
+		wantAllow bool
+	}{
+		{name: "binary units", object: withSize("20Gi", "10Gi"), wantAllow: true},
+		{name: "decimal units", object: withSize("20G", "10G"), wantAllow: true},
+		{name: "not a quantity", object: withSize("20K", "10Gi")},
+		{name: "overlay not a quantity", object: withSize("20Gi", "10K")},
+		{name: "zero", object: withSize("0Gi", "10Gi")},
+		{
+			name:      "unchanged since before the check",
+			object:    withSize("20K", "10Gi"),
+			oldObject: withSize("20K", "10Gi"),
+			wantAllow: true,
+		},
+		{
+			name:      "changed to not a quantity",
+			object:    withSize("30K", "10Gi"),
+			oldObject: withSize("20K", "10Gi"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			operation := admissionv1.Create
+			if tt.oldObject.Raw != nil {
+				operation = admissionv1.Update
+			}
+			resp := d.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: operation,
+				Object:    tt.object,
+				OldObject: tt.oldObject,
+			}})
+			if resp.Allowed != tt.wantAllow {
+				t.Errorf("Handle() allowed = %v, want %v: %v", resp.Allowed, tt.wantAllow, resp.Result)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// ModelRetention denies deleting a Model within spec.minimumRetention of it
+// becoming Ready
+// +kubebuilder:webhook:path=/validate-models-v1alpha1-model-retention,mutating=false,failurePolicy=fail,sideEffects=None,groups=models.main-currents.news,resources=models,verbs=delete,versions=v1alpha1,name=model-retention.models.main-currents.news,admissionReviewVersions=v1
+
+type ModelRetention struct {
+	Client  client.Client
+	Decoder admission.Decoder
+
+	// Now returns the current time; defaults to time.Now
+	Now func() time.Time
+}
+
+// Handle processes admission requests for Models
+func (m *ModelRetention) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := logf.FromContext(ctx).WithName("model-retention")
+
+	model := &modelsv1alpha1.Model{}
+	if err := m.Decoder.DecodeRaw(req.OldObject, model); err != nil {
+		log.Error(err, "Failed to decode model")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	until, protected := retainedUntil(model)
+	now := time.Now
+	if m.Now != nil {
+		now = m.Now
+	}
+	if !protected || !now().Before(until) {
+		return admission.Allowed("not retained")
+	}
+
+	// A namespace being deleted must be able to delete its Models
+	ns := &corev1.Namespace{}
+	if err := m.Client.Get(ctx, client.ObjectKey{Name: req.Namespace}, ns); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+	} else if !ns.DeletionTimestamp.IsZero() {
+		return admission.Allowed("namespace is being deleted")
+	}
+
+	log.Info("Denied deleting retained model", "model", req.Name, "namespace", req.Namespace,
+		"user", req.UserInfo.Username, "until", until)
+	return admission.Denied(fmt.Sprintf(
+		"model %s is retained until %s by spec.minimumRetention; remove the field to delete it sooner",
+		req.Name, until.UTC().Format(time.RFC3339)))
+}
+
+// retainedUntil returns when the minimum retention of a Model ends, and
+// whether it is protected at all: only a Ready Model is, from the time it
+// became Ready
+func retainedUntil(model *modelsv1alpha1.Model) (time.Time, bool) {
+	retention := model.Spec.MinimumRetention
+	if retention == nil || retention.Duration <= 0 || model.Status.Phase != modelsv1alpha1.ModelPhaseReady {
+		return time.Time{}, false
+	}
+	ready := meta.FindStatusCondition(model.Status.Conditions, "Ready")
+	if ready == nil || ready.Status != metav1.ConditionTrue {
+		return time.Time{}, false
+	}
+	return ready.LastTransitionTime.Add(retention.Duration), true
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestModelRetention_Handle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := modelsv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	readyAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	retained := func(mutate func(*modelsv1alpha1.Model)) *modelsv1alpha1.Model {
+		model := overlayModel()
+		model.Spec.MinimumRetention = &metav1.Duration{Duration: 72 * time.Hour}
+		model.Status.Phase = modelsv1alpha1.ModelPhaseReady
+		model.Status.Conditions = []metav1.Condition{{
+			Type: "Ready", Status: metav1.ConditionTrue, Reason: "DownloadComplete",
+			LastTransitionTime: metav1.NewTime(readyAt),
+		}}
+		if mutate != nil {
+			mutate(model)
+		}
+		return model
+	}
+
+	tests := []struct {
+		name        string
+		model       *modelsv1alpha1.Model
+		now         time.Time
+		terminating bool
+		wantAllowed bool
+	}{
+		{
+			name:        "within the window",
+			model:       retained(nil),
+			now:         readyAt.Add(time.Hour),
+			wantAllowed: false,
+		},
+		{
+			name:        "after the window",
+			model:       retained(nil),
+			now:         readyAt.Add(72 * time.Hour),
+			wantAllowed: true,
+		},
+		{
+			name:        "no minimum retention",
+			model:       retained(func(m *modelsv1alpha1.Model) { m.Spec.MinimumRetention = nil }),
+			now:         readyAt.Add(time.Hour),
+			wantAllowed: true,
+		},
+		{
+			name: "not ready",
+			model: retained(func(m *modelsv1alpha1.Model) {
+				m.Status.Phase = modelsv1alpha1.ModelPhaseFailed
+				m.Status.Conditions[0].Status = metav1.ConditionFalse
+			}),
+			now:         readyAt.Add(time.Hour),
+			wantAllowed: true,
+		},
+		{
+			name:        "namespace being deleted",
+			model:       retained(nil),
+			now:         readyAt.Add(time.Hour),
+			terminating: true,
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.terminating {
+				ns.Finalizers = []string{"kubernetes"}
+				builder = builder.WithObjects(ns)
+			}
+			c := builder.Build()
+			if tt.terminating {
+				if err := c.Delete(context.Background(), ns); err != nil {
+					t.Fatal(err)
+				}
+				if err := c.Get(context.Background(), client.ObjectKeyFromObject(ns), ns); err != nil || ns.DeletionTimestamp.IsZero() {
+					t.Fatalf("Namespace not terminating: %v", err)
+				}
+			}
+
+			raw, err := json.Marshal(tt.model)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m := &ModelRetention{Client: c, Decoder: admission.NewDecoder(scheme), Now: func() time.Time { return tt.now }}
+			resp := m.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+				Name:      "llama",
+				Namespace: "default",
+				Operation: admissionv1.Delete,
+				OldObject: runtime.RawExtension{Raw: raw},
+			}})
+
+			if resp.Allowed != tt.wantAllowed {
+				t.Fatalf("Allowed = %v, want %v: %v", resp.Allowed, tt.wantAllowed, resp.Result)
+			}
+			if !resp.Allowed && !strings.Contains(resp.Result.Message, "retained until 2026-03-04T12:00:00Z") {
+				t.Errorf("Denial message = %q", resp.Result.Message)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"strings"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// AnnotationRevision pins a revision kept under spec.revisionHistoryLimit,
+// either a plain revision for a single model or a JSON object mapping model
+// names to revisions
+const AnnotationRevision = "models.main-currents.news/revision"
+
+// pinRevision returns the Model as of the pinned revision, and the directory
+// of the revision on the volume. A pin of the current revision, or no pin,
+// returns the Model as it is and an empty path.
+func pinRevision(model *modelsv1alpha1.Model, revision string) (*modelsv1alpha1.Model, string, error) {
+	if revision == "" || revision == model.Status.SourceRevision {
+		return model, "", nil
+	}
+
+	var kept []string
+	for _, rev := range model.Status.Revisions {
+		if rev.Revision != revision {
+			kept = append(kept, rev.Revision)
+			continue
+		}
+		pinned := model.DeepCopy()
+		if pinned.Spec.Source.HuggingFace != nil {
+			pinned.Spec.Source.HuggingFace.Revision = rev.Revision
+		}
+		pinned.Status.SourceIndex = 0
+		pinned.Status.SourceRevision = rev.Revision
+		pinned.Status.ContentDigest = rev.ContentDigest
+		return pinned, rev.Path, nil
+	}
+
+	if len(kept) == 0 {
+		return nil, "", fmt.Errorf("revision %q is not kept, the model is at %q", revision, model.Status.SourceRevision)
+	}
+	return nil, "", fmt.Errorf("revision %q is not kept, the model is at %q and keeps %s",
+		revision, model.Status.SourceRevision, strings.Join(kept, ", "))
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+func TestModelInjector_PinnedRevision(t *testing.T) {
+	model := fixtureModel("llama", modelsv1alpha1.ModelPhaseReady)
+	model.Spec.Source.HuggingFace.Revision = "v2"
+	model.Status.SourceRevision = "v2"
+	model.Status.ContentDigest = "sha256:v2"
+	model.Status.Revisions = []modelsv1alpha1.ModelRevision{
+		{Revision: "v1", ContentDigest: "sha256:v1", Path: resources.RevisionPath("v1")},
+	}
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(model).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	resp, pod := admitPod(t, injector, fixturePod(map[string]string{
+		AnnotationInject:   "llama",
+		AnnotationRevision: "v1",
+		AnnotationSubPath:  "tokenizer",
+	}, nil))
+	if !resp.Allowed {
+		t.Fatalf("Handle() denied: %v", resp.Result)
+	}
+	mount := pod.Spec.Containers[0].VolumeMounts[0]
+	if want := resources.RevisionPath("v1") + "/tokenizer"; mount.SubPath != want {
+		t.Errorf("SubPath = %q, want %q", mount.SubPath, want)
+	}
+	var infos map[string]ModelInfo
+	if err := json.Unmarshal([]byte(pod.Annotations[AnnotationModelInfo]), &infos); err != nil {
+		t.Fatal(err)
+	}
+	if info := infos["llama"]; info.Source.Revision != "v1" || info.ContentDigest != "sha256:v1" {
+		t.Errorf("ModelInfo = %+v, want the pinned revision", info)
+	}
+
+	// Pinning the current revision mounts the volume root
+	resp, pod = admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama", AnnotationRevision: "v2"}, nil))
+	if !resp.Allowed || pod.Spec.Containers[0].VolumeMounts[0].SubPath != "" {
+		t.Errorf("Handle() allowed = %v, want the current revision mounted: %v", resp.Allowed, resp.Result)
+	}
+
+	resp, _ = admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "llama", AnnotationRevision: "v0"}, nil))
+	want := `revision "v0" is not kept, the model is at "v2" and keeps v1`
+	if resp.Allowed || !strings.Contains(resp.Result.Message, want) {
+		t.Errorf("Handle() allowed = %v, message %q, want denied with %q", resp.Allowed, resp.Result.Message, want)
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// maxWaitTimeout bounds AnnotationWaitTimeout well inside the injector's
+	// 30s webhook timeout, past which the pod is admitted without models
+	maxWaitTimeout = 20 * time.Second
+
+	// waitMinProgress is the download progress from which a Model is
+	// considered nearly ready and worth waiting for
+	waitMinProgress = 90
+)
+
+// waitPollInterval is how often a nearly ready Model is checked again
+var waitPollInterval = time.Second
+
+// parseWaitTimeout parses the AnnotationWaitTimeout value, returning 0 if unset
+func parseWaitTimeout(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if timeout < 0 || timeout > maxWaitTimeout {
+		return 0, fmt.Errorf("%s must be between 0s and %s", value, maxWaitTimeout)
+	}
+	return timeout, nil
+}
+
+// nearlyReady reports whether the Model is in the last stretch of its download
+func nearlyReady(model *modelsv1alpha1.Model) bool {
+	return model.Status.Phase == modelsv1alpha1.ModelPhaseDownloading && model.Status.Progress >= waitMinProgress
+}
+
+// waitForReady polls the Model until it is Ready, for at most timeout, and
+// returns its latest state. Running out of time is not an error.
+func (m *ModelInjector) waitForReady(ctx context.Context, model *modelsv1alpha1.Model, timeout time.Duration) (*modelsv1alpha1.Model, error) {
+	key := types.NamespacedName{Name: model.Name, Namespace: model.Namespace}
+	err := wait.PollUntilContextTimeout(ctx, waitPollInterval, timeout, false, func(ctx context.Context) (bool, error) {
+		latest := &modelsv1alpha1.Model{}
+		if err := m.Client.Get(ctx, key, latest); err != nil {
+			return false, err
+		}
+		model = latest
+		return model.Status.Phase != modelsv1alpha1.ModelPhaseDownloading, nil
+	})
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return model, err
+	}
+	return model, nil
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestParseWaitTimeout(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: 0},
+		{value: "15s", want: 15 * time.Second},
+		{value: "20s", want: 20 * time.Second},
+		{value: "30s", wantErr: true},
+		{value: "-1s", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseWaitTimeout(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseWaitTimeout(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parseWaitTimeout(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModelInjector_WaitTimeout(t *testing.T) {
+	interval := waitPollInterval
+	waitPollInterval = 10 * time.Millisecond
+	t.Cleanup(func() { waitPollInterval = interval })
+
+	downloading := func(name string, progress int) *modelsv1alpha1.Model {
+		model := fixtureModel(name, modelsv1alpha1.ModelPhaseDownloading)
+		model.Status.Progress = progress
+		return model
+	}
+
+	scheme := testScheme(t)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		downloading("finishing", 97),
+		downloading("stalled", 95),
+		downloading("early", 40),
+	).Build()
+	injector := &ModelInjector{Client: c, Decoder: admission.NewDecoder(scheme)}
+
+	t.Run("model becomes ready", func(t *testing.T) {
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			model := &modelsv1alpha1.Model{}
+			if err := c.Get(context.Background(), client.ObjectKey{Name: "finishing", Namespace: "default"}, model); err != nil {
+				t.Error(err)
+				return
+			}
+			model.Status.Phase = modelsv1alpha1.ModelPhaseReady
+			model.Status.Progress = 100
+			if err := c.Update(context.Background(), model); err != nil {
+				t.Error(err)
+			}
+		}()
+
+		resp, pod := admitPod(t, injector, fixturePod(map[string]string{AnnotationInject: "finishing", AnnotationWaitTimeout: "5s"}, nil))
+		if !resp.Allowed || pod.Labels[LabelInjected] != "true" {
+			t.Errorf("Handle() allowed = %v, want the pod injected once the model is Ready: %v", resp.Allowed, resp.Result)
+		}
+	})
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantDenied  string
+		wantWait    bool
+	}{
+		{
+			name:        "model stays downloading",
+			annotations: map[string]string{AnnotationInject: "stalled", AnnotationWaitTimeout: "200ms"},
+			wantDenied:  `model "stalled" is not ready (phase: Downloading)`,
+			wantWait:    true,
+		},
+		{
+			name:        "model far from ready",
+			annotations: map[string]string{AnnotationInject: "early", AnnotationWaitTimeout: "5s"},
+			wantDenied:  `model "early" is not ready (phase: Downloading)`,
+		},
+		{
+			name:        "no wait requested",
+			annotations: map[string]string{AnnotationInject: "stalled"},
+			wantDenied:  `model "stalled" is not ready (phase: Downloading)`,
+		},
+		{
+			name:        "invalid timeout",
+			annotations: map[string]string{AnnotationInject: "stalled", AnnotationWaitTimeout: "1m"},
+			wantDenied:  "invalid " + AnnotationWaitTimeout,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			resp, _ := admitPod(t, injector, fixturePod(tt.annotations, nil))
+			elapsed := time.Since(start)
+
+			if resp.Allowed || !strings.Contains(resp.Result.Message, tt.wantDenied) {
+				t.Errorf("Handle() allowed = %v, message %q, want denied with %q", resp.Allowed, resp.Result.Message, tt.wantDenied)
+			}
+			if waited := elapsed >= 200*time.Millisecond; waited != tt.wantWait {
+				t.Errorf("Handle() took %v, want waiting = %v", elapsed, tt.wantWait)
+			}
+		})
+	}
+}
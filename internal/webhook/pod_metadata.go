@@ -0,0 +1,159 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// AnnotationModels lists the Models injected into a pod
+	AnnotationModels = "models.main-currents.news/models"
+	// AnnotationDigests lists name=digest for the injected Models with a
+	// content digest
+	AnnotationDigests = "models.main-currents.news/digests"
+
+	// AnnotationPodLabels on a Namespace adds labels to the pods injected in
+	// it, as a comma-separated key=value list
+	AnnotationPodLabels = "models.main-currents.news/pod-labels"
+	// AnnotationPodAnnotations on a Namespace adds annotations to the pods
+	// injected in it, as a comma-separated key=value list
+	AnnotationPodAnnotations = "models.main-currents.news/pod-annotations"
+)
+
+// PodMetadata holds the labels and annotations stamped on injected pods
+type PodMetadata struct {
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+// ParsePodMetadata parses comma-separated key=value lists of labels and
+// annotations
+func ParsePodMetadata(labelList, annotationList string) (PodMetadata, error) {
+	labels, err := parseKeyValues(labelList, true)
+	if err != nil {
+		return PodMetadata{}, fmt.Errorf("invalid labels: %w", err)
+	}
+	annotations, err := parseKeyValues(annotationList, false)
+	if err != nil {
+		return PodMetadata{}, fmt.Errorf("invalid annotations: %w", err)
+	}
+	return PodMetadata{Labels: labels, Annotations: annotations}, nil
+}
+
+// parseKeyValues parses a comma-separated key=value list, checking values
+// are valid label values if isLabel is set
+func parseKeyValues(list string, isLabel bool) (map[string]string, error) {
+	values := map[string]string{}
+	for _, pair := range strings.Split(list, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not key=value", pair)
+		}
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); isLabel && len(errs) > 0 {
+			return nil, fmt.Errorf("value %q: %s", value, strings.Join(errs, "; "))
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// podMetadata returns the operator's pod metadata overridden by the
+// namespace's. A namespace with invalid metadata is logged and ignored, so
+// it never blocks pod creation.
+func (m *ModelInjector) podMetadata(ctx context.Context, namespace string) PodMetadata {
+	log := logf.FromContext(ctx).WithName("model-injector")
+
+	metadata := PodMetadata{Labels: maps.Clone(m.PodMetadata.Labels), Annotations: maps.Clone(m.PodMetadata.Annotations)}
+	if metadata.Labels == nil {
+		metadata.Labels = map[string]string{}
+	}
+	if metadata.Annotations == nil {
+		metadata.Annotations = map[string]string{}
+	}
+
+	ns := &corev1.Namespace{}
+	if err := m.Client.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+		if client.IgnoreNotFound(err) != nil {
+			log.Error(err, "Failed to get namespace, skipping its pod metadata", "namespace", namespace)
+		}
+		return metadata
+	}
+	nsMetadata, err := ParsePodMetadata(ns.Annotations[AnnotationPodLabels], ns.Annotations[AnnotationPodAnnotations])
+	if err != nil {
+		log.Info("Ignoring invalid pod metadata on namespace", "namespace", namespace, "reason", err.Error())
+		return metadata
+	}
+	maps.Copy(metadata.Labels, nsMetadata.Labels)
+	maps.Copy(metadata.Annotations, nsMetadata.Annotations)
+	return metadata
+}
+
+// stampPodMetadata adds the configured metadata and the injected Models to
+// the pod. Labels and annotations the pod already sets are kept.
+func stampPodMetadata(pod *corev1.Pod, metadata PodMetadata, models []*modelsv1alpha1.Model) {
+	if pod.Labels == nil {
+		pod.Labels = map[string]string{}
+	}
+	if pod.Annotations == nil {
+		pod.Annotations = map[string]string{}
+	}
+
+	for key, value := range metadata.Labels {
+		if _, ok := pod.Labels[key]; !ok {
+			pod.Labels[key] = value
+		}
+	}
+	for key, value := range metadata.Annotations {
+		if _, ok := pod.Annotations[key]; !ok {
+			pod.Annotations[key] = value
+		}
+	}
+
+	names := make([]string, 0, len(models))
+	var digests []string
+	for _, model := range models {
+		names = append(names, model.Name)
+		if model.Status.ContentDigest != "" {
+			digests = append(digests, model.Name+"="+model.Status.ContentDigest)
+		}
+	}
+	if len(names) > 0 {
+		pod.Annotations[AnnotationModels] = strings.Join(names, ",")
+	}
+	if len(digests) > 0 {
+		pod.Annotations[AnnotationDigests] = strings.Join(digests, ",")
+	}
+}
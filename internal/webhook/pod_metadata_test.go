@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+func TestParsePodMetadata(t *testing.T) {
+	tests := []struct {
+		name            string
+		labels          string
+		annotations     string
+		wantLabels      map[string]string
+		wantAnnotations map[string]string
+		wantErr         bool
+	}{
+		{
+			name:            "empty",
+			wantLabels:      map[string]string{},
+			wantAnnotations: map[string]string{},
+		},
+		{
+			name:            "lists",
+			labels:          "cost-center=ml-research, team=inference",
+			annotations:     "example.com/dashboard=https://grafana.example.com/d/models",
+			wantLabels:      map[string]string{"cost-center": "ml-research", "team": "inference"},
+			wantAnnotations: map[string]string{"example.com/dashboard": "https://grafana.example.com/d/models"},
+		},
+		{
+			name:    "missing value",
+			labels:  "cost-center",
+			wantErr: true,
+		},
+		{
+			name:    "invalid key",
+			labels:  "cost center=ml",
+			wantErr: true,
+		},
+		{
+			name:    "invalid label value",
+			labels:  "dashboard=https://grafana.example.com",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePodMetadata(tt.labels, tt.annotations)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePodMetadata() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !reflect.DeepEqual(got.Labels, tt.wantLabels) {
+				t.Errorf("Labels = %v, want %v", got.Labels, tt.wantLabels)
+			}
+			if !reflect.DeepEqual(got.Annotations, tt.wantAnnotations) {
+				t.Errorf("Annotations = %v, want %v", got.Annotations, tt.wantAnnotations)
+			}
+		})
+	}
+}
+
+func TestModelInjector_PodMetadata(t *testing.T) {
+	scheme := testScheme(t)
+	llama := fixtureModel("llama", modelsv1alpha1.ModelPhaseReady)
+	llama.Status.ContentDigest = "sha256:abc"
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name: "default",
+		Annotations: map[string]string{
+			AnnotationPodLabels:      "cost-center=ml-research",
+			AnnotationPodAnnotations: "example.com/owner=ml-platform",
+		},
+	}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		llama, fixtureModel("mistral", modelsv1alpha1.ModelPhaseReady), namespace,
+	).Build()
+	injector := &ModelInjector{
+		Client:  c,
+		Decoder: admission.NewDecoder(scheme),
+		PodMetadata: PodMetadata{
+			Labels:      map[string]string{"cost-center": "shared", "team": "inference"},
+			Annotations: map[string]string{"example.com/owner": "platform"},
+		},
+	}
+
+	pod := fixturePod(map[string]string{AnnotationInject: "llama,mistral"}, map[string]string{"team": "search"})
+	resp, pod := admitPod(t, injector, pod)
+	if !resp.Allowed {
+		t.Fatalf("Handle() denied: %v", resp.Result)
+	}
+
+	wantLabels := map[string]string{
+		// The namespace overrides the operator, the pod overrides both
+		"cost-center": "ml-research",
+		"team":        "search",
+		LabelInjected: "true",
+	}
+	if !reflect.DeepEqual(pod.Labels, wantLabels) {
+		t.Errorf("Labels = %v, want %v", pod.Labels, wantLabels)
+	}
+	if got := pod.Annotations["example.com/owner"]; got != "ml-platform" {
+		t.Errorf("Owner annotation = %q, want the namespace's ml-platform", got)
+	}
+	if got := pod.Annotations[AnnotationModels]; got != "llama,mistral" {
+		t.Errorf("Models annotation = %q, want llama,mistral", got)
+	}
+	if got := pod.Annotations[AnnotationDigests]; got != "llama=sha256:abc" {
+		t.Errorf("Digests annotation = %q, want llama=sha256:abc", got)
+	}
+}
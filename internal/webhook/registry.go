@@ -0,0 +1,146 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// Paths the webhooks are served on
+const (
+	PathModelInjector  = "/mutate-v1-pod"
+	PathModelOverlay   = "/mutate-models-v1alpha1-model"
+	PathModelAudit     = "/validate-models-v1alpha1-model-audit"
+	PathModelRetention = "/validate-models-v1alpha1-model-retention"
+)
+
+// Registration describes how the API server calls one of the webhooks. It
+// mirrors the kubebuilder markers on the handlers.
+type Registration struct {
+	Name          string
+	Path          string
+	Mutating      bool
+	FailurePolicy admissionregistrationv1.FailurePolicyType
+	SideEffects   admissionregistrationv1.SideEffectClass
+	Rule          admissionregistrationv1.Rule
+	Operations    []admissionregistrationv1.OperationType
+	// TimeoutSeconds overrides the API server's 10s default
+	TimeoutSeconds *int32
+}
+
+// modelsRule matches Models in the operator's API group
+var modelsRule = admissionregistrationv1.Rule{
+	APIGroups:   []string{modelsv1alpha1.GroupVersion.Group},
+	APIVersions: []string{modelsv1alpha1.GroupVersion.Version},
+	Resources:   []string{"models"},
+}
+
+// Registrations lists every webhook the operator serves
+var Registrations = []Registration{
+	{
+		Name:          "model-injector.models.main-currents.news",
+		Path:          PathModelInjector,
+		Mutating:      true,
+		FailurePolicy: admissionregistrationv1.Ignore,
+		SideEffects:   admissionregistrationv1.SideEffectClassNone,
+		Rule: admissionregistrationv1.Rule{
+			APIGroups:   []string{""},
+			APIVersions: []string{"v1"},
+			Resources:   []string{"pods"},
+		},
+		Operations: []admissionregistrationv1.OperationType{admissionregistrationv1.Create},
+		// Leaves room for AnnotationWaitTimeout
+		TimeoutSeconds: ptr.To(int32(30)),
+	},
+	{
+		Name:          "model-overlay.models.main-currents.news",
+		Path:          PathModelOverlay,
+		Mutating:      true,
+		FailurePolicy: admissionregistrationv1.Fail,
+		SideEffects:   admissionregistrationv1.SideEffectClassNone,
+		Rule:          modelsRule,
+		Operations:    []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+	},
+	{
+		Name:          "model-audit.models.main-currents.news",
+		Path:          PathModelAudit,
+		FailurePolicy: admissionregistrationv1.Ignore,
+		SideEffects:   admissionregistrationv1.SideEffectClassNoneOnDryRun,
+		Rule:          modelsRule,
+		Operations:    []admissionregistrationv1.OperationType{admissionregistrationv1.Create, admissionregistrationv1.Update},
+	},
+	{
+		Name:          "model-retention.models.main-currents.news",
+		Path:          PathModelRetention,
+		FailurePolicy: admissionregistrationv1.Fail,
+		SideEffects:   admissionregistrationv1.SideEffectClassNone,
+		Rule:          modelsRule,
+		Operations:    []admissionregistrationv1.OperationType{admissionregistrationv1.Delete},
+	},
+}
+
+// Configurations builds the webhook configurations for Registrations, calling
+// the webhook service with the given name and namespace
+func Configurations(service, namespace string) (*admissionregistrationv1.MutatingWebhookConfiguration,
+	*admissionregistrationv1.ValidatingWebhookConfiguration) {
+	mutating := &admissionregistrationv1.MutatingWebhookConfiguration{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "MutatingWebhookConfiguration"},
+		ObjectMeta: metav1.ObjectMeta{Name: "mutating-webhook-configuration"},
+	}
+	validating := &admissionregistrationv1.ValidatingWebhookConfiguration{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "admissionregistration.k8s.io/v1", Kind: "ValidatingWebhookConfiguration"},
+		ObjectMeta: metav1.ObjectMeta{Name: "validating-webhook-configuration"},
+	}
+
+	for _, reg := range Registrations {
+		clientConfig := admissionregistrationv1.WebhookClientConfig{
+			Service: &admissionregistrationv1.ServiceReference{
+				Name:      service,
+				Namespace: namespace,
+				Path:      ptr.To(reg.Path),
+			},
+		}
+		rules := []admissionregistrationv1.RuleWithOperations{{Operations: reg.Operations, Rule: reg.Rule}}
+
+		if reg.Mutating {
+			mutating.Webhooks = append(mutating.Webhooks, admissionregistrationv1.MutatingWebhook{
+				Name:                    reg.Name,
+				ClientConfig:            clientConfig,
+				Rules:                   rules,
+				FailurePolicy:           ptr.To(reg.FailurePolicy),
+				SideEffects:             ptr.To(reg.SideEffects),
+				TimeoutSeconds:          reg.TimeoutSeconds,
+				AdmissionReviewVersions: []string{"v1"},
+			})
+			continue
+		}
+		validating.Webhooks = append(validating.Webhooks, admissionregistrationv1.ValidatingWebhook{
+			Name:                    reg.Name,
+			ClientConfig:            clientConfig,
+			Rules:                   rules,
+			FailurePolicy:           ptr.To(reg.FailurePolicy),
+			SideEffects:             ptr.To(reg.SideEffects),
+			TimeoutSeconds:          reg.TimeoutSeconds,
+			AdmissionReviewVersions: []string{"v1"},
+		})
+	}
+	return mutating, validating
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"sigs.k8s.io/yaml"
+)
+
+// TestConfigurations_MatchMarkers checks the registration table against the
+// configurations controller-gen generates from the kubebuilder markers
+func TestConfigurations_MatchMarkers(t *testing.T) {
+	raw, err := os.ReadFile(filepath.Join("..", "..", "config", "webhook", "manifests.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantMutating := &admissionregistrationv1.MutatingWebhookConfiguration{}
+	wantValidating := &admissionregistrationv1.ValidatingWebhookConfiguration{}
+	for _, doc := range strings.Split(string(raw), "\n---\n") {
+		switch {
+		case strings.Contains(doc, "kind: MutatingWebhookConfiguration"):
+			err = yaml.UnmarshalStrict([]byte(doc), wantMutating)
+		case strings.Contains(doc, "kind: ValidatingWebhookConfiguration"):
+			err = yaml.UnmarshalStrict([]byte(doc), wantValidating)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	mutating, validating := Configurations("webhook-service", "system")
+	if !equality.Semantic.DeepEqual(mutating, wantMutating) {
+		gotYAML, _ := yaml.Marshal(mutating)
+		t.Errorf("MutatingWebhookConfiguration differs from the markers, got:\n%s", gotYAML)
+	}
+	if !equality.Semantic.DeepEqual(validating, wantValidating) {
+		gotYAML, _ := yaml.Marshal(validating)
+		t.Errorf("ValidatingWebhookConfiguration differs from the markers, got:\n%s", gotYAML)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bundle promotes Models between clusters.
+//
+// A bundle is exported from a Ready Model and holds its spec, pinned to the
+// source the content was downloaded from, and a manifest of that content.
+// Importing the bundle creates a Model that expects the same content digest,
+// so the download in the target cluster fails unless it reproduces the
+// validated content exactly. A bundle may also point at a copy of the
+// content, such as the published OCI image or an uploaded tarball.
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+)
+
+// Kind is the kind of a bundle document
+const Kind = "ModelBundle"
+
+// Bundle is a portable description of a validated Model
+type Bundle struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Name is the name of the exported Model
+	Name string `json:"name"`
+
+	// Spec is the Model spec, with the source pinned to the one the content
+	// was downloaded from
+	Spec modelsv1alpha1.ModelSpec `json:"spec"`
+
+	// Manifest identifies the exported content
+	Manifest Manifest `json:"manifest"`
+
+	// Artifact is where a copy of the content can be fetched from, if any
+	Artifact *Artifact `json:"artifact,omitempty"`
+}
+
+// Manifest identifies the content of an exported Model
+type Manifest struct {
+	// ContentDigest is the digest of the content's file manifest
+	ContentDigest string `json:"contentDigest"`
+
+	// Version is the Model's spec.version
+	Version string `json:"version,omitempty"`
+
+	// DownloadedFrom names the source the content was downloaded from
+	DownloadedFrom string `json:"downloadedFrom,omitempty"`
+
+	// ExportedAt is when the bundle was exported
+	ExportedAt metav1.Time `json:"exportedAt"`
+}
+
+// Artifact locates a copy of the exported content
+type Artifact struct {
+	// Image is the OCI image the content was published as, pinned by digest
+	Image string `json:"image,omitempty"`
+
+	// URL is where a tarball of the content was uploaded
+	URL string `json:"url,omitempty"`
+}
+
+// Export returns the bundle of a Ready Model. artifactURL optionally records
+// where a tarball of the content was uploaded.
+func Export(model *modelsv1alpha1.Model, artifactURL string, now time.Time) (*Bundle, error) {
+	if model.Status.Phase != modelsv1alpha1.ModelPhaseReady {
+		return nil, fmt.Errorf("model %s is %s, only Ready models can be exported", model.Name, model.Status.Phase)
+	}
+	if model.Status.ContentDigest == "" {
+		return nil, fmt.Errorf("model %s has no content digest", model.Name)
+	}
+
+	// Pin the source that produced the content; another fallback may not
+	spec := resources.ForSource(model, model.Status.SourceIndex).Spec.DeepCopy()
+	spec.Source.Fallbacks = nil
+
+	b := &Bundle{
+		TypeMeta: metav1.TypeMeta{APIVersion: modelsv1alpha1.GroupVersion.String(), Kind: Kind},
+		Name:     model.Name,
+		Spec:     *spec,
+		Manifest: Manifest{
+			ContentDigest:  model.Status.ContentDigest,
+			Version:        model.Spec.Version,
+			DownloadedFrom: model.Status.DownloadedFrom,
+			ExportedAt:     metav1.NewTime(now.UTC().Truncate(time.Second)),
+		},
+	}
+
+	artifact := &Artifact{URL: artifactURL}
+	if pub := model.Status.Publication; pub != nil && pub.ContentDigest == model.Status.ContentDigest {
+		artifact.Image = pub.Image
+	}
+	if artifact.Image != "" || artifact.URL != "" {
+		b.Artifact = artifact
+	}
+	return b, nil
+}
+
+// Parse reads a bundle from YAML or JSON
+func Parse(data []byte) (*Bundle, error) {
+	b := &Bundle{}
+	if err := yaml.UnmarshalStrict(data, b); err != nil {
+		return nil, fmt.Errorf("failed to parse bundle: %w", err)
+	}
+	if b.Kind != Kind || b.APIVersion != modelsv1alpha1.GroupVersion.String() {
+		return nil, fmt.Errorf("not a %s/%s document", modelsv1alpha1.GroupVersion, Kind)
+	}
+	if b.Name == "" {
+		return nil, errors.New("bundle has no name")
+	}
+	if b.Manifest.ContentDigest == "" {
+		return nil, errors.New("bundle has no content digest")
+	}
+	return b, nil
+}
+
+// Marshal returns the bundle as YAML
+func (b *Bundle) Marshal() ([]byte, error) {
+	return yaml.Marshal(b)
+}
+
+// Model returns the Model that imports the bundle into namespace. It expects
+// the exported content digest.
+func (b *Bundle) Model(namespace string) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		TypeMeta: metav1.TypeMeta{APIVersion: modelsv1alpha1.GroupVersion.String(), Kind: "Model"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      b.Name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				modelsv1alpha1.AnnotationExpectedContentDigest: b.Manifest.ContentDigest,
+			},
+		},
+		Spec: *b.Spec.DeepCopy(),
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+var digest = "sha256:" + strings.Repeat("4f", 32)
+
+func readyModel() *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-3-8b", Namespace: "staging"},
+		Spec: modelsv1alpha1.ModelSpec{
+			Source: modelsv1alpha1.ModelSource{
+				HuggingFace: &modelsv1alpha1.HuggingFaceSource{RepoID: "meta-llama/Llama-3-8B"},
+				Fallbacks: []modelsv1alpha1.FallbackSource{
+					{S3: &modelsv1alpha1.S3Source{Bucket: "mirror", Key: "llama-3-8b/"}},
+				},
+			},
+			Storage: modelsv1alpha1.StorageSpec{StorageClass: "longhorn", Size: "20Gi"},
+			Version: "1.0",
+		},
+		Status: modelsv1alpha1.ModelStatus{
+			Phase:          modelsv1alpha1.ModelPhaseReady,
+			ContentDigest:  digest,
+			DownloadedFrom: "primary",
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	b, err := Export(readyModel(), "s3://promotions/llama-3-8b.tar", now)
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	if b.Kind != Kind || b.APIVersion != "models.main-currents.news/v1alpha1" {
+		t.Errorf("Export() type = %s/%s, want models.main-currents.news/v1alpha1/%s", b.APIVersion, b.Kind, Kind)
+	}
+	if b.Spec.Source.HuggingFace == nil || b.Spec.Source.Fallbacks != nil {
+		t.Errorf("Export() source = %+v, want only the primary source", b.Spec.Source)
+	}
+	want := Manifest{ContentDigest: digest, Version: "1.0", DownloadedFrom: "primary", ExportedAt: metav1.NewTime(now)}
+	if !b.Manifest.ExportedAt.Equal(&want.ExportedAt) || b.Manifest.ContentDigest != want.ContentDigest ||
+		b.Manifest.Version != want.Version || b.Manifest.DownloadedFrom != want.DownloadedFrom {
+		t.Errorf("Export() manifest = %+v, want %+v", b.Manifest, want)
+	}
+	if b.Artifact == nil || b.Artifact.URL != "s3://promotions/llama-3-8b.tar" || b.Artifact.Image != "" {
+		t.Errorf("Export() artifact = %+v, want only the tarball URL", b.Artifact)
+	}
+}
+
+func TestExport_PinsFallbackSource(t *testing.T) {
+	model := readyModel()
+	model.Status.SourceIndex = 1
+	model.Status.DownloadedFrom = "fallbacks[0]"
+
+	b, err := Export(model, "", time.Now())
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if b.Spec.Source.S3 == nil || b.Spec.Source.HuggingFace != nil || b.Spec.Source.Fallbacks != nil {
+		t.Errorf("Export() source = %+v, want only the S3 fallback", b.Spec.Source)
+	}
+	if b.Artifact != nil {
+		t.Errorf("Export() artifact = %+v, want nil", b.Artifact)
+	}
+}
+
+func TestExport_PublishedImage(t *testing.T) {
+	model := readyModel()
+	model.Status.Publication = &modelsv1alpha1.PublicationStatus{
+		Image:         "registry.internal/models/llama@sha256:" + strings.Repeat("9e", 32),
+		ContentDigest: digest,
+	}
+
+	b, err := Export(model, "", time.Now())
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if b.Artifact == nil || b.Artifact.Image != model.Status.Publication.Image {
+		t.Errorf("Export() artifact = %+v, want the published image", b.Artifact)
+	}
+
+	// An image of older content is not a copy of this content
+	model.Status.Publication.ContentDigest = "sha256:" + strings.Repeat("00", 32)
+	if b, _ := Export(model, "", time.Now()); b.Artifact != nil {
+		t.Errorf("Export() artifact = %+v, want nil for a stale publication", b.Artifact)
+	}
+}
+
+func TestExport_NotReady(t *testing.T) {
+	model := readyModel()
+	model.Status.Phase = modelsv1alpha1.ModelPhaseDownloading
+	if _, err := Export(model, "", time.Now()); err == nil {
+		t.Errorf("Export() of a downloading model should fail")
+	}
+
+	model = readyModel()
+	model.Status.ContentDigest = ""
+	if _, err := Export(model, "", time.Now()); err == nil {
+		t.Errorf("Export() without a content digest should fail")
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	exported, err := Export(readyModel(), "", time.Now())
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	data, err := exported.Marshal()
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	b, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	model := b.Model("prod")
+	if model.Name != "llama-3-8b" || model.Namespace != "prod" {
+		t.Errorf("Model() = %s/%s, want prod/llama-3-8b", model.Namespace, model.Name)
+	}
+	if got := model.Annotations[modelsv1alpha1.AnnotationExpectedContentDigest]; got != digest {
+		t.Errorf("Model() expected digest = %v, want %v", got, digest)
+	}
+	if model.Spec.Source.HuggingFace.RepoID != "meta-llama/Llama-3-8B" || model.Spec.Storage.Size != "20Gi" {
+		t.Errorf("Model() spec = %+v, want the exported spec", model.Spec)
+	}
+}
+
+func TestParse_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"other kind", "apiVersion: models.main-currents.news/v1alpha1\nkind: Model\nname: llama\nmanifest:\n  contentDigest: " + digest},
+		{"no digest", "apiVersion: models.main-currents.news/v1alpha1\nkind: ModelBundle\nname: llama"},
+		{"unknown field", "apiVersion: models.main-currents.news/v1alpha1\nkind: ModelBundle\nname: llama\nstatus: {}"},
+	}
+	for _, tt := range tests {
+		if _, err := Parse([]byte(tt.data)); err == nil {
+			t.Errorf("Parse(%s) should fail", tt.name)
+		}
+	}
+}
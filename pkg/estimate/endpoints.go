@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// awsS3Host matches the virtual-hosted bucket addresses of AWS S3 that
+// S3BucketURL builds for sources without a custom endpoint
+var awsS3Host = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*\.s3\.[a-z0-9-]+\.amazonaws\.com$`)
+
+// RestrictClient returns a copy of httpClient that only sends requests,
+// redirects included, to AWS S3 buckets and to URLs at or below the allowed
+// endpoints. Sources name their endpoints and the requests carry the Model's
+// credentials, so the operator must not contact endpoints a Model author
+// picks freely. Refused requests fail with ErrUnsupported.
+func RestrictClient(httpClient *http.Client, allowedEndpoints []string) (*http.Client, error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	t := &allowlistTransport{next: httpClient.Transport}
+	if t.next == nil {
+		t.next = http.DefaultTransport
+	}
+	for _, endpoint := range allowedEndpoints {
+		u, err := parseEndpoint(endpoint)
+		if err != nil {
+			return nil, err
+		}
+		t.allowed = append(t.allowed, u)
+	}
+	restricted := *httpClient
+	restricted.Transport = t
+	return &restricted, nil
+}
+
+// parseEndpoint parses an allowed endpoint, which is an http or https URL
+// without credentials, query or fragment. The trailing slash is dropped so
+// the path compares as a prefix.
+func parseEndpoint(endpoint string) (*url.URL, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid endpoint %q: %w", endpoint, err)
+	}
+	switch {
+	case u.Scheme != "http" && u.Scheme != "https":
+		return nil, fmt.Errorf("endpoint %q must be an http or https URL", endpoint)
+	case u.Host == "":
+		return nil, fmt.Errorf("endpoint %q has no host", endpoint)
+	case u.User != nil:
+		return nil, errors.New("endpoint must not contain credentials")
+	case u.RawQuery != "" || u.ForceQuery || u.Fragment != "" || u.RawFragment != "":
+		return nil, fmt.Errorf("endpoint %q must not have a query or fragment", endpoint)
+	}
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = ""
+	return u, nil
+}
+
+// allowlistTransport refuses requests outside its allowed endpoints
+type allowlistTransport struct {
+	next    http.RoundTripper
+	allowed []*url.URL
+}
+
+func (t *allowlistTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.allows(req.URL) {
+		return nil, fmt.Errorf("%w: %s is not an allowed endpoint", ErrUnsupported, req.URL.Redacted())
+	}
+	return t.next.RoundTrip(req)
+}
+
+func (t *allowlistTransport) allows(u *url.URL) bool {
+	if u.User != nil || slices.ContainsFunc(strings.Split(u.Path, "/"), func(segment string) bool {
+		return segment == "." || segment == ".."
+	}) {
+		return false
+	}
+	if u.Scheme == "https" && awsS3Host.MatchString(u.Host) {
+		return true
+	}
+	for _, endpoint := range t.allowed {
+		if u.Scheme == endpoint.Scheme && strings.EqualFold(u.Host, endpoint.Host) &&
+			(u.Path == endpoint.Path || strings.HasPrefix(u.Path, endpoint.Path+"/")) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package estimate predicts how much a Model source will download before the
+// download starts, so storage can be sized and capacity planned up front.
+//
+// Each source type has its own estimator: HuggingFace asks the Hub API for
+// file sizes, S3 lists the objects under the key, URL sends a HEAD request
+// and Git resolves the ref with ls-remote and reads LFS pointer sizes from
+// the Hub for Hugging Face hosted repos. DVC sources are not estimated.
+// Source returns the estimate for whichever source a ModelSource sets.
+//
+// Sources name their own endpoints, so the Estimator only contacts AWS S3 and
+// the endpoints the operator allows.
+package estimate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/modelcard"
+)
+
+// maxResponseSize bounds the API responses read by the estimators
+const maxResponseSize = 16 << 20
+
+// ErrUnsupported is returned when a source's size cannot be known without
+// downloading it, or without contacting an endpoint that is not allowed
+var ErrUnsupported = errors.New("size estimation is not supported for this source")
+
+// Estimate is the expected size of a download
+type Estimate struct {
+	// SizeBytes is the total size of the files that will be downloaded
+	SizeBytes int64
+	// Files is the number of files that will be downloaded
+	Files int
+	// Revision is the resolved source revision, if the source has one
+	Revision string
+}
+
+// ETA returns how long the download takes at bytesPerSecond, with
+// downloaded bytes already done. It returns 0 if the rate is unknown.
+func (e Estimate) ETA(downloaded int64, bytesPerSecond float64) time.Duration {
+	remaining := e.SizeBytes - downloaded
+	if bytesPerSecond <= 0 || remaining <= 0 {
+		return 0
+	}
+	return time.Duration(float64(remaining) / bytesPerSecond * float64(time.Second))
+}
+
+// Credentials are the keys of a Model's credentials Secret, such as HF_TOKEN,
+// AWS_ACCESS_KEY_ID and GIT_USERNAME
+type Credentials map[string]string
+
+// Estimator estimates the download size of Model sources
+type Estimator interface {
+	// Estimate returns the estimate for the source set in source
+	Estimate(ctx context.Context, source modelsv1alpha1.ModelSource, creds Credentials) (*Estimate, error)
+}
+
+// NewEstimator returns an Estimator that queries sources with httpClient,
+// restricted with RestrictClient to AWS S3 and the allowed endpoints. Without
+// any, only the public Hugging Face Hub is allowed.
+func NewEstimator(httpClient *http.Client, allowedEndpoints []string) (Estimator, error) {
+	if len(allowedEndpoints) == 0 {
+		allowedEndpoints = []string{modelcard.DefaultEndpoint}
+	}
+	client, err := RestrictClient(httpClient, allowedEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	return &sourceEstimator{client: client}, nil
+}
+
+type sourceEstimator struct {
+	client *http.Client
+}
+
+func (e *sourceEstimator) Estimate(ctx context.Context, source modelsv1alpha1.ModelSource, creds Credentials) (*Estimate, error) {
+	switch {
+	case source.HuggingFace != nil:
+		return HuggingFace(ctx, e.client, source.HuggingFace, creds["HF_TOKEN"])
+	case source.S3 != nil:
+		return S3(ctx, e.client, source.S3, creds)
+	case source.URL != nil:
+		return URL(ctx, e.client, source.URL)
+	case source.Git != nil:
+		return Git(ctx, e.client, source.Git, creds)
+	case source.DVC != nil:
+		// The sizes are in the project's .dvc files, which need a clone to read
+		return nil, ErrUnsupported
+	default:
+		return nil, errors.New("no source specified")
+	}
+}
+
+// get sends req and returns the body of a 2xx response
+func get(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("%s %s: %s", req.Method, req.URL.Redacted(), resp.Status)
+	}
+	return body, nil
+}
+
+// filter keeps the names matching an include pattern, if any, and no
+// exclude pattern. Patterns are shell globs where * also matches /, as
+// huggingface_hub and git sparse checkout treat them.
+func filter(name string, include, exclude []string) bool {
+	if len(include) > 0 && !matchAny(name, include) {
+		return false
+	}
+	return !matchAny(name, exclude)
+}
+
+func matchAny(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if globRegexp(pattern).MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// globRegexp compiles a shell glob to an anchored regular expression
+func globRegexp(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for _, r := range pattern {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/modelcard"
+)
+
+// commitPattern matches a full commit SHA
+var commitPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+
+// Git estimates a Git download. The ref is resolved to a commit with
+// ls-remote over smart HTTP; Hugging Face hosted repos are then sized from
+// the LFS pointer sizes the Hub reports for that commit. Other hosts return
+// ErrUnsupported, as sizing them needs a clone.
+func Git(ctx context.Context, client *http.Client, src *modelsv1alpha1.GitSource, creds Credentials) (*Estimate, error) {
+	commit, err := lsRemote(ctx, client, src.URL, src.Ref, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	repoURL, err := url.Parse(src.URL)
+	if err != nil {
+		return nil, err
+	}
+	hub, _ := url.Parse(modelcard.DefaultEndpoint)
+	if repoURL.Host != hub.Host {
+		return nil, fmt.Errorf("%w: %s is not hosted on the Hugging Face Hub (at %s)", ErrUnsupported, src.URL, commit)
+	}
+
+	repoID := strings.TrimSuffix(strings.Trim(repoURL.Path, "/"), ".git")
+	estimate, err := hubEstimate(ctx, client, modelcard.DefaultEndpoint, repoID, commit, creds["GIT_PASSWORD"],
+		src.Include, src.Exclude)
+	if err != nil {
+		return nil, err
+	}
+	estimate.Revision = commit
+	return estimate, nil
+}
+
+// lsRemote resolves ref to a commit from the refs advertised by the
+// git-upload-pack service, like git ls-remote. An empty ref resolves HEAD.
+func lsRemote(ctx context.Context, client *http.Client, repoURL, ref string, creds Credentials) (string, error) {
+	if commitPattern.MatchString(ref) {
+		return ref, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		strings.TrimSuffix(repoURL, "/")+"/info/refs?service=git-upload-pack", nil)
+	if err != nil {
+		return "", err
+	}
+	if username, password := creds["GIT_USERNAME"], creds["GIT_PASSWORD"]; password != "" {
+		req.SetBasicAuth(username, password)
+	}
+	body, err := get(client, req)
+	if err != nil {
+		return "", err
+	}
+
+	refs, err := parseAdvertisedRefs(body)
+	if err != nil {
+		return "", err
+	}
+	candidates := []string{"HEAD"}
+	if ref != "" {
+		candidates = []string{ref, "refs/heads/" + ref, "refs/tags/" + ref + "^{}", "refs/tags/" + ref}
+	}
+	for _, name := range candidates {
+		if commit, ok := refs[name]; ok {
+			return commit, nil
+		}
+	}
+	return "", fmt.Errorf("ref %q not found in %s", ref, repoURL)
+}
+
+// parseAdvertisedRefs parses the pkt-line ref advertisement of the smart
+// HTTP protocol into a map of ref name to object ID
+func parseAdvertisedRefs(data []byte) (map[string]string, error) {
+	refs := map[string]string{}
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, fmt.Errorf("truncated pkt-line")
+		}
+		length, err := strconv.ParseUint(string(data[:4]), 16, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pkt-line length %q", data[:4])
+		}
+		// A flush packet separates the service line from the refs
+		if length == 0 {
+			data = data[4:]
+			continue
+		}
+		if int(length) > len(data) || length < 4 {
+			return nil, fmt.Errorf("truncated pkt-line")
+		}
+		line := bytes.TrimSuffix(data[4:length], []byte("\n"))
+		data = data[length:]
+
+		if bytes.HasPrefix(line, []byte("#")) {
+			continue
+		}
+		// The first ref carries the capabilities after a NUL
+		line, _, _ = bytes.Cut(line, []byte{0})
+		oid, name, ok := bytes.Cut(line, []byte(" "))
+		if ok {
+			refs[string(name)] = string(oid)
+		}
+	}
+	return refs, nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/modelcard"
+)
+
+// hubRepo is the subset of the Hub model API response that is used
+type hubRepo struct {
+	SHA      string `json:"sha"`
+	Siblings []struct {
+		Name string `json:"rfilename"`
+		Size int64  `json:"size"`
+		LFS  *struct {
+			Size int64 `json:"size"`
+		} `json:"lfs"`
+	} `json:"siblings"`
+}
+
+// HuggingFace estimates a Hugging Face download from the file sizes the Hub
+// API reports, applying the source's include and exclude patterns. The token
+// is only needed for gated or private repos.
+func HuggingFace(ctx context.Context, client *http.Client, src *modelsv1alpha1.HuggingFaceSource, token string) (*Estimate, error) {
+	return hubEstimate(ctx, client, src.Endpoint, src.RepoID, src.Revision, token, src.Include, src.Exclude)
+}
+
+// hubEstimate sums the sizes of the files of repoID at revision
+func hubEstimate(ctx context.Context, client *http.Client, endpoint, repoID, revision, token string,
+	include, exclude []string) (*Estimate, error) {
+	if endpoint == "" {
+		endpoint = modelcard.DefaultEndpoint
+	}
+	if revision == "" {
+		revision = "main"
+	}
+	apiURL := fmt.Sprintf("%s/api/models/%s/revision/%s?blobs=true",
+		strings.TrimSuffix(endpoint, "/"), repoID, url.PathEscape(revision))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	body, err := get(client, req)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := &hubRepo{}
+	if err := json.Unmarshal(body, repo); err != nil {
+		return nil, fmt.Errorf("failed to parse model info: %w", err)
+	}
+	estimate := &Estimate{Revision: repo.SHA}
+	for _, file := range repo.Siblings {
+		if !filter(file.Name, include, exclude) {
+			continue
+		}
+		size := file.Size
+		if file.LFS != nil && file.LFS.Size > 0 {
+			size = file.LFS.Size
+		}
+		estimate.SizeBytes += size
+		estimate.Files++
+	}
+	return estimate, nil
+}
@@ -0,0 +1,199 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimate
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+const (
+	// defaultS3Region is used when the source does not set a region
+	defaultS3Region = "us-east-1"
+	// emptyPayloadHash is the SHA-256 of an empty request body
+	emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+var (
+	// s3BucketPattern matches AWS S3 bucket names
+	s3BucketPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+	// s3RegionPattern matches AWS region names
+	s3RegionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
+)
+
+// listBucketResult is the subset of the ListObjectsV2 response that is used
+type listBucketResult struct {
+	Contents []struct {
+		Key  string `xml:"Key"`
+		Size int64  `xml:"Size"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// S3Object is an object listed under an S3 source key
+type S3Object struct {
+	// Key is the full object key
+	Key string
+	// Size is the object size in bytes
+	Size int64
+}
+
+// S3 estimates an S3 download by listing the objects under the source key
+// with ListS3
+func S3(ctx context.Context, client *http.Client, src *modelsv1alpha1.S3Source, creds Credentials) (*Estimate, error) {
+	objects, err := ListS3(ctx, client, src, creds)
+	if err != nil {
+		return nil, err
+	}
+	estimate := &Estimate{Files: len(objects)}
+	for _, object := range objects {
+		estimate.SizeBytes += object.Size
+	}
+	return estimate, nil
+}
+
+// S3Region returns the region of an S3 source
+func S3Region(src *modelsv1alpha1.S3Source) string {
+	if src.Region == "" {
+		return defaultS3Region
+	}
+	return src.Region
+}
+
+// S3BucketURL returns the URL of the source's bucket. A custom endpoint is
+// addressed path-style, as S3-compatible stores expect. On AWS the bucket and
+// region are part of the host, so they must be valid names.
+func S3BucketURL(src *modelsv1alpha1.S3Source) (*url.URL, error) {
+	if src.Endpoint != "" {
+		return url.Parse(strings.TrimSuffix(src.Endpoint, "/") + "/" + url.PathEscape(src.Bucket))
+	}
+	if !s3BucketPattern.MatchString(src.Bucket) {
+		return nil, fmt.Errorf("invalid S3 bucket name %q", src.Bucket)
+	}
+	if !s3RegionPattern.MatchString(S3Region(src)) {
+		return nil, fmt.Errorf("invalid S3 region %q", src.Region)
+	}
+	return url.Parse(fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", src.Bucket, S3Region(src)))
+}
+
+// ListS3 lists the objects under the source key with ListObjectsV2, leaving
+// out directory placeholders. Requests are signed with AWS_ACCESS_KEY_ID and
+// AWS_SECRET_ACCESS_KEY when set, and anonymous otherwise.
+func ListS3(ctx context.Context, client *http.Client, src *modelsv1alpha1.S3Source, creds Credentials) ([]S3Object, error) {
+	base, err := S3BucketURL(src)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []S3Object
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}, "prefix": {src.Key}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		listURL := *base
+		listURL.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, listURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+		signV4(req, creds, S3Region(src), time.Now().UTC())
+		body, err := get(client, req)
+		if err != nil {
+			return nil, err
+		}
+
+		page := &listBucketResult{}
+		if err := xml.Unmarshal(body, page); err != nil {
+			return nil, fmt.Errorf("failed to parse object list: %w", err)
+		}
+		for _, object := range page.Contents {
+			// Directory placeholders hold no data
+			if strings.HasSuffix(object.Key, "/") && object.Size == 0 {
+				continue
+			}
+			objects = append(objects, S3Object{Key: object.Key, Size: object.Size})
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return objects, nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+// signV4 signs an S3 GET request with AWS Signature Version 4, leaving it
+// anonymous when creds has no access key
+func signV4(req *http.Request, creds Credentials, region string, now time.Time) {
+	accessKey, secretKey := creds["AWS_ACCESS_KEY_ID"], creds["AWS_SECRET_ACCESS_KEY"]
+	if accessKey == "" || secretKey == "" {
+		return
+	}
+
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-content-sha256:%s\nx-amz-date:%s\n",
+		req.URL.Host, emptyPayloadHash, amzDate)
+	if sessionToken := creds["AWS_SESSION_TOKEN"]; sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", sessionToken)
+		signedHeaders += ";x-amz-security-token"
+		canonicalHeaders += "x-amz-security-token:" + sessionToken + "\n"
+	}
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method, path, req.URL.RawQuery, canonicalHeaders, signedHeaders, emptyPayloadHash,
+	}, "\n")
+
+	scope := date + "/" + region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := []byte("AWS4" + secretKey)
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKey, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
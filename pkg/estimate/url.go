@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package estimate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// URL estimates a single file download from the Content-Length of a HEAD
+// request, falling back to a one byte range request for servers that do not
+// answer HEAD with a length
+func URL(ctx context.Context, client *http.Client, src *modelsv1alpha1.URLSource) (*Estimate, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, src.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 200 && resp.StatusCode <= 299 && resp.ContentLength >= 0 {
+		return &Estimate{SizeBytes: resp.ContentLength, Files: 1}, nil
+	}
+
+	req, err = http.NewRequestWithContext(ctx, http.MethodGet, src.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Range", "bytes=0-0")
+	resp, err = client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusPartialContent {
+		return nil, fmt.Errorf("%s: %s without a content length", req.URL.Redacted(), resp.Status)
+	}
+
+	// Content-Range: bytes 0-0/<total>
+	_, total, ok := strings.Cut(resp.Header.Get("Content-Range"), "/")
+	size, err := strconv.ParseInt(total, 10, 64)
+	if !ok || err != nil {
+		return nil, fmt.Errorf("%s: unknown total size in Content-Range %q", req.URL.Redacted(), resp.Header.Get("Content-Range"))
+	}
+	return &Estimate{SizeBytes: size, Files: 1}, nil
+}
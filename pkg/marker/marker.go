@@ -0,0 +1,231 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package marker defines the completion marker contract between the model
+// downloader and model consumers.
+//
+// When a download finishes, the downloader writes a manifest of every file
+// (path and size) to .model-operator/manifest.txt and a completion marker to
+// .model-operator/complete.json containing the source revision, a digest of
+// the manifest, and a timestamp. Applications and init containers should treat
+// a model directory as ready only when the marker exists.
+package marker
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Dir is the metadata directory written at the root of the model volume
+	Dir = ".model-operator"
+	// FileName is the completion marker file inside Dir
+	FileName = "complete.json"
+	// ManifestFileName is the file manifest inside Dir
+	ManifestFileName = "manifest.txt"
+
+	// TerminationMessagePath is where the downloader reports the digest
+	TerminationMessagePath = "/dev/termination-log"
+
+	// digestPrefix identifies the digest algorithm
+	digestPrefix = "sha256:"
+
+	// shortDigestLength is the number of hex characters in a ShortDigest
+	shortDigestLength = 40
+)
+
+// ErrNotComplete is returned when the completion marker does not exist
+var ErrNotComplete = errors.New("model download is not complete")
+
+// Marker is the content of the completion marker file
+type Marker struct {
+	// Revision is the source revision that was downloaded (branch, tag, commit)
+	Revision string `json:"revision,omitempty"`
+	// Version is the Model's spec.version at download time
+	Version string `json:"version,omitempty"`
+	// Digest is the sha256 digest of the file manifest
+	Digest string `json:"digest"`
+	// Timestamp is when the download completed
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Path returns the path of the completion marker under root
+func Path(root string) string {
+	return filepath.Join(root, Dir, FileName)
+}
+
+// ManifestPath returns the path of the file manifest under root
+func ManifestPath(root string) string {
+	return filepath.Join(root, Dir, ManifestFileName)
+}
+
+// Read reads the completion marker under root.
+// It returns ErrNotComplete if the marker does not exist.
+func Read(root string) (*Marker, error) {
+	data, err := os.ReadFile(Path(root))
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotComplete
+		}
+		return nil, err
+	}
+
+	m := &Marker{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("invalid completion marker %s: %w", Path(root), err)
+	}
+	return m, nil
+}
+
+// Write writes the completion marker under root
+func Write(root string, m Marker) error {
+	if err := os.MkdirAll(filepath.Join(root, Dir), 0o755); err != nil {
+		return err
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(Path(root), append(data, '\n'), 0o644)
+}
+
+// IsComplete reports whether a valid completion marker exists under root
+func IsComplete(root string) bool {
+	_, err := Read(root)
+	return err == nil
+}
+
+// BuildManifest returns the manifest for the files under root: one
+// "./<path> <size>" line per regular file, excluding Dir, sorted bytewise.
+func BuildManifest(root string) ([]byte, error) {
+	var lines []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if rel == Dir {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		lines = append(lines, fmt.Sprintf("./%s %d", filepath.ToSlash(rel), info.Size()))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(lines)
+	var b strings.Builder
+	for _, l := range lines {
+		b.WriteString(l)
+		b.WriteByte('\n')
+	}
+	return []byte(b.String()), nil
+}
+
+// Digest returns the digest of a manifest
+func Digest(manifest []byte) string {
+	sum := sha256.Sum256(manifest)
+	return digestPrefix + hex.EncodeToString(sum[:])
+}
+
+// ParseDigest validates a digest produced by Digest, tolerating surrounding whitespace
+func ParseDigest(s string) (string, error) {
+	s = strings.TrimSpace(s)
+	hexPart, ok := strings.CutPrefix(s, digestPrefix)
+	if !ok || len(hexPart) != sha256.Size*2 {
+		return "", fmt.Errorf("invalid digest %q", s)
+	}
+	if _, err := hex.DecodeString(hexPart); err != nil {
+		return "", fmt.Errorf("invalid digest %q: %w", s, err)
+	}
+	return s, nil
+}
+
+// ShortDigest returns the leading hex characters of a digest, short enough
+// to be used as a label value
+func ShortDigest(digest string) string {
+	hexPart := strings.TrimPrefix(digest, digestPrefix)
+	if len(hexPart) > shortDigestLength {
+		return hexPart[:shortDigestLength]
+	}
+	return hexPart
+}
+
+// Verify recomputes the manifest under root and checks it against the digest
+// recorded in the completion marker
+func Verify(root string) error {
+	m, err := Read(root)
+	if err != nil {
+		return err
+	}
+	manifest, err := BuildManifest(root)
+	if err != nil {
+		return err
+	}
+	if got := Digest(manifest); got != m.Digest {
+		return fmt.Errorf("model content digest mismatch: marker has %s, files have %s", m.Digest, got)
+	}
+	return nil
+}
+
+// ReadManifest returns the manifest entries (path to size) written by the downloader
+func ReadManifest(root string) (map[string]int64, error) {
+	f, err := os.Open(ManifestPath(root))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = f.Close() }()
+
+	entries := make(map[string]int64)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		idx := strings.LastIndexByte(line, ' ')
+		if idx < 0 {
+			return nil, fmt.Errorf("invalid manifest line %q", line)
+		}
+		var size int64
+		if _, err := fmt.Sscan(line[idx+1:], &size); err != nil {
+			return nil, fmt.Errorf("invalid manifest line %q: %w", line, err)
+		}
+		entries[line[:idx]] = size
+	}
+	return entries, scanner.Err()
+}
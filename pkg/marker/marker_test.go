@@ -0,0 +1,260 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package marker
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFile(t *testing.T, root, rel, content string) {
+	t.Helper()
+	path := filepath.Join(root, rel)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	root := t.TempDir()
+
+	if _, err := Read(root); !errors.Is(err, ErrNotComplete) {
+		t.Fatalf("Read() error = %v, want ErrNotComplete", err)
+	}
+	if IsComplete(root) {
+		t.Fatal("IsComplete() = true before marker is written")
+	}
+
+	want := Marker{
+		Revision:  "main",
+		Version:   "3.1",
+		Digest:    "sha256:abc",
+		Timestamp: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	if err := Write(root, want); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	got, err := Read(root)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if *got != want {
+		t.Errorf("Read() = %+v, want %+v", *got, want)
+	}
+	if !IsComplete(root) {
+		t.Error("IsComplete() = false after marker is written")
+	}
+}
+
+func TestRead_Invalid(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, filepath.Join(Dir, FileName), "not json")
+
+	if _, err := Read(root); err == nil || errors.Is(err, ErrNotComplete) {
+		t.Errorf("Read() error = %v, want parse error", err)
+	}
+}
+
+func TestBuildManifest(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "config.json", "{}")
+	writeFile(t, root, "weights/model.safetensors", "12345")
+	writeFile(t, root, filepath.Join(Dir, ManifestFileName), "ignored")
+
+	manifest, err := BuildManifest(root)
+	if err != nil {
+		t.Fatalf("BuildManifest() error = %v", err)
+	}
+
+	want := "./config.json 2\n./weights/model.safetensors 5\n"
+	if string(manifest) != want {
+		t.Errorf("BuildManifest() = %q, want %q", manifest, want)
+	}
+
+	if d := Digest(manifest); !strings.HasPrefix(d, "sha256:") || len(d) != len("sha256:")+64 {
+		t.Errorf("Digest() = %v, want sha256 hex digest", d)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, "model.gguf", "weights")
+
+	manifest, err := BuildManifest(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(root, Marker{Digest: Digest(manifest), Timestamp: time.Now()}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := Verify(root); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+
+	writeFile(t, root, "model.gguf", "truncated")
+	if err := Verify(root); err == nil {
+		t.Error("Verify() should fail after content changes")
+	}
+}
+
+func TestReadManifest(t *testing.T) {
+	root := t.TempDir()
+	writeFile(t, root, filepath.Join(Dir, ManifestFileName), "./a b.json 10\n./c 20\n")
+
+	entries, err := ReadManifest(root)
+	if err != nil {
+		t.Fatalf("ReadManifest() error = %v", err)
+	}
+	if entries["./a b.json"] != 10 || entries["./c"] != 20 {
+		t.Errorf("ReadManifest() = %v", entries)
+	}
+}
+
+func TestScript(t *testing.T) {
+	script := Script("/models", "it's-main", "1.0")
+
+	if !strings.Contains(script, "/models") {
+		t.Errorf("Script should cd into the model root")
+	}
+	if !strings.Contains(script, Dir+"/"+FileName) {
+		t.Errorf("Script should write the completion marker")
+	}
+	if !strings.Contains(script, `"it'\''s-main"`) {
+		t.Errorf("Script should shell-escape the revision: %s", script)
+	}
+}
+
+func TestScriptReportsDigest(t *testing.T) {
+	script := Script("/models", "main", "")
+
+	if !strings.Contains(script, TerminationMessagePath) {
+		t.Errorf("Script should write the digest to the termination message: %s", script)
+	}
+}
+
+func TestReportCommand(t *testing.T) {
+	cmd := ReportCommand("/model")
+
+	if len(cmd) != 3 || cmd[0] != "sh" {
+		t.Fatalf("ReportCommand() = %v", cmd)
+	}
+	if !strings.Contains(cmd[2], "test -f /model/"+Dir+"/"+FileName) {
+		t.Errorf("ReportCommand should require the completion marker: %s", cmd[2])
+	}
+	if !strings.Contains(cmd[2], "/model/"+Dir+"/"+ManifestFileName) || !strings.Contains(cmd[2], TerminationMessagePath) {
+		t.Errorf("ReportCommand should report the manifest digest: %s", cmd[2])
+	}
+}
+
+func TestVerifyCommand(t *testing.T) {
+	cmd := VerifyCommand("/model")
+
+	if len(cmd) != 3 || cmd[0] != "sh" {
+		t.Fatalf("VerifyCommand() = %v", cmd)
+	}
+	if !strings.Contains(cmd[2], "cd /model && test -f "+Dir+"/"+FileName) {
+		t.Errorf("VerifyCommand should require the completion marker: %s", cmd[2])
+	}
+	if !strings.Contains(cmd[2], "! -path './"+Dir+"/*'") || !strings.Contains(cmd[2], `"\"digest\":\"$DIGEST\""`) {
+		t.Errorf("VerifyCommand should compare the recomputed manifest with the marker: %s", cmd[2])
+	}
+	if !strings.Contains(cmd[2], TerminationMessagePath) {
+		t.Errorf("VerifyCommand should report the digest: %s", cmd[2])
+	}
+}
+
+func TestSealScript(t *testing.T) {
+	script := SealScript("/models", "main", "1.0")
+
+	if !strings.Contains(script, "[ -f /models/"+Dir+"/"+FileName+" ]") {
+		t.Errorf("SealScript should check for an existing completion marker: %s", script)
+	}
+	if !strings.Contains(script, verifyScript("/models")) {
+		t.Errorf("SealScript should verify files that have a marker: %s", script)
+	}
+	if !strings.Contains(script, Script("/models", "main", "1.0")) {
+		t.Errorf("SealScript should write a marker for files that have none: %s", script)
+	}
+	if !strings.Contains(script, "no files under /models") {
+		t.Errorf("SealScript should fail on an empty volume: %s", script)
+	}
+}
+
+func TestParseDigest(t *testing.T) {
+	valid := Digest([]byte("./config.json 10\n"))
+
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"valid", valid, valid, false},
+		{"trailing newline", valid + "\n", valid, false},
+		{"missing prefix", strings.TrimPrefix(valid, "sha256:"), "", true},
+		{"truncated", valid[:20], "", true},
+		{"not hex", "sha256:" + strings.Repeat("z", 64), "", true},
+		{"empty", "", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDigest(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDigest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDigest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortDigest(t *testing.T) {
+	digest := Digest([]byte("./config.json 10\n"))
+	short := ShortDigest(digest)
+
+	if len(short) != 40 {
+		t.Errorf("len(ShortDigest()) = %v, want 40", len(short))
+	}
+	if !strings.HasPrefix(digest, "sha256:"+short) {
+		t.Errorf("ShortDigest() = %v, should prefix %v", short, digest)
+	}
+}
+
+func TestWaitCommand(t *testing.T) {
+	cmd := WaitCommand("/models/llama", 0)
+
+	if len(cmd) != 3 || cmd[0] != "sh" {
+		t.Fatalf("WaitCommand() = %v", cmd)
+	}
+	if !strings.Contains(cmd[2], "/models/llama/"+Dir+"/"+FileName) {
+		t.Errorf("WaitCommand should poll the marker path: %s", cmd[2])
+	}
+	if !strings.Contains(cmd[2], "sleep 1") {
+		t.Errorf("WaitCommand should clamp interval to 1s: %s", cmd[2])
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package marker
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Script returns a POSIX shell fragment that writes the manifest and the
+// completion marker under root. It only relies on find, stat, sort and
+// sha256sum so it runs in busybox and coreutils based downloader images.
+// The digest is also written to the container termination message so the
+// controller can record it without mounting the volume.
+func Script(root, revision, version string) string {
+	return fmt.Sprintf(`(cd %[1]s && \
+mkdir -p %[2]s && \
+find . -type f ! -path './%[2]s/*' -exec stat -c '%%n %%s' {} + | LC_ALL=C sort > %[2]s/%[3]s && \
+DIGEST="%[4]s$(sha256sum %[2]s/%[3]s | cut -d' ' -f1)" && \
+printf '{"revision":%[5]s,"version":%[6]s,"digest":"%%s","timestamp":"%%s"}\n' "$DIGEST" "$(date -u +%%Y-%%m-%%dT%%H:%%M:%%SZ)" > %[2]s/%[7]s && \
+{ printf '%%s' "$DIGEST" > %[8]s 2>/dev/null || true; })`,
+		root, Dir, ManifestFileName, digestPrefix,
+		shellJSON(revision), shellJSON(version), FileName, TerminationMessagePath)
+}
+
+// WaitCommand returns a container command that blocks until the completion
+// marker exists under root, for use in init containers of consuming pods
+func WaitCommand(root string, interval time.Duration) []string {
+	seconds := int(interval.Seconds())
+	if seconds < 1 {
+		seconds = 1
+	}
+	return []string{"sh", "-c", fmt.Sprintf(
+		`until [ -f %s/%s/%s ]; do echo "waiting for model in %s"; sleep %d; done`,
+		root, Dir, FileName, root, seconds)}
+}
+
+// ReportCommand returns a container command that fails unless the completion
+// marker exists under root, and otherwise reports the digest of the manifest
+// in the termination message, as Script does after a download
+func ReportCommand(root string) []string {
+	return []string{"sh", "-c", fmt.Sprintf(
+		`test -f %[1]s/%[2]s/%[3]s && DIGEST="%[4]s$(sha256sum %[1]s/%[2]s/%[5]s | cut -d' ' -f1)" && printf '%%s' "$DIGEST" > %[6]s`,
+		root, Dir, FileName, digestPrefix, ManifestFileName, TerminationMessagePath)}
+}
+
+// VerifyCommand returns a container command that recomputes the manifest of
+// the files under root and fails unless its digest matches the one recorded
+// in the completion marker. It reports the digest in the termination message.
+func VerifyCommand(root string) []string {
+	return []string{"sh", "-c", verifyScript(root)}
+}
+
+// SealScript returns a POSIX shell fragment for files put under root by
+// something other than the downloader, such as a backup restore. Files that
+// have a completion marker are checked as VerifyCommand does; files without
+// one get the manifest and marker written as Script does. It fails if root
+// holds no files.
+func SealScript(root, revision, version string) string {
+	return fmt.Sprintf(`(if [ -f %[1]s/%[2]s/%[3]s ]; then
+  (%[4]s) || { echo "files under %[1]s do not match their completion marker" >&2; exit 1; }
+elif [ -z "$(find %[1]s -type f ! -path '%[1]s/%[2]s/*' | head -n 1)" ]; then
+  echo "no files under %[1]s" >&2; exit 1
+else
+  %[5]s
+fi)`, root, Dir, FileName, verifyScript(root), Script(root, revision, version))
+}
+
+// verifyScript returns the shell fragment of VerifyCommand
+func verifyScript(root string) string {
+	return fmt.Sprintf(
+		`cd %[1]s && test -f %[2]s/%[3]s && \
+DIGEST="%[4]s$(find . -type f ! -path './%[2]s/*' -exec stat -c '%%n %%s' {} + | LC_ALL=C sort | sha256sum | cut -d' ' -f1)" && \
+grep -q "\"digest\":\"$DIGEST\"" %[2]s/%[3]s && \
+printf '%%s' "$DIGEST" > %[5]s`,
+		root, Dir, FileName, digestPrefix, TerminationMessagePath)
+}
+
+// shellJSON JSON-encodes s for use in a printf format string inside single quotes
+func shellJSON(s string) string {
+	data, _ := json.Marshal(s)
+	escaped := strings.ReplaceAll(string(data), "%", "%%")
+	return strings.ReplaceAll(escaped, "'", `'\''`)
+}
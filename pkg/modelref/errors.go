@@ -0,0 +1,80 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelref
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+)
+
+// NotFoundError is returned when the referenced Model does not exist
+type NotFoundError struct {
+	Ref types.NamespacedName
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("model %s not found", e.Ref)
+}
+
+// NotReadyError is returned when the Model exists but is not Ready yet
+type NotReadyError struct {
+	Ref   types.NamespacedName
+	Phase modelsv1alpha1.ModelPhase
+}
+
+func (e *NotReadyError) Error() string {
+	phase := e.Phase
+	if phase == "" {
+		phase = modelsv1alpha1.ModelPhasePending
+	}
+	return fmt.Sprintf("model %s is not ready (phase: %s)", e.Ref, phase)
+}
+
+// FailedError is returned when the Model download has failed
+type FailedError struct {
+	Ref     types.NamespacedName
+	Message string
+}
+
+func (e *FailedError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("model %s failed", e.Ref)
+	}
+	return fmt.Sprintf("model %s failed: %s", e.Ref, e.Message)
+}
+
+// IsNotFound reports whether err is or wraps a NotFoundError
+func IsNotFound(err error) bool {
+	var target *NotFoundError
+	return errors.As(err, &target)
+}
+
+// IsNotReady reports whether err is or wraps a NotReadyError
+func IsNotReady(err error) bool {
+	var target *NotReadyError
+	return errors.As(err, &target)
+}
+
+// IsFailed reports whether err is or wraps a FailedError
+func IsFailed(err error) bool {
+	var target *FailedError
+	return errors.As(err, &target)
+}
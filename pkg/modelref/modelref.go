@@ -0,0 +1,225 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package modelref lets other controllers consume Models without copying the
+// operator's naming and phase logic.
+//
+// ResolveMount returns the volume and mount a workload needs to read a Ready
+// Model, and WaitUntilReady blocks until a referenced Model becomes Ready.
+// Failures are reported as typed errors (NotFoundError, NotReadyError,
+// FailedError) that callers can inspect with errors.As or the Is* helpers.
+package modelref
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/internal/resources"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+// DefaultPollInterval is how often WaitUntilReady re-reads the Model
+const DefaultPollInterval = 5 * time.Second
+
+// Mount describes how a workload mounts a Model's volume
+type Mount struct {
+	// VolumeName is the pod volume name for the Model
+	VolumeName string
+	// ClaimName is the PVC holding the Model files
+	ClaimName string
+	// MountPath is where the Model is mounted in the container
+	MountPath string
+	// EnvPrefix is the prefix of the environment variables describing the Model
+	EnvPrefix string
+	// ContentDigest identifies the content on the volume, if reported
+	ContentDigest string
+}
+
+// Volume returns the read-only pod volume for the Model's PVC
+func (m *Mount) Volume() corev1.Volume {
+	return corev1.Volume{
+		Name: m.VolumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: m.ClaimName,
+				ReadOnly:  true,
+			},
+		},
+	}
+}
+
+// VolumeMount returns the container volume mount for the Model
+func (m *Mount) VolumeMount() corev1.VolumeMount {
+	return corev1.VolumeMount{
+		Name:      m.VolumeName,
+		MountPath: m.MountPath,
+		ReadOnly:  true,
+	}
+}
+
+// MountPath returns where a Model is mounted given an optional base path,
+// for callers that only have its name. See resources.ResolveMountPath for
+// the rules. It returns an empty path for a base that is relative or uses a
+// placeholder only ExpandMountPath can fill.
+func MountPath(modelName, base string) string {
+	mountPath, err := resources.ResolveMountPath(&modelsv1alpha1.Model{ObjectMeta: metav1.ObjectMeta{Name: modelName}}, base)
+	if err != nil {
+		return ""
+	}
+	return mountPath
+}
+
+// ExpandMountPath returns where a Model is mounted given an optional base
+// path, replacing the {name}, {namespace}, {version}, {digest} and
+// {digest:n} placeholders. See resources.ResolveMountPath for the rules.
+func ExpandMountPath(model *modelsv1alpha1.Model, base string) (string, error) {
+	return resources.ResolveMountPath(model, base)
+}
+
+// ResolveMount returns the mount for a Ready Model at its default path.
+// It returns a NotReadyError or FailedError if the Model is not Ready.
+func ResolveMount(model *modelsv1alpha1.Model) (*Mount, error) {
+	if err := checkReady(model); err != nil {
+		return nil, err
+	}
+
+	claimName := model.Status.PVCName
+	if claimName == "" {
+		claimName = resources.ClaimName(model)
+	}
+
+	return &Mount{
+		VolumeName:    resources.VolumeName(model.Name),
+		ClaimName:     claimName,
+		MountPath:     MountPath(model.Name, ""),
+		EnvPrefix:     resources.EnvVarPrefix(model.Name),
+		ContentDigest: model.Status.ContentDigest,
+	}, nil
+}
+
+// Get fetches the referenced Model, returning a NotFoundError if it does not exist
+func Get(ctx context.Context, c client.Reader, ref types.NamespacedName) (*modelsv1alpha1.Model, error) {
+	model := &modelsv1alpha1.Model{}
+	if err := c.Get(ctx, ref, model); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, &NotFoundError{Ref: ref}
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+// ListByContentDigest returns the Models in namespace whose downloaded content
+// has the given digest, for deduplicating downloads or cloning volumes.
+// An empty namespace lists across all namespaces.
+func ListByContentDigest(ctx context.Context, c client.Reader, namespace, digest string) ([]modelsv1alpha1.Model, error) {
+	opts := []client.ListOption{
+		client.MatchingLabels{modelsv1alpha1.LabelContentDigest: marker.ShortDigest(digest)},
+	}
+	if namespace != "" {
+		opts = append(opts, client.InNamespace(namespace))
+	}
+
+	models := &modelsv1alpha1.ModelList{}
+	if err := c.List(ctx, models, opts...); err != nil {
+		return nil, err
+	}
+
+	// The label holds a short digest, so confirm the full digest
+	var matches []modelsv1alpha1.Model
+	for _, model := range models.Items {
+		if model.Status.ContentDigest == digest {
+			matches = append(matches, model)
+		}
+	}
+	return matches, nil
+}
+
+// WaitOption configures WaitUntilReady
+type WaitOption func(*waitOptions)
+
+type waitOptions struct {
+	interval time.Duration
+}
+
+// WithPollInterval overrides DefaultPollInterval
+func WithPollInterval(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.interval = d
+	}
+}
+
+// WaitUntilReady polls the referenced Model until it is Ready and returns it.
+// It returns a FailedError as soon as the Model fails, and the last
+// NotFoundError or NotReadyError if ctx is done first. Use a context with a
+// deadline to bound the wait.
+func WaitUntilReady(ctx context.Context, c client.Reader, ref types.NamespacedName,
+	opts ...WaitOption) (*modelsv1alpha1.Model, error) {
+	o := waitOptions{interval: DefaultPollInterval}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	var model *modelsv1alpha1.Model
+	var lastErr error
+	err := wait.PollUntilContextCancel(ctx, o.interval, true, func(ctx context.Context) (bool, error) {
+		m, err := Get(ctx, c, ref)
+		if err != nil {
+			if IsNotFound(err) {
+				lastErr = err
+				return false, nil
+			}
+			return false, err
+		}
+		if err := checkReady(m); err != nil {
+			if IsFailed(err) {
+				return false, err
+			}
+			lastErr = err
+			return false, nil
+		}
+		model = m
+		return true, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil && lastErr != nil {
+			return nil, lastErr
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+// checkReady returns a typed error unless the Model is Ready
+func checkReady(model *modelsv1alpha1.Model) error {
+	ref := types.NamespacedName{Name: model.Name, Namespace: model.Namespace}
+	switch model.Status.Phase {
+	case modelsv1alpha1.ModelPhaseReady:
+		return nil
+	case modelsv1alpha1.ModelPhaseFailed:
+		return &FailedError{Ref: ref, Message: model.Status.Message}
+	default:
+		return &NotReadyError{Ref: ref, Phase: model.Status.Phase}
+	}
+}
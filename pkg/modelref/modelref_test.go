@@ -0,0 +1,241 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package modelref
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/marker"
+)
+
+func testModel(phase modelsv1alpha1.ModelPhase) *modelsv1alpha1.Model {
+	return &modelsv1alpha1.Model{
+		ObjectMeta: metav1.ObjectMeta{Name: "llama-3-8b", Namespace: "default"},
+		Status: modelsv1alpha1.ModelStatus{
+			Phase:   phase,
+			PVCName: "model-llama-3-8b",
+			Message: "Download failed",
+		},
+	}
+}
+
+func TestMountPath(t *testing.T) {
+	tests := []struct {
+		name string
+		base string
+		want string
+	}{
+		{"default", "", "/models/llama"},
+		{"placeholder", "/data/{name}/weights", "/data/llama/weights"},
+		{"base path", "/data/", "/data/llama"},
+		{"already suffixed", "/data/llama", "/data/llama"},
+		{"name as suffix of another element", "/opt/my-llama", "/opt/my-llama/llama"},
+		{"relative", "data", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MountPath("llama", tt.base); got != tt.want {
+				t.Errorf("MountPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveMount(t *testing.T) {
+	mount, err := ResolveMount(testModel(modelsv1alpha1.ModelPhaseReady))
+	if err != nil {
+		t.Fatalf("ResolveMount() error = %v", err)
+	}
+
+	if mount.VolumeName != "model-llama-3-8b" {
+		t.Errorf("VolumeName = %v, want %v", mount.VolumeName, "model-llama-3-8b")
+	}
+	if mount.ClaimName != "model-llama-3-8b" {
+		t.Errorf("ClaimName = %v, want %v", mount.ClaimName, "model-llama-3-8b")
+	}
+	if mount.MountPath != "/models/llama-3-8b" {
+		t.Errorf("MountPath = %v, want %v", mount.MountPath, "/models/llama-3-8b")
+	}
+	if mount.EnvPrefix != "MODEL_LLAMA_3_8B" {
+		t.Errorf("EnvPrefix = %v, want %v", mount.EnvPrefix, "MODEL_LLAMA_3_8B")
+	}
+
+	volume := mount.Volume()
+	if volume.PersistentVolumeClaim == nil || !volume.PersistentVolumeClaim.ReadOnly {
+		t.Errorf("Volume() should be a read-only PVC volume, got %+v", volume)
+	}
+	if vm := mount.VolumeMount(); vm.Name != volume.Name || !vm.ReadOnly {
+		t.Errorf("VolumeMount() = %+v, want read-only mount of %v", vm, volume.Name)
+	}
+}
+
+func TestResolveMountNotReady(t *testing.T) {
+	tests := []struct {
+		name  string
+		phase modelsv1alpha1.ModelPhase
+		check func(error) bool
+	}{
+		{"pending", modelsv1alpha1.ModelPhasePending, IsNotReady},
+		{"no phase", "", IsNotReady},
+		{"downloading", modelsv1alpha1.ModelPhaseDownloading, IsNotReady},
+		{"failed", modelsv1alpha1.ModelPhaseFailed, IsFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ResolveMount(testModel(tt.phase))
+			if !tt.check(err) {
+				t.Errorf("ResolveMount() error = %v, wrong type", err)
+			}
+		})
+	}
+}
+
+func TestErrorsWrapped(t *testing.T) {
+	ref := types.NamespacedName{Name: "llama", Namespace: "default"}
+	err := errors.Join(errors.New("context"), &NotFoundError{Ref: ref})
+
+	if !IsNotFound(err) {
+		t.Errorf("IsNotFound() = false for wrapped NotFoundError")
+	}
+	if IsNotReady(err) || IsFailed(err) {
+		t.Errorf("NotFoundError should not match other error types")
+	}
+	if got, want := (&NotFoundError{Ref: ref}).Error(), "model default/llama not found"; got != want {
+		t.Errorf("Error() = %v, want %v", got, want)
+	}
+}
+
+func TestListByContentDigest(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("ab", 32)
+	other := "sha256:" + strings.Repeat("ab", 20) + strings.Repeat("cd", 12)
+
+	withDigest := func(name, namespace, d string) *modelsv1alpha1.Model {
+		m := testModel(modelsv1alpha1.ModelPhaseReady)
+		m.Name = name
+		m.Namespace = namespace
+		m.Labels = map[string]string{modelsv1alpha1.LabelContentDigest: marker.ShortDigest(d)}
+		m.Status.ContentDigest = d
+		return m
+	}
+
+	c := newFakeClient(
+		withDigest("a", "default", digest),
+		withDigest("b", "other", digest),
+		withDigest("c", "default", other), // same short digest, different content
+	).Build()
+
+	models, err := ListByContentDigest(context.Background(), c, "default", digest)
+	if err != nil {
+		t.Fatalf("ListByContentDigest() error = %v", err)
+	}
+	if len(models) != 1 || models[0].Name != "a" {
+		t.Errorf("ListByContentDigest() = %v, want [a]", models)
+	}
+
+	models, err = ListByContentDigest(context.Background(), c, "", digest)
+	if err != nil {
+		t.Fatalf("ListByContentDigest() error = %v", err)
+	}
+	if len(models) != 2 {
+		t.Errorf("ListByContentDigest() across namespaces returned %d models, want 2", len(models))
+	}
+}
+
+func newFakeClient(objs ...*modelsv1alpha1.Model) *fake.ClientBuilder {
+	scheme := runtime.NewScheme()
+	_ = modelsv1alpha1.AddToScheme(scheme)
+	builder := fake.NewClientBuilder().WithScheme(scheme).WithStatusSubresource(&modelsv1alpha1.Model{})
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj)
+	}
+	return builder
+}
+
+func TestWaitUntilReady(t *testing.T) {
+	ref := types.NamespacedName{Name: "llama-3-8b", Namespace: "default"}
+
+	t.Run("ready", func(t *testing.T) {
+		c := newFakeClient(testModel(modelsv1alpha1.ModelPhaseReady)).Build()
+		model, err := WaitUntilReady(context.Background(), c, ref, WithPollInterval(time.Millisecond))
+		if err != nil {
+			t.Fatalf("WaitUntilReady() error = %v", err)
+		}
+		if model.Name != ref.Name {
+			t.Errorf("Name = %v, want %v", model.Name, ref.Name)
+		}
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		c := newFakeClient(testModel(modelsv1alpha1.ModelPhaseFailed)).Build()
+		_, err := WaitUntilReady(context.Background(), c, ref, WithPollInterval(time.Millisecond))
+		var failed *FailedError
+		if !errors.As(err, &failed) {
+			t.Fatalf("WaitUntilReady() error = %v, want FailedError", err)
+		}
+		if failed.Message != "Download failed" {
+			t.Errorf("Message = %v, want %v", failed.Message, "Download failed")
+		}
+	})
+
+	t.Run("times out not ready", func(t *testing.T) {
+		c := newFakeClient(testModel(modelsv1alpha1.ModelPhaseDownloading)).Build()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := WaitUntilReady(ctx, c, ref, WithPollInterval(time.Millisecond))
+		if !IsNotReady(err) {
+			t.Errorf("WaitUntilReady() error = %v, want NotReadyError", err)
+		}
+	})
+
+	t.Run("times out not found", func(t *testing.T) {
+		c := newFakeClient().Build()
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		_, err := WaitUntilReady(ctx, c, ref, WithPollInterval(time.Millisecond))
+		if !IsNotFound(err) {
+			t.Errorf("WaitUntilReady() error = %v, want NotFoundError", err)
+		}
+	})
+
+	t.Run("becomes ready", func(t *testing.T) {
+		c := newFakeClient(testModel(modelsv1alpha1.ModelPhaseDownloading)).Build()
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			model := testModel(modelsv1alpha1.ModelPhaseDownloading)
+			_ = c.Get(context.Background(), ref, model)
+			model.Status.Phase = modelsv1alpha1.ModelPhaseReady
+			_ = c.Status().Update(context.Background(), model)
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if _, err := WaitUntilReady(ctx, c, ref, WithPollInterval(time.Millisecond)); err != nil {
+			t.Errorf("WaitUntilReady() error = %v", err)
+		}
+	})
+}
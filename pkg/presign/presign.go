@@ -0,0 +1,184 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package presign turns an S3 source into short-lived download URLs, signed
+// by the operator at reconcile time, so download pods fetch the objects
+// without ever holding the long-lived credentials.
+//
+// URLs are presigned with AWS Signature Version 4 query parameters, which
+// S3, S3-compatible stores and the GCS XML API (with HMAC keys) accept.
+package presign
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	modelsv1alpha1 "github.com/rsJames-ttrpg/model-operator/api/v1alpha1"
+	"github.com/rsJames-ttrpg/model-operator/pkg/estimate"
+)
+
+const (
+	// DefaultExpiry is how long URLs stay valid when the source does not say
+	DefaultExpiry = time.Hour
+	// MaxExpiry is the longest validity SigV4 allows
+	MaxExpiry = 7 * 24 * time.Hour
+)
+
+// File is an object to download through a presigned URL
+type File struct {
+	// Path is where the object goes, relative to the model directory
+	Path string
+	// URL is the presigned GET URL of the object
+	URL string
+}
+
+// Presigner presigns the objects of S3 sources
+type Presigner interface {
+	// Presign lists the objects under the source key and presigns a GET URL
+	// for each that stays valid for expiry
+	Presign(ctx context.Context, src *modelsv1alpha1.S3Source, creds estimate.Credentials, expiry time.Duration) ([]File, error)
+}
+
+// NewPresigner returns a Presigner that lists objects with httpClient,
+// restricted with estimate.RestrictClient to AWS S3 and the allowed
+// S3-compatible endpoints
+func NewPresigner(httpClient *http.Client, allowedEndpoints []string) (Presigner, error) {
+	client, err := estimate.RestrictClient(httpClient, allowedEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	return &s3Presigner{client: client}, nil
+}
+
+type s3Presigner struct {
+	client *http.Client
+}
+
+func (p *s3Presigner) Presign(ctx context.Context, src *modelsv1alpha1.S3Source, creds estimate.Credentials, expiry time.Duration) ([]File, error) {
+	if creds["AWS_ACCESS_KEY_ID"] == "" || creds["AWS_SECRET_ACCESS_KEY"] == "" {
+		return nil, errors.New("presigning requires AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY in the credentials Secret")
+	}
+
+	objects, err := estimate.ListS3(ctx, p.client, src, creds)
+	if err != nil {
+		return nil, err
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("no objects found under s3://%s/%s", src.Bucket, src.Key)
+	}
+	base, err := estimate.S3BucketURL(src)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	files := make([]File, 0, len(objects))
+	for _, object := range objects {
+		filePath, err := relativePath(src.Key, object.Key)
+		if err != nil {
+			return nil, err
+		}
+		objectURL := *base
+		objectURL.Path = strings.TrimSuffix(base.Path, "/") + "/" + object.Key
+		objectURL.RawPath = strings.TrimSuffix(base.EscapedPath(), "/") + "/" + escapePath(object.Key)
+		files = append(files, File{
+			Path: filePath,
+			URL:  presignV4(&objectURL, creds, estimate.S3Region(src), expiry, now),
+		})
+	}
+	return files, nil
+}
+
+// relativePath returns where an object goes relative to the model directory,
+// like aws s3 cp --recursive: objects keep their path below the key, and a
+// key naming a single object keeps its base name
+func relativePath(key, objectKey string) (string, error) {
+	rel := strings.TrimPrefix(strings.TrimPrefix(objectKey, key), "/")
+	if rel == "" {
+		rel = path.Base(objectKey)
+	}
+	rel = path.Clean(rel)
+	if rel == ".." || strings.HasPrefix(rel, "../") || path.IsAbs(rel) {
+		return "", fmt.Errorf("object key %q escapes the model directory", objectKey)
+	}
+	return rel, nil
+}
+
+// presignV4 returns u presigned for a GET with AWS Signature Version 4 query
+// parameters, valid for expiry from now
+func presignV4(u *url.URL, creds estimate.Credentials, region string, expiry time.Duration, now time.Time) string {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	scope := date + "/" + region + "/s3/aws4_request"
+
+	query := u.Query()
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", creds["AWS_ACCESS_KEY_ID"]+"/"+scope)
+	query.Set("X-Amz-Date", amzDate)
+	query.Set("X-Amz-Expires", strconv.Itoa(int(min(expiry, MaxExpiry).Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+	if sessionToken := creds["AWS_SESSION_TOKEN"]; sessionToken != "" {
+		query.Set("X-Amz-Security-Token", sessionToken)
+	}
+	// AWS encodes spaces as %20, where Go's query encoding uses +
+	rawQuery := strings.ReplaceAll(query.Encode(), "+", "%20")
+
+	canonicalPath := u.EscapedPath()
+	if canonicalPath == "" {
+		canonicalPath = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		http.MethodGet, canonicalPath, rawQuery, "host:" + u.Host + "\n", "host", "UNSIGNED-PAYLOAD",
+	}, "\n")
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	key := []byte("AWS4" + creds["AWS_SECRET_ACCESS_KEY"])
+	for _, part := range []string{date, region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	presigned := *u
+	presigned.RawQuery = rawQuery + "&X-Amz-Signature=" + signature
+	return presigned.String()
+}
+
+// escapePath escapes an object key the way SigV4 canonical URIs expect: every
+// byte but unreserved characters and slashes is percent-encoded
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = strings.ReplaceAll(url.QueryEscape(segment), "+", "%20")
+	}
+	return strings.Join(segments, "/")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}